@@ -4,7 +4,9 @@ import type { Plugin, ViteDevServer } from "vite";
 import { parse as tomlParse, stringify as tomlStringify } from "smol-toml";
 import type {
   CommandTable,
+  Dialogue,
   DropTable,
+  Dungeon,
   EnemyTable,
   Item,
   ItemGroup,
@@ -12,6 +14,7 @@ import type {
   Member,
   Profession,
   Prop,
+  Quest,
   Recipe,
   SpriteSheet,
   Tile,
@@ -45,7 +48,7 @@ interface SpriteSheetInfo {
   sprites: SpriteInfo[];
 }
 
-// スプライトシートの名前→Aseprite JSONパスの対応をraw.tomlから取得し、
+// スプライトシートの名前→Aseprite JSONパスの対応をローデータから取得し、
 // スプライトキー一覧を返す
 class SpriteCache {
   private cache = new Map<string, SpriteSheetInfo>();
@@ -74,7 +77,7 @@ class SpriteCache {
   }
 }
 
-// raw.toml のルート構造。キーは camelCase
+// ローデータのルート構造。キーは camelCase
 interface Raws {
   items?: Item[];
   recipes?: Recipe[];
@@ -88,6 +91,9 @@ interface Raws {
   tiles?: Tile[];
   props?: Prop[];
   professions?: Profession[];
+  dungeons?: Dungeon[];
+  dialogues?: Dialogue[];
+  quests?: Quest[];
 }
 
 // パレット TOML 構造
@@ -164,24 +170,66 @@ function sortAll(raws: Raws): void {
   if (raws.tiles) sortByName(raws.tiles, "name");
   if (raws.props) sortByName(raws.props, "name");
   if (raws.professions) sortByName(raws.professions, "id");
+  // ダンジョンは定義順がそのまま選択画面の並びになるため並べ替えない
+  if (raws.dialogues) sortByName(raws.dialogues, "id");
+  if (raws.quests) sortByName(raws.quests, "id");
 }
 
-// raw.toml の読み書き
+// 本体のローデータの配置。コレクションごとに1つの断片へ置く。Go の raw.fragmentLayout と揃える
+const rawLayout: Record<keyof Raws, string> = {
+  items: "items/items.toml",
+  recipes: "items/recipes.toml",
+  members: "members/members.toml",
+  professions: "members/professions.toml",
+  dialogues: "members/dialogues.toml",
+  props: "props/props.toml",
+  tiles: "props/tiles.toml",
+  commandTables: "tables/command_tables.toml",
+  dropTables: "tables/drop_tables.toml",
+  itemGroups: "tables/item_groups.toml",
+  itemTables: "tables/item_tables.toml",
+  enemyTables: "tables/enemy_tables.toml",
+  spriteSheets: "sprite_sheets.toml",
+  dungeons: "dungeons.toml",
+  quests: "quests.toml",
+};
+
+// ローデータの断片の読み書き
 // 毎回ファイルから読み込むことで、外部編集との競合を防ぐ
 class RawStore {
-  constructor(private filePath: string) {}
+  constructor(private dir: string) {}
 
   private load(): Raws {
-    const content = fs.readFileSync(this.filePath, "utf-8");
-    const raws = tomlParse(content) as Raws;
-    sortAll(raws);
-    return raws;
+    const raws: Record<string, unknown[]> = {};
+    for (const file of new Set(Object.values(rawLayout))) {
+      const filePath = path.join(this.dir, file);
+      if (!fs.existsSync(filePath)) continue;
+      const frag = tomlParse(fs.readFileSync(filePath, "utf-8")) as Record<
+        string,
+        unknown[]
+      >;
+      for (const [key, entries] of Object.entries(frag)) {
+        raws[key] = [...(raws[key] ?? []), ...entries];
+      }
+    }
+    sortAll(raws as unknown as Raws);
+    return raws as unknown as Raws;
   }
 
   private save(raws: Raws): void {
     sortAll(raws);
-    const content = tomlStringify(raws as unknown as Record<string, unknown>);
-    fs.writeFileSync(this.filePath, content, "utf-8");
+    const frags = new Map<string, Record<string, unknown>>();
+    for (const [key, file] of Object.entries(rawLayout)) {
+      const frag = frags.get(file) ?? {};
+      const entries = raws[key as keyof Raws];
+      if (entries && entries.length > 0) frag[key] = entries;
+      frags.set(file, frag);
+    }
+    for (const [file, frag] of frags) {
+      const filePath = path.join(this.dir, file);
+      fs.mkdirSync(path.dirname(filePath), { recursive: true });
+      fs.writeFileSync(filePath, tomlStringify(frag), "utf-8");
+    }
   }
 
   getSlice(key: keyof Raws): unknown[] {
@@ -370,6 +418,9 @@ const RESOURCE_MAP: Record<
   "item-tables": { key: "itemTables", idField: "name", hasGet: false },
   "enemy-tables": { key: "enemyTables", idField: "name", hasGet: false },
   "sprite-sheets": { key: "spriteSheets", idField: "name", hasGet: false },
+  dungeons: { key: "dungeons", idField: "name", hasGet: false },
+  dialogues: { key: "dialogues", idField: "id", hasGet: false },
+  quests: { key: "quests", idField: "id", hasGet: false },
 };
 
 // リクエストボディを読み取る
@@ -396,7 +447,7 @@ async function readJson<T = unknown>(
 }
 
 interface ApiPluginOptions {
-  rawTomlPath: string;
+  rawDir: string;
   palettesDir: string;
   layoutsDir: string;
   chunkDirs: string[];
@@ -404,7 +455,7 @@ interface ApiPluginOptions {
 }
 
 export function editorApiPlugin(options: ApiPluginOptions): Plugin {
-  const rawTomlPath = path.resolve(options.rawTomlPath);
+  const rawDir = path.resolve(options.rawDir);
   const palettesDir = path.resolve(options.palettesDir);
   const layoutsDir = path.resolve(options.layoutsDir);
   const chunkDirs = options.chunkDirs.map((d) => path.resolve(d));
@@ -415,7 +466,7 @@ export function editorApiPlugin(options: ApiPluginOptions): Plugin {
   return {
     name: "editor-api",
     configureServer(server: ViteDevServer) {
-      const rawStore = new RawStore(rawTomlPath);
+      const rawStore = new RawStore(rawDir);
       const paletteStore = new PaletteStore(palettesDir);
       const layoutStore = new LayoutStore(layoutsDir);
       const spriteCache = new SpriteCache(assetsDir);
@@ -15,6 +15,8 @@ const sections: NavSection[] = [
       { path: "/props", label: "置物" },
       { path: "/recipes", label: "レシピ" },
       { path: "/professions", label: "職業" },
+      { path: "/dialogues", label: "会話" },
+      { path: "/quests", label: "クエスト" },
     ],
   },
   {
@@ -31,6 +33,7 @@ const sections: NavSection[] = [
     label: "マップ",
     items: [
       { path: "/tiles", label: "タイル" },
+      { path: "/dungeons", label: "ダンジョン" },
       { path: "/palettes", label: "パレット" },
       { path: "/layouts", label: "レイアウト" },
     ],
@@ -421,6 +421,19 @@ export interface Book {
     'totalEffort': number;
     'skill'?: SkillBook;
 }
+/**
+ * オーバーワールドのチャンク座標
+ */
+export interface ChunkCoord {
+    /**
+     * チャンク座標の成分。東西は東進したチャンク数、南北は帯の行
+     */
+    'x': number;
+    /**
+     * チャンク座標の成分。東西は東進したチャンク数、南北は帯の行
+     */
+    'y': number;
+}
 /**
  * 戦闘ポリシー。エンティティの戦闘時の行動方針を定義する
  */
@@ -478,6 +491,19 @@ export interface Consumable {
 }
 
 
+/**
+ * 合成に使う作業場の種類
+ */
+
+export const CraftStation = {
+    Workbench: 'workbench',
+    Fire: 'fire',
+    Forge: 'forge',
+} as const;
+
+export type CraftStation = typeof CraftStation[keyof typeof CraftStation];
+
+
 /**
  * 会話データ
  */
@@ -487,6 +513,136 @@ export interface Dialog {
      */
     'messageKey': string;
 }
+/**
+ * 選択肢で分岐する会話。id はメンバーの dialog.messageKey から引き、先頭のノードから始める
+ */
+export interface Dialogue {
+    /**
+     * メッセージリソースのキー
+     */
+    'id': string;
+    'nodes': Array<DialogueNode>;
+}
+/**
+ * 会話の選択肢
+ */
+export interface DialogueChoice {
+    /**
+     * 会話の本文や選択肢の文言。英語原文で書き、ja.po の msgid にする。強調語は <keyword> で囲む
+     */
+    'text': string;
+    /**
+     * 選んだあとに進むノード。省略すると会話を終える
+     */
+    'next'?: string;
+    /**
+     * すべて満たすときだけ選択肢を出す
+     */
+    'conditions'?: Array<DialogueCondition>;
+    /**
+     * 選んだときに順に適用する効果
+     */
+    'effects'?: Array<DialogueEffect>;
+}
+/**
+ * 選択肢を出す条件。item、event、skill、relation、quest のどれか1つを指定する
+ */
+export interface DialogueCondition {
+    /**
+     * このアイテムを count 個以上持っている
+     */
+    'item'?: string;
+    /**
+     * item の必要数。省略すると1
+     */
+    'count'?: number;
+    /**
+     * このゲーム進行イベントを見ている
+     */
+    'event'?: string;
+    /**
+     * このスキルの値が level 以上
+     */
+    'skill'?: string;
+    /**
+     * skill の必要値。省略すると1
+     */
+    'level'?: number;
+    /**
+     * 話し手との派閥関係がこれに一致する
+     */
+    'relation'?: FactionRelation;
+    /**
+     * このクエストを受けている。status を指定するとその状態のときだけ満たす
+     */
+    'quest'?: string;
+    /**
+     * quest の状態。省略すると受けていればどの状態でも満たす
+     */
+    'status'?: QuestStatus;
+    /**
+     * 条件を反転するかどうか
+     */
+    'not'?: boolean;
+}
+/**
+ * 選択肢を選んだときの効果。giveItem、takeItem、setEvent、currency、openShop、startQuest のどれか1つを指定する
+ */
+export interface DialogueEffect {
+    /**
+     * プレイヤーにこのアイテムを count 個与える
+     */
+    'giveItem'?: string;
+    /**
+     * プレイヤーからこのアイテムを count 個取り上げる。足りなければ選択肢を出さない
+     */
+    'takeItem'?: string;
+    /**
+     * giveItem と takeItem の個数。省略すると1
+     */
+    'count'?: number;
+    /**
+     * このゲーム進行イベントを見たことにする
+     */
+    'setEvent'?: string;
+    /**
+     * プレイヤーの所持金を増減する。払えなければ選択肢を出さない
+     */
+    'currency'?: number;
+    /**
+     * 話し手の店を開くかどうか
+     */
+    'openShop'?: boolean;
+    /**
+     * このクエストを受ける。受けたことがあれば選択肢を出さない
+     */
+    'startQuest'?: string;
+}
+/**
+ * 会話一覧
+ */
+export interface DialogueList {
+    'data': Array<Dialogue>;
+    'totalCount': number;
+}
+/**
+ * 会話の1ページ
+ */
+export interface DialogueNode {
+    /**
+     * 会話ノードの ID。1つの会話の中で一意にする
+     */
+    'id': string;
+    /**
+     * 会話の本文や選択肢の文言。英語原文で書き、ja.po の msgid にする。強調語は <keyword> で囲む
+     */
+    'text': string;
+    /**
+     * 選択肢がないときに続けて表示するノード。省略すると会話を終える
+     */
+    'next'?: string;
+    'choices'?: Array<DialogueChoice>;
+}
 /**
  * 分解定義。これを持つ prop・item は対応工具で分解できる
  */
@@ -583,6 +739,72 @@ export interface DropTableList {
     'data': Array<DropTable>;
     'totalCount': number;
 }
+/**
+ * フロアを生成して潜るダンジョン。name はセーブに残るステージ名で、定義を引くキーになる
+ */
+export interface Dungeon {
+    /**
+     * エンティティ名
+     */
+    'name': string;
+    /**
+     * 説明文
+     */
+    'description'?: string;
+    /**
+     * 選択画面の背景画像のスプライトキー
+     */
+    'imageKey'?: string;
+    /**
+     * ダンジョンの総階層数。最終階がボスフロアになる
+     */
+    'totalFloors': number;
+    /**
+     * 敵を湧かせるテーブルの id。省略すると敵が湧かない
+     */
+    'enemyTableId'?: string;
+    /**
+     * アイテムを置くテーブルの id。省略するとアイテムを置かない
+     */
+    'itemTableId'?: string;
+    /**
+     * ダンジョンの基本気温（摂氏）
+     */
+    'baseTemperature': number;
+    /**
+     * 最終階で使うプランナー。省略するとボスフロアなし
+     */
+    'bossPlanner'?: string;
+    'planners': Array<DungeonPlanner>;
+    /**
+     * 各階で流す曲。省略すると既定のダンジョン曲を流す
+     */
+    'music'?: string;
+    /**
+     * ボスフロアで流す曲。省略すると既定のボス曲を流す
+     */
+    'bossMusic'?: string;
+}
+/**
+ * ダンジョン一覧
+ */
+export interface DungeonList {
+    'data': Array<Dungeon>;
+    'totalCount': number;
+}
+/**
+ * ダンジョンで使うマップ種類と出現重み
+ */
+export interface DungeonPlanner {
+    /**
+     * マップ生成プランナーの名前。mapplanner.PlannerTypeByName で引ける名前を書く
+     */
+    'planner': string;
+    /**
+     * テーブルエントリの重み。大きいほど選ばれやすい
+     */
+    'weight': number;
+}
 /**
  * 攻撃属性
  */
@@ -718,6 +940,19 @@ export const FactionMemberType = {
 export type FactionMemberType = typeof FactionMemberType[keyof typeof FactionMemberType];
 
 
+/**
+ * プレイヤーから見た話し手の派閥関係
+ */
+
+export const FactionRelation = {
+    Hostile: 'hostile',
+    Friendly: 'friendly',
+    Neutral: 'neutral',
+} as const;
+
+export type FactionRelation = typeof FactionRelation[keyof typeof FactionRelation];
+
+
 /**
  * 遠距離攻撃設定
  */
@@ -839,6 +1074,22 @@ export interface Item {
      * 携行光源。装備すると owner を照らす
      */
     'lightSource'?: LightSource;
+    /**
+     * 投擲設定。投げるの対象になる
+     */
+    'throwable'?: Throwable;
+    /**
+     * 手当ての治療量。使うと負傷を深い順に治す
+     */
+    'providesTreatment'?: number;
+    /**
+     * 鍵として開けられる錠の ID
+     */
+    'key'?: string;
+    /**
+     * 命中や拾得などで鳴らす効果音。省略した出来事は既定の音を鳴らす
+     */
+    'sounds'?: SoundSet;
 }
 /**
  * アイテムグループ。アイテムの出現セットを定義する
@@ -956,6 +1207,19 @@ export interface LightSource {
      */
     'enabled': boolean;
 }
+/**
+ * 錠ローデータ。付いた置物は鍵で開けるか、解錠か破錠で錠を外すまで開かない
+ */
+export interface LockRaw {
+    /**
+     * 錠の難度。機械スキルと工具のグレードを足した値と競う
+     */
+    'difficulty': number;
+    /**
+     * 合う鍵の ID。省略すると鍵では開かない
+     */
+    'keyId'?: string;
+}
 /**
  * 近接攻撃設定
  */
@@ -1017,6 +1281,7 @@ export interface Member {
     'factionType'?: FactionMemberType;
     'combatPolicy'?: CombatPolicyType;
     'movementPattern'?: MovementPatternType;
+    'planner'?: MemberPlannerType;
     /**
      * AI視界距離（タイル単位）
      */
@@ -1030,6 +1295,10 @@ export interface Member {
      * プレイヤーキャラクターでは省略可能
      */
     'dropTableId'?: string;
+    /**
+     * 素手や牙での攻撃の効果音。武器が音を持てばそちらを優先する
+     */
+    'sounds'?: SoundSet;
 }
 
 
@@ -1040,6 +1309,35 @@ export interface MemberList {
     'data': Array<Member>;
     'totalCount': number;
 }
+/**
+ * 行動計画の種類。群れで配置されたときの振る舞いを定義する
+ */
+
+export const MemberPlannerType = {
+    Solo: 'solo',
+    Squad: 'squad',
+} as const;
+
+export type MemberPlannerType = typeof MemberPlannerType[keyof typeof MemberPlannerType];
+
+
+/**
+ * 過去の run の記録から判定する節目。指定した項目をすべて満たすと到達する
+ */
+export interface Milestone {
+    /**
+     * 終えた run の数がこれ以上
+     */
+    'runs'?: number;
+    /**
+     * 最も長く生き延びた日数がこれ以上
+     */
+    'days'?: number;
+    /**
+     * 最高スコアがこれ以上
+     */
+    'score'?: number;
+}
 /**
  * エラーレスポンス
  */
@@ -1122,6 +1420,10 @@ export interface Profession {
     'skills'?: Array<ProfessionSkill>;
     'items': Array<ProfessionItem>;
     'equips': Array<ProfessionEquip>;
+    /**
+     * 到達すると選べるようになる節目。省略すると最初から選べる
+     */
+    'unlock'?: Milestone;
 }
 /**
  * 職業初期装備
@@ -1147,6 +1449,10 @@ export interface ProfessionItem {
      * アイテム所持数
      */
     'count': number;
+    /**
+     * 到達すると初期所持に加わる節目。省略すると最初から持つ
+     */
+    'unlock'?: Milestone;
 }
 /**
  * 職業一覧
@@ -1228,8 +1534,22 @@ export interface Prop {
      * 通信販売の出荷場所ローデータ。収納の中身を集荷対象にし、出荷場所メニューを開く相互作用が付く。積載量は storage で持つ
      */
     'shippingStation'?: object;
+    /**
+     * 錠。収納に付けると中を覗く前に外す必要がある
+     */
+    'lock'?: LockRaw;
     'disassembly'?: Disassembly;
+    /**
+     * 合成の作業場として使えること
+     */
+    'craftStation'?: CraftStation;
+    /**
+     * 扉を開けたときなどに鳴らす効果音
+     */
+    'sounds'?: SoundSet;
 }
+
+
 /**
  * 置物一覧
  */
@@ -1253,6 +1573,100 @@ export interface ProvidesHealing {
 }
 
 
+/**
+ * 目標を並べたクエスト。会話の startQuest で受け、目標をすべて満たすと報酬を受け取って終わる
+ */
+export interface Quest {
+    /**
+     * クエストの ID
+     */
+    'id': string;
+    /**
+     * エンティティ名
+     */
+    'name': string;
+    /**
+     * 説明文
+     */
+    'description': string;
+    'objectives': Array<QuestObjective>;
+    'reward'?: QuestReward;
+}
+/**
+ * クエスト一覧
+ */
+export interface QuestList {
+    'data': Array<Quest>;
+    'totalCount': number;
+}
+/**
+ * クエストの目標。collect、deliver、kill、reach、surviveDays のどれか1つを指定する
+ */
+export interface QuestObjective {
+    /**
+     * このアイテムを count 個持っている
+     */
+    'collect'?: string;
+    /**
+     * このアイテムを count 個、to のメンバーに話しかけて渡す
+     */
+    'deliver'?: string;
+    /**
+     * deliver の渡し先のメンバー id
+     */
+    'to'?: string;
+    /**
+     * このメンバーを count 体倒す
+     */
+    'kill'?: string;
+    /**
+     * collect、deliver、kill の数。省略すると1
+     */
+    'count'?: number;
+    /**
+     * オーバーワールドのこのチャンクに着く
+     */
+    'reach'?: ChunkCoord;
+    /**
+     * クエストを受けてから生き延びる日数
+     */
+    'surviveDays'?: number;
+}
+/**
+ * クエスト報酬
+ */
+export interface QuestReward {
+    /**
+     * クエスト達成で受け取る所持金
+     */
+    'currency'?: number;
+    'items'?: Array<QuestRewardItem>;
+}
+/**
+ * クエスト報酬のアイテム
+ */
+export interface QuestRewardItem {
+    /**
+     * エンティティの英語 id
+     */
+    'id': string;
+    /**
+     * アイテム所持数
+     */
+    'count': number;
+}
+/**
+ * クエストの進み具合
+ */
+
+export const QuestStatus = {
+    Active: 'active',
+    Completed: 'completed'
+} as const;
+
+export type QuestStatus = typeof QuestStatus[keyof typeof QuestStatus];
+
+
 /**
  * RGBA色
  */
@@ -1290,6 +1704,9 @@ export interface Raws {
     'tiles'?: Array<Tile>;
     'props'?: Array<Prop>;
     'professions'?: Array<Profession>;
+    'dungeons'?: Array<Dungeon>;
+    'dialogues'?: Array<Dialogue>;
+    'quests'?: Array<Quest>;
 }
 /**
  * レシピ
@@ -1304,7 +1721,21 @@ export interface Recipe {
      */
     'name': string;
     'inputs': Array<RecipeInput>;
+    /**
+     * 近くに要る作業場。省略するとどこでも合成できる
+     */
+    'station'?: CraftStation;
+    /**
+     * 所持品に要る工具。省略すると工具は要らない
+     */
+    'tool'?: RecipeTool;
+    /**
+     * 合成にかかる作業量。省略すると即座に仕上がる
+     */
+    'workAP'?: number;
 }
+
+
 /**
  * レシピ素材
  */
@@ -1325,6 +1756,18 @@ export interface RecipeList {
     'data': Array<Recipe>;
     'totalCount': number;
 }
+/**
+ * レシピの必要工具。分解工具の分類とグレードで判定する
+ */
+export interface RecipeTool {
+    'category': ToolCategory;
+    /**
+     * 必要な最低グレード
+     */
+    'grade': number;
+}
+
+
 /**
  * 能力値
  */
@@ -2178,14 +2621,39 @@ export interface SkillBook {
     'maxLevel': number;
 }
 /**
- * スプライト描画深度
+ * 出来事ごとの効果音の ID。省略した出来事は既定の音を鳴らす
  */
-
-export const SpriteDepth = {
-    NUMBER_0: 0,
-    NUMBER_1: 1,
-    NUMBER_2: 2,
-    NUMBER_3: 3,
+export interface SoundSet {
+    /**
+     * 攻撃が当たったとき
+     */
+    'hit'?: string;
+    /**
+     * 攻撃が外れたとき
+     */
+    'miss'?: string;
+    /**
+     * 拾ったとき
+     */
+    'pickup'?: string;
+    /**
+     * 装填し終えたとき
+     */
+    'reload'?: string;
+    /**
+     * 扉を開けたとき
+     */
+    'open'?: string;
+}
+/**
+ * スプライト描画深度
+ */
+
+export const SpriteDepth = {
+    NUMBER_0: 0,
+    NUMBER_1: 1,
+    NUMBER_2: 2,
+    NUMBER_3: 3,
 } as const;
 
 export type SpriteDepth = typeof SpriteDepth[keyof typeof SpriteDepth];
@@ -2243,6 +2711,10 @@ export interface StorageRaw {
      * 初期アイテム数のダイス表記。省略時は1
      */
     'lootCount'?: string;
+    /**
+     * 収納内を保つ気温。省略すると置き場所の気温と同じになる
+     */
+    'temperature'?: number;
 }
 /**
  * ターゲットグループ
@@ -2270,6 +2742,27 @@ export const TargetNum = {
 export type TargetNum = typeof TargetNum[keyof typeof TargetNum];
 
 
+/**
+ * 投擲設定
+ */
+export interface Throwable {
+    /**
+     * 投擲の射程（タイル単位）
+     */
+    'range': number;
+    /**
+     * 基本ダメージ
+     */
+    'damage': number;
+    /**
+     * 着弾時に壊れるかどうか
+     */
+    'breaksOnImpact': boolean;
+    /**
+     * 着弾点からの巻き込み半径（タイル単位）。0で着弾点だけ
+     */
+    'splash'?: number;
+}
 /**
  * タイル
  */
@@ -2659,6 +3152,297 @@ export class CommandTablesApi extends BaseAPI {
 
 
 
+/**
+ * DialoguesApi - axios parameter creator
+ */
+export const DialoguesApiAxiosParamCreator = function (configuration?: Configuration) {
+    return {
+        /**
+         * 会話作成
+         * @param {Dialogue} dialogue 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        dialoguesCreate: async (dialogue: Dialogue, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'dialogue' is not null or undefined
+            assertParamExists('dialoguesCreate', 'dialogue', dialogue)
+            const localVarPath = `/api/v1/dialogues`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+            localVarHeaderParameter['Accept'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(dialogue, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 会話削除
+         * @param {number} index 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        dialoguesDelete: async (index: number, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'index' is not null or undefined
+            assertParamExists('dialoguesDelete', 'index', index)
+            const localVarPath = `/api/v1/dialogues/{index}`
+                .replace('{index}', encodeURIComponent(String(index)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'DELETE', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            localVarHeaderParameter['Accept'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 会話一覧取得
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        dialoguesList: async (options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/api/v1/dialogues`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            localVarHeaderParameter['Accept'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * 会話更新
+         * @param {number} index 
+         * @param {Dialogue} dialogue 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        dialoguesUpdate: async (index: number, dialogue: Dialogue, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'index' is not null or undefined
+            assertParamExists('dialoguesUpdate', 'index', index)
+            // verify required parameter 'dialogue' is not null or undefined
+            assertParamExists('dialoguesUpdate', 'dialogue', dialogue)
+            const localVarPath = `/api/v1/dialogues/{index}`
+                .replace('{index}', encodeURIComponent(String(index)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'PUT', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+            localVarHeaderParameter['Accept'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(dialogue, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+    }
+};
+
+/**
+ * DialoguesApi - functional programming interface
+ */
+export const DialoguesApiFp = function(configuration?: Configuration) {
+    const localVarAxiosParamCreator = DialoguesApiAxiosParamCreator(configuration)
+    return {
+        /**
+         * 会話作成
+         * @param {Dialogue} dialogue 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async dialoguesCreate(dialogue: Dialogue, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Dialogue>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.dialoguesCreate(dialogue, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DialoguesApi.dialoguesCreate']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 会話削除
+         * @param {number} index 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async dialoguesDelete(index: number, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.dialoguesDelete(index, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DialoguesApi.dialoguesDelete']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 会話一覧取得
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async dialoguesList(options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<DialogueList>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.dialoguesList(options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DialoguesApi.dialoguesList']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * 会話更新
+         * @param {number} index 
+         * @param {Dialogue} dialogue 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async dialoguesUpdate(index: number, dialogue: Dialogue, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Dialogue>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.dialoguesUpdate(index, dialogue, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DialoguesApi.dialoguesUpdate']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+    }
+};
+
+/**
+ * DialoguesApi - factory interface
+ */
+export const DialoguesApiFactory = function (configuration?: Configuration, basePath?: string, axios?: AxiosInstance) {
+    const localVarFp = DialoguesApiFp(configuration)
+    return {
+        /**
+         * 会話作成
+         * @param {Dialogue} dialogue 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        dialoguesCreate(dialogue: Dialogue, options?: RawAxiosRequestConfig): AxiosPromise<Dialogue> {
+            return localVarFp.dialoguesCreate(dialogue, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 会話削除
+         * @param {number} index 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        dialoguesDelete(index: number, options?: RawAxiosRequestConfig): AxiosPromise<void> {
+            return localVarFp.dialoguesDelete(index, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 会話一覧取得
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        dialoguesList(options?: RawAxiosRequestConfig): AxiosPromise<DialogueList> {
+            return localVarFp.dialoguesList(options).then((request) => request(axios, basePath));
+        },
+        /**
+         * 会話更新
+         * @param {number} index 
+         * @param {Dialogue} dialogue 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        dialoguesUpdate(index: number, dialogue: Dialogue, options?: RawAxiosRequestConfig): AxiosPromise<Dialogue> {
+            return localVarFp.dialoguesUpdate(index, dialogue, options).then((request) => request(axios, basePath));
+        },
+    };
+};
+
+/**
+ * DialoguesApi - object-oriented interface
+ */
+export class DialoguesApi extends BaseAPI {
+    /**
+     * 会話作成
+     * @param {Dialogue} dialogue 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public dialoguesCreate(dialogue: Dialogue, options?: RawAxiosRequestConfig) {
+        return DialoguesApiFp(this.configuration).dialoguesCreate(dialogue, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 会話削除
+     * @param {number} index 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public dialoguesDelete(index: number, options?: RawAxiosRequestConfig) {
+        return DialoguesApiFp(this.configuration).dialoguesDelete(index, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 会話一覧取得
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public dialoguesList(options?: RawAxiosRequestConfig) {
+        return DialoguesApiFp(this.configuration).dialoguesList(options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * 会話更新
+     * @param {number} index 
+     * @param {Dialogue} dialogue 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public dialoguesUpdate(index: number, dialogue: Dialogue, options?: RawAxiosRequestConfig) {
+        return DialoguesApiFp(this.configuration).dialoguesUpdate(index, dialogue, options).then((request) => request(this.axios, this.basePath));
+    }
+}
+
+
+
 /**
  * DropTablesApi - axios parameter creator
  */
@@ -2828,123 +3612,414 @@ export const DropTablesApiFp = function(configuration?: Configuration) {
         async dropTablesDelete(index: number, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
             const localVarAxiosArgs = await localVarAxiosParamCreator.dropTablesDelete(index, options);
             const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
-            const localVarOperationServerBasePath = operationServerMap['DropTablesApi.dropTablesDelete']?.[localVarOperationServerIndex]?.url;
+            const localVarOperationServerBasePath = operationServerMap['DropTablesApi.dropTablesDelete']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * ドロップテーブル一覧取得
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async dropTablesList(options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<DropTableList>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.dropTablesList(options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DropTablesApi.dropTablesList']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * ドロップテーブル更新
+         * @param {number} index 
+         * @param {DropTable} dropTable 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async dropTablesUpdate(index: number, dropTable: DropTable, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<DropTable>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.dropTablesUpdate(index, dropTable, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DropTablesApi.dropTablesUpdate']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+    }
+};
+
+/**
+ * DropTablesApi - factory interface
+ */
+export const DropTablesApiFactory = function (configuration?: Configuration, basePath?: string, axios?: AxiosInstance) {
+    const localVarFp = DropTablesApiFp(configuration)
+    return {
+        /**
+         * ドロップテーブル作成
+         * @param {DropTable} dropTable 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        dropTablesCreate(dropTable: DropTable, options?: RawAxiosRequestConfig): AxiosPromise<DropTable> {
+            return localVarFp.dropTablesCreate(dropTable, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * ドロップテーブル削除
+         * @param {number} index 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        dropTablesDelete(index: number, options?: RawAxiosRequestConfig): AxiosPromise<void> {
+            return localVarFp.dropTablesDelete(index, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * ドロップテーブル一覧取得
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        dropTablesList(options?: RawAxiosRequestConfig): AxiosPromise<DropTableList> {
+            return localVarFp.dropTablesList(options).then((request) => request(axios, basePath));
+        },
+        /**
+         * ドロップテーブル更新
+         * @param {number} index 
+         * @param {DropTable} dropTable 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        dropTablesUpdate(index: number, dropTable: DropTable, options?: RawAxiosRequestConfig): AxiosPromise<DropTable> {
+            return localVarFp.dropTablesUpdate(index, dropTable, options).then((request) => request(axios, basePath));
+        },
+    };
+};
+
+/**
+ * DropTablesApi - object-oriented interface
+ */
+export class DropTablesApi extends BaseAPI {
+    /**
+     * ドロップテーブル作成
+     * @param {DropTable} dropTable 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public dropTablesCreate(dropTable: DropTable, options?: RawAxiosRequestConfig) {
+        return DropTablesApiFp(this.configuration).dropTablesCreate(dropTable, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * ドロップテーブル削除
+     * @param {number} index 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public dropTablesDelete(index: number, options?: RawAxiosRequestConfig) {
+        return DropTablesApiFp(this.configuration).dropTablesDelete(index, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * ドロップテーブル一覧取得
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public dropTablesList(options?: RawAxiosRequestConfig) {
+        return DropTablesApiFp(this.configuration).dropTablesList(options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * ドロップテーブル更新
+     * @param {number} index 
+     * @param {DropTable} dropTable 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public dropTablesUpdate(index: number, dropTable: DropTable, options?: RawAxiosRequestConfig) {
+        return DropTablesApiFp(this.configuration).dropTablesUpdate(index, dropTable, options).then((request) => request(this.axios, this.basePath));
+    }
+}
+
+
+
+/**
+ * DungeonsApi - axios parameter creator
+ */
+export const DungeonsApiAxiosParamCreator = function (configuration?: Configuration) {
+    return {
+        /**
+         * ダンジョン作成
+         * @param {Dungeon} dungeon 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        dungeonsCreate: async (dungeon: Dungeon, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'dungeon' is not null or undefined
+            assertParamExists('dungeonsCreate', 'dungeon', dungeon)
+            const localVarPath = `/api/v1/dungeons`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+            localVarHeaderParameter['Accept'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(dungeon, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * ダンジョン削除
+         * @param {number} index 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        dungeonsDelete: async (index: number, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'index' is not null or undefined
+            assertParamExists('dungeonsDelete', 'index', index)
+            const localVarPath = `/api/v1/dungeons/{index}`
+                .replace('{index}', encodeURIComponent(String(index)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'DELETE', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            localVarHeaderParameter['Accept'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * ダンジョン一覧取得
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        dungeonsList: async (options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/api/v1/dungeons`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            localVarHeaderParameter['Accept'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * ダンジョン更新
+         * @param {number} index 
+         * @param {Dungeon} dungeon 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        dungeonsUpdate: async (index: number, dungeon: Dungeon, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'index' is not null or undefined
+            assertParamExists('dungeonsUpdate', 'index', index)
+            // verify required parameter 'dungeon' is not null or undefined
+            assertParamExists('dungeonsUpdate', 'dungeon', dungeon)
+            const localVarPath = `/api/v1/dungeons/{index}`
+                .replace('{index}', encodeURIComponent(String(index)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'PUT', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+            localVarHeaderParameter['Accept'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(dungeon, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+    }
+};
+
+/**
+ * DungeonsApi - functional programming interface
+ */
+export const DungeonsApiFp = function(configuration?: Configuration) {
+    const localVarAxiosParamCreator = DungeonsApiAxiosParamCreator(configuration)
+    return {
+        /**
+         * ダンジョン作成
+         * @param {Dungeon} dungeon 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async dungeonsCreate(dungeon: Dungeon, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Dungeon>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.dungeonsCreate(dungeon, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DungeonsApi.dungeonsCreate']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * ダンジョン削除
+         * @param {number} index 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async dungeonsDelete(index: number, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.dungeonsDelete(index, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['DungeonsApi.dungeonsDelete']?.[localVarOperationServerIndex]?.url;
             return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
         },
         /**
-         * ドロップテーブル一覧取得
+         * ダンジョン一覧取得
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        async dropTablesList(options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<DropTableList>> {
-            const localVarAxiosArgs = await localVarAxiosParamCreator.dropTablesList(options);
+        async dungeonsList(options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<DungeonList>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.dungeonsList(options);
             const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
-            const localVarOperationServerBasePath = operationServerMap['DropTablesApi.dropTablesList']?.[localVarOperationServerIndex]?.url;
+            const localVarOperationServerBasePath = operationServerMap['DungeonsApi.dungeonsList']?.[localVarOperationServerIndex]?.url;
             return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
         },
         /**
-         * ドロップテーブル更新
+         * ダンジョン更新
          * @param {number} index 
-         * @param {DropTable} dropTable 
+         * @param {Dungeon} dungeon 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        async dropTablesUpdate(index: number, dropTable: DropTable, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<DropTable>> {
-            const localVarAxiosArgs = await localVarAxiosParamCreator.dropTablesUpdate(index, dropTable, options);
+        async dungeonsUpdate(index: number, dungeon: Dungeon, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Dungeon>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.dungeonsUpdate(index, dungeon, options);
             const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
-            const localVarOperationServerBasePath = operationServerMap['DropTablesApi.dropTablesUpdate']?.[localVarOperationServerIndex]?.url;
+            const localVarOperationServerBasePath = operationServerMap['DungeonsApi.dungeonsUpdate']?.[localVarOperationServerIndex]?.url;
             return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
         },
     }
 };
 
 /**
- * DropTablesApi - factory interface
+ * DungeonsApi - factory interface
  */
-export const DropTablesApiFactory = function (configuration?: Configuration, basePath?: string, axios?: AxiosInstance) {
-    const localVarFp = DropTablesApiFp(configuration)
+export const DungeonsApiFactory = function (configuration?: Configuration, basePath?: string, axios?: AxiosInstance) {
+    const localVarFp = DungeonsApiFp(configuration)
     return {
         /**
-         * ドロップテーブル作成
-         * @param {DropTable} dropTable 
+         * ダンジョン作成
+         * @param {Dungeon} dungeon 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        dropTablesCreate(dropTable: DropTable, options?: RawAxiosRequestConfig): AxiosPromise<DropTable> {
-            return localVarFp.dropTablesCreate(dropTable, options).then((request) => request(axios, basePath));
+        dungeonsCreate(dungeon: Dungeon, options?: RawAxiosRequestConfig): AxiosPromise<Dungeon> {
+            return localVarFp.dungeonsCreate(dungeon, options).then((request) => request(axios, basePath));
         },
         /**
-         * ドロップテーブル削除
+         * ダンジョン削除
          * @param {number} index 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        dropTablesDelete(index: number, options?: RawAxiosRequestConfig): AxiosPromise<void> {
-            return localVarFp.dropTablesDelete(index, options).then((request) => request(axios, basePath));
+        dungeonsDelete(index: number, options?: RawAxiosRequestConfig): AxiosPromise<void> {
+            return localVarFp.dungeonsDelete(index, options).then((request) => request(axios, basePath));
         },
         /**
-         * ドロップテーブル一覧取得
+         * ダンジョン一覧取得
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        dropTablesList(options?: RawAxiosRequestConfig): AxiosPromise<DropTableList> {
-            return localVarFp.dropTablesList(options).then((request) => request(axios, basePath));
+        dungeonsList(options?: RawAxiosRequestConfig): AxiosPromise<DungeonList> {
+            return localVarFp.dungeonsList(options).then((request) => request(axios, basePath));
         },
         /**
-         * ドロップテーブル更新
+         * ダンジョン更新
          * @param {number} index 
-         * @param {DropTable} dropTable 
+         * @param {Dungeon} dungeon 
          * @param {*} [options] Override http request option.
          * @throws {RequiredError}
          */
-        dropTablesUpdate(index: number, dropTable: DropTable, options?: RawAxiosRequestConfig): AxiosPromise<DropTable> {
-            return localVarFp.dropTablesUpdate(index, dropTable, options).then((request) => request(axios, basePath));
+        dungeonsUpdate(index: number, dungeon: Dungeon, options?: RawAxiosRequestConfig): AxiosPromise<Dungeon> {
+            return localVarFp.dungeonsUpdate(index, dungeon, options).then((request) => request(axios, basePath));
         },
     };
 };
 
 /**
- * DropTablesApi - object-oriented interface
+ * DungeonsApi - object-oriented interface
  */
-export class DropTablesApi extends BaseAPI {
+export class DungeonsApi extends BaseAPI {
     /**
-     * ドロップテーブル作成
-     * @param {DropTable} dropTable 
+     * ダンジョン作成
+     * @param {Dungeon} dungeon 
      * @param {*} [options] Override http request option.
      * @throws {RequiredError}
      */
-    public dropTablesCreate(dropTable: DropTable, options?: RawAxiosRequestConfig) {
-        return DropTablesApiFp(this.configuration).dropTablesCreate(dropTable, options).then((request) => request(this.axios, this.basePath));
+    public dungeonsCreate(dungeon: Dungeon, options?: RawAxiosRequestConfig) {
+        return DungeonsApiFp(this.configuration).dungeonsCreate(dungeon, options).then((request) => request(this.axios, this.basePath));
     }
 
     /**
-     * ドロップテーブル削除
+     * ダンジョン削除
      * @param {number} index 
      * @param {*} [options] Override http request option.
      * @throws {RequiredError}
      */
-    public dropTablesDelete(index: number, options?: RawAxiosRequestConfig) {
-        return DropTablesApiFp(this.configuration).dropTablesDelete(index, options).then((request) => request(this.axios, this.basePath));
+    public dungeonsDelete(index: number, options?: RawAxiosRequestConfig) {
+        return DungeonsApiFp(this.configuration).dungeonsDelete(index, options).then((request) => request(this.axios, this.basePath));
     }
 
     /**
-     * ドロップテーブル一覧取得
+     * ダンジョン一覧取得
      * @param {*} [options] Override http request option.
      * @throws {RequiredError}
      */
-    public dropTablesList(options?: RawAxiosRequestConfig) {
-        return DropTablesApiFp(this.configuration).dropTablesList(options).then((request) => request(this.axios, this.basePath));
+    public dungeonsList(options?: RawAxiosRequestConfig) {
+        return DungeonsApiFp(this.configuration).dungeonsList(options).then((request) => request(this.axios, this.basePath));
     }
 
     /**
-     * ドロップテーブル更新
+     * ダンジョン更新
      * @param {number} index 
-     * @param {DropTable} dropTable 
+     * @param {Dungeon} dungeon 
      * @param {*} [options] Override http request option.
      * @throws {RequiredError}
      */
-    public dropTablesUpdate(index: number, dropTable: DropTable, options?: RawAxiosRequestConfig) {
-        return DropTablesApiFp(this.configuration).dropTablesUpdate(index, dropTable, options).then((request) => request(this.axios, this.basePath));
+    public dungeonsUpdate(index: number, dungeon: Dungeon, options?: RawAxiosRequestConfig) {
+        return DungeonsApiFp(this.configuration).dungeonsUpdate(index, dungeon, options).then((request) => request(this.axios, this.basePath));
     }
 }
 
@@ -5534,6 +6609,297 @@ export class PropsApi extends BaseAPI {
 
 
 
+/**
+ * QuestsApi - axios parameter creator
+ */
+export const QuestsApiAxiosParamCreator = function (configuration?: Configuration) {
+    return {
+        /**
+         * クエスト作成
+         * @param {Quest} quest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        questsCreate: async (quest: Quest, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'quest' is not null or undefined
+            assertParamExists('questsCreate', 'quest', quest)
+            const localVarPath = `/api/v1/quests`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'POST', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+            localVarHeaderParameter['Accept'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(quest, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * クエスト削除
+         * @param {number} index 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        questsDelete: async (index: number, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'index' is not null or undefined
+            assertParamExists('questsDelete', 'index', index)
+            const localVarPath = `/api/v1/quests/{index}`
+                .replace('{index}', encodeURIComponent(String(index)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'DELETE', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            localVarHeaderParameter['Accept'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * クエスト一覧取得
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        questsList: async (options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            const localVarPath = `/api/v1/quests`;
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'GET', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            localVarHeaderParameter['Accept'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+        /**
+         * クエスト更新
+         * @param {number} index 
+         * @param {Quest} quest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        questsUpdate: async (index: number, quest: Quest, options: RawAxiosRequestConfig = {}): Promise<RequestArgs> => {
+            // verify required parameter 'index' is not null or undefined
+            assertParamExists('questsUpdate', 'index', index)
+            // verify required parameter 'quest' is not null or undefined
+            assertParamExists('questsUpdate', 'quest', quest)
+            const localVarPath = `/api/v1/quests/{index}`
+                .replace('{index}', encodeURIComponent(String(index)));
+            // use dummy base URL string because the URL constructor only accepts absolute URLs.
+            const localVarUrlObj = new URL(localVarPath, DUMMY_BASE_URL);
+            let baseOptions;
+            if (configuration) {
+                baseOptions = configuration.baseOptions;
+            }
+
+            const localVarRequestOptions = { method: 'PUT', ...baseOptions, ...options};
+            const localVarHeaderParameter = {} as any;
+            const localVarQueryParameter = {} as any;
+
+            localVarHeaderParameter['Content-Type'] = 'application/json';
+            localVarHeaderParameter['Accept'] = 'application/json';
+
+            setSearchParams(localVarUrlObj, localVarQueryParameter);
+            let headersFromBaseOptions = baseOptions && baseOptions.headers ? baseOptions.headers : {};
+            localVarRequestOptions.headers = {...localVarHeaderParameter, ...headersFromBaseOptions, ...options.headers};
+            localVarRequestOptions.data = serializeDataIfNeeded(quest, localVarRequestOptions, configuration)
+
+            return {
+                url: toPathString(localVarUrlObj),
+                options: localVarRequestOptions,
+            };
+        },
+    }
+};
+
+/**
+ * QuestsApi - functional programming interface
+ */
+export const QuestsApiFp = function(configuration?: Configuration) {
+    const localVarAxiosParamCreator = QuestsApiAxiosParamCreator(configuration)
+    return {
+        /**
+         * クエスト作成
+         * @param {Quest} quest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async questsCreate(quest: Quest, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Quest>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.questsCreate(quest, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['QuestsApi.questsCreate']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * クエスト削除
+         * @param {number} index 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async questsDelete(index: number, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<void>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.questsDelete(index, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['QuestsApi.questsDelete']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * クエスト一覧取得
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async questsList(options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<QuestList>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.questsList(options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['QuestsApi.questsList']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+        /**
+         * クエスト更新
+         * @param {number} index 
+         * @param {Quest} quest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        async questsUpdate(index: number, quest: Quest, options?: RawAxiosRequestConfig): Promise<(axios?: AxiosInstance, basePath?: string) => AxiosPromise<Quest>> {
+            const localVarAxiosArgs = await localVarAxiosParamCreator.questsUpdate(index, quest, options);
+            const localVarOperationServerIndex = configuration?.serverIndex ?? 0;
+            const localVarOperationServerBasePath = operationServerMap['QuestsApi.questsUpdate']?.[localVarOperationServerIndex]?.url;
+            return (axios, basePath) => createRequestFunction(localVarAxiosArgs, globalAxios, BASE_PATH, configuration)(axios, localVarOperationServerBasePath || basePath);
+        },
+    }
+};
+
+/**
+ * QuestsApi - factory interface
+ */
+export const QuestsApiFactory = function (configuration?: Configuration, basePath?: string, axios?: AxiosInstance) {
+    const localVarFp = QuestsApiFp(configuration)
+    return {
+        /**
+         * クエスト作成
+         * @param {Quest} quest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        questsCreate(quest: Quest, options?: RawAxiosRequestConfig): AxiosPromise<Quest> {
+            return localVarFp.questsCreate(quest, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * クエスト削除
+         * @param {number} index 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        questsDelete(index: number, options?: RawAxiosRequestConfig): AxiosPromise<void> {
+            return localVarFp.questsDelete(index, options).then((request) => request(axios, basePath));
+        },
+        /**
+         * クエスト一覧取得
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        questsList(options?: RawAxiosRequestConfig): AxiosPromise<QuestList> {
+            return localVarFp.questsList(options).then((request) => request(axios, basePath));
+        },
+        /**
+         * クエスト更新
+         * @param {number} index 
+         * @param {Quest} quest 
+         * @param {*} [options] Override http request option.
+         * @throws {RequiredError}
+         */
+        questsUpdate(index: number, quest: Quest, options?: RawAxiosRequestConfig): AxiosPromise<Quest> {
+            return localVarFp.questsUpdate(index, quest, options).then((request) => request(axios, basePath));
+        },
+    };
+};
+
+/**
+ * QuestsApi - object-oriented interface
+ */
+export class QuestsApi extends BaseAPI {
+    /**
+     * クエスト作成
+     * @param {Quest} quest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public questsCreate(quest: Quest, options?: RawAxiosRequestConfig) {
+        return QuestsApiFp(this.configuration).questsCreate(quest, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * クエスト削除
+     * @param {number} index 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public questsDelete(index: number, options?: RawAxiosRequestConfig) {
+        return QuestsApiFp(this.configuration).questsDelete(index, options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * クエスト一覧取得
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public questsList(options?: RawAxiosRequestConfig) {
+        return QuestsApiFp(this.configuration).questsList(options).then((request) => request(this.axios, this.basePath));
+    }
+
+    /**
+     * クエスト更新
+     * @param {number} index 
+     * @param {Quest} quest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     */
+    public questsUpdate(index: number, quest: Quest, options?: RawAxiosRequestConfig) {
+        return QuestsApiFp(this.configuration).questsUpdate(index, quest, options).then((request) => request(this.axios, this.basePath));
+    }
+}
+
+
+
 /**
  * RecipesApi - axios parameter creator
  */
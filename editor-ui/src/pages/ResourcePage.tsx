@@ -223,6 +223,27 @@ const createTemplates: Record<string, Record<string, JsonValue>> = {
     items: [],
     equips: [],
   },
+  dungeons: {
+    name: "新規",
+    description: "",
+    imageKey: "",
+    totalFloors: 20,
+    enemyTableId: "",
+    itemTableId: "",
+    baseTemperature: 15,
+    bossPlanner: "",
+    planners: [],
+  },
+  dialogues: {
+    id: "new",
+    nodes: [{ id: "start", text: "..." }],
+  },
+  quests: {
+    id: "new",
+    name: "新規",
+    description: "",
+    objectives: [{ kill: "", count: 1 }],
+  },
 };
 
 // 配列要素の新規追加テンプレート。フィールド名からデフォルト値を決定する
@@ -233,6 +254,12 @@ const arrayElementTemplates: Record<string, JsonValue> = {
   items: { name: "", count: 1 },
   equips: { name: "", slot: "" },
   skills: { id: "", value: 1 },
+  planners: { planner: "", weight: 1 },
+  nodes: { id: "", text: "" },
+  choices: { text: "" },
+  conditions: {},
+  effects: {},
+  objectives: {},
 };
 
 // entriesの各リソース用テンプレート
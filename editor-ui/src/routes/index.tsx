@@ -67,6 +67,22 @@ export const router = createBrowserRouter([
           <ResourcePage resource="professions" label="職業" nameField="id" />
         ),
       },
+      {
+        path: "dialogues",
+        element: (
+          <ResourcePage resource="dialogues" label="会話" nameField="id" />
+        ),
+      },
+      {
+        path: "quests",
+        element: (
+          <ResourcePage resource="quests" label="クエスト" nameField="id" />
+        ),
+      },
+      {
+        path: "dungeons",
+        element: <ResourcePage resource="dungeons" label="ダンジョン" />,
+      },
       {
         path: "sprite-sheets",
         element: (
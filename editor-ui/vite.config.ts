@@ -6,7 +6,7 @@ export default defineConfig(() => ({
   plugins: [
     react(),
     editorApiPlugin({
-      rawTomlPath: "../assets/metadata/entities/raw/raw.toml",
+      rawDir: "../assets/metadata/entities/raw",
       palettesDir: "../assets/levels/palettes",
       layoutsDir: "../assets/levels/layouts",
       chunkDirs: [
@@ -51,8 +51,14 @@ func GetBehavior(name gc.BehaviorName) (Behavior, error) {
 		return &ShootBehavior{}, nil
 	case gc.BehaviorReload:
 		return &ReloadBehavior{}, nil
+	case gc.BehaviorThrow:
+		return &ThrowBehavior{}, nil
 	case gc.BehaviorDisassemble:
 		return &DisassembleBehavior{}, nil
+	case gc.BehaviorCraft:
+		return &CraftBehavior{}, nil
+	case gc.BehaviorPickLock:
+		return &PickLockBehavior{}, nil
 	case gc.BehaviorPush:
 		return &PushBehavior{}, nil
 	case gc.BehaviorPull:
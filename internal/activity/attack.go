@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/kijimaD/ruins/internal/audio"
 	gc "github.com/kijimaD/ruins/internal/components"
 	"github.com/kijimaD/ruins/internal/consts"
 	"github.com/kijimaD/ruins/internal/formula"
@@ -22,6 +23,10 @@ import (
 // 攻撃システムの定数
 const (
 	MeleeAttackRange = 1.5 // 近接攻撃の最大射程（斜めも考慮）
+
+	// ElementConditionBuildup は元素攻撃1回の命中で進む状態タイマー量。耐性倍率で減る。
+	// 1回で軽度、重ねて当たると重くなる
+	ElementConditionBuildup = 30.0
 )
 
 // MeleeBehavior はBehaviorの実装
@@ -150,7 +155,7 @@ func (ab *MeleeBehavior) performAttack(comp *gc.Activity, actor ecs.Entity, worl
 		return fmt.Errorf("failed to get attack parameters: %w", err)
 	}
 
-	return applyAttackDamage(actor, target, world, attack, attackMethodName, 0, 0)
+	return applyAttackToTargets(actor, target, world, attack, attackMethodName, 0, 0)
 }
 
 func (ab *MeleeBehavior) canAttack(comp *gc.Activity, actor ecs.Entity, world w.World) bool {
@@ -286,6 +291,47 @@ func applyElementResist(damage int, target ecs.Entity, element gc.ElementType, w
 	return reduced
 }
 
+// attackTargets は攻撃が当たる相手を対象を先頭にして返す。全体攻撃なら対象とその周囲1マスにいる
+// actor の敵対者すべて、そうでなければ対象だけを返す
+func attackTargets(actor, target ecs.Entity, attack gc.Attacker, world w.World) []ecs.Entity {
+	targets := []ecs.Entity{target}
+	if attack.GetTargetType().TargetNum != gc.TargetAll || !world.Components.GridElement.Has(target) {
+		return targets
+	}
+	center := world.Components.GridElement.Get(target).Coord
+	for dy := consts.Tile(-1); dy <= 1; dy++ {
+		for dx := consts.Tile(-1); dx <= 1; dx++ {
+			for _, e := range query.GetEntitiesAt(world, center.X+dx, center.Y+dy) {
+				if e == actor || e == target || world.Components.Dead.Has(e) || !world.Components.Abilities.Has(e) {
+					continue
+				}
+				if query.FactionRelation(world, actor, e) != query.RelationHostile {
+					continue
+				}
+				targets = append(targets, e)
+			}
+		}
+	}
+	return targets
+}
+
+// applyAttackToTargets は attackTargets の相手それぞれに applyAttackDamage を適用する。
+// 途中で倒れた相手は飛ばす
+func applyAttackToTargets(actor, target ecs.Entity, world w.World, attack gc.Attacker, attackMethodName string, hitRateModifier int, damageModifier int) error {
+	if attack == nil {
+		return fmt.Errorf("attack must not be nil")
+	}
+	for _, e := range attackTargets(actor, target, attack, world) {
+		if !world.ECS.Alive(e) || world.Components.Dead.Has(e) {
+			continue
+		}
+		if err := applyAttackDamage(actor, e, world, attack, attackMethodName, hitRateModifier, damageModifier); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // applyAttackDamage はダメージ適用・ログ出力・スキル成長・死亡処理を一括で行う共通関数。
 // ShootBehaviorからも使用される
 func applyAttackDamage(actor, target ecs.Entity, world w.World, attack gc.Attacker, attackMethodName string, hitRateModifier int, damageModifier int) error {
@@ -296,6 +342,7 @@ func applyAttackDamage(actor, target ecs.Entity, world w.World, attack gc.Attack
 	hit, criticalHit := rollHitCheckWithModifier(actor, target, world, attack, hitRateModifier)
 	if !hit {
 		logAttackResult(actor, target, world, false, false, 0, attackMethodName)
+		playAttackSound(actor, target, world, false)
 		lifecycle.SpawnVisualEffect(target, gc.NewMissEffect(), world)
 		return nil
 	}
@@ -303,9 +350,11 @@ func applyAttackDamage(actor, target ecs.Entity, world w.World, attack gc.Attack
 	damage := max(calculateDamage(actor, target, world, attack, criticalHit, damageModifier), 0)
 
 	logAttackResult(actor, target, world, true, criticalHit, damage, attackMethodName)
+	playAttackSound(actor, target, world, true)
 	growWeaponSkill(actor, world, attack)
 	lifecycle.SpawnVisualEffect(target, gc.NewDamageEffect(damage), world)
-	gameaction.ApplyDamage(world, target, damage, actor)
+	gameaction.ApplyDamageWith(world, target, damage, actor, gameaction.Hit{Weapon: attackMethodName, Element: attack.GetElement()})
+	applyElementCondition(target, world, attack.GetElement())
 
 	// 被ダメージで中断可能なアクティビティをキャンセルする
 	if comp := query.GetActivity(world, target); comp != nil && CanInterrupt(comp) {
@@ -315,6 +364,64 @@ func applyAttackDamage(actor, target ecs.Entity, world w.World, attack gc.Attack
 	return nil
 }
 
+// applyElementCondition は元素攻撃の命中で対象の全身に元素状態を進める。
+// 進み方は対象の元素耐性倍率で減る。HealthStatus を持たない対象や死んだ対象には付かない
+func applyElementCondition(target ecs.Entity, world w.World, element gc.ElementType) {
+	condType, ok := gc.ElementCondition(element)
+	if !ok || world.Components.Dead.Has(target) || !world.Components.HealthStatus.Has(target) {
+		return
+	}
+
+	buildup := ElementConditionBuildup
+	if world.Components.CharModifiers.Has(target) {
+		if mult, ok := world.Components.CharModifiers.Get(target).ElementResist[element]; ok {
+			buildup = mult.ApplyFloat(buildup)
+		}
+	}
+	if buildup <= 0 {
+		return
+	}
+
+	partHealth := &world.Components.HealthStatus.Get(target).Parts[gc.BodyPartWholeBody]
+	change := partHealth.UpdateConditionTimer(condType, buildup)
+	if change.Prev == change.Current {
+		return
+	}
+	if cond := partHealth.GetCondition(condType); cond != nil {
+		cond.Effects = gc.ElementConditionEffects(condType, cond.Severity)
+	}
+
+	if !world.Components.StatsChanged.Has(target) {
+		world.Components.StatsChanged.Add(target, &gc.StatsChanged{})
+	}
+	if world.Components.Player.Has(target) {
+		if msg := elementConditionOnsetMessage(condType, change.Current); msg != "" {
+			gamelog.New(query.GetGameLog(world)).
+				Markup(gamelog.Tag("warning", query.T(world, msg))).
+				Log()
+		}
+	}
+}
+
+// elementConditionOnsetMessage は元素状態が悪化したときのメッセージを返す
+func elementConditionOnsetMessage(condType gc.ConditionType, severity gc.Severity) string {
+	if severity == gc.SeverityNone {
+		return ""
+	}
+	switch condType {
+	case gc.ConditionBurning:
+		return "You are on fire"
+	case gc.ConditionShocked:
+		return "Electricity numbs your body"
+	case gc.ConditionFrozen:
+		return "Your body is freezing stiff"
+	case gc.ConditionBlinded:
+		return "The flash blinds you"
+	default:
+		return ""
+	}
+}
+
 // calculateHitRate は命中率を算出する。ダイスロールなしの純粋な計算で、UI表示と命中判定の両方で使用する
 func calculateHitRate(attacker, target ecs.Entity, world w.World, attack gc.Attacker, modifier int) int {
 	if !world.Components.Abilities.Has(attacker) {
@@ -426,7 +533,38 @@ func growWeaponSkill(actor ecs.Entity, world w.World, attack gc.Attacker) {
 		gamelog.New(query.GetGameLog(world)).
 			Markup(query.T(world, "%s's skill rose! (%s Lv%d)", actorName, string(skillID), s.Value)).
 			Log()
+		if world.Components.Player.Has(actor) {
+			gameaction.PlaySound(world, audio.EventLevelUp)
+		}
+	}
+}
+
+// playAttackSound は攻撃結果の効果音を鳴らす。ログと同じく味方が関わる攻撃だけ鳴らす。
+// プレイヤーは選択中の武器の音を優先し、無ければ攻撃者自身の定義の音を使う
+func playAttackSound(attacker, target ecs.Entity, world w.World, hit bool) {
+	if !query.IsAlly(world, attacker) && !query.IsAlly(world, target) {
+		return
+	}
+	event := audio.EventAttackMiss
+	if hit {
+		event = audio.EventAttackHit
+	}
+	weapon, _ := selectedWeapon(attacker, world)
+	gameaction.PlaySound(world, event, weapon, attacker)
+}
+
+// selectedWeapon はプレイヤーが選択中のスロットに装備した武器を返す。
+// プレイヤー以外や空きスロットなら false を返す
+func selectedWeapon(actor ecs.Entity, world w.World) (ecs.Entity, bool) {
+	if !world.Components.Player.Has(actor) {
+		return ecs.Entity{}, false
+	}
+	weapons := query.GetWeapons(world, actor)
+	slot := query.GetWeaponSelection(world).Slot
+	if slot < 1 || slot > len(weapons) || weapons[slot-1] == nil {
+		return ecs.Entity{}, false
 	}
+	return *weapons[slot-1], true
 }
 
 // logAttackResult は攻撃結果をログに出力する
@@ -4,8 +4,11 @@ import (
 	"testing"
 
 	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/consts"
 	"github.com/kijimaD/ruins/internal/formula"
 	"github.com/kijimaD/ruins/internal/testutil"
+	"github.com/kijimaD/ruins/internal/world/lifecycle"
+	"github.com/mlange-42/ark/ecs"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -104,6 +107,55 @@ func TestApplyElementResist_MinimumDamage(t *testing.T) {
 	assert.Equal(t, formula.MinDamage, applyElementResist(5, target, gc.ElementTypeFire, world))
 }
 
+func TestApplyElementCondition(t *testing.T) {
+	t.Parallel()
+
+	t.Run("元素攻撃の命中で対応する状態が付く", func(t *testing.T) {
+		t.Parallel()
+		world := testutil.InitTestWorld(t)
+		target := world.ECS.NewEntity()
+		world.Components.HealthStatus.Add(target, &gc.HealthStatus{})
+
+		applyElementCondition(target, world, gc.ElementTypeFire)
+
+		cond := world.Components.HealthStatus.Get(target).Parts[gc.BodyPartWholeBody].GetCondition(gc.ConditionBurning)
+		require.NotNil(t, cond)
+		assert.InDelta(t, ElementConditionBuildup, cond.Timer, 0.001)
+		assert.Equal(t, gc.SeverityMinor, cond.Severity)
+		assert.NotEmpty(t, cond.Effects)
+		assert.True(t, world.Components.StatsChanged.Has(target), "能力値を再計算させる")
+	})
+
+	t.Run("耐性が高いほど状態が進みにくい", func(t *testing.T) {
+		t.Parallel()
+		world := testutil.InitTestWorld(t)
+		target := world.ECS.NewEntity()
+		world.Components.HealthStatus.Add(target, &gc.HealthStatus{})
+		skills := gc.NewSkills()
+		skills.Get(gc.SkillChillResist).Value = 5
+		world.Components.CharModifiers.Add(target, gc.RecalculateCharModifiers(skills, nil, nil))
+
+		applyElementCondition(target, world, gc.ElementTypeChill)
+
+		// 耐冷Lv5: 30 * 85 / 100 = 25.5
+		cond := world.Components.HealthStatus.Get(target).Parts[gc.BodyPartWholeBody].GetCondition(gc.ConditionFrozen)
+		require.NotNil(t, cond)
+		assert.InDelta(t, 25.5, cond.Timer, 0.001)
+	})
+
+	t.Run("無属性やHealthStatusを持たない対象には付かない", func(t *testing.T) {
+		t.Parallel()
+		world := testutil.InitTestWorld(t)
+		target := world.ECS.NewEntity()
+		world.Components.HealthStatus.Add(target, &gc.HealthStatus{})
+		applyElementCondition(target, world, gc.ElementTypeNone)
+		assert.Empty(t, world.Components.HealthStatus.Get(target).Parts[gc.BodyPartWholeBody].Conditions)
+
+		other := world.ECS.NewEntity()
+		assert.NotPanics(t, func() { applyElementCondition(other, world, gc.ElementTypeThunder) })
+	})
+}
+
 func TestGrowWeaponSkill_NoSkillsComponent(t *testing.T) {
 	t.Parallel()
 
@@ -440,3 +492,29 @@ func TestGrowWeaponSkill_UnknownWeaponType(t *testing.T) {
 		assert.Equal(t, 0, skills.Get(id).Exp.Current, "スキル %s の経験値が変わらない", id)
 	}
 }
+
+func TestAttackTargets(t *testing.T) {
+	t.Parallel()
+
+	world := testutil.InitTestWorld(t)
+	player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 10, Y: 10}, "ash")
+	require.NoError(t, err)
+	target, err := lifecycle.SpawnEnemy(world, consts.Coord[consts.Tile]{X: 12, Y: 10}, "fireball")
+	require.NoError(t, err)
+	beside, err := lifecycle.SpawnEnemy(world, consts.Coord[consts.Tile]{X: 13, Y: 11}, "fireball")
+	require.NoError(t, err)
+	_, err = lifecycle.SpawnEnemy(world, consts.Coord[consts.Tile]{X: 15, Y: 10}, "fireball")
+	require.NoError(t, err)
+	_, err = lifecycle.SpawnEnemy(world, consts.Coord[consts.Tile]{X: 9, Y: 11}, "fireball")
+	require.NoError(t, err)
+
+	single := &gc.Melee{TargetType: gc.TargetType{TargetGroup: gc.TargetGroupEnemy, TargetNum: gc.TargetSingle}}
+	assert.Equal(t, []ecs.Entity{target}, attackTargets(player, target, single, world))
+
+	all := &gc.Fire{TargetType: gc.TargetType{TargetGroup: gc.TargetGroupEnemy, TargetNum: gc.TargetAll}}
+	assert.Equal(t, []ecs.Entity{target, beside}, attackTargets(player, target, all, world),
+		"全体攻撃は対象と周囲1マスの敵対者に当たり、離れた相手には届かない")
+
+	// 敵の全体攻撃はプレイヤーの隣にいる仲間を巻き込まない
+	assert.Equal(t, []ecs.Entity{player}, attackTargets(beside, player, all, world))
+}
@@ -0,0 +1,254 @@
+package activity
+
+import (
+	"fmt"
+	"math"
+
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/gamelog"
+	"github.com/kijimaD/ruins/internal/oapi"
+	"github.com/kijimaD/ruins/internal/raw"
+	w "github.com/kijimaD/ruins/internal/world"
+
+	"github.com/kijimaD/ruins/internal/world/gameaction"
+	"github.com/kijimaD/ruins/internal/world/query"
+	"github.com/mlange-42/ark/ecs"
+)
+
+// CraftStationRange は作業場を使える距離。actor から縦横斜めにこのタイル数以内の作業場を使う
+const CraftStationRange = 2
+
+// CraftBehavior はレシピに従って素材からアイテムを作るアクティビティの実装。
+// 素材は仕上がったときにまとめて消費するので、途中で中断しても素材は減らない。
+// 作業場と工具は毎ターン確かめ直し、離れたり工具を失ったりすれば中断する
+type CraftBehavior struct{}
+
+// Info はBehaviorの実装
+func (cb *CraftBehavior) Info() Info {
+	return Info{
+		Name:            "Craft",
+		Description:     "Craft an item from materials following a recipe",
+		Interruptible:   true,
+		Resumable:       true,
+		ActionPointCost: consts.StandardActionCost,
+	}
+}
+
+// Name はBehaviorの実装
+func (cb *CraftBehavior) Name() gc.BehaviorName {
+	return gc.BehaviorCraft
+}
+
+// NewCraftActivity は合成するレシピを指定して合成アクティビティを組む。
+// 必要APはレシピの作業量に工具グレードの短縮を掛けて求める。作業量の無いレシピは即座に仕上がる
+func NewCraftActivity(recipeID string, actor ecs.Entity, world w.World) *gc.Activity {
+	requiredAP := 0
+	if recipe := findRecipe(world, recipeID); recipe != nil {
+		grade := 1
+		if recipe.Tool != nil {
+			if g, _, ok := FindBestDisassemblyTool(world, actor, recipe.Tool.Category); ok {
+				grade = g
+			}
+		}
+		requiredAP = RequiredCraftAP(recipe.WorkAP, grade)
+	}
+	comp := NewActivity(gc.BehaviorCraft, requiredAP)
+	comp.Params = &gc.CraftParams{RecipeID: recipeID, Result: gc.InvalidEntity}
+	return comp
+}
+
+// Validate は合成アクティビティの検証を行う
+func (cb *CraftBehavior) Validate(comp *gc.Activity, actor ecs.Entity, world w.World) error {
+	p, ok := comp.Params.(*gc.CraftParams)
+	if !ok {
+		return ErrParamsTypeMismatch
+	}
+	if err := CheckCraftRequirements(world, actor, p.RecipeID); err != nil {
+		return err
+	}
+	if !isAreaSafe(actor, world) {
+		return &UserError{Msg: query.T(world, "cannot craft because enemies are nearby")}
+	}
+	return nil
+}
+
+// Start は合成開始時の処理を実行する
+func (cb *CraftBehavior) Start(comp *gc.Activity, actor ecs.Entity, world w.World) error {
+	p, ok := comp.Params.(*gc.CraftParams)
+	if !ok {
+		return ErrParamsTypeMismatch
+	}
+	// 即座に仕上がるレシピは完了のログだけを出す
+	if comp.Progress.Max > 0 && world.Components.Player.Has(actor) {
+		gamelog.New(query.GetGameLog(world)).
+			Markup(query.T(world, "Began crafting %s", gamelog.Tag("item", recipeItemName(world, p.RecipeID)))).
+			Log()
+	}
+
+	log.Debug("craft started", "actor", actor, "recipe", p.RecipeID, "required", comp.Progress.Max)
+	return nil
+}
+
+// DoTurn は合成アクティビティの1ターン分の処理を実行する。
+// 素材・作業場・工具と周囲の安全を毎ターン確かめ、欠ければ中断する
+func (cb *CraftBehavior) DoTurn(comp *gc.Activity, actor ecs.Entity, world w.World) error {
+	p, ok := comp.Params.(*gc.CraftParams)
+	if !ok {
+		Cancel(comp, "craft recipe is not set")
+		return ErrParamsTypeMismatch
+	}
+	if err := CheckCraftRequirements(world, actor, p.RecipeID); err != nil {
+		Cancel(comp, "crafting interrupted because the requirements are no longer met")
+		return nil
+	}
+	if !isAreaSafe(actor, world) {
+		Cancel(comp, "crafting interrupted because enemies are nearby")
+		return nil
+	}
+
+	// 今ターンのAPを注ぐ。APが高いほど速く仕上がる
+	comp.Progress.Current += perTurnAP(actor, world)
+	if comp.Progress.Current >= comp.Progress.Max {
+		Complete(comp)
+	}
+	return nil
+}
+
+// Finish は合成完了時の処理を実行する。素材を消費してアイテムを所持品へ加える
+func (cb *CraftBehavior) Finish(comp *gc.Activity, actor ecs.Entity, world w.World) error {
+	p, ok := comp.Params.(*gc.CraftParams)
+	if !ok {
+		return ErrParamsTypeMismatch
+	}
+	result, err := gameaction.Craft(world, p.RecipeID)
+	if err != nil {
+		return fmt.Errorf("failed to craft %s: %w", p.RecipeID, err)
+	}
+	p.Result = result
+
+	if world.Components.Player.Has(actor) {
+		gamelog.New(query.GetGameLog(world)).
+			Markup(query.T(world, "Crafted %s.", gamelog.Tag("item", query.GetEntityName(result, world)))).
+			Log()
+	}
+
+	log.Debug("craft finished", "actor", actor, "recipe", p.RecipeID)
+	return nil
+}
+
+// Canceled は合成キャンセル時の処理を実行する
+func (cb *CraftBehavior) Canceled(comp *gc.Activity, actor ecs.Entity, world w.World) error {
+	if world.Components.Player.Has(actor) {
+		logger := gamelog.New(query.GetGameLog(world))
+		if p, ok := comp.Params.(*gc.CraftParams); ok {
+			logger.Markup(query.T(world, "Interrupted crafting %s", gamelog.Tag("item", recipeItemName(world, p.RecipeID))))
+		} else {
+			logger.Markup(query.T(world, "Interrupted crafting"))
+		}
+		logger.Log()
+	}
+
+	log.Debug("craft interrupted", "reason", comp.CancelReason)
+	return nil
+}
+
+// CheckCraftRequirements は actor がいまの場所で recipeID を合成できるかを調べる。
+// 素材・作業場・工具のどれかが欠けていれば、理由を *UserError で返す。周囲の安全は見ない
+func CheckCraftRequirements(world w.World, actor ecs.Entity, recipeID string) error {
+	recipe := findRecipe(world, recipeID)
+	if recipe == nil {
+		return fmt.Errorf("recipe not found: %s", recipeID)
+	}
+	itemName := gamelog.Tag("item", recipeItemName(world, recipeID))
+
+	canCraft, err := gameaction.CanCraft(world, recipeID)
+	if err != nil {
+		return err
+	}
+	if !canCraft {
+		return &UserError{Msg: query.T(world, "Not enough materials to craft %s", itemName)}
+	}
+	if recipe.Station != nil && !HasCraftStationNearby(world, actor, *recipe.Station) {
+		return &UserError{Msg: query.T(world, "Need a %s nearby to craft %s", query.T(world, CraftStationName(*recipe.Station)), itemName)}
+	}
+	if recipe.Tool != nil {
+		if grade, _, ok := FindBestDisassemblyTool(world, actor, recipe.Tool.Category); !ok || grade < recipe.Tool.Grade {
+			return &UserError{Msg: query.T(world, "Need a %s tool of grade %d or higher to craft %s",
+				query.T(world, ToolCategoryName(recipe.Tool.Category)), recipe.Tool.Grade, itemName)}
+		}
+	}
+	return nil
+}
+
+// HasCraftStationNearby は actor の CraftStationRange 以内に station の作業場があるかを返す
+func HasCraftStationNearby(world w.World, actor ecs.Entity, station oapi.CraftStation) bool {
+	if !world.Components.GridElement.Has(actor) {
+		return false
+	}
+	origin := world.Components.GridElement.Get(actor)
+	originX, originY := int(origin.X), int(origin.Y)
+
+	found := false
+	stationQuery := query.ActiveFilter1[gc.GridElement](world).Query()
+	for stationQuery.Next() {
+		entity := stationQuery.Entity()
+		if found || !world.Components.RawID.Has(entity) {
+			continue
+		}
+		grid := world.Components.GridElement.Get(entity)
+		dx, dy := int(grid.X)-originX, int(grid.Y)-originY
+		if dx < -CraftStationRange || dx > CraftStationRange || dy < -CraftStationRange || dy > CraftStationRange {
+			continue
+		}
+		if kind, ok := raw.FindCraftStation(world.Resources.RawMaster, world.Components.RawID.Get(entity).ID); ok && kind == station {
+			found = true
+		}
+	}
+	return found
+}
+
+// RequiredCraftAP は合成に必要な総APを計算する。工具グレードは1で等倍、2で80%、3で60%
+func RequiredCraftAP(workAP int, toolGrade int) int {
+	return int(math.Ceil(float64(workAP) * toolGradeFactor(toolGrade)))
+}
+
+// CraftStationName は作業場の種類の表示名を返す。値は query.T の msgid として使う
+func CraftStationName(station oapi.CraftStation) string {
+	switch station {
+	case oapi.CraftStationWorkbench:
+		return "Workbench"
+	case oapi.CraftStationFire:
+		return "Fire"
+	case oapi.CraftStationForge:
+		return "Forge"
+	}
+	return string(station)
+}
+
+// ToolCategoryName は工具の分類の表示名を返す。値は query.T の msgid として使う
+func ToolCategoryName(category oapi.ToolCategory) string {
+	switch category {
+	case oapi.Prying:
+		return "Prying"
+	case oapi.Precision:
+		return "Precision"
+	case oapi.Cutting:
+		return "Cutting"
+	}
+	return string(category)
+}
+
+// findRecipe はレシピの合成条件を返す。見つからなければ nil
+func findRecipe(world w.World, recipeID string) *gc.Recipe {
+	spec, err := raw.NewRecipeSpec(world.Resources.RawMaster, recipeID)
+	if err != nil {
+		return nil
+	}
+	return spec.Recipe
+}
+
+// recipeItemName はレシピで作るアイテムの表示名を返す。レシピ id は生成アイテム id と一致する
+func recipeItemName(world w.World, recipeID string) string {
+	return query.T(world, raw.ItemName(world.Resources.RawMaster, recipeID))
+}
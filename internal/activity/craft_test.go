@@ -0,0 +1,159 @@
+package activity
+
+import (
+	"testing"
+
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/oapi"
+	"github.com/kijimaD/ruins/internal/testutil"
+	"github.com/kijimaD/ruins/internal/world/lifecycle"
+	"github.com/kijimaD/ruins/internal/world/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequiredCraftAP(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		workAP    int
+		toolGrade int
+		want      int
+	}{
+		{"グレード1は等倍", 2000, 1, 2000},
+		{"グレード2は80%になる", 2000, 2, 1600},
+		{"グレード3は60%になる", 2000, 3, 1200},
+		{"作業量0は即座に仕上がる", 0, 3, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, RequiredCraftAP(tt.workAP, tt.toolGrade))
+		})
+	}
+}
+
+func TestCheckCraftRequirements(t *testing.T) {
+	t.Parallel()
+
+	t.Run("素材が足りなければエラー", func(t *testing.T) {
+		t.Parallel()
+		world := testutil.InitTestWorld(t)
+		player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 10, Y: 10}, "ash")
+		require.NoError(t, err)
+
+		var ve *UserError
+		require.ErrorAs(t, CheckCraftRequirements(world, player, "dried_meat"), &ve)
+	})
+
+	t.Run("作業場が近くになければエラー", func(t *testing.T) {
+		t.Parallel()
+		world := testutil.InitTestWorld(t)
+		player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 10, Y: 10}, "ash")
+		require.NoError(t, err)
+		_, err = lifecycle.SpawnBackpackItem(world, "raw_meat", 2)
+		require.NoError(t, err)
+
+		// 焚き火があっても届かなければ使えない
+		_, err = lifecycle.SpawnProp(world, "bonfire", 10+CraftStationRange+1, 10)
+		require.NoError(t, err)
+
+		var ve *UserError
+		require.ErrorAs(t, CheckCraftRequirements(world, player, "dried_meat"), &ve)
+		assert.False(t, HasCraftStationNearby(world, player, oapi.CraftStationFire))
+	})
+
+	t.Run("近くの作業場を使える", func(t *testing.T) {
+		t.Parallel()
+		world := testutil.InitTestWorld(t)
+		player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 10, Y: 10}, "ash")
+		require.NoError(t, err)
+		_, err = lifecycle.SpawnBackpackItem(world, "raw_meat", 2)
+		require.NoError(t, err)
+		_, err = lifecycle.SpawnProp(world, "bonfire", 11, 11)
+		require.NoError(t, err)
+
+		require.NoError(t, CheckCraftRequirements(world, player, "dried_meat"))
+		assert.False(t, HasCraftStationNearby(world, player, oapi.CraftStationForge), "種類の違う作業場は使えない")
+	})
+
+	t.Run("工具のグレードが足りなければエラー", func(t *testing.T) {
+		t.Parallel()
+		world := testutil.InitTestWorld(t)
+		player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 10, Y: 10}, "ash")
+		require.NoError(t, err)
+		_, err = lifecycle.SpawnBackpackItem(world, "iron", 1)
+		require.NoError(t, err)
+
+		var ve *UserError
+		require.ErrorAs(t, CheckCraftRequirements(world, player, "machine_ring"), &ve)
+
+		_, err = lifecycle.SpawnBackpackItem(world, "electric_screwdriver", 1)
+		require.NoError(t, err)
+		require.NoError(t, CheckCraftRequirements(world, player, "machine_ring"))
+	})
+}
+
+func TestCraftBehavior_作業量だけターンをかけて仕上がる(t *testing.T) {
+	t.Parallel()
+
+	world := testutil.InitTestWorld(t)
+	player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 10, Y: 10}, "ash")
+	require.NoError(t, err)
+	_, err = lifecycle.SpawnBackpackItem(world, "iron", 1)
+	require.NoError(t, err)
+	_, err = lifecycle.SpawnBackpackItem(world, "electric_screwdriver", 1)
+	require.NoError(t, err)
+
+	cb := &CraftBehavior{}
+	comp := NewCraftActivity("machine_ring", player, world)
+	// 作業量2000 グレード2 で必要AP1600
+	assert.Equal(t, 1600, comp.Progress.Max)
+
+	require.NoError(t, cb.Validate(comp, player, world))
+	require.NoError(t, cb.Start(comp, player, world))
+	turns := 0
+	for comp.State == gc.ActivityStateRunning {
+		_, found := query.FindStackInInventory(world, "iron")
+		assert.True(t, found, "仕上がるまで素材は消費されないべき")
+		require.NoError(t, cb.DoTurn(comp, player, world))
+		turns++
+	}
+	require.Equal(t, gc.ActivityStateCompleted, comp.State)
+	assert.Greater(t, turns, 1, "作業量のあるレシピは複数ターンかかるべき")
+	require.NoError(t, cb.Finish(comp, player, world))
+
+	p := comp.Params.(*gc.CraftParams)
+	require.True(t, world.ECS.Alive(p.Result))
+	assert.Equal(t, "Machine Ring", query.GetEntityName(p.Result, world))
+	_, found := query.FindStackInInventory(world, "iron")
+	assert.False(t, found, "完成時に素材が消費されるべき")
+}
+
+func TestCraftBehavior_作業場から離れると中断する(t *testing.T) {
+	t.Parallel()
+
+	world := testutil.InitTestWorld(t)
+	player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 10, Y: 10}, "ash")
+	require.NoError(t, err)
+	_, err = lifecycle.SpawnBackpackItem(world, "raw_meat", 2)
+	require.NoError(t, err)
+	_, err = lifecycle.SpawnProp(world, "bonfire", 11, 10)
+	require.NoError(t, err)
+
+	cb := &CraftBehavior{}
+	comp := NewCraftActivity("dried_meat", player, world)
+	require.NoError(t, cb.Validate(comp, player, world))
+	require.NoError(t, cb.Start(comp, player, world))
+	require.NoError(t, cb.DoTurn(comp, player, world))
+	require.Equal(t, gc.ActivityStateRunning, comp.State)
+
+	world.Components.GridElement.Get(player).X = 20
+	require.NoError(t, cb.DoTurn(comp, player, world))
+	assert.Equal(t, gc.ActivityStateCanceled, comp.State)
+
+	_, found := query.FindStackInInventory(world, "raw_meat")
+	assert.True(t, found, "中断しても素材は残るべき")
+}
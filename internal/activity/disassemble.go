@@ -6,6 +6,7 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/kijimaD/ruins/internal/audio"
 	gc "github.com/kijimaD/ruins/internal/components"
 	"github.com/kijimaD/ruins/internal/consts"
 	"github.com/kijimaD/ruins/internal/gamelog"
@@ -14,6 +15,7 @@ import (
 	"github.com/kijimaD/ruins/internal/skill"
 	w "github.com/kijimaD/ruins/internal/world"
 
+	"github.com/kijimaD/ruins/internal/world/gameaction"
 	"github.com/kijimaD/ruins/internal/world/lifecycle"
 	"github.com/kijimaD/ruins/internal/world/query"
 	"github.com/mlange-42/ark/ecs"
@@ -191,7 +193,7 @@ func (db *DisassembleBehavior) Finish(comp *gc.Activity, actor ecs.Entity, world
 	}
 	logger.Log()
 
-	db.gainMechanicExp(actor, world)
+	gainMechanicExp(actor, world)
 
 	log.Debug("disassemble finished", "actor", actor, "target", name, "yields", stacks)
 	return nil
@@ -214,8 +216,8 @@ func (db *DisassembleBehavior) Canceled(comp *gc.Activity, actor ecs.Entity, wor
 	return nil
 }
 
-// gainMechanicExp は分解完了で機械スキルの経験値を与える
-func (db *DisassembleBehavior) gainMechanicExp(actor ecs.Entity, world w.World) {
+// gainMechanicExp は分解や解錠の完了で機械スキルの経験値を与える
+func gainMechanicExp(actor ecs.Entity, world w.World) {
 	if !world.Components.Skills.Has(actor) {
 		return
 	}
@@ -234,6 +236,7 @@ func (db *DisassembleBehavior) gainMechanicExp(actor ecs.Entity, world w.World)
 		gamelog.New(query.GetGameLog(world)).
 			Markup(query.T(world, "%s skill rose to %d", gc.SkillName(gc.SkillMechanic), s.Value)).
 			Log()
+		gameaction.PlaySound(world, audio.EventLevelUp)
 	}
 }
 
@@ -244,14 +247,18 @@ func RequiredDisassemblyAP(baseAP int, skillValue int, toolGrade int) int {
 	if skillFactor < 0.5 {
 		skillFactor = 0.5
 	}
-	gradeFactor := 1.0
+	return int(math.Ceil(float64(baseAP) * skillFactor * toolGradeFactor(toolGrade)))
+}
+
+// toolGradeFactor は工具グレードによる作業時間の倍率を返す。分解と合成で共通
+func toolGradeFactor(toolGrade int) float64 {
 	switch toolGrade {
 	case 2:
-		gradeFactor = 0.8
+		return 0.8
 	case 3:
-		gradeFactor = 0.6
+		return 0.6
 	}
-	return int(math.Ceil(float64(baseAP) * skillFactor * gradeFactor))
+	return 1.0
 }
 
 // FindBestDisassemblyTool はactorの所持品から分類に適合する最良の分解工具を探す。
@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"slices"
 
+	"github.com/kijimaD/ruins/internal/audio"
 	gc "github.com/kijimaD/ruins/internal/components"
 	"github.com/kijimaD/ruins/internal/consts"
 	"github.com/kijimaD/ruins/internal/gamelog"
 	w "github.com/kijimaD/ruins/internal/world"
 
+	"github.com/kijimaD/ruins/internal/world/gameaction"
 	"github.com/kijimaD/ruins/internal/world/lifecycle"
 	"github.com/kijimaD/ruins/internal/world/query"
 	"github.com/mlange-42/ark/ecs"
@@ -42,7 +44,7 @@ func NewOpenDoorActivity(target ecs.Entity) *gc.Activity {
 }
 
 // Validate は扉開閉アクティビティの検証を行う
-func (odb *OpenDoorBehavior) Validate(comp *gc.Activity, _ ecs.Entity, world w.World) error {
+func (odb *OpenDoorBehavior) Validate(comp *gc.Activity, actor ecs.Entity, world w.World) error {
 	p, ok := comp.Params.(*gc.OpenDoorParams)
 	if !ok {
 		return ErrParamsTypeMismatch
@@ -60,6 +62,13 @@ func (odb *OpenDoorBehavior) Validate(comp *gc.Activity, _ ecs.Entity, world w.W
 		return fmt.Errorf("target is not a door")
 	}
 
+	// 錠が掛かっていれば、合う鍵を持つときだけ開けられる。鍵が無ければ解錠か破錠に回す
+	if world.Components.Lock.Has(targetEntity) {
+		if _, ok := findLockKey(world, actor, world.Components.Lock.Get(targetEntity).KeyID); !ok {
+			return &UserError{Msg: query.T(world, "%s is locked.", gamelog.Tag("item", query.GetEntityName(targetEntity, world)))}
+		}
+	}
+
 	return nil
 }
 
@@ -70,7 +79,7 @@ func (odb *OpenDoorBehavior) Start(_ *gc.Activity, actor ecs.Entity, _ w.World)
 }
 
 // DoTurn は扉開閉アクティビティの1ターン分の処理を実行する
-func (odb *OpenDoorBehavior) DoTurn(comp *gc.Activity, _ ecs.Entity, world w.World) error {
+func (odb *OpenDoorBehavior) DoTurn(comp *gc.Activity, actor ecs.Entity, world w.World) error {
 	p, ok := comp.Params.(*gc.OpenDoorParams)
 	if !ok {
 		Cancel(comp, "door entity is not set")
@@ -85,6 +94,12 @@ func (odb *OpenDoorBehavior) DoTurn(comp *gc.Activity, _ ecs.Entity, world w.Wor
 	raw := world.Components.Door.Get(targetEntity)
 	doorComp := raw
 
+	// 錠は合う鍵で外してから開く。検証のあとで鍵を失っていれば開けない
+	if world.Components.Lock.Has(targetEntity) && !unlockWithKey(world, actor, targetEntity) {
+		Cancel(comp, "door is locked")
+		return nil
+	}
+
 	// 扉を開く
 	if !doorComp.IsOpen {
 		if err := lifecycle.OpenDoor(world, targetEntity); err != nil {
@@ -93,6 +108,9 @@ func (odb *OpenDoorBehavior) DoTurn(comp *gc.Activity, _ ecs.Entity, world w.Wor
 		}
 
 		log.Debug("door opened", "door", targetEntity)
+		if query.IsAlly(world, actor) {
+			gameaction.PlaySound(world, audio.EventDoorOpen, targetEntity)
+		}
 
 		// 視界の更新が必要
 		query.GetVisionState(world).RequestUpdate()
@@ -31,6 +31,9 @@ var (
 	// 射撃関連エラー
 	ErrShootNoFireWeapon = errors.New("no ranged weapon equipped")
 
+	// 投擲関連エラー
+	ErrThrowNotThrowable = errors.New("item is not throwable")
+
 	// 移動関連エラー
 	ErrMoveTargetInvalid   = errors.New("move destination is invalid")
 	ErrGridElementNotFound = errors.New("GridElement component not found")
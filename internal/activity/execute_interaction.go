@@ -4,9 +4,11 @@ import (
 	"fmt"
 
 	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/gamelog"
 	w "github.com/kijimaD/ruins/internal/world"
 
 	"github.com/kijimaD/ruins/internal/world/lifecycle"
+	"github.com/kijimaD/ruins/internal/world/query"
 	"github.com/mlange-42/ark/ecs"
 )
 
@@ -38,7 +40,7 @@ func ExecuteInteraction(actor ecs.Entity, target ecs.Entity, interaction gc.Inte
 	case gc.InteractionItemAll:
 		return executeItemAll(actor, world)
 	case gc.InteractionStorage:
-		return executeStorage(target, world)
+		return executeStorage(actor, target, world)
 	case gc.InteractionMelee:
 		return executeMelee(actor, target, world)
 	case gc.InteractionDisassemble:
@@ -54,6 +56,10 @@ func ExecuteInteraction(actor ecs.Entity, target ecs.Entity, interaction gc.Inte
 		return executePortal(world, gc.OpenCubePanelEvent(), "control panel state change request error", "opened control panel")
 	case gc.InteractionAuction:
 		return executePortal(world, gc.OpenAuctionEvent(target), "auction menu state change request error", "opened shipping station")
+	case gc.InteractionPickLock:
+		return Execute(NewPickLockActivity(target, actor, world, false), actor, world)
+	case gc.InteractionForceLock:
+		return Execute(NewPickLockActivity(target, actor, world, true), actor, world)
 	}
 	// default を置かず exhaustive に全種別を強制する。未知入力は raw/save 由来でありうるので
 	// panic せず error で loud に落とす
@@ -127,7 +133,14 @@ func executeItemAll(actor ecs.Entity, world w.World) (*ActionResult, error) {
 	return Execute(NewPickupTileActivity(world, gridElement.Coord), actor, world)
 }
 
-func executeStorage(storageEntity ecs.Entity, world w.World) (*ActionResult, error) {
+// executeStorage は収納のメニューを開く。錠が掛かっていれば合う鍵で外してから開き、
+// 鍵が無ければ理由を gamelog へ出して開かない
+func executeStorage(actor ecs.Entity, storageEntity ecs.Entity, world w.World) (*ActionResult, error) {
+	if world.Components.Lock.Has(storageEntity) && !unlockWithKey(world, actor, storageEntity) {
+		msg := query.T(world, "%s is locked.", gamelog.Tag("item", query.GetEntityName(storageEntity, world)))
+		gamelog.New(query.GetGameLog(world)).Markup(msg).Log()
+		return &ActionResult{Success: false, State: gc.ActivityStateCanceled, ActivityName: gc.BehaviorStorage, Message: msg}, nil
+	}
 	if err := lifecycle.RequestStateChange(world, gc.OpenStorageEvent(storageEntity)); err != nil {
 		return nil, fmt.Errorf("storage menu state change request error: %w", err)
 	}
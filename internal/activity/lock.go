@@ -0,0 +1,258 @@
+package activity
+
+import (
+	"fmt"
+
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/gamelog"
+	"github.com/kijimaD/ruins/internal/oapi"
+	"github.com/kijimaD/ruins/internal/raw"
+	w "github.com/kijimaD/ruins/internal/world"
+
+	"github.com/kijimaD/ruins/internal/world/gameaction"
+	"github.com/kijimaD/ruins/internal/world/lifecycle"
+	"github.com/kijimaD/ruins/internal/world/query"
+	"github.com/mlange-42/ark/ecs"
+)
+
+// PickLockBehavior は扉や収納の錠を工具で外すアクティビティの実装。
+// 解錠は精密工具で静かに進め、破錠はこじ開け工具で速く進めるが毎ターン音を立てる。
+// 成否は完了時に機械スキルと工具のグレードを錠の難度と比べて1回だけ引く。
+// 分解と同じく工具は開始時に固定せず、毎ターン所持品から最良の1つを解決する
+type PickLockBehavior struct{}
+
+// Info はBehaviorの実装
+func (pb *PickLockBehavior) Info() Info {
+	return Info{
+		Name:            "Pick Lock",
+		Description:     "Open a lock with a tool",
+		Interruptible:   true,
+		Resumable:       true,
+		ActionPointCost: consts.StandardActionCost,
+	}
+}
+
+// Name はBehaviorの実装
+func (pb *PickLockBehavior) Name() gc.BehaviorName {
+	return gc.BehaviorPickLock
+}
+
+// NewPickLockActivity は錠の掛かった対象を指定して解錠アクティビティを組む。force なら破錠にする。
+// 必要APは錠の難度から求めた基礎工数に、分解と同じ機械スキルと工具グレードの短縮を掛ける
+func NewPickLockActivity(target, actor ecs.Entity, world w.World, force bool) *gc.Activity {
+	requiredAP := 0
+	if world.ECS.Alive(target) && world.Components.Lock.Has(target) {
+		if grade, _, ok := FindBestDisassemblyTool(world, actor, lockToolCategory(force)); ok {
+			baseAP := lockBaseAP(world.Components.Lock.Get(target).Difficulty, force)
+			requiredAP = RequiredDisassemblyAP(baseAP, mechanicSkillValue(actor, world), grade)
+		}
+	}
+	comp := NewActivity(gc.BehaviorPickLock, requiredAP)
+	comp.Params = &gc.PickLockParams{Target: target, Force: force}
+	return comp
+}
+
+// Validate は解錠アクティビティの検証を行う
+func (pb *PickLockBehavior) Validate(comp *gc.Activity, actor ecs.Entity, world w.World) error {
+	p, ok := comp.Params.(*gc.PickLockParams)
+	if !ok {
+		return ErrParamsTypeMismatch
+	}
+	if !world.ECS.Alive(p.Target) {
+		return fmt.Errorf("target does not exist")
+	}
+	name := gamelog.Tag("item", query.GetEntityName(p.Target, world))
+	if !world.Components.Lock.Has(p.Target) {
+		return &UserError{Msg: query.T(world, "%s is not locked.", name)}
+	}
+	if _, _, ok := FindBestDisassemblyTool(world, actor, lockToolCategory(p.Force)); !ok {
+		if p.Force {
+			return &UserError{Msg: query.T(world, "Do not have a tool to force open %s", name)}
+		}
+		return &UserError{Msg: query.T(world, "Do not have a tool to pick the lock of %s", name)}
+	}
+	if !isAreaSafe(actor, world) {
+		return &UserError{Msg: query.T(world, "cannot work on the lock because enemies are nearby")}
+	}
+	return nil
+}
+
+// Start は解錠開始時の処理を実行する
+func (pb *PickLockBehavior) Start(comp *gc.Activity, actor ecs.Entity, world w.World) error {
+	p, ok := comp.Params.(*gc.PickLockParams)
+	if !ok {
+		return ErrParamsTypeMismatch
+	}
+	_, toolName, ok := FindBestDisassemblyTool(world, actor, lockToolCategory(p.Force))
+	if !ok {
+		return fmt.Errorf("does not have the tool required for the lock")
+	}
+
+	name := query.GetEntityName(p.Target, world)
+	if world.Components.Player.Has(actor) {
+		logger := gamelog.New(query.GetGameLog(world))
+		if p.Force {
+			logger.Markup(query.T(world, "Using %s, began forcing %s open", gamelog.Tag("item", toolName), gamelog.Tag("item", name)))
+		} else {
+			logger.Markup(query.T(world, "Using %s, began picking the lock of %s", gamelog.Tag("item", toolName), gamelog.Tag("item", name)))
+		}
+		logger.Log()
+	}
+
+	log.Debug("pick lock started", "actor", actor, "target", name, "tool", toolName, "force", p.Force)
+	return nil
+}
+
+// DoTurn は解錠アクティビティの1ターン分の処理を実行する。
+// 破錠は毎ターン物音を立て、近くの敵に出どころを調べに来させる
+func (pb *PickLockBehavior) DoTurn(comp *gc.Activity, actor ecs.Entity, world w.World) error {
+	p, ok := comp.Params.(*gc.PickLockParams)
+	if !ok {
+		Cancel(comp, "lock target is not set")
+		return ErrParamsTypeMismatch
+	}
+	if !world.ECS.Alive(p.Target) || !world.Components.Lock.Has(p.Target) {
+		Cancel(comp, "interrupted because the lock disappeared")
+		return nil
+	}
+	if !isAreaSafe(actor, world) {
+		Cancel(comp, "lock work interrupted because enemies are nearby")
+		return nil
+	}
+	if _, _, ok := FindBestDisassemblyTool(world, actor, lockToolCategory(p.Force)); !ok {
+		Cancel(comp, "lock work interrupted because the tool was lost")
+		return nil
+	}
+
+	if p.Force {
+		gameaction.EmitNoise(world, actor, gameaction.NoiseForceLock)
+	}
+
+	comp.Progress.Current += perTurnAP(actor, world)
+	if comp.Progress.Current >= comp.Progress.Max {
+		Complete(comp)
+	}
+	return nil
+}
+
+// Finish は解錠完了時の処理を実行する。成否を引き、成功すれば錠を外す。
+// 失敗しても錠は残るので、もう一度試すか破錠に切り替えられる
+func (pb *PickLockBehavior) Finish(comp *gc.Activity, actor ecs.Entity, world w.World) error {
+	p, ok := comp.Params.(*gc.PickLockParams)
+	if !ok {
+		return ErrParamsTypeMismatch
+	}
+	target := p.Target
+	if !world.ECS.Alive(target) || !world.Components.Lock.Has(target) {
+		return nil
+	}
+	grade, _, ok := FindBestDisassemblyTool(world, actor, lockToolCategory(p.Force))
+	if !ok {
+		return fmt.Errorf("does not have the tool required for the lock")
+	}
+
+	chance := LockChance(world.Components.Lock.Get(target).Difficulty, mechanicSkillValue(actor, world), grade, p.Force)
+	success := world.Resources.Config.RNG.IntN(100) < chance
+	if success {
+		lifecycle.Unlock(world, target)
+		gainMechanicExp(actor, world)
+	}
+
+	if world.Components.Player.Has(actor) {
+		name := gamelog.Tag("item", query.GetEntityName(target, world))
+		logger := gamelog.New(query.GetGameLog(world))
+		switch {
+		case success && p.Force:
+			logger.Markup(query.T(world, "Forced %s open.", name))
+		case success:
+			logger.Markup(query.T(world, "Picked the lock of %s.", name))
+		case p.Force:
+			logger.Markup(query.T(world, "Failed to force %s open.", name))
+		default:
+			logger.Markup(query.T(world, "Failed to pick the lock of %s.", name))
+		}
+		logger.Log()
+	}
+
+	log.Debug("pick lock finished", "actor", actor, "target", target, "force", p.Force, "chance", chance, "success", success)
+	return nil
+}
+
+// Canceled は解錠キャンセル時の処理を実行する
+func (pb *PickLockBehavior) Canceled(comp *gc.Activity, actor ecs.Entity, world w.World) error {
+	if world.Components.Player.Has(actor) {
+		gamelog.New(query.GetGameLog(world)).
+			Markup(query.T(world, "Interrupted work on the lock")).
+			Log()
+	}
+
+	log.Debug("pick lock interrupted", "reason", comp.CancelReason)
+	return nil
+}
+
+// LockChance は錠を外せる確率を百分率で返す。機械スキルに工具グレード1につき10を足した腕前を難度と比べる。
+// 解錠は腕前と難度が釣り合って50%、差1につき2%動く。破錠は釣り合って70%、差1につき1%動き、
+// 腕前が足りなくても開けやすい。どちらも5%から95%に収める
+func LockChance(difficulty, skillValue, toolGrade int, force bool) int {
+	margin := skillValue + toolGrade*10 - difficulty
+	chance := 50 + margin*2
+	if force {
+		chance = 70 + margin
+	}
+	return min(max(chance, 5), 95)
+}
+
+// lockBaseAP は錠の難度から基礎工数を返す。解錠は難度に比例して長く、破錠は難度によらず短い
+func lockBaseAP(difficulty int, force bool) int {
+	if force {
+		return 2 * consts.StandardActionCost
+	}
+	return consts.StandardActionCost + difficulty*5
+}
+
+// lockToolCategory は解錠なら精密工具、破錠ならこじ開け工具を返す
+func lockToolCategory(force bool) oapi.ToolCategory {
+	if force {
+		return oapi.Prying
+	}
+	return oapi.Precision
+}
+
+// findLockKey は actor の所持品から keyID の錠に合う鍵を探し、名前を返す。keyID が空なら合う鍵は無い
+func findLockKey(world w.World, actor ecs.Entity, keyID string) (string, bool) {
+	if keyID == "" {
+		return "", false
+	}
+	q := ecs.NewFilter1[gc.LocationInBackpack](world.ECS).Query()
+	for q.Next() {
+		itemEntity := q.Entity()
+		if world.Components.LocationInBackpack.Get(itemEntity).Owner != actor {
+			continue
+		}
+		if key, ok := raw.FindLockKey(world.Resources.RawMaster, query.GetEntityID(itemEntity, world)); ok && key == keyID {
+			q.Close()
+			return query.GetEntityName(itemEntity, world), true
+		}
+	}
+	return "", false
+}
+
+// unlockWithKey は target の錠に合う鍵を actor が持っていれば錠を外し、プレイヤーならログに残す。
+// 錠が無いか合う鍵が無ければ何もせず false を返す
+func unlockWithKey(world w.World, actor, target ecs.Entity) bool {
+	if !world.Components.Lock.Has(target) {
+		return false
+	}
+	keyName, ok := findLockKey(world, actor, world.Components.Lock.Get(target).KeyID)
+	if !ok {
+		return false
+	}
+	lifecycle.Unlock(world, target)
+	if world.Components.Player.Has(actor) {
+		gamelog.New(query.GetGameLog(world)).
+			Markup(query.T(world, "Used %s to unlock %s.", gamelog.Tag("item", keyName), gamelog.Tag("item", query.GetEntityName(target, world)))).
+			Log()
+	}
+	return true
+}
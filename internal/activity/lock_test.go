@@ -0,0 +1,203 @@
+package activity
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/testutil"
+	w "github.com/kijimaD/ruins/internal/world"
+	"github.com/kijimaD/ruins/internal/world/lifecycle"
+	"github.com/mlange-42/ark/ecs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockChance(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		difficulty int
+		skill      int
+		toolGrade  int
+		force      bool
+		want       int
+	}{
+		{"解錠は腕前と難度が釣り合えば50%", 30, 20, 1, false, 50},
+		{"解錠は差1につき2%上がる", 30, 25, 1, false, 60},
+		{"破錠は腕前と難度が釣り合えば70%", 30, 20, 1, true, 70},
+		{"破錠は腕前が足りなくても開けやすい", 50, 0, 1, true, 30},
+		{"上限は95%", 10, 100, 3, false, 95},
+		{"下限は5%", 100, 0, 1, false, 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, LockChance(tt.difficulty, tt.skill, tt.toolGrade, tt.force))
+		})
+	}
+}
+
+// spawnLockedDoor は (11,10) に錠の掛かった扉を置く
+func spawnLockedDoor(t *testing.T, world w.World, lock gc.Lock) ecs.Entity {
+	t.Helper()
+	door, err := lifecycle.SpawnDoor(world, consts.Coord[consts.Tile]{X: 11, Y: 10}, gc.DoorOrientationHorizontal)
+	require.NoError(t, err)
+	require.NoError(t, lifecycle.LockEntity(world, door, lock))
+	return door
+}
+
+func TestPickLockBehavior_Validate_工具がないとエラー(t *testing.T) {
+	t.Parallel()
+
+	world := testutil.InitTestWorld(t)
+	player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 10, Y: 10}, "ash")
+	require.NoError(t, err)
+	door := spawnLockedDoor(t, world, gc.Lock{Difficulty: 20})
+
+	pb := &PickLockBehavior{}
+	for _, force := range []bool{false, true} {
+		err = pb.Validate(NewPickLockActivity(door, player, world, force), player, world)
+		var ue *UserError
+		require.ErrorAs(t, err, &ue, "force=%v", force)
+	}
+}
+
+func TestPickLockBehavior_Validate_錠が無ければエラー(t *testing.T) {
+	t.Parallel()
+
+	world := testutil.InitTestWorld(t)
+	player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 10, Y: 10}, "ash")
+	require.NoError(t, err)
+	_, err = lifecycle.SpawnBackpackItem(world, "lockpick", 1)
+	require.NoError(t, err)
+	door, err := lifecycle.SpawnDoor(world, consts.Coord[consts.Tile]{X: 11, Y: 10}, gc.DoorOrientationHorizontal)
+	require.NoError(t, err)
+
+	err = (&PickLockBehavior{}).Validate(NewPickLockActivity(door, player, world, false), player, world)
+	var ue *UserError
+	require.ErrorAs(t, err, &ue)
+}
+
+func TestPickLockBehavior_解錠に成功すると錠が外れて扉を開けられる(t *testing.T) {
+	t.Parallel()
+
+	world := testutil.InitTestWorld(t)
+	world.Resources.Config.RNG = rand.New(rand.NewPCG(7, 0))
+	player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 10, Y: 10}, "ash")
+	require.NoError(t, err)
+	world.Components.Skills.Get(player).Get(gc.SkillMechanic).Value = 80
+	_, err = lifecycle.SpawnBackpackItem(world, "lockpick", 1)
+	require.NoError(t, err)
+	door := spawnLockedDoor(t, world, gc.Lock{Difficulty: 20, KeyID: "storeroom"})
+
+	assert.Contains(t, world.Components.Interactable.Get(door).Interactions, gc.InteractionPickLock)
+	result, err := Execute(NewOpenDoorActivity(door), player, world)
+	require.NoError(t, err)
+	assert.False(t, result.Success, "鍵の無い施錠扉は開けられない")
+
+	pb := &PickLockBehavior{}
+	comp := NewPickLockActivity(door, player, world, false)
+	// 基礎工数 100+20*5=200 をスキル短縮の上限50%で半分にする
+	assert.Equal(t, 100, comp.Progress.Max)
+
+	require.NoError(t, pb.Validate(comp, player, world))
+	require.NoError(t, pb.Start(comp, player, world))
+	for comp.State == gc.ActivityStateRunning {
+		require.NoError(t, pb.DoTurn(comp, player, world))
+	}
+	require.NoError(t, pb.Finish(comp, player, world))
+
+	assert.False(t, world.Components.Lock.Has(door), "錠が外れるべき")
+	assert.NotContains(t, world.Components.Interactable.Get(door).Interactions, gc.InteractionPickLock,
+		"錠を外したら解錠の相互作用も消えるべき")
+	assert.Positive(t, world.Components.Skills.Get(player).Get(gc.SkillMechanic).Exp.Current)
+
+	result, err = Execute(NewOpenDoorActivity(door), player, world)
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.True(t, world.Components.Door.Get(door).IsOpen)
+}
+
+func TestPickLockBehavior_破錠の物音は近くの敵に届く(t *testing.T) {
+	t.Parallel()
+
+	world := testutil.InitTestWorld(t)
+	world.Resources.Config.RNG = rand.New(rand.NewPCG(7, 0))
+	player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 10, Y: 10}, "ash")
+	require.NoError(t, err)
+	_, err = lifecycle.SpawnBackpackItem(world, "monkey_wrench", 1)
+	require.NoError(t, err)
+	door := spawnLockedDoor(t, world, gc.Lock{Difficulty: 20})
+
+	near, err := lifecycle.SpawnEnemy(world, consts.Coord[consts.Tile]{X: 15, Y: 10}, "fireball")
+	require.NoError(t, err)
+	far, err := lifecycle.SpawnEnemy(world, consts.Coord[consts.Tile]{X: 30, Y: 10}, "fireball")
+	require.NoError(t, err)
+	for _, e := range []ecs.Entity{near, far} {
+		solo := world.Components.SoloAI.Get(e)
+		solo.CombatCurrent = gc.CombatAttack
+		solo.SubState = gc.AIStateWaiting
+	}
+
+	pb := &PickLockBehavior{}
+	comp := NewPickLockActivity(door, player, world, true)
+	require.NoError(t, pb.Validate(comp, player, world))
+	require.NoError(t, pb.Start(comp, player, world))
+	require.NoError(t, pb.DoTurn(comp, player, world))
+
+	heard := world.Components.SoloAI.Get(near).NoiseOrigin
+	require.NotNil(t, heard, "音の届く敵は出どころを覚える")
+	assert.Equal(t, consts.Coord[consts.Tile]{X: 10, Y: 10}, *heard)
+	assert.Nil(t, world.Components.SoloAI.Get(far).NoiseOrigin, "遠くの敵には届かない")
+}
+
+func TestOpenDoorBehavior_合う鍵を持っていれば施錠扉を開ける(t *testing.T) {
+	t.Parallel()
+
+	world := testutil.InitTestWorld(t)
+	player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 10, Y: 10}, "ash")
+	require.NoError(t, err)
+	door := spawnLockedDoor(t, world, gc.Lock{Difficulty: 90, KeyID: "storeroom"})
+
+	_, err = lifecycle.SpawnBackpackItem(world, "office_key", 1)
+	require.NoError(t, err)
+	result, err := Execute(NewOpenDoorActivity(door), player, world)
+	require.NoError(t, err)
+	assert.False(t, result.Success, "合わない鍵では開かない")
+	assert.True(t, world.Components.Lock.Has(door))
+
+	_, err = lifecycle.SpawnBackpackItem(world, "storeroom_key", 1)
+	require.NoError(t, err)
+	result, err = Execute(NewOpenDoorActivity(door), player, world)
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.False(t, world.Components.Lock.Has(door), "鍵で開けると錠が外れる")
+	assert.True(t, world.Components.Door.Get(door).IsOpen)
+}
+
+func TestExecuteInteraction_Storage_施錠された収納は鍵が無ければ開かない(t *testing.T) {
+	t.Parallel()
+
+	world := testutil.InitTestWorld(t)
+	player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 10, Y: 10}, "ash")
+	require.NoError(t, err)
+	locker, err := lifecycle.SpawnProp(world, "locker", 11, 10)
+	require.NoError(t, err)
+	require.True(t, world.Components.Lock.Has(locker), "ロッカーは錠付きで生成される")
+
+	result, err := ExecuteInteraction(player, locker, gc.InteractionStorage, world)
+	require.NoError(t, err)
+	assert.False(t, result.Success, "鍵が無ければ開かない")
+	assert.Nil(t, lifecycle.ConsumeStateChange(world))
+
+	_, err = lifecycle.SpawnBackpackItem(world, "locker_key", 1)
+	require.NoError(t, err)
+	result, err = ExecuteInteraction(player, locker, gc.InteractionStorage, world)
+	require.NoError(t, err)
+	assert.True(t, result.Success, "合う鍵があれば錠を外して開く")
+	assert.False(t, world.Components.Lock.Has(locker))
+	assert.NotNil(t, lifecycle.ConsumeStateChange(world))
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/kijimaD/ruins/internal/consts"
 	w "github.com/kijimaD/ruins/internal/world"
 
+	"github.com/kijimaD/ruins/internal/world/gameaction"
 	"github.com/kijimaD/ruins/internal/world/query"
 	"github.com/mlange-42/ark/ecs"
 )
@@ -203,6 +204,12 @@ func (mb *MoveBehavior) performMove(comp *gc.Activity, actor ecs.Entity, world w
 	// 同一ターン内で後続のAIが移動先を正しく判定できるようにする）。
 	query.UpdateCharacterPositionInIndex(world, actor, old, dest)
 
+	// プレイヤーの足音は近くの敵に届く。AI の足音は立てない。AI どうしはふつう敵対せず、
+	// 全 AI の1歩ごとに全 AI を走査するのは重いため
+	if world.Components.Player.Has(actor) {
+		gameaction.EmitNoise(world, actor, gameaction.FootstepNoise(world, actor))
+	}
+
 	log.Debug("move finished",
 		"actor", actor,
 		"from", old.String(),
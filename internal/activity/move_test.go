@@ -113,6 +113,30 @@ func TestMoveBehavior_DoTurn(t *testing.T) {
 		assert.Equal(t, 10, int(gridElement.Y))
 	})
 
+	t.Run("プレイヤーの足音は近くの敵に届く", func(t *testing.T) {
+		t.Parallel()
+		world := testutil.InitTestWorld(t)
+
+		player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 10, Y: 10}, "ash")
+		require.NoError(t, err)
+		enemy, err := lifecycle.SpawnEnemy(world, consts.Coord[consts.Tile]{X: 13, Y: 10}, "fireball")
+		require.NoError(t, err)
+		solo := world.Components.SoloAI.Get(enemy)
+		solo.CombatCurrent = gc.CombatAttack
+		solo.SubState = gc.AIStateWaiting
+
+		comp := &gc.Activity{
+			BehaviorName: gc.BehaviorMove,
+			State:        gc.ActivityStateRunning,
+			Params:       &gc.MoveParams{Destination: gc.GridElement{Coord: consts.Coord[consts.Tile]{X: 11, Y: 10}}},
+		}
+		require.NoError(t, (&MoveBehavior{}).DoTurn(comp, player, world))
+
+		heard := world.Components.SoloAI.Get(enemy).NoiseOrigin
+		require.NotNil(t, heard)
+		assert.Equal(t, consts.Coord[consts.Tile]{X: 11, Y: 10}, *heard, "出どころは歩いた先")
+	})
+
 	t.Run("移動先がnilの場合はキャンセルされる", func(t *testing.T) {
 		t.Parallel()
 		world := testutil.InitTestWorld(t)
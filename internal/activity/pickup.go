@@ -4,11 +4,13 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/kijimaD/ruins/internal/audio"
 	gc "github.com/kijimaD/ruins/internal/components"
 	"github.com/kijimaD/ruins/internal/consts"
 	"github.com/kijimaD/ruins/internal/gamelog"
 	w "github.com/kijimaD/ruins/internal/world"
 
+	"github.com/kijimaD/ruins/internal/world/gameaction"
 	"github.com/kijimaD/ruins/internal/world/lifecycle"
 	"github.com/kijimaD/ruins/internal/world/query"
 	"github.com/mlange-42/ark/ecs"
@@ -118,6 +120,8 @@ func (pb *PickupBehavior) performPickup(comp *gc.Activity, actor ecs.Entity, wor
 	actorName := query.GetEntityName(actor, world)
 	total := 0
 	var errs []error
+	// 効果音を引く代表。一度にまとめて拾っても鳴らすのは最初に拾ったものの音だけ
+	var first ecs.Entity
 	for _, stack := range query.GroupStacks(world, pickable) {
 		// 表示名と個数は移動前に確定する。移動後はスタックが割れて個数が変わるため先に数えておく
 		formattedName := query.FormatItemName(world, stack.Rep)
@@ -128,6 +132,9 @@ func (pb *PickupBehavior) performPickup(comp *gc.Activity, actor ecs.Entity, wor
 		if moved == 0 {
 			continue
 		}
+		if total == 0 {
+			first = stack.Rep
+		}
 		gamelog.New(query.GetGameLog(world)).
 			Markup(query.T(world, "%s picked up %s.",
 				query.NameMarkup(actor, actorName, world),
@@ -144,6 +151,9 @@ func (pb *PickupBehavior) performPickup(comp *gc.Activity, actor ecs.Entity, wor
 	}
 
 	log.Debug("pickup finished", "count", total)
+	if query.IsAlly(world, actor) {
+		gameaction.PlaySound(world, audio.EventPickup, first)
+	}
 
 	// 漁り統計: 拾えた個数を run 統計へ加算する
 	if s := query.GetRunStats(world); s != nil {
@@ -117,10 +117,12 @@ func (pb *PushBehavior) Finish(comp *gc.Activity, actor ecs.Entity, world w.Worl
 	if !world.ECS.Alive(cube) || !world.Components.GridElement.Has(cube) {
 		return nil
 	}
-	world.Components.GridElement.Get(cube).Coord = p.Destination.Coord
+	cubeGrid := world.Components.GridElement.Get(cube)
+	cubeOld := cubeGrid.Coord
+	cubeGrid.Coord = p.Destination.Coord
 
-	// キューブは BlockPass なので通行索引が変わる。全再構築で確実に反映する
-	query.InvalidateSpatialIndex(world)
+	// キューブは BlockPass なので通行索引が変わる。移動元と移動先だけを増分で反映する
+	query.UpdateBlockerInIndex(world, cube, &cubeOld)
 
 	log.Debug("push finished", "actor", actor, "cube", cube, "to", p.Destination.String())
 	return nil
@@ -264,11 +266,14 @@ func (pb *PullBehavior) Finish(comp *gc.Activity, actor ecs.Entity, world w.Worl
 	retreat := pullRetreat(cubeOld, p.Destination.Coord)
 
 	// 先にプレイヤーを後退させてタイルを空け、そこへキューブを引き入れる
-	world.Components.GridElement.Get(actor).Coord = retreat
+	actorGrid := world.Components.GridElement.Get(actor)
+	actorOld := actorGrid.Coord
+	actorGrid.Coord = retreat
 	cubeGrid.Coord = p.Destination.Coord
 
-	// キューブは BlockPass なので通行索引が変わる。全再構築で確実に反映する
-	query.InvalidateSpatialIndex(world)
+	// キューブは BlockPass なので通行索引が変わる。引き手とキューブの移動を増分で反映する
+	query.UpdateCharacterPositionInIndex(world, actor, actorOld, retreat)
+	query.UpdateBlockerInIndex(world, cube, &cubeOld)
 
 	log.Debug("pull finished", "actor", actor, "cube", cube, "to", p.Destination.String())
 	return nil
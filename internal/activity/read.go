@@ -3,12 +3,14 @@ package activity
 import (
 	"fmt"
 
+	"github.com/kijimaD/ruins/internal/audio"
 	gc "github.com/kijimaD/ruins/internal/components"
 	"github.com/kijimaD/ruins/internal/consts"
 	"github.com/kijimaD/ruins/internal/gamelog"
 	"github.com/kijimaD/ruins/internal/skill"
 	w "github.com/kijimaD/ruins/internal/world"
 
+	"github.com/kijimaD/ruins/internal/world/gameaction"
 	"github.com/kijimaD/ruins/internal/world/lifecycle"
 	"github.com/kijimaD/ruins/internal/world/query"
 	"github.com/mlange-42/ark/ecs"
@@ -230,6 +232,7 @@ func (rb *ReadBehavior) applyPerTurnEffect(book *gc.Book, actor ecs.Entity, worl
 		gamelog.New(query.GetGameLog(world)).
 			Markup(query.T(world, "%s skill rose to %d", name, s.Value)).
 			Log()
+		gameaction.PlaySound(world, audio.EventLevelUp)
 	}
 }
 
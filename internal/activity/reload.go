@@ -4,11 +4,13 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/kijimaD/ruins/internal/audio"
 	gc "github.com/kijimaD/ruins/internal/components"
 	"github.com/kijimaD/ruins/internal/consts"
 	"github.com/kijimaD/ruins/internal/gamelog"
 	w "github.com/kijimaD/ruins/internal/world"
 
+	"github.com/kijimaD/ruins/internal/world/gameaction"
 	"github.com/kijimaD/ruins/internal/world/lifecycle"
 	"github.com/kijimaD/ruins/internal/world/query"
 	"github.com/mlange-42/ark/ecs"
@@ -64,7 +66,10 @@ func (rb *ReloadBehavior) Validate(_ *gc.Activity, actor ecs.Entity, world w.Wor
 		return &UserError{Msg: query.T(world, "reload is not needed")}
 	}
 
-	// 弾薬の在庫チェック
+	// 弾薬の在庫チェック。コマンドテーブルの射撃武器は弾薬を持ち歩かない
+	if firesFromCommandTable(actor, world) {
+		return nil
+	}
 	if _, found := query.FindAmmoInInventory(world, fire.AmmoTag); !found {
 		return &UserError{Msg: query.T(world, "no ammo")}
 	}
@@ -73,10 +78,12 @@ func (rb *ReloadBehavior) Validate(_ *gc.Activity, actor ecs.Entity, world w.Wor
 }
 
 // Start はリロード開始時の処理
-func (rb *ReloadBehavior) Start(_ *gc.Activity, _ ecs.Entity, world w.World) error {
-	gamelog.New(query.GetGameLog(world)).
-		Markup(query.T(world, "started reloading")).
-		Log()
+func (rb *ReloadBehavior) Start(_ *gc.Activity, actor ecs.Entity, world w.World) error {
+	if world.Components.Player.Has(actor) {
+		gamelog.New(query.GetGameLog(world)).
+			Markup(query.T(world, "started reloading")).
+			Log()
+	}
 	return nil
 }
 
@@ -92,6 +99,12 @@ func (rb *ReloadBehavior) DoTurn(comp *gc.Activity, actor ecs.Entity, world w.Wo
 	comp.Progress.Current += rb.calcEffortPerTurn(actor, fire, world)
 
 	// 工数が目標に達したら装填完了
+	if comp.Progress.Current >= comp.Progress.Max && firesFromCommandTable(actor, world) {
+		// 弾薬を持ち歩かない武器は在庫を引かずに満たす
+		fire.Magazine = fire.MagazineSize
+		Complete(comp)
+		return nil
+	}
 	if comp.Progress.Current >= comp.Progress.Max {
 		// 装填数を計算（マガジン容量と弾薬在庫の小さい方）
 		needed := fire.MagazineSize - fire.Magazine
@@ -114,9 +127,13 @@ func (rb *ReloadBehavior) DoTurn(comp *gc.Activity, actor ecs.Entity, world w.Wo
 			return fmt.Errorf("failed to consume ammo: %w", err)
 		}
 
-		gamelog.New(query.GetGameLog(world)).
-			Markup(query.T(world, "reload complete (%d/%d)", fire.Magazine, fire.MagazineSize)).
-			Log()
+		if world.Components.Player.Has(actor) {
+			gamelog.New(query.GetGameLog(world)).
+				Markup(query.T(world, "reload complete (%d/%d)", fire.Magazine, fire.MagazineSize)).
+				Log()
+			weapon, _ := selectedWeapon(actor, world)
+			gameaction.PlaySound(world, audio.EventReload, weapon)
+		}
 
 		Complete(comp)
 		return nil
@@ -133,9 +150,11 @@ func (rb *ReloadBehavior) Finish(_ *gc.Activity, actor ecs.Entity, _ w.World) er
 
 // Canceled はリロードキャンセル時の処理
 func (rb *ReloadBehavior) Canceled(comp *gc.Activity, actor ecs.Entity, world w.World) error {
-	gamelog.New(query.GetGameLog(world)).
-		Markup(query.T(world, "interrupted reloading")).
-		Log()
+	if world.Components.Player.Has(actor) {
+		gamelog.New(query.GetGameLog(world)).
+			Markup(query.T(world, "interrupted reloading")).
+			Log()
+	}
 	log.Debug("reload canceled", "actor", actor, "reason", comp.CancelReason)
 	return nil
 }
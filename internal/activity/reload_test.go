@@ -180,6 +180,28 @@ func TestReloadBehavior_DoTurn(t *testing.T) {
 	})
 }
 
+func TestReloadBehavior_AIは弾薬を持たずに弾倉を満たす(t *testing.T) {
+	t.Parallel()
+
+	world := testutil.InitTestWorld(t)
+	tank, err := lifecycle.SpawnEnemy(world, consts.Coord[consts.Tile]{X: 10, Y: 10}, "light_tank")
+	require.NoError(t, err)
+	fire := world.Components.CommandTable.Get(tank).Fire
+	require.NotNil(t, fire)
+	fire.Magazine = 0
+
+	ra := &ReloadBehavior{}
+	comp := NewReloadActivity(tank, world)
+	require.NoError(t, ra.Validate(comp, tank, world))
+	require.NoError(t, ra.Start(comp, tank, world))
+	for comp.State != gc.ActivityStateCompleted {
+		require.NoError(t, ra.DoTurn(comp, tank, world))
+	}
+
+	assert.Equal(t, fire.MagazineSize, fire.Magazine)
+	assert.Empty(t, query.GetGameLog(world).GetRecent(10), "AIの装填はログに出さない")
+}
+
 // TestReloadBehavior_進捗はアクティビティごとに独立する は、装填工数の累積を
 // Behavior インスタンスのフィールドではなく gc.Activity 側に持たせる規律を固定する。
 // 進捗をインスタンスに置くと、1つのインスタンスへ複数アクティビティを通したとき
@@ -9,6 +9,7 @@ import (
 	"github.com/kijimaD/ruins/internal/geometry"
 	w "github.com/kijimaD/ruins/internal/world"
 
+	"github.com/kijimaD/ruins/internal/world/gameaction"
 	"github.com/kijimaD/ruins/internal/world/query"
 	"github.com/mlange-42/ark/ecs"
 )
@@ -119,13 +120,14 @@ func (sb *ShootBehavior) DoTurn(comp *gc.Activity, actor ecs.Entity, world w.Wor
 
 	// 弾薬消費
 	fire.Magazine--
+	gameaction.EmitNoise(world, actor, gameaction.NoiseShot)
 
 	// 命中率修正を計算（距離ペナルティ + 遮蔽ペナルティ + 弾薬修正）
 	hitModifier := calculateRangedHitModifier(actor, target, fire, world)
 	hitModifier += fire.LoadedAccuracyBonus
 
-	// ダメージ適用（共通関数を使用）
-	if err := applyAttackDamage(actor, target, world, fire, weaponName, hitModifier, fire.LoadedDamageBonus); err != nil {
+	// ダメージ適用（共通関数を使用）。全体攻撃なら着弾点の周りにも当たる
+	if err := applyAttackToTargets(actor, target, world, fire, weaponName, hitModifier, fire.LoadedDamageBonus); err != nil {
 		return err
 	}
 
@@ -145,8 +147,17 @@ func (sb *ShootBehavior) Canceled(comp *gc.Activity, actor ecs.Entity, _ w.World
 	return nil
 }
 
-// getEquippedFire はプレイヤーの装備中の遠距離武器のFireと武器名を取得する
+// getEquippedFire は actor の遠距離武器のFireと武器名を取得する。
+// プレイヤーは選択中の武器スロットから、AI はコマンドテーブルの射撃武器から引く
 func getEquippedFire(actor ecs.Entity, world w.World) (*gc.Fire, string, error) {
+	if firesFromCommandTable(actor, world) {
+		ct := world.Components.CommandTable.Get(actor)
+		if ct.Fire == nil {
+			return nil, "", ErrShootNoFireWeapon
+		}
+		return ct.Fire, ct.FireWeapon, nil
+	}
+
 	selectedSlot := query.GetWeaponSelection(world).Slot
 	weaponIndex := selectedSlot - 1
 	if weaponIndex < 0 || weaponIndex >= 5 {
@@ -166,6 +177,12 @@ func getEquippedFire(actor ecs.Entity, world w.World) (*gc.Fire, string, error)
 	return fire, name, nil
 }
 
+// firesFromCommandTable は actor の射撃武器がコマンドテーブル由来かを返す。
+// そうした武器は弾薬を持ち歩かず、装填に所持品の弾薬を要しない
+func firesFromCommandTable(actor ecs.Entity, world w.World) bool {
+	return !world.Components.Player.Has(actor) && world.Components.CommandTable.Has(actor)
+}
+
 // calculateRangedHitModifier は距離と遮蔽による命中率修正を計算する
 func calculateRangedHitModifier(actor, target ecs.Entity, attack gc.Attacker, world w.World) int {
 	modifier := 0
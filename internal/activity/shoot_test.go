@@ -502,6 +502,23 @@ func TestGetEquippedFire(t *testing.T) {
 		assert.Positive(t, fire.MagazineSize)
 	})
 
+	t.Run("AIはコマンドテーブルの射撃武器を使う", func(t *testing.T) {
+		t.Parallel()
+		world := testutil.InitTestWorld(t)
+
+		eye, err := lifecycle.SpawnEnemy(world, consts.Coord[consts.Tile]{X: 10, Y: 10}, "floating_eye")
+		require.NoError(t, err)
+		fire, name, err := getEquippedFire(eye, world)
+		require.NoError(t, err)
+		assert.Equal(t, "Light Shot", name)
+		assert.Same(t, world.Components.CommandTable.Get(eye).Fire, fire, "弾倉は個体が持つ")
+
+		fireball, err := lifecycle.SpawnEnemy(world, consts.Coord[consts.Tile]{X: 12, Y: 10}, "fireball")
+		require.NoError(t, err)
+		_, _, err = getEquippedFire(fireball, world)
+		assert.ErrorIs(t, err, ErrShootNoFireWeapon)
+	})
+
 	t.Run("武器未装備でエラー", func(t *testing.T) {
 		t.Parallel()
 		world := testutil.InitTestWorld(t)
@@ -515,6 +532,27 @@ func TestGetEquippedFire(t *testing.T) {
 	})
 }
 
+func TestShootBehavior_AIは射撃武器でプレイヤーを撃つ(t *testing.T) {
+	t.Parallel()
+
+	world := testutil.InitTestWorld(t)
+	player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 10, Y: 10}, "ash")
+	require.NoError(t, err)
+	eye, err := lifecycle.SpawnEnemy(world, consts.Coord[consts.Tile]{X: 15, Y: 10}, "floating_eye")
+	require.NoError(t, err)
+	fire := world.Components.CommandTable.Get(eye).Fire
+	before := fire.Magazine
+
+	require.True(t, CanShootTarget(eye, player, world))
+	result, err := Execute(NewShootActivity(player), eye, world)
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.Equal(t, before-1, fire.Magazine)
+
+	fire.Magazine = 0
+	assert.False(t, CanShootTarget(eye, player, world), "弾倉が空なら撃てない")
+}
+
 // === CalculateShootHitRate テスト ===
 
 func TestCalculateShootHitRate(t *testing.T) {
@@ -6,6 +6,7 @@ import (
 	gc "github.com/kijimaD/ruins/internal/components"
 	"github.com/kijimaD/ruins/internal/consts"
 	"github.com/kijimaD/ruins/internal/gamelog"
+	"github.com/kijimaD/ruins/internal/quest"
 	w "github.com/kijimaD/ruins/internal/world"
 
 	"github.com/kijimaD/ruins/internal/world/lifecycle"
@@ -118,6 +119,11 @@ func (tb *TalkBehavior) Finish(comp *gc.Activity, actor ecs.Entity, world w.Worl
 			Markup(query.T(world, "Talked with %s.", query.GetEntityName(targetEntity, world))).
 			Log()
 
+		// 納品目標の渡し先なら、会話の前に品を渡す
+		if err := quest.Deliver(world, targetEntity); err != nil {
+			return err
+		}
+
 		// 会話ダイアログを表示
 		if world.Components.Dialog.Has(targetEntity) {
 			dialog := world.Components.Dialog.Get(targetEntity)
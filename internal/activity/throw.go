@@ -0,0 +1,211 @@
+package activity
+
+import (
+	"fmt"
+
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/gamelog"
+	"github.com/kijimaD/ruins/internal/geometry"
+	w "github.com/kijimaD/ruins/internal/world"
+
+	"github.com/kijimaD/ruins/internal/world/gameaction"
+	"github.com/kijimaD/ruins/internal/world/lifecycle"
+	"github.com/kijimaD/ruins/internal/world/query"
+	"github.com/mlange-42/ark/ecs"
+)
+
+// ThrowBehavior は投擲アクティビティの実装
+type ThrowBehavior struct{}
+
+// Info はBehaviorの実装
+func (tb *ThrowBehavior) Info() Info {
+	return Info{
+		Name:            "Throw",
+		Description:     "Throw an item at a target",
+		Interruptible:   false,
+		Resumable:       false,
+		ActionPointCost: consts.StandardActionCost,
+	}
+}
+
+// Name はBehaviorの実装
+func (tb *ThrowBehavior) Name() gc.BehaviorName {
+	return gc.BehaviorThrow
+}
+
+// NewThrowActivity は投げるアイテムと狙う相手を指定して投擲アクティビティを組む。
+func NewThrowActivity(item, target ecs.Entity) *gc.Activity {
+	comp := NewActivity(gc.BehaviorThrow, 0)
+	comp.Params = &gc.ThrowParams{Item: item, Target: target}
+	return comp
+}
+
+// Validate は投擲の検証を行う
+func (tb *ThrowBehavior) Validate(comp *gc.Activity, actor ecs.Entity, world w.World) error {
+	p, ok := comp.Params.(*gc.ThrowParams)
+	if !ok {
+		return ErrParamsTypeMismatch
+	}
+	if world.Components.Dead.Has(actor) {
+		return ErrAttackerDead
+	}
+
+	// 投げるアイテムは自分のバックパックにある投擲可能品に限る
+	if !world.Components.Throwable.Has(p.Item) {
+		return ErrThrowNotThrowable
+	}
+	loc := world.Components.LocationInBackpack.Get(p.Item)
+	if loc == nil || loc.Owner != actor {
+		return fmt.Errorf("item is not in the backpack")
+	}
+
+	// 対象は CanThrowAt が事前に絞る。ここで弾かれるのは選択後の消失などの不変条件違反
+	if !world.Components.GridElement.Has(p.Target) {
+		return fmt.Errorf("target has no position")
+	}
+	if world.Components.Dead.Has(p.Target) {
+		return fmt.Errorf("target is already dead")
+	}
+
+	throwable := world.Components.Throwable.Get(p.Item)
+	if EntityDistance(actor, p.Target, world) > float64(throwable.Range) {
+		return fmt.Errorf("target is out of range")
+	}
+	if blocked, _ := checkLineOfSight(actor, p.Target, world); blocked {
+		return fmt.Errorf("line of sight is blocked")
+	}
+
+	return nil
+}
+
+// Start はBehaviorの実装
+func (tb *ThrowBehavior) Start(comp *gc.Activity, actor ecs.Entity, _ w.World) error {
+	if p, ok := comp.Params.(*gc.ThrowParams); ok {
+		log.Debug("throw started", "actor", actor, "item", p.Item, "target", p.Target)
+	}
+	return nil
+}
+
+// DoTurn は投擲の実行処理
+func (tb *ThrowBehavior) DoTurn(comp *gc.Activity, actor ecs.Entity, world w.World) error {
+	p, ok := comp.Params.(*gc.ThrowParams)
+	if !ok {
+		Cancel(comp, "throw target is not set")
+		return ErrParamsTypeMismatch
+	}
+
+	item := p.Item
+	throwable := *world.Components.Throwable.Get(item)
+	// 名前は壊れて消える前に確定する
+	itemName := query.GetEntityName(item, world)
+	itemMarkup := gamelog.Tag("item", itemName)
+	hit := gameaction.ItemHit(world, item)
+
+	landing, _ := resolveThrowLanding(actor, p.Target, world)
+
+	gamelog.New(query.GetGameLog(world)).
+		Markup(query.T(world, "%s threw %s.", query.NameMarkup(actor, query.GetEntityName(actor, world), world), itemMarkup)).
+		Log()
+
+	for _, victim := range throwVictims(world, landing, throwable.Splash) {
+		damage := throwable.Damage
+		victimMarkup := query.NameMarkup(victim, query.GetEntityName(victim, world), world)
+		gamelog.New(query.GetGameLog(world)).
+			Markup(query.T(world, "%s was hit by %s and took %d damage.", victimMarkup, itemMarkup, damage)).
+			Log()
+		lifecycle.SpawnVisualEffect(victim, gc.NewDamageEffect(damage), world)
+		gameaction.ApplyDamageWith(world, victim, damage, actor, hit)
+
+		// 被ダメージで中断可能なアクティビティをキャンセルする
+		if act := query.GetActivity(world, victim); act != nil && CanInterrupt(act) {
+			CancelActivity(victim, "took an attack", world)
+		}
+	}
+
+	// 壊れる物は着弾で消え、壊れない物は着弾点の床に残る
+	if throwable.BreaksOnImpact {
+		if err := lifecycle.ChangeItemCount(world, item, -1); err != nil {
+			Cancel(comp, "failed to break thrown item")
+			return fmt.Errorf("failed to break thrown item: %w", err)
+		}
+		gamelog.New(query.GetGameLog(world)).
+			Markup(query.T(world, "%s broke.", itemMarkup)).
+			Log()
+	} else {
+		lifecycle.MoveMembersToField(world, []ecs.Entity{item}, landing, actor)
+	}
+
+	Complete(comp)
+	return nil
+}
+
+// Finish はBehaviorの実装
+func (tb *ThrowBehavior) Finish(_ *gc.Activity, actor ecs.Entity, _ w.World) error {
+	log.Debug("throw finished", "actor", actor)
+	return nil
+}
+
+// Canceled はBehaviorの実装
+func (tb *ThrowBehavior) Canceled(comp *gc.Activity, actor ecs.Entity, _ w.World) error {
+	log.Debug("throw canceled", "actor", actor, "reason", comp.CancelReason)
+	return nil
+}
+
+// resolveThrowLanding は actor から target へ投げた物の着弾点を決める。
+// 線上を手前から辿り、HPを持つものに当たればそのタイルに落ちて hit を返す。
+// 通れないタイルに当たればその手前に落ちる。何にも当たらなければ target のタイルに落ちる
+func resolveThrowLanding(actor, target ecs.Entity, world w.World) (consts.Coord[consts.Tile], *ecs.Entity) {
+	from := world.Components.GridElement.Get(actor).Coord
+	to := world.Components.GridElement.Get(target).Coord
+
+	points := geometry.BresenhamLine(consts.Coord[int]{X: int(from.X), Y: int(from.Y)}, consts.Coord[int]{X: int(to.X), Y: int(to.Y)})
+	points = append(points, consts.Coord[int]{X: int(to.X), Y: int(to.Y)})
+
+	landing := from
+	for _, pt := range points {
+		coord := consts.Coord[consts.Tile]{X: consts.Tile(pt.X), Y: consts.Tile(pt.Y)}
+		blocked := false
+		for _, e := range query.GetEntitiesAt(world, coord.X, coord.Y) {
+			if isThrowVictim(world, e) {
+				return coord, &e
+			}
+			if world.Components.BlockPass.Has(e) {
+				blocked = true
+			}
+		}
+		if blocked {
+			return landing, nil
+		}
+		landing = coord
+	}
+	return landing, nil
+}
+
+// throwVictims は着弾点から splash タイル以内にいる被弾者を返す。
+// 巻き込みは投げた本人も区別しない
+func throwVictims(world w.World, landing consts.Coord[consts.Tile], splash consts.Tile) []ecs.Entity {
+	var victims []ecs.Entity
+	for dy := -splash; dy <= splash; dy++ {
+		for dx := -splash; dx <= splash; dx++ {
+			for _, e := range query.GetEntitiesAt(world, landing.X+dx, landing.Y+dy) {
+				if isThrowVictim(world, e) {
+					victims = append(victims, e)
+				}
+			}
+		}
+	}
+	return victims
+}
+
+// isThrowVictim は投げた物が当たる相手かを返す。HPを持ち生きているものが当たる
+func isThrowVictim(world w.World, entity ecs.Entity) bool {
+	return world.Components.HP.Has(entity) && !world.Components.Dead.Has(entity)
+}
+
+// CanThrowAt はactorがitemをtargetへ投げられるかを判定する。
+// 投擲対象選択UIでのフィルタリング用
+func CanThrowAt(actor, item, target ecs.Entity, world w.World) bool {
+	comp := NewThrowActivity(item, target)
+	return (&ThrowBehavior{}).Validate(comp, actor, world) == nil
+}
@@ -0,0 +1,159 @@
+package activity
+
+import (
+	"testing"
+
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/testutil"
+	iw "github.com/kijimaD/ruins/internal/world"
+
+	"github.com/kijimaD/ruins/internal/world/lifecycle"
+	"github.com/mlange-42/ark/ecs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupThrowingWorld は投擲テスト用のWorldを構築する。
+// プレイヤーに itemName を持たせ、3タイル東に敵を置く
+func setupThrowingWorld(t *testing.T, itemName string) (world iw.World, player, enemy, item ecs.Entity) {
+	t.Helper()
+	world = testutil.InitTestWorld(t)
+
+	p, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 10, Y: 10}, "ash")
+	require.NoError(t, err)
+
+	it, err := lifecycle.SpawnBackpackItem(world, itemName, 1)
+	require.NoError(t, err)
+
+	e, err := lifecycle.SpawnEnemy(world, consts.Coord[consts.Tile]{X: 13, Y: 10}, "fireball")
+	require.NoError(t, err)
+
+	return world, p, e, it
+}
+
+func TestThrowBehavior_Info(t *testing.T) {
+	t.Parallel()
+	tb := &ThrowBehavior{}
+	assert.Equal(t, "Throw", tb.Info().Name)
+	assert.Equal(t, gc.BehaviorThrow, tb.Name())
+
+	b, err := GetBehavior(gc.BehaviorThrow)
+	require.NoError(t, err)
+	assert.IsType(t, &ThrowBehavior{}, b)
+}
+
+func TestThrowBehavior_Validate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("射程内の敵へ投げられる", func(t *testing.T) {
+		t.Parallel()
+		world, player, enemy, item := setupThrowingWorld(t, "stone")
+		assert.True(t, CanThrowAt(player, item, enemy, world))
+	})
+
+	t.Run("投擲できないアイテムは投げられない", func(t *testing.T) {
+		t.Parallel()
+		world, player, enemy, _ := setupThrowingWorld(t, "stone")
+		other, err := lifecycle.SpawnBackpackItem(world, "antidote", 1)
+		require.NoError(t, err)
+
+		err = (&ThrowBehavior{}).Validate(NewThrowActivity(other, enemy), player, world)
+		require.ErrorIs(t, err, ErrThrowNotThrowable)
+	})
+
+	t.Run("射程外の敵へは投げられない", func(t *testing.T) {
+		t.Parallel()
+		world, player, enemy, item := setupThrowingWorld(t, "stone")
+		world.Components.GridElement.Get(enemy).Coord = consts.Coord[consts.Tile]{X: 10 + world.Components.Throwable.Get(item).Range + 1, Y: 10}
+		assert.False(t, CanThrowAt(player, item, enemy, world))
+	})
+
+	t.Run("視線が壁で遮られていると投げられない", func(t *testing.T) {
+		t.Parallel()
+		world, player, enemy, item := setupThrowingWorld(t, "stone")
+		wall := world.ECS.NewEntity()
+		world.Components.GridElement.Add(wall, &gc.GridElement{Coord: consts.Coord[consts.Tile]{X: 12, Y: 10}})
+		world.Components.BlockView.Add(wall, &gc.BlockView{})
+		world.Components.BlockPass.Add(wall, &gc.BlockPass{})
+		assert.False(t, CanThrowAt(player, item, enemy, world))
+	})
+}
+
+func TestResolveThrowLanding(t *testing.T) {
+	t.Parallel()
+
+	t.Run("間に何もなければ標的に当たる", func(t *testing.T) {
+		t.Parallel()
+		world, player, enemy, _ := setupThrowingWorld(t, "stone")
+
+		landing, hit := resolveThrowLanding(player, enemy, world)
+		assert.Equal(t, consts.Coord[consts.Tile]{X: 13, Y: 10}, landing)
+		require.NotNil(t, hit)
+		assert.Equal(t, enemy, *hit)
+	})
+
+	t.Run("手前のキャラクターに先に当たる", func(t *testing.T) {
+		t.Parallel()
+		world, player, enemy, _ := setupThrowingWorld(t, "stone")
+		front, err := lifecycle.SpawnEnemy(world, consts.Coord[consts.Tile]{X: 11, Y: 10}, "fireball")
+		require.NoError(t, err)
+
+		landing, hit := resolveThrowLanding(player, enemy, world)
+		assert.Equal(t, consts.Coord[consts.Tile]{X: 11, Y: 10}, landing)
+		require.NotNil(t, hit)
+		assert.Equal(t, front, *hit)
+	})
+
+	t.Run("通れない障害物の手前に落ちる", func(t *testing.T) {
+		t.Parallel()
+		world, player, enemy, _ := setupThrowingWorld(t, "stone")
+		cover := world.ECS.NewEntity()
+		world.Components.GridElement.Add(cover, &gc.GridElement{Coord: consts.Coord[consts.Tile]{X: 12, Y: 10}})
+		world.Components.BlockPass.Add(cover, &gc.BlockPass{})
+
+		landing, hit := resolveThrowLanding(player, enemy, world)
+		assert.Equal(t, consts.Coord[consts.Tile]{X: 11, Y: 10}, landing)
+		assert.Nil(t, hit)
+	})
+}
+
+func TestExecute_Throw(t *testing.T) {
+	t.Parallel()
+
+	t.Run("壊れない物は標的にダメージを与えて足元に残る", func(t *testing.T) {
+		t.Parallel()
+		world, player, enemy, item := setupThrowingWorld(t, "stone")
+		hp := world.Components.HP.Get(enemy)
+		before := hp.Current
+		damage := world.Components.Throwable.Get(item).Damage
+
+		_, err := Execute(NewThrowActivity(item, enemy), player, world)
+		require.NoError(t, err)
+
+		assert.Equal(t, max(before-damage, 0), hp.Current)
+		assert.False(t, world.Components.LocationInBackpack.Has(item), "投げた物は手元から離れる")
+		require.True(t, world.Components.LocationOnField.Has(item), "壊れない物は床に残る")
+		assert.Equal(t, consts.Coord[consts.Tile]{X: 13, Y: 10}, world.Components.GridElement.Get(item).Coord)
+	})
+
+	t.Run("壊れる物は着弾で消え周囲も巻き込む", func(t *testing.T) {
+		t.Parallel()
+		world, player, enemy, item := setupThrowingWorld(t, "grenade")
+		neighbor, err := lifecycle.SpawnEnemy(world, consts.Coord[consts.Tile]{X: 14, Y: 11}, "fireball")
+		require.NoError(t, err)
+		far, err := lifecycle.SpawnEnemy(world, consts.Coord[consts.Tile]{X: 16, Y: 10}, "fireball")
+		require.NoError(t, err)
+		beforeNeighbor := world.Components.HP.Get(neighbor).Current
+		beforeFar := world.Components.HP.Get(far).Current
+		beforeEnemy := world.Components.HP.Get(enemy).Current
+
+		_, err = Execute(NewThrowActivity(item, enemy), player, world)
+		require.NoError(t, err)
+
+		assert.False(t, world.ECS.Alive(item), "壊れる物は着弾で消える")
+		assert.Less(t, world.Components.HP.Get(enemy).Current, beforeEnemy)
+		assert.Less(t, world.Components.HP.Get(neighbor).Current, beforeNeighbor, "巻き込み半径内も被弾する")
+		assert.Equal(t, beforeFar, world.Components.HP.Get(far).Current, "巻き込み半径外は無傷")
+	})
+}
@@ -53,7 +53,8 @@ func (u *UseItemBehavior) Validate(comp *gc.Activity, actor ecs.Entity, world w.
 	// 何らかの効果があるかチェック
 	hasEffect := world.Components.ProvidesHealing.Has(item) ||
 		world.Components.ProvidesNutrition.Has(item) ||
-		world.Components.InflictsDamage.Has(item)
+		world.Components.InflictsDamage.Has(item) ||
+		world.Components.ProvidesTreatment.Has(item)
 
 	// Use は効果のあるアイテムにしか提示されない。ここで効果なしなのは不変条件違反
 	if !hasEffect {
@@ -103,11 +104,17 @@ func (u *UseItemBehavior) DoTurn(comp *gc.Activity, actor ecs.Entity, world w.Wo
 		}
 	}
 
+	// 手当て効果があるかチェック
+	if world.Components.ProvidesTreatment.Has(item) {
+		treatment := world.Components.ProvidesTreatment.Get(item)
+		u.applyTreatment(actor, world, treatment.Amount, item)
+	}
+
 	// ダメージ効果があるかチェック
 	if world.Components.InflictsDamage.Has(item) {
 		damage := world.Components.InflictsDamage.Get(item)
 		// 共通のダメージ処理を使用
-		gameaction.ApplyDamage(world, actor, damage.Amount, actor)
+		gameaction.ApplyDamageWith(world, actor, damage.Amount, actor, gameaction.ItemHit(world, item))
 	}
 
 	// 消費可能アイテムの場合は削除または個数を減らす
@@ -155,6 +162,37 @@ func (u *UseItemBehavior) applyHealing(_ *gc.Activity, actor ecs.Entity, world w
 	return nil
 }
 
+// applyTreatment は手当てを行う。治療量を回復効果倍率で増減し、負傷の深い順に配る
+func (u *UseItemBehavior) applyTreatment(actor ecs.Entity, world w.World, amount int, item ecs.Entity) {
+	if !world.Components.HealthStatus.Has(actor) {
+		return
+	}
+	if world.Components.CharModifiers.Has(actor) {
+		amount = world.Components.CharModifiers.Get(actor).HealingEffect.ApplyInt(amount)
+	}
+
+	healed, used := world.Components.HealthStatus.Get(actor).Tend(float64(max(amount, 1)))
+	if used > 0 && !world.Components.StatsChanged.Has(actor) {
+		world.Components.StatsChanged.Add(actor, &gc.StatsChanged{})
+	}
+
+	if !world.Components.Player.Has(actor) {
+		return
+	}
+	actorMarkup := query.NameMarkup(actor, query.GetEntityName(actor, world), world)
+	itemMarkup := gamelog.Tag("item", u.getItemName(item, world))
+	logger := gamelog.New(query.GetGameLog(world))
+	switch {
+	case used == 0:
+		logger.Markup(query.T(world, "%s used %s, but had no injuries to treat.", actorMarkup, itemMarkup))
+	case healed == 0:
+		logger.Markup(query.T(world, "%s used %s and eased an injury.", actorMarkup, itemMarkup))
+	default:
+		logger.Markup(query.T(world, "%s used %s and treated %d injuries.", actorMarkup, itemMarkup, healed))
+	}
+	logger.Log()
+}
+
 // rottenNutritionPercent は腐敗した食料から得られる栄養の割合。満額の3割。
 const rottenNutritionPercent = 30
 
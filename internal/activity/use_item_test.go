@@ -507,3 +507,28 @@ func TestUseItemBehavior_applyHealing(t *testing.T) {
 		assert.Equal(t, 11, hp.Current, "倍率0でも最低1は回復するべき")
 	})
 }
+
+func TestUseItemBehavior_手当てで負傷を深い順に治す(t *testing.T) {
+	t.Parallel()
+	world := testutil.InitTestWorld(t)
+
+	player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 5, Y: 5}, "ash")
+	require.NoError(t, err)
+	bandage, err := lifecycle.SpawnBackpackItem(world, "bandage", 1)
+	require.NoError(t, err)
+	world.Components.StatsChanged.Remove(player)
+	hs := world.Components.HealthStatus.Get(player)
+	hs.UpdateInjury(gc.BodyPartLegs, 30)
+	hs.UpdateInjury(gc.BodyPartArms, 20)
+
+	_, err = Execute(NewUseItemActivity(bandage), player, world)
+	require.NoError(t, err)
+
+	hs = world.Components.HealthStatus.Get(player)
+	assert.Nil(t, hs.Parts[gc.BodyPartLegs].GetCondition(gc.ConditionInjury), "深い脚から治す")
+	arms := hs.Parts[gc.BodyPartArms].GetCondition(gc.ConditionInjury)
+	require.NotNil(t, arms, "治療量が尽きた腕は浅くなって残る")
+	assert.Less(t, arms.Timer, 20.0)
+	assert.True(t, world.Components.StatsChanged.Has(player))
+	assert.False(t, world.ECS.Alive(bandage), "包帯は使うと無くなる")
+}
@@ -846,3 +846,91 @@ func TestPlanAction_ChasingState_プレイヤーに接近(t *testing.T) {
 	move := activityParams[*gc.MoveParams](t, behavior)
 	assert.Greater(t, int(move.Destination.X), 5, "プレイヤー方向に移動すべき")
 }
+
+func TestPlanAction_InvestigatingState_出どころへ近づき着けば忘れる(t *testing.T) {
+	t.Parallel()
+	world := testutil.InitTestWorld(t)
+
+	_, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 1, Y: 1}, "ash")
+	require.NoError(t, err)
+
+	origin := consts.Coord[consts.Tile]{X: 12, Y: 10}
+	solo := &gc.SoloAI{
+		CombatDefault:         gc.CombatAttack,
+		CombatCurrent:         gc.CombatAttack,
+		Movement:              gc.SoloStationary,
+		ViewDistance:          3,
+		SubState:              gc.AIStateInvestigating,
+		StartSubStateTurn:     1,
+		DurationSubStateTurns: 100,
+		NoiseOrigin:           &origin,
+	}
+	entity := setupTestAI(t, world, 10, 10, solo)
+
+	rp := newSoloPlanner(newTestRNG())
+
+	behavior := rp.Plan(world, entity)
+	require.Equal(t, gc.BehaviorMove, behavior.BehaviorName, "動かない個体でも物音の出どころへは向かう")
+	move := activityParams[*gc.MoveParams](t, behavior)
+	assert.Equal(t, consts.Coord[consts.Tile]{X: 11, Y: 10}, move.Destination.Coord)
+
+	world.Components.GridElement.Get(entity).Coord = origin
+	behavior = rp.Plan(world, entity)
+	assert.Equal(t, gc.BehaviorWait, behavior.BehaviorName)
+	assert.Nil(t, world.Components.SoloAI.Get(entity).NoiseOrigin, "出どころに着いたら忘れる")
+}
+
+func TestPlanAction_ChasingState_射撃武器を持つAI(t *testing.T) {
+	t.Parallel()
+
+	// setup はプレイヤーを (10,10) に、射撃武器を持つ浮遊眼を追跡状態で (x,10) に置く
+	setup := func(t *testing.T, x consts.Tile) (w.World, ecs.Entity, ecs.Entity) {
+		t.Helper()
+		world := testutil.InitTestWorld(t)
+		player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 10, Y: 10}, "ash")
+		require.NoError(t, err)
+		eye, err := lifecycle.SpawnEnemy(world, consts.Coord[consts.Tile]{X: x, Y: 10}, "floating_eye")
+		require.NoError(t, err)
+		solo := world.Components.SoloAI.Get(eye)
+		solo.CombatCurrent = gc.CombatAttack
+		solo.ViewDistance = 10
+		solo.SubState = gc.AIStateChasing
+		solo.StartSubStateTurn = 1
+		solo.DurationSubStateTurns = 100
+		return world, player, eye
+	}
+
+	t.Run("射程内なら撃つ", func(t *testing.T) {
+		t.Parallel()
+		world, player, eye := setup(t, 15)
+
+		behavior := newSoloPlanner(newTestRNG()).Plan(world, eye)
+		require.Equal(t, gc.BehaviorShoot, behavior.BehaviorName)
+		assert.Equal(t, player, activityParams[*gc.ShootParams](t, behavior).Target)
+	})
+
+	t.Run("間合いを詰められたら下がる", func(t *testing.T) {
+		t.Parallel()
+		world, _, eye := setup(t, 11)
+
+		behavior := newSoloPlanner(newTestRNG()).Plan(world, eye)
+		require.Equal(t, gc.BehaviorMove, behavior.BehaviorName)
+		assert.Equal(t, consts.Tile(12), activityParams[*gc.MoveParams](t, behavior).Destination.X)
+	})
+
+	t.Run("弾倉が空なら装填し、装填中は計画し直さない", func(t *testing.T) {
+		t.Parallel()
+		world, _, eye := setup(t, 15)
+		world.Components.CommandTable.Get(eye).Fire.Magazine = 0
+
+		planner := newSoloPlanner(newTestRNG())
+		behavior := planner.Plan(world, eye)
+		require.Equal(t, gc.BehaviorReload, behavior.BehaviorName)
+
+		runAPLoop(world, eye, planner, planner.logger)
+		current := world.Components.Activity.Get(eye)
+		require.NotNil(t, current, "装填は複数ターンかかる")
+		assert.Equal(t, gc.BehaviorReload, current.BehaviorName)
+		assert.Equal(t, gc.ActivityStateRunning, current.State)
+	})
+}
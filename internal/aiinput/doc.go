@@ -9,10 +9,17 @@
 // # 仕様
 //   - Plannerインターフェースで行動決定を抽象化し、runAPLoopで統一的にAP消費ループを実行する
 //   - 敵・中立NPCはsoloPlannerが状態遷移とアクション計画をインラインで処理する
+//   - 群れで配置された分隊員はsquadPlannerが標的共有・包囲・潰走を足し、状態遷移はsoloPlannerを使う
+//   - 待機・徘徊中のAIは物音を聞くと出どころを調べに向かい、その間に相手を見つければ交戦へ移る
+//   - 物音は射撃・こじ開け・破壊・足音が立てる。足音は歩きと走りを分けない1段階
+//   - コマンドテーブルに射撃武器を持つAIは間合いを保って撃ち、弾倉が空になれば装填する
+//   - 装填のように複数ターンかかる行動の途中は計画を立て直さず、継続処理に任せる
+//   - 視界距離は相手の隠密スキルで縮み、相手がプレイヤーなら足元の暗さでも縮む
+//   - 視線はプレイヤーの視界と同じ対称シャドウキャストで判定し、壁越しの相手は見つけない
 //   - 遠方の非交戦AIは距離カリングで処理対象から外す
 //
 // # 使い分け
 //   - Processor: AIシステム全体の処理制御。ProcessAllで全AIエンティティを処理する
-//   - Planner: 行動決定インターフェース。soloPlannerが実装する
+//   - Planner: 行動決定インターフェース。soloPlannerとsquadPlannerが実装する
 //   - VisionSystem: 視界判定
 package aiinput
@@ -10,6 +10,7 @@ import (
 	"github.com/kijimaD/ruins/internal/logger"
 	w "github.com/kijimaD/ruins/internal/world"
 
+	"github.com/kijimaD/ruins/internal/world/query"
 	"github.com/mlange-42/ark/ecs"
 )
 
@@ -32,6 +33,11 @@ func runAPLoop(world w.World, entity ecs.Entity, planner Planner, log *logger.Lo
 			break
 		}
 
+		// 装填のように複数ターンかかる行動は ProcessContinuousActivities が進める。計画し直すと中断するので手を止める
+		if current := query.GetActivity(world, entity); current != nil && activity.IsActive(current) {
+			break
+		}
+
 		comp := planner.Plan(world, entity)
 		if comp == nil {
 			break
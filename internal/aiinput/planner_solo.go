@@ -17,6 +17,9 @@ import (
 // territorialRadius はTerritorial移動パターンでスポーン地点から離れられる最大距離を定義する
 const territorialRadius consts.Tile = 5
 
+// kiteDistance は射撃武器を持つAIが標的との間に保とうとする距離を定義する。これより近づかれると下がる
+const kiteDistance = 3.0
+
 // soloPlanner は敵・中立NPC用の行動計画を実装する。
 // AIStateの状態遷移とSoloMovementによる移動を統合して行動を決定する
 type soloPlanner struct {
@@ -57,6 +60,8 @@ func (rp *soloPlanner) Plan(world w.World, entity ecs.Entity) *gc.Activity {
 		return rp.planChaseAction(world, entity, *target, grid)
 	case gc.AIStateFleeing:
 		return rp.planFleeAction(world, entity, *target, grid)
+	case gc.AIStateInvestigating:
+		return rp.planInvestigateAction(world, entity, solo, grid)
 	case gc.AIStateDriving:
 		return rp.planDrivingAction(world, entity, solo, grid)
 	case gc.AIStateWaiting:
@@ -90,6 +95,8 @@ func (rp *soloPlanner) updateState(solo *gc.SoloAI, canSeePlayer bool, currentTu
 		rp.updateFromChasing(solo, canSeePlayer, elapsedTurns, currentTurn)
 	case gc.AIStateFleeing:
 		rp.updateFromFleeing(solo, canSeePlayer, elapsedTurns, currentTurn)
+	case gc.AIStateInvestigating:
+		rp.updateFromInvestigating(solo, canSeePlayer, elapsedTurns, currentTurn)
 	default:
 		rp.initializeToWaiting(solo, currentTurn)
 	}
@@ -104,6 +111,8 @@ func (rp *soloPlanner) updateFromWaiting(solo *gc.SoloAI, canSeePlayer bool, ela
 			rp.transitionToChasing(solo, currentTurn)
 		case gc.CombatIgnore:
 		}
+	} else if solo.NoiseOrigin != nil {
+		rp.transitionToInvestigating(solo, currentTurn)
 	} else if elapsedTurns >= solo.DurationSubStateTurns {
 		rp.transitionToDriving(solo, currentTurn)
 	}
@@ -118,6 +127,8 @@ func (rp *soloPlanner) updateFromDriving(solo *gc.SoloAI, canSeePlayer bool, ela
 			rp.transitionToChasing(solo, currentTurn)
 		case gc.CombatIgnore:
 		}
+	} else if solo.NoiseOrigin != nil {
+		rp.transitionToInvestigating(solo, currentTurn)
 	} else if elapsedTurns >= solo.DurationSubStateTurns {
 		rp.transitionToWaiting(solo, currentTurn)
 	}
@@ -142,6 +153,26 @@ func (rp *soloPlanner) updateFromFleeing(solo *gc.SoloAI, canSeePlayer bool, ela
 	}
 }
 
+// updateFromInvestigating は物音を調べている間に相手を見つければ交戦へ移り、
+// 出どころを調べ終えるか時間切れになれば待機へ戻る
+func (rp *soloPlanner) updateFromInvestigating(solo *gc.SoloAI, canSeePlayer bool, elapsedTurns, currentTurn consts.Turn) {
+	if canSeePlayer {
+		switch solo.CombatCurrent {
+		case gc.CombatEvade:
+			rp.transitionToFleeing(solo, currentTurn)
+			return
+		case gc.CombatAttack:
+			rp.transitionToChasing(solo, currentTurn)
+			return
+		case gc.CombatIgnore:
+		}
+	}
+	if solo.NoiseOrigin == nil || elapsedTurns >= solo.DurationSubStateTurns {
+		solo.NoiseOrigin = nil
+		rp.transitionToWaiting(solo, currentTurn)
+	}
+}
+
 func (rp *soloPlanner) transitionToWaiting(solo *gc.SoloAI, currentTurn consts.Turn) {
 	solo.SubState = gc.AIStateWaiting
 	solo.StartSubStateTurn = currentTurn
@@ -155,17 +186,25 @@ func (rp *soloPlanner) transitionToDriving(solo *gc.SoloAI, currentTurn consts.T
 }
 
 func (rp *soloPlanner) transitionToChasing(solo *gc.SoloAI, currentTurn consts.Turn) {
+	solo.NoiseOrigin = nil
 	solo.SubState = gc.AIStateChasing
 	solo.StartSubStateTurn = currentTurn
 	solo.DurationSubStateTurns = consts.Turn(10 + rp.rng.IntN(5))
 }
 
 func (rp *soloPlanner) transitionToFleeing(solo *gc.SoloAI, currentTurn consts.Turn) {
+	solo.NoiseOrigin = nil
 	solo.SubState = gc.AIStateFleeing
 	solo.StartSubStateTurn = currentTurn
 	solo.DurationSubStateTurns = consts.Turn(5 + rp.rng.IntN(5))
 }
 
+func (rp *soloPlanner) transitionToInvestigating(solo *gc.SoloAI, currentTurn consts.Turn) {
+	solo.SubState = gc.AIStateInvestigating
+	solo.StartSubStateTurn = currentTurn
+	solo.DurationSubStateTurns = consts.Turn(8 + rp.rng.IntN(5))
+}
+
 func (rp *soloPlanner) initializeToWaiting(solo *gc.SoloAI, currentTurn consts.Turn) {
 	solo.SubState = gc.AIStateWaiting
 	solo.StartSubStateTurn = currentTurn
@@ -175,6 +214,10 @@ func (rp *soloPlanner) initializeToWaiting(solo *gc.SoloAI, currentTurn consts.T
 // ========== アクション計画ロジック ==========
 
 func (rp *soloPlanner) planChaseAction(world w.World, aiEntity, playerEntity ecs.Entity, aiGrid *gc.GridElement) *gc.Activity {
+	if b, ok := rp.planRangedAction(world, aiEntity, playerEntity, aiGrid); ok {
+		return b
+	}
+
 	playerGrid := world.Components.GridElement.Get(playerEntity)
 
 	if isAdjacent(aiGrid, playerGrid) {
@@ -192,6 +235,34 @@ func (rp *soloPlanner) planChaseAction(world w.World, aiEntity, playerEntity ecs
 	return waitAction()
 }
 
+// planRangedAction はコマンドテーブルに射撃武器を持つAIの追跡中の行動を決める。
+// 間合いを詰められていれば下がり、弾倉が空なら装填し、撃てるなら撃つ。
+// 射撃武器が無い、下がれずに隣接している、射線が通らないときは false を返し、近接の追跡に任せる
+func (rp *soloPlanner) planRangedAction(world w.World, aiEntity, target ecs.Entity, aiGrid *gc.GridElement) (*gc.Activity, bool) {
+	ct := world.Components.CommandTable.Get(aiEntity)
+	if ct == nil || ct.Fire == nil {
+		return nil, false
+	}
+	targetGrid := world.Components.GridElement.Get(target)
+
+	if activity.EntityDistance(aiEntity, target, world) < kiteDistance {
+		candidates := calculateMoveCandidates(aiGrid.Coord.Sub(targetGrid.Coord))
+		if b, ok := tryMoveCandidates(world, aiEntity, aiGrid, candidates); ok {
+			return b, true
+		}
+	}
+	if isAdjacent(aiGrid, targetGrid) {
+		return nil, false
+	}
+	if ct.Fire.Magazine <= 0 {
+		return activity.NewReloadActivity(aiEntity, world), true
+	}
+	if activity.CanShootTarget(aiEntity, target, world) {
+		return activity.NewShootActivity(target), true
+	}
+	return nil, false
+}
+
 func (rp *soloPlanner) planFleeAction(world w.World, aiEntity, playerEntity ecs.Entity, aiGrid *gc.GridElement) *gc.Activity {
 	playerGrid := world.Components.GridElement.Get(playerEntity)
 
@@ -206,6 +277,27 @@ func (rp *soloPlanner) planFleeAction(world w.World, aiEntity, playerEntity ecs.
 	return rp.planRandomMoveAction(world, aiEntity, aiGrid)
 }
 
+// planInvestigateAction は物音の出どころへ1歩近づく。経路が引ければ最短経路に沿い、引けなければ
+// 追跡と同じく向きだけで寄る。出どころに着くか寄る手が無ければ、調べ終えたものとして出どころを忘れる
+func (rp *soloPlanner) planInvestigateAction(world w.World, aiEntity ecs.Entity, solo *gc.SoloAI, aiGrid *gc.GridElement) *gc.Activity {
+	if solo.NoiseOrigin == nil {
+		return waitAction()
+	}
+	from := aiGrid.Coord
+	goal := *solo.NoiseOrigin
+	if from != goal {
+		if next, ok := activity.FindNextStep(world, aiEntity, from, goal); ok && activity.CanMoveTo(world, next, from, aiEntity) {
+			return moveAction(next)
+		}
+		candidates := calculateMoveCandidates(consts.Coord[consts.Tile]{X: goal.X - from.X, Y: goal.Y - from.Y})
+		if b, ok := tryMoveCandidates(world, aiEntity, aiGrid, candidates); ok {
+			return b
+		}
+	}
+	solo.NoiseOrigin = nil
+	return waitAction()
+}
+
 func (rp *soloPlanner) planRandomMoveAction(world w.World, aiEntity ecs.Entity, aiGrid *gc.GridElement) *gc.Activity {
 	if rp.rng.Float64() < 0.3 {
 		return waitAction()
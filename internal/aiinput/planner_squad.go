@@ -0,0 +1,250 @@
+package aiinput
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/kijimaD/ruins/internal/activity"
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/geometry"
+	"github.com/kijimaD/ruins/internal/logger"
+	w "github.com/kijimaD/ruins/internal/world"
+	"github.com/kijimaD/ruins/internal/world/query"
+
+	"github.com/mlange-42/ark/ecs"
+)
+
+// squadFollowRadius は非交戦時に手下が頭から離れてよい最大距離を定義する
+const squadFollowRadius = 2
+
+// squadPlanner は群れで配置された敵用の行動計画を実装する。
+// 状態遷移と単独の動きは soloPlanner に任せ、分隊として次を足す
+//   - 頭の SoloAI.TargetEntity を標的として全員で共有し、誰か1体が見えていれば全員が気づく
+//   - 追跡中は標的の隣接タイルを手分けして取り、囲むように寄る
+//   - 非交戦時は頭だけが移動パターンで動き、手下は頭の近くに留まる
+//   - 頭が倒れたら全員が同時に逃亡へ移り、以降は単独で動く
+type squadPlanner struct {
+	solo   *soloPlanner
+	logger *logger.Logger
+}
+
+func newSquadPlanner(solo *soloPlanner) *squadPlanner {
+	return &squadPlanner{
+		solo:   solo,
+		logger: logger.New(logger.CategoryTurn),
+	}
+}
+
+// Plan は分隊の状況を見て行動を決める。潰走後や分隊を組めない場合は soloPlanner に委ねる
+func (sp *squadPlanner) Plan(world w.World, entity ecs.Entity) *gc.Activity {
+	squad := world.Components.Squad.Get(entity)
+	solo := world.Components.SoloAI.Get(entity)
+	if squad == nil || solo == nil || squad.Routed {
+		return sp.solo.Plan(world, entity)
+	}
+
+	members := squadMembers(world, squad.ID)
+	leader, ok := squadLeader(world, members)
+	if !ok {
+		sp.rout(world, members)
+		return sp.solo.Plan(world, entity)
+	}
+
+	grid := world.Components.GridElement.Get(entity)
+	target, canSee := sp.shareTarget(world, leader, members)
+	if target == nil {
+		return sp.planIdleAction(world, entity, leader, solo, grid)
+	}
+
+	turnNumber := query.GetTurnState(world).TurnNumber
+	sp.solo.updateState(solo, canSee, turnNumber)
+
+	switch solo.SubState {
+	case gc.AIStateChasing:
+		return sp.planFlankAction(world, entity, *target, members, grid)
+	case gc.AIStateFleeing:
+		return sp.solo.planFleeAction(world, entity, *target, grid)
+	case gc.AIStateInvestigating:
+		return sp.solo.planInvestigateAction(world, entity, solo, grid)
+	case gc.AIStateDriving:
+		return sp.planIdleAction(world, entity, leader, solo, grid)
+	case gc.AIStateWaiting:
+		return waitAction()
+	default:
+		return waitAction()
+	}
+}
+
+// squadMembers は生きている分隊員を ID の昇順で返す。手分けの順番を毎回同じにするため並べる
+func squadMembers(world w.World, id uint64) []ecs.Entity {
+	var members []ecs.Entity
+	q := query.ActiveFilter3[gc.Squad, gc.SoloAI, gc.GridElement](world).Query()
+	for q.Next() {
+		squad, _, _ := q.Get()
+		if squad.ID != id || world.Components.Dead.Has(q.Entity()) {
+			continue
+		}
+		members = append(members, q.Entity())
+	}
+	slices.SortFunc(members, func(a, b ecs.Entity) int { return cmp.Compare(a.ID(), b.ID()) })
+	return members
+}
+
+// squadLeader は分隊員から頭を探す
+func squadLeader(world w.World, members []ecs.Entity) (ecs.Entity, bool) {
+	for _, m := range members {
+		if world.Components.Squad.Get(m).Leader {
+			return m, true
+		}
+	}
+	return ecs.Entity{}, false
+}
+
+// rout は頭を失った分隊を潰走させる。全員を同じターンに逃亡へ移し、戦闘方針も回避に切り替える。
+// 逃げ切ると soloPlanner の状態遷移で元の戦闘方針に戻る
+func (sp *squadPlanner) rout(world w.World, members []ecs.Entity) {
+	turnNumber := query.GetTurnState(world).TurnNumber
+	for _, m := range members {
+		world.Components.Squad.Get(m).Routed = true
+		solo := world.Components.SoloAI.Get(m)
+		solo.CombatCurrent = gc.CombatEvade
+		sp.solo.transitionToFleeing(solo, turnNumber)
+	}
+	sp.logger.Debug("squad routed", "members", len(members))
+}
+
+// shareTarget は分隊で共有する標的と、分隊の誰かがそれを見ているかを返す。
+// 標的は頭の TargetEntity に置く。未設定なら、敵対者を見つけた最初の分隊員の最寄りの敵対者を標的にする。
+// 誰にも見えず頭も交戦中でなければ標的を手放す
+func (sp *squadPlanner) shareTarget(world w.World, leader ecs.Entity, members []ecs.Entity) (*ecs.Entity, bool) {
+	leaderAI := world.Components.SoloAI.Get(leader)
+	if t := leaderAI.TargetEntity; t != nil && !isValidTarget(world, leader, *t) {
+		leaderAI.TargetEntity = nil
+	}
+
+	if leaderAI.TargetEntity == nil {
+		for _, m := range members {
+			t := sp.solo.findNearestHostile(world, m)
+			if t != nil && sp.canSee(world, m, *t) {
+				leaderAI.TargetEntity = t
+				return t, true
+			}
+		}
+		return nil, false
+	}
+
+	target := leaderAI.TargetEntity
+	for _, m := range members {
+		if sp.canSee(world, m, *target) {
+			return target, true
+		}
+	}
+	if !isActiveCombatState(leaderAI.SubState) {
+		leaderAI.TargetEntity = nil
+		return nil, false
+	}
+	return target, false
+}
+
+// canSee は分隊員が対象を視界に捉えているかを返す
+func (sp *squadPlanner) canSee(world w.World, member, target ecs.Entity) bool {
+	return sp.solo.visionSystem.CanSeeTarget(world, member, target, world.Components.SoloAI.Get(member).ViewDistance)
+}
+
+// isValidTarget は標的がまだ追う価値のある敵対者かを返す
+func isValidTarget(world w.World, self, target ecs.Entity) bool {
+	return world.ECS.Alive(target) &&
+		!world.Components.Dead.Has(target) &&
+		world.Components.GridElement.Has(target) &&
+		query.FactionRelation(world, self, target) == query.RelationHostile
+}
+
+// planIdleAction は非交戦時の行動を返す。頭は自分の移動パターンで動き、手下は頭の近くへ寄る
+func (sp *squadPlanner) planIdleAction(world w.World, entity, leader ecs.Entity, solo *gc.SoloAI, grid *gc.GridElement) *gc.Activity {
+	if entity == leader {
+		return sp.solo.planDrivingAction(world, entity, solo, grid)
+	}
+
+	leaderGrid := world.Components.GridElement.Get(leader)
+	if geometry.ChebyshevDistance(grid.Coord, leaderGrid.Coord) <= squadFollowRadius {
+		return sp.solo.planWanderAction(world, entity, grid)
+	}
+
+	candidates := calculateMoveCandidates(leaderGrid.Coord.Sub(grid.Coord))
+	if b, ok := tryMoveCandidates(world, entity, grid, candidates); ok {
+		return b
+	}
+	return waitAction()
+}
+
+// planFlankAction は標的を囲むように寄る。射撃武器を持つ分隊員は囲みに加わらず soloPlanner と同じく撃つ。
+// 隣接していれば殴り、そうでなければ自分に割り当てた隣接タイルへ向かう。割り当てが無ければ
+// soloPlanner と同じく標的へ直進する
+func (sp *squadPlanner) planFlankAction(world w.World, entity, target ecs.Entity, members []ecs.Entity, grid *gc.GridElement) *gc.Activity {
+	if b, ok := sp.solo.planRangedAction(world, entity, target, grid); ok {
+		return b
+	}
+	targetGrid := world.Components.GridElement.Get(target)
+	if isAdjacent(grid, targetGrid) {
+		return activity.NewMeleeActivity(target)
+	}
+
+	if slot, ok := flankSlot(world, entity, targetGrid.Coord, members); ok {
+		candidates := calculateMoveCandidates(slot.Sub(grid.Coord))
+		if b, ok := tryMoveCandidates(world, entity, grid, candidates); ok {
+			return b
+		}
+	}
+	return sp.solo.planChaseAction(world, entity, target, grid)
+}
+
+// flankSlot は標的の隣接タイルを分隊員に手分けして割り当て、entity の取り分を返す。
+// 既に隣接している分隊員はその場所を取る。残りは ID の昇順に、空いている中で最も近いタイルを取る。
+// 壁と分隊外のキャラクターがいるタイルは割り当てない
+func flankSlot(world w.World, entity ecs.Entity, target consts.Coord[consts.Tile], members []ecs.Entity) (consts.Coord[consts.Tile], bool) {
+	si := query.GetSpatialIndex(world)
+	claimed := map[consts.Coord[consts.Tile]]bool{}
+	for _, m := range members {
+		pos := world.Components.GridElement.Get(m).Coord
+		if geometry.IsAdjacent(pos, target) {
+			claimed[pos] = true
+		}
+	}
+
+	var slots []consts.Coord[consts.Tile]
+	for _, d := range eightDirections {
+		slot := target.Add(d)
+		if claimed[slot] || si.IsBlockPass(slot) {
+			continue
+		}
+		if occupant, ok := si.CharacterAt(slot); ok && !slices.Contains(members, occupant) {
+			continue
+		}
+		slots = append(slots, slot)
+	}
+
+	for _, m := range members {
+		pos := world.Components.GridElement.Get(m).Coord
+		if geometry.IsAdjacent(pos, target) {
+			continue
+		}
+		best := -1
+		for i, slot := range slots {
+			if claimed[slot] {
+				continue
+			}
+			if best < 0 || geometry.ChebyshevDistance(pos, slot) < geometry.ChebyshevDistance(pos, slots[best]) {
+				best = i
+			}
+		}
+		if best < 0 {
+			return consts.Coord[consts.Tile]{}, false
+		}
+		claimed[slots[best]] = true
+		if m == entity {
+			return slots[best], true
+		}
+	}
+	return consts.Coord[consts.Tile]{}, false
+}
@@ -0,0 +1,151 @@
+package aiinput
+
+import (
+	"testing"
+
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/geometry"
+	"github.com/kijimaD/ruins/internal/testutil"
+	w "github.com/kijimaD/ruins/internal/world"
+	"github.com/kijimaD/ruins/internal/world/lifecycle"
+	"github.com/mlange-42/ark/ecs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupSquadMember は分隊員を作成する。状態は Waiting から始める
+func setupSquadMember(t *testing.T, world w.World, x, y int, id uint64, leader bool, viewDistance consts.Tile) ecs.Entity {
+	t.Helper()
+	solo := &gc.SoloAI{
+		CombatDefault:         gc.CombatAttack,
+		CombatCurrent:         gc.CombatAttack,
+		Movement:              gc.SoloStationary,
+		ViewDistance:          viewDistance,
+		SubState:              gc.AIStateWaiting,
+		StartSubStateTurn:     1,
+		DurationSubStateTurns: 100,
+	}
+	entity := setupTestAI(t, world, x, y, solo)
+	world.Components.Squad.Add(entity, &gc.Squad{ID: id, Leader: leader})
+	return entity
+}
+
+func TestSquadPlanner_誰か1体が見つければ頭の標的を共有して全員が追う(t *testing.T) {
+	t.Parallel()
+	world := testutil.InitTestWorld(t)
+
+	player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 5, Y: 5}, "ash")
+	require.NoError(t, err)
+
+	// 頭は遠くて見えず、手下だけがプレイヤーを視界に捉えている
+	leader := setupSquadMember(t, world, 15, 5, 1, true, 3)
+	follower := setupSquadMember(t, world, 7, 5, 1, false, 3)
+
+	sp := newSquadPlanner(newSoloPlanner(newTestRNG()))
+
+	sp.Plan(world, leader)
+	leaderAI := world.Components.SoloAI.Get(leader)
+	require.NotNil(t, leaderAI.TargetEntity, "手下が見つけた標的を頭が持つ")
+	assert.Equal(t, player, *leaderAI.TargetEntity)
+	assert.Equal(t, gc.AIStateChasing, leaderAI.SubState, "見えていない頭も追跡に移る")
+
+	sp.Plan(world, follower)
+	assert.Equal(t, gc.AIStateChasing, world.Components.SoloAI.Get(follower).SubState)
+}
+
+func TestSquadPlanner_追跡中は標的の隣接タイルを手分けする(t *testing.T) {
+	t.Parallel()
+	world := testutil.InitTestWorld(t)
+
+	_, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 10, Y: 10}, "ash")
+	require.NoError(t, err)
+
+	// 同じ側から寄る2体。手分けしなければ同じタイルを目指す
+	a := setupSquadMember(t, world, 13, 10, 1, true, 8)
+	b := setupSquadMember(t, world, 13, 11, 1, false, 8)
+	members := []ecs.Entity{a, b}
+	target := consts.Coord[consts.Tile]{X: 10, Y: 10}
+
+	slotA, ok := flankSlot(world, a, target, members)
+	require.True(t, ok)
+	slotB, ok := flankSlot(world, b, target, members)
+	require.True(t, ok)
+
+	assert.NotEqual(t, slotA, slotB, "分隊員ごとに別の隣接タイルを割り当てる")
+	assert.True(t, geometry.IsAdjacent(slotA, target))
+	assert.True(t, geometry.IsAdjacent(slotB, target))
+}
+
+func TestSquadPlanner_隣接していれば殴る(t *testing.T) {
+	t.Parallel()
+	world := testutil.InitTestWorld(t)
+
+	_, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 5, Y: 5}, "ash")
+	require.NoError(t, err)
+
+	leader := setupSquadMember(t, world, 6, 5, 1, true, 5)
+	world.Components.SoloAI.Get(leader).SubState = gc.AIStateChasing
+
+	sp := newSquadPlanner(newSoloPlanner(newTestRNG()))
+	behavior := sp.Plan(world, leader)
+	assert.Equal(t, gc.BehaviorMelee, behavior.BehaviorName)
+}
+
+func TestSquadPlanner_頭が倒れると全員が潰走する(t *testing.T) {
+	t.Parallel()
+	world := testutil.InitTestWorld(t)
+
+	_, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 5, Y: 5}, "ash")
+	require.NoError(t, err)
+
+	leader := setupSquadMember(t, world, 8, 5, 1, true, 5)
+	a := setupSquadMember(t, world, 9, 5, 1, false, 5)
+	b := setupSquadMember(t, world, 9, 6, 1, false, 5)
+	// 別の分隊は巻き込まない
+	other := setupSquadMember(t, world, 20, 20, 2, false, 5)
+	otherLeader := setupSquadMember(t, world, 21, 20, 2, true, 5)
+	world.Components.Dead.Add(leader, &gc.Dead{})
+
+	sp := newSquadPlanner(newSoloPlanner(newTestRNG()))
+	sp.Plan(world, a)
+
+	for _, e := range []ecs.Entity{a, b} {
+		assert.True(t, world.Components.Squad.Get(e).Routed, "頭を失った分隊員は潰走する")
+		solo := world.Components.SoloAI.Get(e)
+		assert.Equal(t, gc.AIStateFleeing, solo.SubState, "全員が同じターンに逃亡へ移る")
+		assert.Equal(t, gc.CombatEvade, solo.CombatCurrent)
+	}
+	for _, e := range []ecs.Entity{other, otherLeader} {
+		assert.False(t, world.Components.Squad.Get(e).Routed)
+	}
+}
+
+func TestSquadPlanner_非交戦時は手下が頭へ寄る(t *testing.T) {
+	t.Parallel()
+	world := testutil.InitTestWorld(t)
+
+	_, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 1, Y: 1}, "ash")
+	require.NoError(t, err)
+
+	setupSquadMember(t, world, 20, 20, 1, true, 3)
+	follower := setupSquadMember(t, world, 26, 20, 1, false, 3)
+
+	sp := newSquadPlanner(newSoloPlanner(newTestRNG()))
+	behavior := sp.Plan(world, follower)
+	require.Equal(t, gc.BehaviorMove, behavior.BehaviorName)
+	move := activityParams[*gc.MoveParams](t, behavior)
+	assert.Equal(t, consts.Tile(25), move.Destination.X, "頭のいる西へ1歩寄る")
+}
+
+func TestProcessor_分隊員はsquadPlannerで処理する(t *testing.T) {
+	t.Parallel()
+	world := testutil.InitTestWorld(t)
+
+	p := NewProcessor(newTestRNG())
+	member := setupSquadMember(t, world, 5, 5, 1, true, 3)
+	solo := setupTestAI(t, world, 8, 8, &gc.SoloAI{})
+
+	assert.Same(t, p.squad, p.plannerFor(world, member))
+	assert.Same(t, p.solo, p.plannerFor(world, solo))
+}
@@ -23,22 +23,25 @@ const activationMargin = 2
 const activationRadius = int(consts.VisionRadiusTiles) + activationMargin
 
 // Processor はAIエンティティの行動処理を管理する。
-// SoloAI エンティティを soloPlanner で処理する
+// SoloAI エンティティを soloPlanner で、分隊員を squadPlanner で処理する
 type Processor struct {
-	logger  *logger.Logger
-	planner Planner
+	logger *logger.Logger
+	solo   Planner
+	squad  Planner
 }
 
 // NewProcessor は新しいProcessorを作成する。
 // rngはゲーム全体のseedから派生した乱数生成器を渡す
 func NewProcessor(rng *rand.Rand) *Processor {
+	solo := newSoloPlanner(rand.New(rand.NewPCG(rng.Uint64(), rng.Uint64())))
 	return &Processor{
-		logger:  logger.New(logger.CategoryTurn),
-		planner: newSoloPlanner(rand.New(rand.NewPCG(rng.Uint64(), rng.Uint64()))),
+		logger: logger.New(logger.CategoryTurn),
+		solo:   solo,
+		squad:  newSquadPlanner(solo),
 	}
 }
 
-// ProcessAll は全 SoloAI エンティティを soloPlanner で処理する。
+// ProcessAll は全 SoloAI エンティティを、分隊員かどうかで Planner を選んで処理する。
 func (p *Processor) ProcessAll(world w.World) error {
 	// 退避中ステージのAIはターンを取らない
 	var targets []ecs.Entity
@@ -57,14 +60,22 @@ func (p *Processor) ProcessAll(world w.World) error {
 		if world.Components.Dead.Has(entity) {
 			continue
 		}
-		runAPLoop(world, entity, p.planner, p.logger)
+		runAPLoop(world, entity, p.plannerFor(world, entity), p.logger)
 	}
 
 	return nil
 }
 
+// plannerFor は分隊員なら squadPlanner を、それ以外は soloPlanner を返す
+func (p *Processor) plannerFor(world w.World, entity ecs.Entity) Planner {
+	if world.Components.Squad.Has(entity) {
+		return p.squad
+	}
+	return p.solo
+}
+
 // cullDistantSolo は遠方の非交戦 SoloAI を処理対象から除外する。
-// 交戦中は視界外でも対象を追い続ける設計のため、距離に関わらず残す。
+// 交戦中は視界外でも対象を追い続ける設計のため、距離に関わらず残す。物音を調べに向かう途中の個体も残す。
 func cullDistantSolo(world w.World, targets []ecs.Entity) ([]ecs.Entity, error) {
 	playerEntity, err := query.GetPlayerEntity(world)
 	if err != nil {
@@ -78,7 +89,7 @@ func cullDistantSolo(world w.World, targets []ecs.Entity) ([]ecs.Entity, error)
 	kept := make([]ecs.Entity, 0, len(targets))
 	for _, entity := range targets {
 		solo := world.Components.SoloAI.Get(entity)
-		if solo != nil && !isActiveCombatState(solo.SubState) {
+		if solo != nil && !isActiveCombatState(solo.SubState) && solo.SubState != gc.AIStateInvestigating {
 			grid := world.Components.GridElement.Get(entity)
 			if geometry.ChebyshevDistance(playerGrid.Coord, grid.Coord) > activationRadius {
 				// 圏外の待機・徘徊敵はスキップ。画面外で観測不能なため凍結してよい
@@ -158,7 +158,8 @@ func TestProcessor(t *testing.T) {
 
 	processor := NewProcessor(newTestRNG())
 	assert.NotNil(t, processor, "Processorが作成できること")
-	assert.NotNil(t, processor.planner)
+	assert.NotNil(t, processor.solo)
+	assert.NotNil(t, processor.squad)
 }
 
 // containsEntity はスライスにエンティティが含まれるかを返す（テスト用）
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/consts"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -263,3 +264,60 @@ func TestUpdateState_ChasingTimeout(t *testing.T) {
 	rp.updateState(solo, true, 10)
 	assert.Equal(t, gc.AIStateWaiting, solo.SubState)
 }
+
+func TestUpdateState_WaitingToInvestigating_物音を聞くと調べに向かう(t *testing.T) {
+	t.Parallel()
+
+	rp := newSoloPlanner(newTestRNG())
+	solo := &gc.SoloAI{
+		CombatDefault:         gc.CombatAttack,
+		CombatCurrent:         gc.CombatAttack,
+		SubState:              gc.AIStateWaiting,
+		StartSubStateTurn:     0,
+		DurationSubStateTurns: 100,
+		NoiseOrigin:           &consts.Coord[consts.Tile]{X: 3, Y: 4},
+	}
+
+	rp.updateState(solo, false, 1)
+	assert.Equal(t, gc.AIStateInvestigating, solo.SubState)
+	assert.Equal(t, consts.Turn(1), solo.StartSubStateTurn)
+}
+
+func TestUpdateState_Investigating_見つければ追跡して出どころを忘れる(t *testing.T) {
+	t.Parallel()
+
+	rp := newSoloPlanner(newTestRNG())
+	solo := &gc.SoloAI{
+		CombatDefault:         gc.CombatAttack,
+		CombatCurrent:         gc.CombatAttack,
+		SubState:              gc.AIStateInvestigating,
+		StartSubStateTurn:     0,
+		DurationSubStateTurns: 10,
+		NoiseOrigin:           &consts.Coord[consts.Tile]{X: 3, Y: 4},
+	}
+
+	rp.updateState(solo, true, 2)
+	assert.Equal(t, gc.AIStateChasing, solo.SubState)
+	assert.Nil(t, solo.NoiseOrigin)
+}
+
+func TestUpdateState_Investigating_時間切れで待機へ戻る(t *testing.T) {
+	t.Parallel()
+
+	rp := newSoloPlanner(newTestRNG())
+	solo := &gc.SoloAI{
+		CombatDefault:         gc.CombatAttack,
+		CombatCurrent:         gc.CombatAttack,
+		SubState:              gc.AIStateInvestigating,
+		StartSubStateTurn:     0,
+		DurationSubStateTurns: 10,
+		NoiseOrigin:           &consts.Coord[consts.Tile]{X: 3, Y: 4},
+	}
+
+	rp.updateState(solo, false, 9)
+	assert.Equal(t, gc.AIStateInvestigating, solo.SubState, "時間内は調べ続ける")
+
+	rp.updateState(solo, false, 10)
+	assert.Equal(t, gc.AIStateWaiting, solo.SubState)
+	assert.Nil(t, solo.NoiseOrigin)
+}
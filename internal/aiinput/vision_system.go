@@ -2,10 +2,15 @@ package aiinput
 
 import (
 	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/geometry"
 	w "github.com/kijimaD/ruins/internal/world"
+	"github.com/kijimaD/ruins/internal/world/query"
 	"github.com/mlange-42/ark/ecs"
 )
 
+// darknessVisionCut は足元が真っ暗なときに視界距離を縮める割合。暗さに比例して効く
+const darknessVisionCut = 0.6
+
 // VisionSystem はAIの視界判定システム
 type VisionSystem interface {
 	CanSeeTarget(world w.World, aiEntity, targetEntity ecs.Entity, viewDistance consts.Tile) bool
@@ -19,7 +24,10 @@ func NewVisionSystem() VisionSystem {
 	return &DefaultVisionSystem{}
 }
 
-// CanSeeTarget はターゲットが視界内にいるかチェック
+// CanSeeTarget はターゲットが視界内にいるかチェック。
+// 視界距離は隠密スキルの倍率と、プレイヤーなら足元の暗さで縮む。
+// 距離内でも壁越しには見えない。視線はプレイヤーの視界と同じ対称シャドウキャストで判定するので、
+// プレイヤーから見えない敵がプレイヤーを一方的に見つけることはない
 func (vs *DefaultVisionSystem) CanSeeTarget(world w.World, aiEntity, targetEntity ecs.Entity, viewDistance consts.Tile) bool {
 	aiGrid := world.Components.GridElement.Get(aiEntity)
 	targetGrid := world.Components.GridElement.Get(targetEntity)
@@ -35,5 +43,22 @@ func (vs *DefaultVisionSystem) CanSeeTarget(world w.World, aiEntity, targetEntit
 		viewDist = mods.EnemyVision.ApplyFloat(viewDist)
 	}
 
-	return float64(distSq) <= viewDist*viewDist
+	// 暗がりに立つプレイヤーは近くまで寄られないと見つからない
+	if world.Components.Player.Has(targetEntity) {
+		if state := query.GetVisionState(world); state != nil {
+			viewDist *= 1 - state.PlayerDarkness*darknessVisionCut
+		}
+	}
+
+	if float64(distSq) > viewDist*viewDist {
+		return false
+	}
+
+	si := query.GetSpatialIndex(world)
+	if si == nil {
+		return true
+	}
+	from := consts.Coord[int]{X: int(aiGrid.X), Y: int(aiGrid.Y)}
+	to := consts.Coord[int]{X: int(targetGrid.X), Y: int(targetGrid.Y)}
+	return geometry.InFieldOfView(from, to, si.IsOpaque)
 }
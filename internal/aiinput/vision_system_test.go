@@ -0,0 +1,53 @@
+package aiinput
+
+import (
+	"testing"
+
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/testutil"
+	"github.com/kijimaD/ruins/internal/world/lifecycle"
+	"github.com/kijimaD/ruins/internal/world/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanSeeTarget_暗がりのプレイヤーは近くでないと見つからない(t *testing.T) {
+	t.Parallel()
+	world := testutil.InitTestWorld(t)
+
+	player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 10, Y: 10}, "ash")
+	require.NoError(t, err)
+	near := setupTestAI(t, world, 13, 10, &gc.SoloAI{ViewDistance: 8})
+	far := setupTestAI(t, world, 17, 10, &gc.SoloAI{ViewDistance: 8})
+
+	vs := NewVisionSystem()
+	assert.True(t, vs.CanSeeTarget(world, far, player, 8), "明るければ視界距離いっぱいで見つかる")
+
+	// 真っ暗なら視界距離8が3.2まで縮む
+	query.GetVisionState(world).PlayerDarkness = 1
+	assert.False(t, vs.CanSeeTarget(world, far, player, 8), "暗がりでは遠くから見つからない")
+	assert.True(t, vs.CanSeeTarget(world, near, player, 8), "暗がりでも近くなら見つかる")
+}
+
+func TestCanSeeTarget_壁越しのプレイヤーは見つからない(t *testing.T) {
+	t.Parallel()
+	world := testutil.InitTestWorld(t)
+
+	player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 10, Y: 10}, "ash")
+	require.NoError(t, err)
+	ai := setupTestAI(t, world, 15, 10, &gc.SoloAI{ViewDistance: 8})
+
+	vs := NewVisionSystem()
+	assert.True(t, vs.CanSeeTarget(world, ai, player, 8), "遮るものが無ければ見つかる")
+
+	// 間にドアを閉じて置くと視線が切れる。開けば再び見える
+	door, err := lifecycle.SpawnDoor(world, consts.Coord[consts.Tile]{X: 12, Y: 10}, gc.DoorOrientationVertical)
+	require.NoError(t, err)
+	require.NoError(t, lifecycle.CloseDoor(world, door))
+	assert.False(t, vs.CanSeeTarget(world, ai, player, 8), "閉じたドア越しには見つからない")
+	assert.False(t, vs.CanSeeTarget(world, player, ai, 8), "視線は対称で、プレイヤー側からも見えない")
+
+	require.NoError(t, lifecycle.OpenDoor(world, door))
+	assert.True(t, vs.CanSeeTarget(world, ai, player, 8), "開いたドアは視線を通す")
+}
@@ -0,0 +1,159 @@
+package audio
+
+import "github.com/kijimaD/ruins/internal/config"
+
+// Event は効果音を鳴らすゲーム内の出来事を表す。
+// 値はその出来事の既定の効果音 ID を兼ねる
+type Event string
+
+const (
+	// EventAttackHit は攻撃が命中したとき
+	EventAttackHit Event = "attack_hit"
+	// EventAttackMiss は攻撃が外れたとき
+	EventAttackMiss Event = "attack_miss"
+	// EventDoorOpen は扉を開けたとき
+	EventDoorOpen Event = "door_open"
+	// EventPickup はアイテムを拾ったとき
+	EventPickup Event = "pickup"
+	// EventReload は装填を終えたとき
+	EventReload Event = "reload"
+	// EventLevelUp はスキルの段階が上がったとき
+	EventLevelUp Event = "level_up"
+	// EventTemperatureWarning は体温の状態が悪化したとき
+	EventTemperatureWarning Event = "temperature_warning"
+)
+
+// DefaultSound は出来事の既定の効果音 ID を返す
+func (e Event) DefaultSound() string {
+	return string(e)
+}
+
+// 定義が曲を指定していないときに流す既定の曲 ID
+const (
+	// MusicOverworld は地上で流す曲
+	MusicOverworld = "overworld"
+	// MusicDungeon はダンジョンの通常階で流す曲
+	MusicDungeon = "dungeon"
+	// MusicBoss はボスフロアで流す曲
+	MusicBoss = "boss"
+)
+
+// Volume は音量設定を表す。各値は 0 から 100 の百分率
+type Volume struct {
+	Master int
+	Music  int
+	SE     int
+}
+
+// UserVolume はユーザー設定の音量を Volume にする
+func UserVolume(user config.UserConfig) Volume {
+	return Volume{Master: user.MasterVolume, Music: user.MusicVolume, SE: user.SEVolume}
+}
+
+// music は曲に掛ける実際の音量を 0.0〜1.0 で返す
+func (v Volume) music() float64 {
+	return float64(v.Master*v.Music) / 10000
+}
+
+// se は効果音に掛ける実際の音量を 0.0〜1.0 で返す
+func (v Volume) se() float64 {
+	return float64(v.Master*v.SE) / 10000
+}
+
+// Backend は音を実際に鳴らす実装を表す。volume は 0.0〜1.0
+type Backend interface {
+	// PlayMusic は id の曲をループ再生する。再生中の曲は止める
+	PlayMusic(id string, volume float64)
+	// StopMusic は再生中の曲を止める
+	StopMusic()
+	// SetMusicVolume は再生中の曲の音量を変える
+	SetMusicVolume(volume float64)
+	// PlaySE は id の効果音を一度鳴らす
+	PlaySE(id string, volume float64)
+}
+
+// NullBackend は何も鳴らさない Backend
+type NullBackend struct{}
+
+var _ Backend = NullBackend{}
+
+// PlayMusic は何もしない
+func (NullBackend) PlayMusic(string, float64) {}
+
+// StopMusic は何もしない
+func (NullBackend) StopMusic() {}
+
+// SetMusicVolume は何もしない
+func (NullBackend) SetMusicVolume(float64) {}
+
+// PlaySE は何もしない
+func (NullBackend) PlaySE(string, float64) {}
+
+// Player はゲームから使う再生窓口。音量と再生中の曲を管理し、Backend へ委譲する
+type Player struct {
+	backend Backend
+	volume  Volume
+	// 再生中の曲 ID。止まっていれば空
+	music string
+}
+
+// NewPlayer は backend で鳴らす Player を返す
+func NewPlayer(backend Backend, volume Volume) *Player {
+	return &Player{backend: backend, volume: volume}
+}
+
+// SetBackend は鳴らす先を差し替える。再生中の曲は新しい Backend で流し直す
+func (p *Player) SetBackend(backend Backend) {
+	p.backend.StopMusic()
+	p.backend = backend
+	if p.music != "" {
+		p.backend.PlayMusic(p.music, p.volume.music())
+	}
+}
+
+// Volume は現在の音量設定を返す
+func (p *Player) Volume() Volume {
+	return p.volume
+}
+
+// SetVolume は音量設定を変え、再生中の曲にも反映する
+func (p *Player) SetVolume(volume Volume) {
+	p.volume = volume
+	p.backend.SetMusicVolume(volume.music())
+}
+
+// Music は再生中の曲 ID を返す。止まっていれば空文字を返す
+func (p *Player) Music() string {
+	return p.music
+}
+
+// PlayMusic は id の曲を流す。同じ曲が流れていれば頭から流し直さない
+func (p *Player) PlayMusic(id string) {
+	if id == p.music {
+		return
+	}
+	if id == "" {
+		p.StopMusic()
+		return
+	}
+	p.music = id
+	p.backend.PlayMusic(id, p.volume.music())
+}
+
+// StopMusic は再生中の曲を止める
+func (p *Player) StopMusic() {
+	if p.music == "" {
+		return
+	}
+	p.music = ""
+	p.backend.StopMusic()
+}
+
+// PlaySE は id の効果音を鳴らす。id が空か音量が 0 なら何もしない
+func (p *Player) PlaySE(id string) {
+	volume := p.volume.se()
+	if id == "" || volume <= 0 {
+		return
+	}
+	p.backend.PlaySE(id, volume)
+}
@@ -0,0 +1,108 @@
+package audio
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// recorder は呼ばれた操作を記録する Backend
+type recorder struct {
+	calls []string
+	vol   float64
+}
+
+func (r *recorder) PlayMusic(id string, volume float64) {
+	r.calls = append(r.calls, "music:"+id)
+	r.vol = volume
+}
+func (r *recorder) StopMusic()                    { r.calls = append(r.calls, "stop") }
+func (r *recorder) SetMusicVolume(volume float64) { r.vol = volume }
+func (r *recorder) PlaySE(id string, volume float64) {
+	r.calls = append(r.calls, "se:"+id)
+	r.vol = volume
+}
+
+func TestPlayer_PlayMusic(t *testing.T) {
+	t.Parallel()
+
+	t.Run("同じ曲は流し直さない", func(t *testing.T) {
+		t.Parallel()
+		rec := &recorder{}
+		p := NewPlayer(rec, Volume{Master: 100, Music: 100, SE: 100})
+
+		p.PlayMusic("dungeon")
+		p.PlayMusic("dungeon")
+		p.PlayMusic("boss")
+
+		assert.Equal(t, []string{"music:dungeon", "music:boss"}, rec.calls)
+		assert.Equal(t, "boss", p.Music())
+	})
+
+	t.Run("空の ID は曲を止める", func(t *testing.T) {
+		t.Parallel()
+		rec := &recorder{}
+		p := NewPlayer(rec, Volume{Master: 100, Music: 100, SE: 100})
+
+		p.PlayMusic("dungeon")
+		p.PlayMusic("")
+		p.StopMusic()
+
+		assert.Equal(t, []string{"music:dungeon", "stop"}, rec.calls)
+		assert.Empty(t, p.Music())
+	})
+
+	t.Run("音量は全体と曲の積になる", func(t *testing.T) {
+		t.Parallel()
+		rec := &recorder{}
+		p := NewPlayer(rec, Volume{Master: 50, Music: 80, SE: 100})
+
+		p.PlayMusic("overworld")
+		assert.InDelta(t, 0.4, rec.vol, 1e-9)
+
+		p.SetVolume(Volume{Master: 100, Music: 30, SE: 100})
+		assert.InDelta(t, 0.3, rec.vol, 1e-9)
+	})
+}
+
+func TestPlayer_PlaySE(t *testing.T) {
+	t.Parallel()
+
+	t.Run("音量は全体と効果音の積になる", func(t *testing.T) {
+		t.Parallel()
+		rec := &recorder{}
+		p := NewPlayer(rec, Volume{Master: 80, Music: 100, SE: 50})
+
+		p.PlaySE(EventPickup.DefaultSound())
+
+		assert.Equal(t, []string{"se:pickup"}, rec.calls)
+		assert.InDelta(t, 0.4, rec.vol, 1e-9)
+	})
+
+	t.Run("音量0や空の ID では鳴らさない", func(t *testing.T) {
+		t.Parallel()
+		rec := &recorder{}
+		p := NewPlayer(rec, Volume{Master: 100, Music: 100, SE: 0})
+
+		p.PlaySE("pickup")
+		p.SetVolume(Volume{Master: 100, Music: 100, SE: 100})
+		p.PlaySE("")
+
+		assert.Empty(t, rec.calls)
+	})
+}
+
+func TestPlayer_SetBackend(t *testing.T) {
+	t.Parallel()
+
+	old := &recorder{}
+	p := NewPlayer(old, Volume{Master: 100, Music: 100, SE: 100})
+	p.PlayMusic("overworld")
+
+	next := &recorder{}
+	p.SetBackend(next)
+
+	// 再生中の曲は新しい Backend で流し直す
+	assert.Equal(t, []string{"music:overworld", "stop"}, old.calls)
+	assert.Equal(t, []string{"music:overworld"}, next.calls)
+}
@@ -0,0 +1,26 @@
+// Package audio は曲と効果音の再生を提供する。
+//
+// 再生の実体は Backend インターフェースの裏に隠す。ゲーム本体は Player だけを
+// 触り、どの音声デバイスで鳴らすかを知らない。
+//
+//   - EbitenBackend: ebiten/v2/audio で実際に鳴らす。ウィンドウ付きの起動で使う
+//   - NullBackend: 何もしない。テストやヘッドレス実行で音声デバイスを要求しないために使う
+//
+// # 音の ID
+//
+// 曲と効果音は ID で指す。ID X は assets/file/sounds/X.ogg (.wav / .mp3 も可) に
+// 対応する。ファイルが無い ID は鳴らさずに無視するため、音声ファイルを用意する前
+// でもローデータに ID を書いてよい。同梱の音は合成した仮の WAV で、差し替えるときは
+// 同じ ID のファイルを置く。
+//
+// 曲は DungeonState の中でだけ systems.MusicSystem が流し、DungeonState を抜けると止める。
+//
+// 効果音を鳴らす出来事は Event で表す。ローデータのアイテム・置物・メンバーは
+// sounds で出来事ごとの ID を上書きでき、上書きが無い出来事は Event 自身の値と同じ
+// ID の既定音を鳴らす。上書きの解決は gameaction.PlaySound が担う。
+//
+// # 音量
+//
+// 音量は Volume で全体・曲・効果音の百分率として持つ。実際の音量は全体と各種別の
+// 積になる。値はユーザー設定 (config.UserConfig) から与える。
+package audio
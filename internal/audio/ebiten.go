@@ -0,0 +1,179 @@
+package audio
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+
+	eaudio "github.com/hajimehoshi/ebiten/v2/audio"
+	"github.com/hajimehoshi/ebiten/v2/audio/mp3"
+	"github.com/hajimehoshi/ebiten/v2/audio/vorbis"
+	"github.com/hajimehoshi/ebiten/v2/audio/wav"
+
+	"github.com/kijimaD/ruins/internal/logger"
+)
+
+// SoundDir は音声ファイルを置く assets.FS 内のディレクトリ
+const SoundDir = "file/sounds"
+
+// sampleRate は音声コンテキストのサンプルレート。異なる音源は読み込み時に変換する
+const sampleRate = 44100
+
+// stream はデコード済みの音源。各形式の Stream が満たす
+type stream interface {
+	io.ReadSeeker
+	Length() int64
+	SampleRate() int
+}
+
+// decoders は拡張子ごとのデコーダ。探す順に並べる
+var decoders = []struct {
+	ext    string
+	decode func(io.Reader) (stream, error)
+}{
+	{".ogg", func(r io.Reader) (stream, error) { return vorbis.DecodeF32(r) }},
+	{".wav", func(r io.Reader) (stream, error) { return wav.DecodeF32(r) }},
+	{".mp3", func(r io.Reader) (stream, error) { return mp3.DecodeF32(r) }},
+}
+
+// EbitenBackend は ebiten/v2/audio で音を鳴らす Backend
+type EbitenBackend struct {
+	ctx  *eaudio.Context
+	fsys fs.FS
+	dir  string
+	// 効果音のデコード済み PCM。ファイルが無い ID は nil で記録し、探し直さない
+	se map[string][]byte
+	// 再生中の曲
+	music *eaudio.Player
+}
+
+var _ Backend = (*EbitenBackend)(nil)
+
+// NewEbitenBackend は fsys の dir 配下の音声ファイルを鳴らす Backend を返す。
+// 音声コンテキストはプロセスに1つしか作れないため、既にあればそれを使う
+func NewEbitenBackend(fsys fs.FS, dir string) *EbitenBackend {
+	ctx := eaudio.CurrentContext()
+	if ctx == nil {
+		ctx = eaudio.NewContext(sampleRate)
+	}
+	return &EbitenBackend{
+		ctx:  ctx,
+		fsys: fsys,
+		dir:  dir,
+		se:   map[string][]byte{},
+	}
+}
+
+// PlayMusic は id の曲をループ再生する
+func (b *EbitenBackend) PlayMusic(id string, volume float64) {
+	b.StopMusic()
+	s, err := b.open(id)
+	if err != nil {
+		logMissing(id, err)
+		return
+	}
+	player, err := b.ctx.NewPlayerF32(eaudio.NewInfiniteLoopF32(s, s.Length()))
+	if err != nil {
+		logger.New(logger.CategoryAudio).Warn("failed to play music", "id", id, "error", err.Error())
+		return
+	}
+	player.SetVolume(volume)
+	player.Play()
+	b.music = player
+}
+
+// StopMusic は再生中の曲を止める
+func (b *EbitenBackend) StopMusic() {
+	if b.music == nil {
+		return
+	}
+	if err := b.music.Close(); err != nil {
+		logger.New(logger.CategoryAudio).Warn("failed to stop music", "error", err.Error())
+	}
+	b.music = nil
+}
+
+// SetMusicVolume は再生中の曲の音量を変える
+func (b *EbitenBackend) SetMusicVolume(volume float64) {
+	if b.music != nil {
+		b.music.SetVolume(volume)
+	}
+}
+
+// PlaySE は id の効果音を一度鳴らす
+func (b *EbitenBackend) PlaySE(id string, volume float64) {
+	pcm, ok := b.se[id]
+	if !ok {
+		pcm = b.loadSE(id)
+		b.se[id] = pcm
+	}
+	if pcm == nil {
+		return
+	}
+	player := b.ctx.NewPlayerF32FromBytes(pcm)
+	player.SetVolume(volume)
+	player.Play()
+}
+
+// loadSE は効果音をデコードして PCM を返す。読めなければ nil を返す
+func (b *EbitenBackend) loadSE(id string) []byte {
+	s, err := b.open(id)
+	if err != nil {
+		logMissing(id, err)
+		return nil
+	}
+	pcm, err := io.ReadAll(s)
+	if err != nil {
+		logger.New(logger.CategoryAudio).Warn("failed to decode sound effect", "id", id, "error", err.Error())
+		return nil
+	}
+	return pcm
+}
+
+// open は id の音声ファイルを探してデコードし、コンテキストのサンプルレートに揃えて返す
+func (b *EbitenBackend) open(id string) (stream, error) {
+	for _, d := range decoders {
+		data, err := fs.ReadFile(b.fsys, path.Join(b.dir, id+d.ext))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		s, err := d.decode(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s%s: %w", id, d.ext, err)
+		}
+		if s.SampleRate() != b.ctx.SampleRate() {
+			return resampled{
+				ReadSeeker: eaudio.ResampleF32(s, s.Length(), s.SampleRate(), b.ctx.SampleRate()),
+				length:     s.Length() * int64(b.ctx.SampleRate()) / int64(s.SampleRate()),
+				sampleRate: b.ctx.SampleRate(),
+			}, nil
+		}
+		return s, nil
+	}
+	return nil, fs.ErrNotExist
+}
+
+// resampled はサンプルレートを変換した音源
+type resampled struct {
+	io.ReadSeeker
+	length     int64
+	sampleRate int
+}
+
+func (r resampled) Length() int64   { return r.length }
+func (r resampled) SampleRate() int { return r.sampleRate }
+
+// logMissing は鳴らせない音を記録する。音声ファイルの無い ID は想定内なのでデバッグ扱いにする
+func logMissing(id string, err error) {
+	if errors.Is(err, fs.ErrNotExist) {
+		logger.New(logger.CategoryAudio).Debug("sound file not found", "id", id)
+		return
+	}
+	logger.New(logger.CategoryAudio).Warn("failed to load sound file", "id", id, "error", err.Error())
+}
@@ -0,0 +1,76 @@
+package audio
+
+import (
+	"bytes"
+	"io/fs"
+	"path"
+	"testing"
+
+	"github.com/kijimaD/ruins/assets"
+	"github.com/kijimaD/ruins/internal/oapi"
+	"github.com/kijimaD/ruins/internal/raw"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// soundIDs は既定の曲と出来事の既定音、ローデータが指す音の ID を集める
+func soundIDs(t *testing.T) map[string]bool {
+	t.Helper()
+	ids := map[string]bool{MusicOverworld: true, MusicDungeon: true, MusicBoss: true}
+	for _, e := range []Event{EventAttackHit, EventAttackMiss, EventDoorOpen, EventPickup, EventReload, EventLevelUp, EventTemperatureWarning} {
+		ids[e.DefaultSound()] = true
+	}
+
+	master, err := raw.LoadFromDir(raw.DefaultDir, "")
+	require.NoError(t, err)
+	add := func(id *oapi.SoundId) {
+		if id != nil {
+			ids[*id] = true
+		}
+	}
+	addSet := func(s *oapi.SoundSet) {
+		if s != nil {
+			add(s.Hit)
+			add(s.Miss)
+			add(s.Open)
+			add(s.Pickup)
+			add(s.Reload)
+		}
+	}
+	for _, it := range raw.PtrSlice(master.Items) {
+		addSet(it.Sounds)
+	}
+	for _, m := range raw.PtrSlice(master.Members) {
+		addSet(m.Sounds)
+	}
+	for _, p := range raw.PtrSlice(master.Props) {
+		addSet(p.Sounds)
+	}
+	for _, d := range raw.PtrSlice(master.Dungeons) {
+		add(d.Music)
+		add(d.BossMusic)
+	}
+	return ids
+}
+
+func TestSoundFiles_使う音はすべて同梱されていて読める(t *testing.T) {
+	t.Parallel()
+
+	for id := range soundIDs(t) {
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+			found := false
+			for _, d := range decoders {
+				data, err := fs.ReadFile(assets.FS, path.Join(SoundDir, id+d.ext))
+				if err != nil {
+					continue
+				}
+				found = true
+				s, err := d.decode(bytes.NewReader(data))
+				require.NoError(t, err)
+				assert.Positive(t, s.Length())
+			}
+			assert.True(t, found, "%s に %s の音声ファイルが無い", SoundDir, id)
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package autoplay
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/inputmapper"
+	w "github.com/kijimaD/ruins/internal/world"
+	"github.com/kijimaD/ruins/internal/world/query"
+)
+
+// Bot はプレイヤーの代わりに Action を決める。Next はプレイヤーが行動できるフレームにだけ呼ぶ。
+// 返した Action は DungeonState.DoAction へそのまま渡る
+type Bot interface {
+	// Name は結果に記録するボット名
+	Name() string
+	// Next は次の Action を返す
+	Next(world w.World) inputmapper.ActionID
+}
+
+// ボット名。CLI の --bot と Result.Bot に使う
+const (
+	BotRandomWalker  = "random"
+	BotStairsSeeker  = "stairs"
+	BotGreedyFighter = "fighter"
+)
+
+// BotNames は NewBot が受け付ける名前の一覧
+var BotNames = []string{BotRandomWalker, BotStairsSeeker, BotGreedyFighter}
+
+// NewBot は名前からボットを作る。乱数は seed から作るので同じ seed なら同じ手を指す
+func NewBot(name string, seed uint64) (Bot, error) {
+	rng := rand.New(rand.NewPCG(seed, 0))
+	switch name {
+	case BotRandomWalker:
+		return &randomWalker{rng: rng}, nil
+	case BotStairsSeeker:
+		return &stairsSeeker{rng: rng}, nil
+	case BotGreedyFighter:
+		return &greedyFighter{seeker: stairsSeeker{rng: rng}}, nil
+	default:
+		return nil, fmt.Errorf("unknown bot: %s", name)
+	}
+}
+
+// randomWalker は4方向へでたらめに歩く。比較の下限に使う
+type randomWalker struct {
+	rng *rand.Rand
+}
+
+func (b *randomWalker) Name() string { return BotRandomWalker }
+
+func (b *randomWalker) Next(_ w.World) inputmapper.ActionID {
+	return moveActions[b.rng.IntN(len(moveActions))].action
+}
+
+// stairsSeeker は下り階段を目指す。見つけていれば最短で向かい、未発見なら最寄りの未探索タイルへ歩く。
+// 敵は避けも狙いもしない。道中で塞がれたら歩き込んで殴ることになる
+type stairsSeeker struct {
+	rng *rand.Rand
+}
+
+func (b *stairsSeeker) Name() string { return BotStairsSeeker }
+
+func (b *stairsSeeker) Next(world w.World) inputmapper.ActionID {
+	pos, ok := playerCoord(world)
+	if !ok {
+		return inputmapper.ActionWait
+	}
+	field := query.GetCurrentStageField(world)
+	stairs := nextPortals(world, field)
+	if stairs[pos] {
+		return inputmapper.ActionInteract
+	}
+	if action, ok := stepToward(world, pos, func(c consts.Coord[consts.Tile]) bool { return stairs[c] }); ok {
+		return action
+	}
+	// 未探索タイルへ向かう。視界が届いた時点で探索済みになるので、壁の向こうは自然に外れる
+	si := query.GetSpatialIndex(world)
+	if field != nil && si != nil {
+		unexplored := func(c consts.Coord[consts.Tile]) bool {
+			key := gc.GridElement{Coord: c}
+			return !field.ExploredTiles[key] && !si.BlockPass[key]
+		}
+		if action, ok := stepToward(world, pos, unexplored); ok {
+			return action
+		}
+	}
+	return moveActions[b.rng.IntN(len(moveActions))].action
+}
+
+// nextPortals は探索済みの下り階段の位置を返す。まだ見ていない階段は知らないものとして扱う
+func nextPortals(world w.World, field *gc.StageField) map[consts.Coord[consts.Tile]]bool {
+	portals := map[consts.Coord[consts.Tile]]bool{}
+	if field == nil {
+		return portals
+	}
+	q := query.ActiveFilter2[gc.GridElement, gc.Interactable](world).Query()
+	for q.Next() {
+		grid, interactable := q.Get()
+		if !field.ExploredTiles[*grid] {
+			continue
+		}
+		for _, interaction := range interactable.Interactions {
+			if interaction == gc.InteractionPortalNext {
+				portals[grid.Coord] = true
+			}
+		}
+	}
+	return portals
+}
+
+// greedyFighter は見えている敵へ最短で向かって殴る。敵が見えなければ足元の物を拾い、
+// それも無ければ stairsSeeker と同じく階段を目指す
+type greedyFighter struct {
+	seeker stairsSeeker
+	// lastPickup は直前に拾いを試みた位置。拾えない物の上で拾いを繰り返さないために覚える
+	lastPickup *consts.Coord[consts.Tile]
+}
+
+func (b *greedyFighter) Name() string { return BotGreedyFighter }
+
+func (b *greedyFighter) Next(world w.World) inputmapper.ActionID {
+	pos, ok := playerCoord(world)
+	if !ok {
+		return inputmapper.ActionWait
+	}
+	if enemies, err := query.GetVisibleEnemies(world); err == nil && len(enemies) > 0 {
+		targets := map[consts.Coord[consts.Tile]]bool{}
+		for _, enemy := range enemies {
+			if world.Components.Dead.Has(enemy) {
+				continue
+			}
+			targets[world.Components.GridElement.Get(enemy).Coord] = true
+		}
+		if action, ok := stepToward(world, pos, func(c consts.Coord[consts.Tile]) bool { return targets[c] }); ok {
+			return action
+		}
+	}
+	if len(query.PickablesAt(world, pos)) > 0 && (b.lastPickup == nil || *b.lastPickup != pos) {
+		b.lastPickup = &pos
+		return inputmapper.ActionPickup
+	}
+	return b.seeker.Next(world)
+}
@@ -0,0 +1,16 @@
+// Package autoplay は本番のゲームループをボットの入力で回し、run の統計を採る。
+//
+// # 責務
+//
+// 描画せずに MainGame.Update だけを回し、TurnSystem と aiinput.Processor を本番どおりに動かす。
+// 入力は world.Resources.InputSource へ差したボットから供給するので、DoAction 以降は
+// キーボード操作と同じ経路を通る。結果は RunStats などから Result にまとめて返す。
+//
+// internal/balance は戦闘を数式で再実装した軽量モデルで、こちらは本番そのものを測る。
+// ボットの腕が結果に混ざるため、数値は同じボットどうしの比較に使う。
+//
+// # 使い分け
+//
+//   - Bot: 1フレームぶんの Action を決める差し替え口。NewBot で名前から引く
+//   - Run: 1 run を回して Result を返す。world は呼び出し側が用意し、run ごとに初期化する
+package autoplay
@@ -0,0 +1,92 @@
+package autoplay
+
+import (
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/inputmapper"
+	w "github.com/kijimaD/ruins/internal/world"
+	"github.com/kijimaD/ruins/internal/world/query"
+)
+
+// moveActions は4方向の移動 Action と、その1歩の差分。ボットは視点を回さないので
+// 画面の上下左右がそのまま world の方向になる
+var moveActions = []struct {
+	action inputmapper.ActionID
+	delta  consts.Coord[consts.Tile]
+}{
+	{inputmapper.ActionMoveNorth, gc.DirectionUp.GetDelta()},
+	{inputmapper.ActionMoveSouth, gc.DirectionDown.GetDelta()},
+	{inputmapper.ActionMoveWest, gc.DirectionLeft.GetDelta()},
+	{inputmapper.ActionMoveEast, gc.DirectionRight.GetDelta()},
+}
+
+// stepToward は from から goal を満たす最寄りのタイルへ4方向 BFS で向かう最初の1歩を返す。
+// 閉じた扉は歩き込めば開くので通れるものとして扱う。キャラクターのいるタイルは goal のときだけ
+// 踏み込み先に選ぶ。敵へ歩き込むと近接攻撃になる。
+// 到達できる goal が無ければ偽を返す
+func stepToward(world w.World, from consts.Coord[consts.Tile], goal func(consts.Coord[consts.Tile]) bool) (inputmapper.ActionID, bool) {
+	si := query.GetSpatialIndex(world)
+	if si == nil {
+		return "", false
+	}
+	doors := closedDoors(world)
+	inBounds := func(p consts.Coord[consts.Tile]) bool {
+		return p.X >= 0 && p.Y >= 0 && p.X < si.MapWidth && p.Y < si.MapHeight
+	}
+	passable := func(p consts.Coord[consts.Tile]) bool {
+		key := gc.GridElement{Coord: p}
+		if si.BlockPass[key] && !doors[p] {
+			return false
+		}
+		_, occupied := si.Characters[key]
+		return !occupied
+	}
+
+	firstStep := map[consts.Coord[consts.Tile]]inputmapper.ActionID{from: ""}
+	queue := []consts.Coord[consts.Tile]{from}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for _, m := range moveActions {
+			next := cur.Add(m.delta)
+			if _, seen := firstStep[next]; seen || !inBounds(next) {
+				continue
+			}
+			step := firstStep[cur]
+			if cur == from {
+				step = m.action
+			}
+			if goal(next) {
+				return step, true
+			}
+			if !passable(next) {
+				continue
+			}
+			firstStep[next] = step
+			queue = append(queue, next)
+		}
+	}
+	return "", false
+}
+
+// closedDoors は現ステージの閉じた扉の位置を返す。錠の掛かった扉は歩き込んでも開かないので含めない
+func closedDoors(world w.World) map[consts.Coord[consts.Tile]]bool {
+	doors := map[consts.Coord[consts.Tile]]bool{}
+	q := query.ActiveFilter2[gc.GridElement, gc.Door](world).Query()
+	for q.Next() {
+		grid, door := q.Get()
+		if !door.IsOpen && !world.Components.Lock.Has(q.Entity()) {
+			doors[grid.Coord] = true
+		}
+	}
+	return doors
+}
+
+// playerCoord はプレイヤーの現在位置を返す
+func playerCoord(world w.World) (consts.Coord[consts.Tile], bool) {
+	player, err := query.GetPlayerEntity(world)
+	if err != nil || !world.Components.GridElement.Has(player) {
+		return consts.Coord[consts.Tile]{}, false
+	}
+	return world.Components.GridElement.Get(player).Coord, true
+}
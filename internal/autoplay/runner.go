@@ -0,0 +1,176 @@
+package autoplay
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/consts"
+	es "github.com/kijimaD/ruins/internal/engine/states"
+	"github.com/kijimaD/ruins/internal/inputmapper"
+	"github.com/kijimaD/ruins/internal/maingame"
+	"github.com/kijimaD/ruins/internal/raw"
+	gs "github.com/kijimaD/ruins/internal/states"
+	w "github.com/kijimaD/ruins/internal/world"
+	"github.com/kijimaD/ruins/internal/world/gameaction"
+	"github.com/kijimaD/ruins/internal/world/lifecycle"
+	"github.com/kijimaD/ruins/internal/world/query"
+)
+
+// Outcome は run が終わった理由
+type Outcome string
+
+const (
+	// OutcomeDead はプレイヤーが死んだ
+	OutcomeDead Outcome = "dead"
+	// OutcomeDepthLimit は MaxDepth に着いた
+	OutcomeDepthLimit Outcome = "depth_limit"
+	// OutcomeTurnLimit は MaxTurns を使い切った
+	OutcomeTurnLimit Outcome = "turn_limit"
+	// OutcomeFrameLimit はターンが進まないまま MaxFrames を使い切った。ボットが詰まったことを表す
+	OutcomeFrameLimit Outcome = "frame_limit"
+)
+
+// Options は1 run の条件
+type Options struct {
+	// Seed はマップ生成と戦闘の乱数の種。ボットの乱数もここから作る
+	Seed uint64
+	// Dungeon は潜る遺跡の定義名
+	Dungeon string
+	// Player はプレイヤーの raw 名
+	Player string
+	// MaxDepth はこの深度に着いたら打ち切る
+	MaxDepth int
+	// MaxTurns はこのターン数を過ぎたら打ち切る
+	MaxTurns consts.Turn
+	// MaxFrames は回す最大フレーム数。ボットが詰まってターンが進まない場合の歯止め
+	MaxFrames int
+}
+
+// Result は1 run の統計。JSON でそのまま書き出す
+type Result struct {
+	Bot            string          `json:"bot"`
+	Seed           uint64          `json:"seed"`
+	Dungeon        string          `json:"dungeon"`
+	Outcome        Outcome         `json:"outcome"`
+	Depth          int             `json:"depth"`
+	Turns          consts.Turn     `json:"turns"`
+	HP             int             `json:"hp"`
+	MaxHP          int             `json:"maxHp"`
+	EnemiesKilled  int             `json:"enemiesKilled"`
+	ItemsScavenged int             `json:"itemsScavenged"`
+	SalesTotal     consts.Currency `json:"salesTotal"`
+	Cause          string          `json:"cause,omitempty"`
+}
+
+// Run は world を新しいゲームとして初期化し、bot の入力で opts.Dungeon の1階から本番ループを回す。
+// world はリソース読み込み済みのものを渡す。描画はしないので画面は要らない。
+//
+// 更新は MainGame.Update を通すので、ターン進行・敵の行動・死亡処理は本番と同じ。
+// ダンジョン以外の state が積まれたら取り消しで閉じ、ボットにはダンジョン操作だけを任せる。
+func Run(world w.World, bot Bot, opts Options) (Result, error) {
+	world.ResetForNewGame()
+	world.Resources.Config.Seed = opts.Seed
+	world.Resources.Config.RNG = rand.New(rand.NewPCG(opts.Seed, 0))
+	// シェーダを組まない。描画しないので後段の効果は要らない
+	world.Resources.Config.DisableScreenFilter = true
+
+	if err := spawnPlayer(world, opts.Player); err != nil {
+		return Result{}, err
+	}
+	sm, err := es.Init[w.World](&gs.DungeonState{Depth: 1, DefinitionName: opts.Dungeon}, world)
+	if err != nil {
+		return Result{}, err
+	}
+	game, err := maingame.NewMainGame(world, sm)
+	if err != nil {
+		return Result{}, err
+	}
+	world.Resources.InputSource = botSource(world, &game.StateMachine, bot)
+	defer func() { world.Resources.InputSource = nil }()
+
+	outcome := OutcomeFrameLimit
+	for range opts.MaxFrames {
+		if err := game.Update(); err != nil {
+			if errors.Is(err, ebiten.Termination) {
+				break
+			}
+			return Result{}, err
+		}
+		if o, done := finished(world, opts); done {
+			outcome = o
+			break
+		}
+	}
+	return collect(world, bot, opts, outcome), nil
+}
+
+// botSource はボットを入力供給源にする。積まれたメニューは取り消しで閉じ、
+// プレイヤーが行動できるフレームだけボットに手を決めさせる
+func botSource(world w.World, sm *es.StateMachine[w.World], bot Bot) inputmapper.Source {
+	return func() (inputmapper.ActionID, bool) {
+		if _, ok := sm.GetCurrentState().(*gs.DungeonState); !ok {
+			return inputmapper.ActionMenuCancel, true
+		}
+		if !query.CanPlayerAct(world) {
+			return "", false
+		}
+		return bot.Next(world), true
+	}
+}
+
+// spawnPlayer はプレイヤーを生成して最初の職業を当てる。位置は DungeonState が開始時に決める
+func spawnPlayer(world w.World, name string) error {
+	player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{}, name)
+	if err != nil {
+		return fmt.Errorf("failed to spawn player: %w", err)
+	}
+	professions := raw.PtrSlice(world.Resources.RawMaster.Professions)
+	if len(professions) > 0 {
+		if err := gameaction.ApplyProfession(world, player, professions[0]); err != nil {
+			return fmt.Errorf("failed to apply profession: %w", err)
+		}
+	}
+	return nil
+}
+
+// finished は run を打ち切るかを返す
+func finished(world w.World, opts Options) (Outcome, bool) {
+	player, err := query.GetPlayerEntity(world)
+	if err != nil || world.Components.Dead.Has(player) {
+		return OutcomeDead, true
+	}
+	if query.GetDungeon(world).CurrentStage.Depth >= opts.MaxDepth {
+		return OutcomeDepthLimit, true
+	}
+	if query.GetGameTime(world).TotalTurns >= opts.MaxTurns {
+		return OutcomeTurnLimit, true
+	}
+	return "", false
+}
+
+// collect は run の終わりの world から Result を作る
+func collect(world w.World, bot Bot, opts Options, outcome Outcome) Result {
+	result := Result{
+		Bot:     bot.Name(),
+		Seed:    opts.Seed,
+		Dungeon: opts.Dungeon,
+		Outcome: outcome,
+		Depth:   query.GetDungeon(world).CurrentStage.Depth,
+		Turns:   query.GetGameTime(world).TotalTurns,
+	}
+	if stats := query.GetRunStats(world); stats != nil {
+		result.EnemiesKilled = stats.EnemiesKilled
+		result.ItemsScavenged = stats.ItemsScavenged
+		result.SalesTotal = stats.SalesTotal
+		result.Cause = stats.Cause.String()
+	}
+	if player, err := query.GetPlayerEntity(world); err == nil && world.Components.HP.Has(player) {
+		hp := (*gc.Pool[int])(world.Components.HP.Get(player))
+		result.HP = hp.Current
+		result.MaxHP = hp.Max
+	}
+	return result
+}
@@ -0,0 +1,80 @@
+package autoplay_test
+
+import (
+	"testing"
+
+	"github.com/kijimaD/ruins/internal/autoplay"
+	"github.com/kijimaD/ruins/internal/dungeon"
+	gs "github.com/kijimaD/ruins/internal/systems"
+	"github.com/kijimaD/ruins/internal/testutil"
+	"github.com/kijimaD/ruins/internal/vrt"
+	w "github.com/kijimaD/ruins/internal/world"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// initWorld は run を回せる world を作る。DungeonState は登録済みのシステムを回し、開始演出で
+// UI のフォントを引くので、その2つを載せる。描画はしないのでスプライトは要らない
+func initWorld(t *testing.T) w.World {
+	t.Helper()
+	world := testutil.InitTestWorld(t)
+	world.Resources.UIResources = vrt.SharedUIResources(t)
+	world.Updaters, world.Renderers = gs.InitializeSystems(world)
+	return world
+}
+
+func debugOptions(seed uint64) autoplay.Options {
+	return autoplay.Options{
+		Seed:      seed,
+		Dungeon:   dungeon.DungeonDebug.Name(),
+		Player:    "ash",
+		MaxDepth:  3,
+		MaxTurns:  60,
+		MaxFrames: 2000,
+	}
+}
+
+func TestNewBot_未知の名前はエラー(t *testing.T) {
+	t.Parallel()
+	_, err := autoplay.NewBot("nope", 1)
+	assert.Error(t, err)
+}
+
+// TestRun_全ボットが本番ループでターンを進める は、各ボットの入力で TurnSystem が回り、
+// 打ち切り条件のいずれかで run が終わることを検証する。
+func TestRun_全ボットが本番ループでターンを進める(t *testing.T) {
+	t.Parallel()
+	for _, name := range autoplay.BotNames {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			world := initWorld(t)
+			bot, err := autoplay.NewBot(name, 7)
+			require.NoError(t, err)
+
+			result, err := autoplay.Run(world, bot, debugOptions(7))
+			require.NoError(t, err)
+
+			assert.Equal(t, name, result.Bot)
+			assert.Positive(t, result.Turns, "ボットの入力でターンが進む")
+			assert.GreaterOrEqual(t, result.Depth, 1)
+			assert.Contains(t, []autoplay.Outcome{autoplay.OutcomeDead, autoplay.OutcomeDepthLimit, autoplay.OutcomeTurnLimit}, result.Outcome)
+			assert.Nil(t, world.Resources.InputSource, "終わったら供給源を外す")
+		})
+	}
+}
+
+// TestRun_同じシードなら同じ結果になる は、run が seed だけで再現できることを検証する。
+// 同じ world を使い回しても前の run の状態が持ち越されないことも兼ねる。
+func TestRun_同じシードなら同じ結果になる(t *testing.T) {
+	t.Parallel()
+	world := initWorld(t)
+
+	run := func() autoplay.Result {
+		bot, err := autoplay.NewBot(autoplay.BotGreedyFighter, 3)
+		require.NoError(t, err)
+		result, err := autoplay.Run(world, bot, debugOptions(3))
+		require.NoError(t, err)
+		return result
+	}
+	assert.Equal(t, run(), run())
+}
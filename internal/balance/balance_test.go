@@ -4,16 +4,15 @@ import (
 	"math/rand/v2"
 	"testing"
 
-	"github.com/kijimaD/ruins/internal/oapi"
 	"github.com/kijimaD/ruins/internal/raw"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-func loadTestMaster(t *testing.T) oapi.Raws {
+func loadTestMaster(t *testing.T) raw.Master {
 	t.Helper()
-	master, err := raw.LoadFromFile("metadata/entities/raw/raw.toml")
-	require.NoError(t, err, "raw.tomlの読み込みに失敗")
+	master, err := raw.LoadFromDir(raw.DefaultDir, "")
+	require.NoError(t, err, "ローデータの読み込みに失敗")
 	return master
 }
 
@@ -5,7 +5,6 @@ import (
 	"math/rand/v2"
 
 	"github.com/kijimaD/ruins/internal/formula"
-	"github.com/kijimaD/ruins/internal/oapi"
 	"github.com/kijimaD/ruins/internal/raw"
 )
 
@@ -88,7 +87,7 @@ func SimulateBattle(player, enemy CombatantStats, playerWeapon, enemyWeapon Weap
 }
 
 // LoadCombatantFromMember はraw.Masterのメンバー定義からCombatantStatsを生成する
-func LoadCombatantFromMember(master oapi.Raws, name string) (CombatantStats, error) {
+func LoadCombatantFromMember(master raw.Master, name string) (CombatantStats, error) {
 	spec, err := raw.NewMemberSpec(master, name)
 	if err != nil {
 		return CombatantStats{}, fmt.Errorf("failed to load member %q: %w", name, err)
@@ -111,7 +110,7 @@ func LoadCombatantFromMember(master oapi.Raws, name string) (CombatantStats, err
 }
 
 // LoadWeaponFromItem はraw.MasterのアイテムからWeaponStatsを生成する
-func LoadWeaponFromItem(master oapi.Raws, name string) (WeaponStats, error) {
+func LoadWeaponFromItem(master raw.Master, name string) (WeaponStats, error) {
 	spec, err := raw.NewItemSpec(master, name)
 	if err != nil {
 		return WeaponStats{}, fmt.Errorf("failed to load weapon %q: %w", name, err)
@@ -137,7 +136,7 @@ func LoadWeaponFromItem(master oapi.Raws, name string) (WeaponStats, error) {
 }
 
 // LoadEnemyWeapon は敵のCommandTableから武器を取得しWeaponStatsを返す
-func LoadEnemyWeapon(master oapi.Raws, enemyName string) (WeaponStats, error) {
+func LoadEnemyWeapon(master raw.Master, enemyName string) (WeaponStats, error) {
 	member, err := raw.FindMember(master, enemyName)
 	if err != nil {
 		return WeaponStats{}, err
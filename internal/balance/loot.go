@@ -27,7 +27,7 @@ var reportFacilities = []interior.FacilityKind{"house", "store", "antique", "cli
 // GenerateRoomLoot は各施設種別を trials 回生成し、床 loot と収納 loot を実際の抽選経路で materialize して
 // 部屋役割ごとにアイテム別の出現確率と期待個数を集計する。解析でなくサンプリングで、PickN・Amount・pack・
 // lootRaw・収納テーブルの相互作用をそのまま反映する。同一 seed で同一結果になる。
-func GenerateRoomLoot(master oapi.Raws, trials int, seed uint64) []oapi.BalanceFacilityLoot {
+func GenerateRoomLoot(master raw.Master, trials int, seed uint64) []oapi.BalanceFacilityLoot {
 	footprint := interior.Rect{X: 0, Y: 0, W: 28, H: 20}
 	door := interior.Vec{X: 14, Y: 0}
 
@@ -91,7 +91,7 @@ type drawnLoot struct {
 
 // resolvePlacedLoot は1つの配置指示を実行と同じ経路で materialize してアイテムと個数を返す。床 loot は
 // item group から、収納家具は Storage.LootTableId から引く。それ以外は何も返さない。
-func resolvePlacedLoot(master oapi.Raws, p interior.Placed, rng *rand.Rand) []drawnLoot {
+func resolvePlacedLoot(master raw.Master, p interior.Placed, rng *rand.Rand) []drawnLoot {
 	switch p.Kind {
 	case interior.KindLoot:
 		groupID, ok := interior.LootGroupName(p.Ref)
@@ -143,7 +143,7 @@ func roomRoleAt(site interior.Site, pos interior.Vec) string {
 }
 
 // buildRoomLoot は集計 map を役割ごとの RoomLoot へ整える。全体を先頭に、以降は期待個数の合計が多い役割順に並べる。
-func buildRoomLoot(master oapi.Raws, total, present map[string]map[string]int, trials int) []oapi.BalanceRoomLoot {
+func buildRoomLoot(master raw.Master, total, present map[string]map[string]int, trials int) []oapi.BalanceRoomLoot {
 	rooms := make([]oapi.BalanceRoomLoot, 0, len(total))
 	for role, items := range total {
 		stats := make([]oapi.BalanceLootItemStat, 0, len(items))
@@ -187,7 +187,7 @@ func roomTotalExpected(r oapi.BalanceRoomLoot) float64 {
 
 // rollStorageLoot は収納家具1個の中身を抽選し、アイテム名を並べて返す。ゲームの populateStorageLoot と同型で、
 // LootTableId のテーブルから LootCount 個を深度考慮で引く。収納でない家具は空を返す。
-func rollStorageLoot(master oapi.Raws, propName string, rng *rand.Rand) []string {
+func rollStorageLoot(master raw.Master, propName string, rng *rand.Rand) []string {
 	prop, err := raw.GetProp(master, propName)
 	if err != nil || prop.Storage == nil || prop.Storage.LootTableId == nil || *prop.Storage.LootTableId == "" {
 		return nil
@@ -214,7 +214,7 @@ func rollStorageLoot(master oapi.Raws, propName string, rng *rand.Rand) []string
 }
 
 // itemValue は raw のアイテム価値を返す。未定義は0。
-func itemValue(master oapi.Raws, name string) int {
+func itemValue(master raw.Master, name string) int {
 	item, err := raw.FindItem(master, name)
 	if err != nil {
 		return 0
@@ -10,7 +10,7 @@ import (
 
 // GenerateReport はマスターデータからシミュレーションを実行し、レポートを生成する。返す型は tsp 由来の
 // oapi.BalanceReport で、balance.json の形は oas/typespec/balance.tsp を単一ソースとする。
-func GenerateReport(master oapi.Raws, playerName string, weaponName string, maxDepth int, trials int, seed uint64) (*oapi.BalanceReport, error) {
+func GenerateReport(master raw.Master, playerName string, weaponName string, maxDepth int, trials int, seed uint64) (*oapi.BalanceReport, error) {
 	player, err := LoadCombatantFromMember(master, playerName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load player: %w", err)
@@ -131,7 +131,7 @@ const roomLootTrials = 2000
 const battleMetricTrials = 500
 
 // generateBattleMetrics は全武器×全敵の組み合わせで戦闘シミュレーションを実行する
-func generateBattleMetrics(master oapi.Raws, playerName string, seed uint64) ([]oapi.BalanceBattleMetric, error) {
+func generateBattleMetrics(master raw.Master, playerName string, seed uint64) ([]oapi.BalanceBattleMetric, error) {
 	player, err := LoadCombatantFromMember(master, playerName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load player for battle metrics: %w", err)
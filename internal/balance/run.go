@@ -4,7 +4,6 @@ import (
 	"math/rand/v2"
 
 	gc "github.com/kijimaD/ruins/internal/components"
-	"github.com/kijimaD/ruins/internal/oapi"
 	"github.com/kijimaD/ruins/internal/raw"
 )
 
@@ -35,7 +34,7 @@ type RunResult struct {
 // SimulateRun はラン全体を模擬する。
 // maxDepth まで進み、死亡したらそこで終了する。
 // フロアのアイテムドロップから武器を取得した場合、より強い武器に切り替える
-func SimulateRun(master oapi.Raws, enemyTableName string, player CombatantStats, playerWeapon WeaponStats, maxDepth int, rng *rand.Rand) RunResult {
+func SimulateRun(master raw.Master, enemyTableName string, player CombatantStats, playerWeapon WeaponStats, maxDepth int, rng *rand.Rand) RunResult {
 	result := RunResult{
 		HPByDepth:           make(map[int]int),
 		HPBeforeHealByDepth: make(map[int]int),
@@ -158,7 +157,7 @@ type floorLoot struct {
 
 // rollFloorLoot はフロアで拾えるアイテムを計算する。
 // 回復アイテムと武器の両方を処理し、武器はフロア内で最も強いものを返す
-func rollFloorLoot(master oapi.Raws, tableName string, depth int, playerMaxHP int, rng *rand.Rand) floorLoot {
+func rollFloorLoot(master raw.Master, tableName string, depth int, playerMaxHP int, rng *rand.Rand) floorLoot {
 	result := floorLoot{}
 
 	itemTable, err := raw.GetItemTable(master, tableName)
@@ -229,7 +228,7 @@ func (bs BattleStats) DPS() float64 {
 }
 
 // RunSimulations はN回のランシミュレーションを実行する
-func RunSimulations(master oapi.Raws, enemyTableName string, player CombatantStats, playerWeapon WeaponStats, maxDepth int, n int, seed uint64) RunStats {
+func RunSimulations(master raw.Master, enemyTableName string, player CombatantStats, playerWeapon WeaponStats, maxDepth int, n int, seed uint64) RunStats {
 	results := make([]RunResult, n)
 	for i := range n {
 		rng := rand.New(rand.NewPCG(seed+uint64(i), 0))
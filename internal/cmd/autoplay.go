@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/kijimaD/ruins/internal/autoplay"
+	"github.com/kijimaD/ruins/internal/config"
+	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/logger"
+	"github.com/kijimaD/ruins/internal/maingame"
+	"github.com/urfave/cli/v3"
+)
+
+// CmdAutoplay は本番のゲームループをボットで回し、run ごとの統計をJSON出力するコマンド
+var CmdAutoplay = &cli.Command{
+	Name:        "autoplay",
+	Usage:       "autoplay [--bot] [--runs] [--seed] [--dungeon] [--out]",
+	Description: "Run the real game loop headlessly with a bot and output per-run stats as JSON",
+	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "bot", Value: autoplay.BotGreedyFighter, Usage: "bot name (" + strings.Join(autoplay.BotNames, ", ") + ")"},
+		&cli.IntFlag{Name: "runs", Value: 10, Usage: "number of runs"},
+		&cli.Uint64Flag{Name: "seed", Value: 1, Usage: "seed of the first run. run i uses seed+i"},
+		&cli.StringFlag{Name: "dungeon", Value: "Dead forest", Usage: "dungeon name defined in the raw data"},
+		&cli.StringFlag{Name: "player", Value: "ash", Usage: "player raw name"},
+		&cli.IntFlag{Name: "max-depth", Value: 10, Usage: "stop a run at this depth"},
+		&cli.IntFlag{Name: "max-turns", Value: 3000, Usage: "stop a run after this many turns"},
+		&cli.IntFlag{Name: "max-frames", Value: 100000, Usage: "stop a run after this many frames"},
+		&cli.StringFlag{Name: "out", Value: "autoplay.json", Usage: "output path"},
+	},
+	Action: runAutoplay,
+}
+
+func runAutoplay(_ context.Context, cmd *cli.Command) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	logger.LoadFromConfig(cfg.LogLevel, cfg.LogCategories)
+
+	world, err := maingame.InitWorld(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to init world: %w", err)
+	}
+
+	results := make([]autoplay.Result, 0, cmd.Int("runs"))
+	for i := range cmd.Int("runs") {
+		seed := cmd.Uint64("seed") + uint64(i)
+		bot, err := autoplay.NewBot(cmd.String("bot"), seed)
+		if err != nil {
+			return err
+		}
+		result, err := autoplay.Run(world, bot, autoplay.Options{
+			Seed:      seed,
+			Dungeon:   cmd.String("dungeon"),
+			Player:    cmd.String("player"),
+			MaxDepth:  cmd.Int("max-depth"),
+			MaxTurns:  consts.Turn(cmd.Int("max-turns")),
+			MaxFrames: cmd.Int("max-frames"),
+		})
+		if err != nil {
+			return fmt.Errorf("run %d (seed %d) failed: %w", i, seed, err)
+		}
+		results = append(results, result)
+	}
+
+	data, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize JSON: %w", err)
+	}
+	if err := os.WriteFile(cmd.String("out"), data, 0644); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	return nil
+}
@@ -30,6 +30,8 @@ func NewMainApp() *cli.Command {
 			CmdGenReadme,
 			CmdGenComponents,
 			CmdDesignDoc,
+			CmdServeEditor,
+			CmdAutoplay,
 		},
 	}
 
@@ -8,9 +8,13 @@ import (
 	"runtime"
 
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/kijimaD/ruins/assets"
+	"github.com/kijimaD/ruins/internal/audio"
 	"github.com/kijimaD/ruins/internal/config"
 	"github.com/kijimaD/ruins/internal/logger"
 	"github.com/kijimaD/ruins/internal/maingame"
+	"github.com/kijimaD/ruins/internal/morgue"
+	ruinsprofile "github.com/kijimaD/ruins/internal/profile"
 	"github.com/kijimaD/ruins/internal/steam"
 	"github.com/pkg/profile"
 	"github.com/urfave/cli/v3"
@@ -104,6 +108,17 @@ func runPlay(_ context.Context, _ *cli.Command) error {
 	if err != nil {
 		return err
 	}
+	// ワールドは音を鳴らさない状態で組まれる。ウィンドウ付きの起動でだけ実際に鳴らす
+	world.Resources.Audio.SetBackend(audio.NewEbitenBackend(assets.FS, audio.SoundDir))
+	// ワールドは記録を保存しないプロフィールで組まれる。ウィンドウ付きの起動でだけ保存済みの記録を読む。
+	// 読めなくてもゲームは続けられるため、警告のみで既定のまま進める
+	if p, err := ruinsprofile.Load(); err != nil {
+		logger.New(logger.CategoryLoad).Warn("failed to load profile", "error", err)
+	} else {
+		world.Resources.Profile = p
+	}
+	// ワールドは死の記録を書き出さない状態で組まれる。ウィンドウ付きの起動でだけ保存先へ書く
+	world.Resources.Morgue = morgue.Store{}
 
 	// 開始ステートの決定
 	var initialState es.State[w.World]
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/kijimaD/ruins/internal/editorapi"
+	"github.com/urfave/cli/v3"
+)
+
+// CmdServeEditor はエディタ用のREST APIサーバを起動するコマンド
+var CmdServeEditor = &cli.Command{
+	Name:        "serve-editor",
+	Usage:       "serve-editor [--addr] [--raw] [--palettes]",
+	Description: "Serve the editor REST API backed by the same validation the game uses",
+	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "addr", Value: "localhost:8080", Usage: "listen address"},
+		&cli.StringFlag{Name: "raw", Value: editorapi.DefaultRawDir, Usage: "raw data directory"},
+		&cli.StringFlag{Name: "palettes", Value: editorapi.DefaultPaletteDir, Usage: "palette directory"},
+	},
+	Action: runServeEditor,
+}
+
+func runServeEditor(ctx context.Context, cmd *cli.Command) error {
+	server := editorapi.NewServer(cmd.String("raw"), cmd.String("palettes"))
+	httpServer := &http.Server{
+		Addr:              cmd.String("addr"),
+		Handler:           server.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		_ = httpServer.Close()
+	}()
+
+	fmt.Printf("serving editor API on http://%s\n", httpServer.Addr)
+	if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed to serve: %w", err)
+	}
+	return nil
+}
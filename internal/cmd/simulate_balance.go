@@ -26,9 +26,9 @@ const (
 )
 
 func runSimulateBalance(_ context.Context, _ *cli.Command) error {
-	master, err := raw.LoadFromFile("metadata/entities/raw/raw.toml")
+	master, err := raw.LoadFromDir(raw.DefaultDir, "")
 	if err != nil {
-		return fmt.Errorf("failed to load raw.toml: %w", err)
+		return fmt.Errorf("failed to load raw data: %w", err)
 	}
 
 	report, err := balance.GenerateReport(master, "ash", "bare_hands", simMaxDepth, simTrials, simSeed)
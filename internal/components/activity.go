@@ -60,6 +60,12 @@ const (
 	BehaviorPush BehaviorName = "Push"
 	// BehaviorPull は隣接する移動拠点キューブを自分の側へ引いて動かす
 	BehaviorPull BehaviorName = "Pull"
+	// BehaviorThrow は所持品を標的へ投げる
+	BehaviorThrow BehaviorName = "Throw"
+	// BehaviorCraft はレシピに従って素材からアイテムを作る
+	BehaviorCraft BehaviorName = "Craft"
+	// BehaviorPickLock は工具で扉や収納の錠を外す。力ずくでこじ開けることもできる
+	BehaviorPickLock BehaviorName = "PickLock"
 )
 
 // Activity は実行中のアクティビティを保持するコンポーネント
@@ -126,6 +132,14 @@ type ShootParams struct {
 
 func (*ShootParams) isActivityParams() {}
 
+// ThrowParams は投擲のパラメータ。
+type ThrowParams struct {
+	Item   ecs.Entity // 投げるアイテムのエンティティ
+	Target ecs.Entity // 狙う相手のエンティティ
+}
+
+func (*ThrowParams) isActivityParams() {}
+
 // UseItemParams はアイテム使用のパラメータ。
 type UseItemParams struct {
 	Target ecs.Entity // 使用するアイテムのエンティティ
@@ -147,6 +161,22 @@ type DisassembleParams struct {
 
 func (*DisassembleParams) isActivityParams() {}
 
+// PickLockParams は解錠のパラメータ。
+type PickLockParams struct {
+	Target ecs.Entity // 錠の掛かった扉か収納のエンティティ
+	Force  bool       // true ならこじ開ける。解錠より易しく速いが音を立てる
+}
+
+func (*PickLockParams) isActivityParams() {}
+
+// CraftParams は合成のパラメータ。
+type CraftParams struct {
+	RecipeID string     // 合成するレシピの id。生成アイテムの id と一致する
+	Result   ecs.Entity // 仕上がったアイテム。完了するまでは InvalidEntity のまま。合成画面が結果の表示に使う
+}
+
+func (*CraftParams) isActivityParams() {}
+
 // PlaceParams は対象と操作先を取るアクションのパラメータ。ドロップ・押し引きが使う。
 type PlaceParams struct {
 	Target      ecs.Entity  // 操作対象のエンティティ
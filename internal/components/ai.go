@@ -17,6 +17,8 @@ const (
 	AIStateChasing = AIStateSubState("CHASING")
 	// AIStateFleeing は逃亡状態
 	AIStateFleeing = AIStateSubState("FLEEING")
+	// AIStateInvestigating は物音の出どころを調べに向かう状態
+	AIStateInvestigating = AIStateSubState("INVESTIGATING")
 )
 
 // SoloAI は単独行動NPC用の設定と状態を保持する
@@ -32,6 +34,17 @@ type SoloAI struct {
 	Origin                consts.Coord[consts.Tile] // パトロール原点のタイル座標
 	PatrolDir             consts.Coord[consts.Tile] // パトロール方向。各成分は -1/0/1
 	TargetEntity          *ecs.Entity
+	NoiseOrigin           *consts.Coord[consts.Tile] // 聞きつけた物音の出どころ。調べ終えるか相手を見つけると nil に戻る
+}
+
+// Squad は群れで配置された敵の分隊所属を保持する。同じ ID を持つ個体が1つの分隊になる。
+// 分隊員は SoloAI も持ち、状態遷移はそちらを使う。行動計画だけを分隊用に差し替える
+type Squad struct {
+	ID uint64
+	// Leader は分隊の頭であることを表す。追う標的は頭の SoloAI.TargetEntity を共有する
+	Leader bool
+	// Routed は頭を失って潰走したことを表す。以降は各個体が単独で動く
+	Routed bool
 }
 
 // ReactToHostile は被ダメージ時に戦闘方針を変化させる。
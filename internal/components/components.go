@@ -183,6 +183,22 @@ type InflictsDamage struct {
 	Amount int
 }
 
+// ProvidesTreatment は負傷を手当てする性質。
+// Amount は1回の手当ての治療量で、負傷の重い順に配られる
+type ProvidesTreatment struct {
+	Amount int
+}
+
+// Throwable は投げて使える性質。
+// 射程内の標的へ投げ、着弾点の周囲 Splash タイルまでに Damage を与える。
+// BreaksOnImpact なら着弾で壊れ、そうでなければ着弾点の床に残る
+type Throwable struct {
+	Range          consts.Tile // 投擲の射程
+	Damage         int         // 着弾時に与えるダメージ
+	BreaksOnImpact bool        // 着弾で壊れるか
+	Splash         consts.Tile // 着弾点からの巻き込み半径。0なら着弾点だけ
+}
+
 // Value はアイテムの基本価値
 // 売買時の基準となる。実際の売値・買値は店や状況に応じて倍率が適用される
 type Value struct {
@@ -195,9 +211,18 @@ type Weight struct {
 	consts.Milligram
 }
 
-// Recipe は合成に必要な素材
+// Recipe は合成に必要な素材と作業の条件
 type Recipe struct {
-	Inputs []RecipeInput
+	Inputs  []RecipeInput
+	Station *oapi.CraftStation // 近くに要る作業場。nil ならどこでも合成できる
+	Tool    *RecipeTool        // 所持品に要る工具。nil なら工具は要らない
+	WorkAP  int                // 合成にかかる作業量。0 なら即座に仕上がる
+}
+
+// RecipeTool は合成に要る工具。分解工具の分類が合い、グレードが Grade 以上のものを使える
+type RecipeTool struct {
+	Category oapi.ToolCategory
+	Grade    int
 }
 
 // StatsChanged はステータス再計算が必要なことを示すダーティーフラグ
@@ -302,6 +327,11 @@ func (f *Fire) GetTargetType() TargetType { return f.TargetType }
 // CommandTable はAI用の、戦闘コマンドテーブル名
 type CommandTable struct {
 	Name string
+	// Fire はテーブルに射撃武器があるとき、その武器の性能と弾倉。無ければnil。
+	// 装填数は撃つたびに減るので、テーブルの定義とは別に個体ごとに持つ
+	Fire *Fire
+	// FireWeapon は Fire を持つ武器の名前。攻撃ログに出す
+	FireWeapon string
 }
 
 // DropTable はドロップテーブル名
@@ -375,6 +405,13 @@ type Door struct {
 	Orientation DoorOrientation // 扉の向き
 }
 
+// Lock は扉や収納に掛かった錠。付いている間は扉を開けられず、収納を覗けない。
+// 合う鍵を持っていれば開き、鍵が無ければ解錠か破錠で外す。外した錠は戻らない
+type Lock struct {
+	Difficulty int    // 錠の難度。機械スキルと工具のグレードを足した値と競う
+	KeyID      string // 合う鍵の ID。空ならどの鍵でも開かない
+}
+
 // DoorOrientation は扉の向き
 type DoorOrientation int
 
@@ -28,6 +28,7 @@ type EntitySpec struct {
 	LocationInStorage  *LocationInStorage
 	Tile               *Tile
 	SoloAI             *SoloAI
+	Squad              *Squad
 	Camera             *Camera
 	Position           *Position
 	GridElement        *GridElement
@@ -36,15 +37,18 @@ type EntitySpec struct {
 	BlockPass          *BlockPass
 	PassCost           *PassCost
 	Door               *Door
+	Lock               *Lock
 	Fixed              *Fixed
 	Pushable           *Pushable
 	LightSource        *LightSource
 	Interactable       *Interactable
 	VisualEffects      *VisualEffects
 	TileTemperature    *TileTemperature
+	StorageTemperature *StorageTemperature
 	StageBound         *StageBound
 	StageField         *StageField
 	SeamlessBand       *SeamlessBand
+	ChunkOrigin        *ChunkOrigin
 	PortalConnection   *PortalConnection
 	DungeonEntrance    *DungeonEntrance
 	Suspended          *Suspended
@@ -57,6 +61,7 @@ type EntitySpec struct {
 	FactionNeutral     *FactionNeutral
 	Boss               *Boss
 	Dialog             *Dialog
+	Merchant           *Merchant
 	Dead               *Dead
 	TurnBased          *TurnBased
 	HealthStatus       *HealthStatus
@@ -68,6 +73,8 @@ type EntitySpec struct {
 	ProvidesHealing    *ProvidesHealing
 	ProvidesNutrition  *ProvidesNutrition
 	InflictsDamage     *InflictsDamage
+	ProvidesTreatment  *ProvidesTreatment
+	Throwable          *Throwable
 	Book               *Book
 	CommandTable       *CommandTable
 	DropTable          *DropTable
@@ -84,6 +91,7 @@ type EntitySpec struct {
 	UserSettings       *UserSettings
 	AuctionHistory     *AuctionHistory
 	RunStats           *RunStats
+	QuestLog           *QuestLog
 	AuctionListing     *AuctionListing
 	AuctionSold        *AuctionSold
 	AuctionStation     *AuctionStation
@@ -113,6 +121,7 @@ type Components struct {
 	LocationInStorage  *ecs.Map[LocationInStorage]
 	Tile               *ecs.Map[Tile]
 	SoloAI             *ecs.Map[SoloAI]
+	Squad              *ecs.Map[Squad]
 	Camera             *ecs.Map[Camera]
 	Position           *ecs.Map[Position]
 	GridElement        *ecs.Map[GridElement]
@@ -121,15 +130,18 @@ type Components struct {
 	BlockPass          *ecs.Map[BlockPass]
 	PassCost           *ecs.Map[PassCost]
 	Door               *ecs.Map[Door]
+	Lock               *ecs.Map[Lock]
 	Fixed              *ecs.Map[Fixed]
 	Pushable           *ecs.Map[Pushable]
 	LightSource        *ecs.Map[LightSource]
 	Interactable       *ecs.Map[Interactable]
 	VisualEffects      *ecs.Map[VisualEffects]
 	TileTemperature    *ecs.Map[TileTemperature]
+	StorageTemperature *ecs.Map[StorageTemperature]
 	StageBound         *ecs.Map[StageBound]
 	StageField         *ecs.Map[StageField]
 	SeamlessBand       *ecs.Map[SeamlessBand]
+	ChunkOrigin        *ecs.Map[ChunkOrigin]
 	PortalConnection   *ecs.Map[PortalConnection]
 	DungeonEntrance    *ecs.Map[DungeonEntrance]
 	Suspended          *ecs.Map[Suspended]
@@ -142,6 +154,7 @@ type Components struct {
 	FactionNeutral     *ecs.Map[FactionNeutral]
 	Boss               *ecs.Map[Boss]
 	Dialog             *ecs.Map[Dialog]
+	Merchant           *ecs.Map[Merchant]
 	Dead               *ecs.Map[Dead]
 	TurnBased          *ecs.Map[TurnBased]
 	HealthStatus       *ecs.Map[HealthStatus]
@@ -153,6 +166,8 @@ type Components struct {
 	ProvidesHealing    *ecs.Map[ProvidesHealing]
 	ProvidesNutrition  *ecs.Map[ProvidesNutrition]
 	InflictsDamage     *ecs.Map[InflictsDamage]
+	ProvidesTreatment  *ecs.Map[ProvidesTreatment]
+	Throwable          *ecs.Map[Throwable]
 	Book               *ecs.Map[Book]
 	CommandTable       *ecs.Map[CommandTable]
 	DropTable          *ecs.Map[DropTable]
@@ -169,6 +184,7 @@ type Components struct {
 	UserSettings       *ecs.Map[UserSettings]
 	AuctionHistory     *ecs.Map[AuctionHistory]
 	RunStats           *ecs.Map[RunStats]
+	QuestLog           *ecs.Map[QuestLog]
 	AuctionListing     *ecs.Map[AuctionListing]
 	AuctionSold        *ecs.Map[AuctionSold]
 	AuctionStation     *ecs.Map[AuctionStation]
@@ -198,6 +214,7 @@ func (c *Components) InitializeComponents(world *ecs.World) error {
 	c.LocationInStorage = ecs.NewMap[LocationInStorage](world)
 	c.Tile = ecs.NewMap[Tile](world)
 	c.SoloAI = ecs.NewMap[SoloAI](world)
+	c.Squad = ecs.NewMap[Squad](world)
 	c.Camera = ecs.NewMap[Camera](world)
 	c.Position = ecs.NewMap[Position](world)
 	c.GridElement = ecs.NewMap[GridElement](world)
@@ -206,15 +223,18 @@ func (c *Components) InitializeComponents(world *ecs.World) error {
 	c.BlockPass = ecs.NewMap[BlockPass](world)
 	c.PassCost = ecs.NewMap[PassCost](world)
 	c.Door = ecs.NewMap[Door](world)
+	c.Lock = ecs.NewMap[Lock](world)
 	c.Fixed = ecs.NewMap[Fixed](world)
 	c.Pushable = ecs.NewMap[Pushable](world)
 	c.LightSource = ecs.NewMap[LightSource](world)
 	c.Interactable = ecs.NewMap[Interactable](world)
 	c.VisualEffects = ecs.NewMap[VisualEffects](world)
 	c.TileTemperature = ecs.NewMap[TileTemperature](world)
+	c.StorageTemperature = ecs.NewMap[StorageTemperature](world)
 	c.StageBound = ecs.NewMap[StageBound](world)
 	c.StageField = ecs.NewMap[StageField](world)
 	c.SeamlessBand = ecs.NewMap[SeamlessBand](world)
+	c.ChunkOrigin = ecs.NewMap[ChunkOrigin](world)
 	c.PortalConnection = ecs.NewMap[PortalConnection](world)
 	c.DungeonEntrance = ecs.NewMap[DungeonEntrance](world)
 	c.Suspended = ecs.NewMap[Suspended](world)
@@ -227,6 +247,7 @@ func (c *Components) InitializeComponents(world *ecs.World) error {
 	c.FactionNeutral = ecs.NewMap[FactionNeutral](world)
 	c.Boss = ecs.NewMap[Boss](world)
 	c.Dialog = ecs.NewMap[Dialog](world)
+	c.Merchant = ecs.NewMap[Merchant](world)
 	c.Dead = ecs.NewMap[Dead](world)
 	c.TurnBased = ecs.NewMap[TurnBased](world)
 	c.HealthStatus = ecs.NewMap[HealthStatus](world)
@@ -238,6 +259,8 @@ func (c *Components) InitializeComponents(world *ecs.World) error {
 	c.ProvidesHealing = ecs.NewMap[ProvidesHealing](world)
 	c.ProvidesNutrition = ecs.NewMap[ProvidesNutrition](world)
 	c.InflictsDamage = ecs.NewMap[InflictsDamage](world)
+	c.ProvidesTreatment = ecs.NewMap[ProvidesTreatment](world)
+	c.Throwable = ecs.NewMap[Throwable](world)
 	c.Book = ecs.NewMap[Book](world)
 	c.CommandTable = ecs.NewMap[CommandTable](world)
 	c.DropTable = ecs.NewMap[DropTable](world)
@@ -254,6 +277,7 @@ func (c *Components) InitializeComponents(world *ecs.World) error {
 	c.UserSettings = ecs.NewMap[UserSettings](world)
 	c.AuctionHistory = ecs.NewMap[AuctionHistory](world)
 	c.RunStats = ecs.NewMap[RunStats](world)
+	c.QuestLog = ecs.NewMap[QuestLog](world)
 	c.AuctionListing = ecs.NewMap[AuctionListing](world)
 	c.AuctionSold = ecs.NewMap[AuctionSold](world)
 	c.AuctionStation = ecs.NewMap[AuctionStation](world)
@@ -285,6 +309,7 @@ func (c *Components) AddEntity(world *ecs.World, spec *EntitySpec) ecs.Entity {
 	addComp(c.LocationInStorage, entity, spec.LocationInStorage)
 	addComp(c.Tile, entity, spec.Tile)
 	addComp(c.SoloAI, entity, spec.SoloAI)
+	addComp(c.Squad, entity, spec.Squad)
 	addComp(c.Camera, entity, spec.Camera)
 	addComp(c.Position, entity, spec.Position)
 	addComp(c.GridElement, entity, spec.GridElement)
@@ -293,15 +318,18 @@ func (c *Components) AddEntity(world *ecs.World, spec *EntitySpec) ecs.Entity {
 	addComp(c.BlockPass, entity, spec.BlockPass)
 	addComp(c.PassCost, entity, spec.PassCost)
 	addComp(c.Door, entity, spec.Door)
+	addComp(c.Lock, entity, spec.Lock)
 	addComp(c.Fixed, entity, spec.Fixed)
 	addComp(c.Pushable, entity, spec.Pushable)
 	addComp(c.LightSource, entity, spec.LightSource)
 	addComp(c.Interactable, entity, spec.Interactable)
 	addComp(c.VisualEffects, entity, spec.VisualEffects)
 	addComp(c.TileTemperature, entity, spec.TileTemperature)
+	addComp(c.StorageTemperature, entity, spec.StorageTemperature)
 	addComp(c.StageBound, entity, spec.StageBound)
 	addComp(c.StageField, entity, spec.StageField)
 	addComp(c.SeamlessBand, entity, spec.SeamlessBand)
+	addComp(c.ChunkOrigin, entity, spec.ChunkOrigin)
 	addComp(c.PortalConnection, entity, spec.PortalConnection)
 	addComp(c.DungeonEntrance, entity, spec.DungeonEntrance)
 	addComp(c.Suspended, entity, spec.Suspended)
@@ -314,6 +342,7 @@ func (c *Components) AddEntity(world *ecs.World, spec *EntitySpec) ecs.Entity {
 	addComp(c.FactionNeutral, entity, spec.FactionNeutral)
 	addComp(c.Boss, entity, spec.Boss)
 	addComp(c.Dialog, entity, spec.Dialog)
+	addComp(c.Merchant, entity, spec.Merchant)
 	addComp(c.Dead, entity, spec.Dead)
 	addComp(c.TurnBased, entity, spec.TurnBased)
 	addComp(c.HealthStatus, entity, spec.HealthStatus)
@@ -325,6 +354,8 @@ func (c *Components) AddEntity(world *ecs.World, spec *EntitySpec) ecs.Entity {
 	addComp(c.ProvidesHealing, entity, spec.ProvidesHealing)
 	addComp(c.ProvidesNutrition, entity, spec.ProvidesNutrition)
 	addComp(c.InflictsDamage, entity, spec.InflictsDamage)
+	addComp(c.ProvidesTreatment, entity, spec.ProvidesTreatment)
+	addComp(c.Throwable, entity, spec.Throwable)
 	addComp(c.Book, entity, spec.Book)
 	addComp(c.CommandTable, entity, spec.CommandTable)
 	addComp(c.DropTable, entity, spec.DropTable)
@@ -341,6 +372,7 @@ func (c *Components) AddEntity(world *ecs.World, spec *EntitySpec) ecs.Entity {
 	addComp(c.UserSettings, entity, spec.UserSettings)
 	addComp(c.AuctionHistory, entity, spec.AuctionHistory)
 	addComp(c.RunStats, entity, spec.RunStats)
+	addComp(c.QuestLog, entity, spec.QuestLog)
 	addComp(c.AuctionListing, entity, spec.AuctionListing)
 	addComp(c.AuctionSold, entity, spec.AuctionSold)
 	addComp(c.AuctionStation, entity, spec.AuctionStation)
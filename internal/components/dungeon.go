@@ -16,7 +16,7 @@ type LightInfo struct {
 }
 
 // SeamlessBand はオーバーワールドのアクティブ帯の永続状態を保持する。
-// Active が true のときのみ有効。全フィールドがスカラーなので serde に乗る。
+// Active が true のときのみ有効。全フィールドがスカラーかその構造体のスライスなので serde に乗る。
 // これによりロード後や遺跡遷移後に Band を再構築できる。
 type SeamlessBand struct {
 	// Active はシームレスワールド中かを表す
@@ -36,6 +36,48 @@ type SeamlessBand struct {
 
 	// Front は寒波前線の永続状態。帯の Active に従属し、帯とセットで復元される。
 	Front SeamlessFront
+
+	// Chunks はチャンクごとの決定的生成に対する差分。帯を往復しても荒らした跡が残るよう、
+	// 帯から外れたチャンクの状態をここに持ち、再生成のときに当て直す
+	Chunks []ChunkDelta
+}
+
+// ChunkEntityKey はチャンク生成が置いた実体の同定キー。
+// 同じ seed からは同じ位置に同じ raw id の実体が生成されるので、再生成をまたいで対応が取れる
+type ChunkEntityKey struct {
+	// Pos はチャンク左上からの相対タイル座標
+	Pos consts.Coord[consts.Tile]
+	// ID は raw id。raw を持たない扉は ChunkDoorID
+	ID string
+}
+
+// ChunkDoorID は raw を持たない扉の ChunkEntityKey.ID
+const ChunkDoorID = "door"
+
+// ChunkDelta はチャンク1つぶんの決定的生成に対する差分。
+// 帯内にある間は Generated に生成結果を持ち、帯から外れるときに残りの差分へ畳む
+type ChunkDelta struct {
+	// Chunk は絶対チャンク座標
+	Chunk consts.Coord[consts.Chunk]
+	// Generated は帯内にある間だけ持つ、生成で置かれた追跡対象の一覧
+	Generated []ChunkEntityKey
+	// Removed は生成されたが失われた実体。倒した敵や拾ったアイテム、壊した置物
+	Removed []ChunkEntityKey
+	// OpenDoors は帯から外れたときに開いていた扉。扉は閉じた状態で生成される
+	OpenDoors []ChunkEntityKey
+	// Unlocked は錠を掛けて生成されたが、帯から外れたときには錠が外れていた扉や収納
+	Unlocked []ChunkEntityKey
+	// Dropped は外から持ち込まれて地面に置かれたアイテム。ID は item id
+	Dropped []ChunkEntityKey
+}
+
+// ChunkOrigin はチャンク生成で置かれた実体に付く印。
+// チャンクが帯から外れるとき、どの生成物が生き残っているかの判定に使う
+type ChunkOrigin struct {
+	Chunk consts.Coord[consts.Chunk]
+	Key   ChunkEntityKey
+	// Locked は生成時に錠が掛かっていたか。外した錠を再生成で戻さないために控える
+	Locked bool
 }
 
 // SeamlessFront は寒波前線の永続状態。現在位置は保存せず、config と永続の
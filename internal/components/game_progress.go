@@ -65,3 +65,8 @@ func (gp *GameProgress) IsEventUnseen(eventID string) bool {
 	ev, ok := gp.Events[eventID]
 	return ok && ev.Active && !ev.Seen
 }
+
+// IsEventSeen はイベントが視聴済みかを返す
+func (gp *GameProgress) IsEventSeen(eventID string) bool {
+	return gp.Events[eventID].Seen
+}
@@ -44,8 +44,10 @@ func TestGameProgress_EventState(t *testing.T) {
 	assert.True(t, gp.IsEventUnseen(EventAllCleared))
 
 	// Seenを設定すると未視聴ではなくなる
+	assert.False(t, gp.IsEventSeen(EventAllCleared))
 	gp.MarkEventSeen(EventAllCleared)
 	assert.False(t, gp.IsEventUnseen(EventAllCleared))
+	assert.True(t, gp.IsEventSeen(EventAllCleared))
 }
 
 func TestGameProgress_IsAllCleared_EmptyList(t *testing.T) {
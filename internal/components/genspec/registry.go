@@ -32,27 +32,31 @@ var Registry = []Def{
 	{Field: "LocationInStorage"},  // 収納内にあることを表す
 
 	// field ================
-	{Field: "Tile"},            // タイルエンティティであることを示す
-	{Field: "SoloAI"},          // 単独行動AIの設定を保持する
-	{Field: "Camera"},          // カメラの位置とズームを保持する
-	{Field: "Position"},        // フィールド上のピクセル座標を保持する
-	{Field: "GridElement"},     // フィールド上のグリッド座標を保持する
-	{Field: "SpriteRender"},    // スプライト描画情報を保持する
-	{Field: "BlockView"},       // 視界を遮ることを示す
-	{Field: "BlockPass"},       // 通行不可であることを示す
-	{Field: "PassCost"},        // タイルの移動コスト修正を保持する
-	{Field: "Door"},            // 開閉可能な扉であることを表す
-	{Field: "Fixed"},           // 世界に固定され拾えない固定物であることを示す
-	{Field: "Pushable"},        // 押して動かせることを示す。移動拠点キューブが最初の利用者だが印は汎用
-	{Field: "LightSource"},     // 光源であることを表す
-	{Field: "Interactable"},    // 相互作用可能であることを示す
-	{Field: "VisualEffects"},   // 紐づくビジュアルエフェクトを管理する
-	{Field: "TileTemperature"}, // タイルの気温修正値を保持する
+	{Field: "Tile"},               // タイルエンティティであることを示す
+	{Field: "SoloAI"},             // 単独行動AIの設定を保持する
+	{Field: "Squad"},              // 群れの分隊所属と頭かどうかを保持する
+	{Field: "Camera"},             // カメラの位置とズームを保持する
+	{Field: "Position"},           // フィールド上のピクセル座標を保持する
+	{Field: "GridElement"},        // フィールド上のグリッド座標を保持する
+	{Field: "SpriteRender"},       // スプライト描画情報を保持する
+	{Field: "BlockView"},          // 視界を遮ることを示す
+	{Field: "BlockPass"},          // 通行不可であることを示す
+	{Field: "PassCost"},           // タイルの移動コスト修正を保持する
+	{Field: "Door"},               // 開閉可能な扉であることを表す
+	{Field: "Lock"},               // 扉や収納に掛かった錠の難度と合う鍵を保持する
+	{Field: "Fixed"},              // 世界に固定され拾えない固定物であることを示す
+	{Field: "Pushable"},           // 押して動かせることを示す。移動拠点キューブが最初の利用者だが印は汎用
+	{Field: "LightSource"},        // 光源であることを表す
+	{Field: "Interactable"},       // 相互作用可能であることを示す
+	{Field: "VisualEffects"},      // 紐づくビジュアルエフェクトを管理する
+	{Field: "TileTemperature"},    // タイルの気温修正値を保持する
+	{Field: "StorageTemperature"}, // 収納内を保つ気温を保持する。中身の腐敗速度に効く
 
 	// stage ================
 	{Field: "StageBound"},       // 束縛先ステージを保持する。往復するステージの同定に使う
 	{Field: "StageField"},       // ステージごとのフィールド状態を保持する。現ステージは CurrentStage で引く
 	{Field: "SeamlessBand"},     // オーバーワールドの帯・前線の永続状態を保持する。有無がオーバーワールド判定を兼ねる
+	{Field: "ChunkOrigin"},      // チャンク生成で置かれた実体の生成元チャンクと同定キーを保持する
 	{Field: "PortalConnection"}, // ポータルの行き先ステージと着地座標を保持する
 	{Field: "DungeonEntrance"},  // 遺跡入口が進入先の遺跡定義名を保持する
 	{Field: "Suspended"},        // 現ステージ以外に属し稼働しないことを示すマーカー
@@ -67,6 +71,7 @@ var Registry = []Def{
 	{Field: "FactionNeutral"}, // 中立派閥であることを示す
 	{Field: "Boss"},           // ボスエンティティであることを示す
 	{Field: "Dialog"},         // 会話データを保持する
+	{Field: "Merchant"},       // 商人の集落の種類と品ごとの供給量を保持する
 	{Field: "Dead"},           // 死亡状態であることを示す
 	{Field: "TurnBased"},      // アクションポイントを管理する
 	{Field: "HealthStatus"},   // 部位ごとの健康状態を保持する
@@ -80,6 +85,8 @@ var Registry = []Def{
 	{Field: "ProvidesHealing"},    // HP回復の性質を保持する
 	{Field: "ProvidesNutrition"},  // 空腹度回復の性質を保持する
 	{Field: "InflictsDamage"},     // ダメージを与える性質を保持する
+	{Field: "ProvidesTreatment"},  // 負傷を手当てする性質を保持する
+	{Field: "Throwable"},          // 投げて使える性質を保持する
 
 	// book ================
 	{Field: "Book"}, // 読書可能な本であることを表す
@@ -104,6 +111,7 @@ var Registry = []Def{
 	{Field: "UserSettings"},    // 設定画面で変更するグローバル設定を保持するシングルトン
 	{Field: "AuctionHistory"},  // 通信販売の金銭明細と出荷実績履歴、採番カウンタ、評判を保持するシングルトン
 	{Field: "RunStats"},        // run を通じて積み上げる統計と死因を保持するシングルトン。serde 保存
+	{Field: "QuestLog"},        // 受けたクエストと進み具合を保持するシングルトン。serde 保存
 
 	// auction ================
 	{Field: "AuctionListing"}, // 通信販売で出品中の品の現在値と採番を保持する
@@ -73,6 +73,10 @@ type ConditionType string
 const (
 	ConditionHypothermia  ConditionType = "Hypothermia"  // 低体温
 	ConditionHyperthermia ConditionType = "Hyperthermia" // 高体温
+	ConditionBurning      ConditionType = "Burning"      // 炎上
+	ConditionShocked      ConditionType = "Shocked"      // 感電。痺れて AP の回復が減り、重度では手番を失う
+	ConditionFrozen       ConditionType = "Frozen"       // 凍結
+	ConditionBlinded      ConditionType = "Blinded"      // 眩惑
 )
 
 // ConditionTypeDisplayName は状態種類の表示名を返す
@@ -82,11 +86,80 @@ func ConditionTypeDisplayName(ct ConditionType) string {
 		return "Hypothermia"
 	case ConditionHyperthermia:
 		return "Hyperthermia"
+	case ConditionBurning:
+		return "Burning"
+	case ConditionShocked:
+		return "Shocked"
+	case ConditionFrozen:
+		return "Frozen"
+	case ConditionBlinded:
+		return "Blinded"
+	case ConditionInjury:
+		return "Injury"
 	default:
 		return string(ct)
 	}
 }
 
+// ElementCondition は元素攻撃の命中で付く状態を返す。無属性は状態を付けないので false
+func ElementCondition(elem ElementType) (ConditionType, bool) {
+	switch elem {
+	case ElementTypeFire:
+		return ConditionBurning, true
+	case ElementTypeThunder:
+		return ConditionShocked, true
+	case ElementTypeChill:
+		return ConditionFrozen, true
+	case ElementTypePhoton:
+		return ConditionBlinded, true
+	default:
+		return "", false
+	}
+}
+
+// IsElementCondition は元素攻撃由来の状態かを返す
+func IsElementCondition(ct ConditionType) bool {
+	switch ct {
+	case ConditionBurning, ConditionShocked, ConditionFrozen, ConditionBlinded:
+		return true
+	default:
+		return false
+	}
+}
+
+// ElementConditionEffects は元素状態の重症度に応じた全身への効果を返す
+func ElementConditionEffects(ct ConditionType, severity Severity) []StatEffect {
+	m := int(severity)
+	if m == 0 {
+		return nil
+	}
+
+	switch ct {
+	case ConditionBurning:
+		return []StatEffect{
+			{Stat: StatVitality, Value: -1 * m},
+			{Stat: StatDefense, Value: -1 * m},
+		}
+	case ConditionShocked:
+		return []StatEffect{
+			{Stat: StatDexterity, Value: -1 * m},
+			{Stat: StatAgility, Value: -1 * m},
+		}
+	case ConditionFrozen:
+		return []StatEffect{
+			{Stat: StatStrength, Value: -1 * m},
+			{Stat: StatAgility, Value: -1 * m},
+		}
+	case ConditionBlinded:
+		return []StatEffect{
+			{Stat: StatSensation, Value: -2 * m},
+			{Stat: StatDexterity, Value: -1 * m},
+		}
+	default:
+		return nil
+	}
+}
+
 // HealthCondition は部位に付与される1つの状態
 type HealthCondition struct {
 	Type     ConditionType // 状態の種類
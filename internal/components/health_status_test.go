@@ -281,3 +281,27 @@ func TestClamp(t *testing.T) {
 		assert.InDelta(t, 10.0, clamp(15.0, 0.0, 10.0), 0.001)
 	})
 }
+
+func TestElementCondition(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		elem ElementType
+		want ConditionType
+	}{
+		{ElementTypeFire, ConditionBurning},
+		{ElementTypeThunder, ConditionShocked},
+		{ElementTypeChill, ConditionFrozen},
+		{ElementTypePhoton, ConditionBlinded},
+	}
+	for _, tt := range tests {
+		got, ok := ElementCondition(tt.elem)
+		require.True(t, ok)
+		assert.Equal(t, tt.want, got)
+		assert.True(t, IsElementCondition(got))
+	}
+
+	_, ok := ElementCondition(ElementTypeNone)
+	assert.False(t, ok, "無属性は状態を付けない")
+	assert.False(t, IsElementCondition(ConditionHypothermia))
+}
@@ -0,0 +1,126 @@
+package components
+
+import "slices"
+
+// ConditionInjury は被弾で部位に付く負傷。
+// 部位ごとの耐久は持たず、深さを HealthCondition の Timer で表す
+const ConditionInjury ConditionType = "Injury"
+
+// InjuryPart は負傷を負う部位と、被弾時に選ばれる重み
+type InjuryPart struct {
+	Part   BodyPart
+	Weight int
+}
+
+// InjuryParts は負傷を負う部位の一覧。部位は頭・胴・腕・脚に絞り、重みは的の大きさを表す
+var InjuryParts = []InjuryPart{
+	{Part: BodyPartHead, Weight: 10},
+	{Part: BodyPartTorso, Weight: 40},
+	{Part: BodyPartArms, Weight: 25},
+	{Part: BodyPartLegs, Weight: 25},
+}
+
+// PickInjuryPart は 0 以上重み合計未満の roll から負傷する部位を選ぶ
+func PickInjuryPart(roll int) BodyPart {
+	for _, ip := range InjuryParts {
+		if roll < ip.Weight {
+			return ip.Part
+		}
+		roll -= ip.Weight
+	}
+	return InjuryParts[len(InjuryParts)-1].Part
+}
+
+// InjuryPartsTotalWeight は InjuryParts の重み合計を返す
+func InjuryPartsTotalWeight() int {
+	total := 0
+	for _, ip := range InjuryParts {
+		total += ip.Weight
+	}
+	return total
+}
+
+// InjuryEffects は負傷した部位と重症度から能力への効果を返す。
+// 頭は感覚、胴は体力、腕は器用さ（命中）、脚は敏捷に効く。脚の移動コストは CharModifiers 側で足す
+func InjuryEffects(part BodyPart, severity Severity) []StatEffect {
+	m := int(severity)
+	if m == 0 {
+		return nil
+	}
+
+	var stat StatType
+	switch part {
+	case BodyPartHead:
+		stat = StatSensation
+	case BodyPartTorso:
+		stat = StatVitality
+	case BodyPartArms:
+		stat = StatDexterity
+	case BodyPartLegs:
+		stat = StatAgility
+	default:
+		return nil
+	}
+	return []StatEffect{{Stat: stat, Value: -2 * m}}
+}
+
+// UpdateInjury は部位の負傷の深さを delta だけ変え、効果を更新する。
+// 深さが0になった負傷は消える
+func (hs *HealthStatus) UpdateInjury(part BodyPart, delta float64) SeverityChange {
+	partHealth := &hs.Parts[part]
+	change := partHealth.UpdateConditionTimer(ConditionInjury, delta)
+	if cond := partHealth.GetCondition(ConditionInjury); cond != nil {
+		cond.Effects = InjuryEffects(part, cond.Severity)
+	}
+	return change
+}
+
+// Tend は手当てを1回行う。治療量 budget を負傷の深い順に配り、深さぶんを使って負傷を治す。
+// 足りなければ最後の負傷は浅くなるだけで残る。部位は選ばない。
+// 戻り値: 完全に治した負傷の数と、使った治療量
+func (hs *HealthStatus) Tend(budget float64) (healed int, used float64) {
+	var parts []BodyPart
+	for _, ip := range InjuryParts {
+		if hs.Parts[ip.Part].GetCondition(ConditionInjury) != nil {
+			parts = append(parts, ip.Part)
+		}
+	}
+	// 深い負傷から配る。同じ深さなら InjuryParts の並び順
+	slices.SortStableFunc(parts, func(a, b BodyPart) int {
+		ta := hs.Parts[a].GetCondition(ConditionInjury).Timer
+		tb := hs.Parts[b].GetCondition(ConditionInjury).Timer
+		switch {
+		case ta > tb:
+			return -1
+		case ta < tb:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	for _, part := range parts {
+		if budget <= 0 {
+			break
+		}
+		depth := hs.Parts[part].GetCondition(ConditionInjury).Timer
+		amount := min(depth, budget)
+		hs.UpdateInjury(part, -amount)
+		budget -= amount
+		used += amount
+		if amount == depth {
+			healed++
+		}
+	}
+	return healed, used
+}
+
+// HasInjury は手当てできる負傷があるかを返す
+func (hs *HealthStatus) HasInjury() bool {
+	for _, ip := range InjuryParts {
+		if hs.Parts[ip.Part].GetCondition(ConditionInjury) != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package components
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPickInjuryPart(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, BodyPartHead, PickInjuryPart(0))
+	assert.Equal(t, BodyPartTorso, PickInjuryPart(10))
+	assert.Equal(t, BodyPartArms, PickInjuryPart(50))
+	assert.Equal(t, BodyPartLegs, PickInjuryPart(InjuryPartsTotalWeight()-1))
+}
+
+func TestInjuryEffects(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, InjuryEffects(BodyPartArms, SeverityNone))
+	assert.Equal(t, []StatEffect{{Stat: StatDexterity, Value: -2}}, InjuryEffects(BodyPartArms, SeverityMinor), "腕の負傷は狙いを乱す")
+	assert.Equal(t, []StatEffect{{Stat: StatAgility, Value: -6}}, InjuryEffects(BodyPartLegs, SeveritySevere), "脚の負傷は鈍らせる")
+	assert.Nil(t, InjuryEffects(BodyPartWholeBody, SeveritySevere), "負傷を負わない部位は効果なし")
+}
+
+func TestHealthStatus_UpdateInjury(t *testing.T) {
+	t.Parallel()
+
+	hs := &HealthStatus{}
+	change := hs.UpdateInjury(BodyPartHead, 55)
+	assert.Equal(t, SeverityNone, change.Prev)
+	assert.Equal(t, SeverityMedium, change.Current)
+	assert.Equal(t, -4, hs.GetStatModifier(StatSensation))
+
+	hs.UpdateInjury(BodyPartHead, -55)
+	assert.Nil(t, hs.Parts[BodyPartHead].GetCondition(ConditionInjury), "深さが0になると消える")
+	assert.Equal(t, 0, hs.GetStatModifier(StatSensation))
+}
+
+func TestHealthStatus_Tend(t *testing.T) {
+	t.Parallel()
+
+	t.Run("深い負傷から治療量を配る", func(t *testing.T) {
+		t.Parallel()
+		hs := &HealthStatus{}
+		hs.UpdateInjury(BodyPartArms, 30)
+		hs.UpdateInjury(BodyPartLegs, 60)
+		hs.UpdateInjury(BodyPartHead, 10)
+
+		healed, used := hs.Tend(80)
+
+		assert.Equal(t, 1, healed, "脚だけを治しきる")
+		assert.InDelta(t, 80.0, used, 0.001)
+		assert.Nil(t, hs.Parts[BodyPartLegs].GetCondition(ConditionInjury))
+		arms := hs.Parts[BodyPartArms].GetCondition(ConditionInjury)
+		require.NotNil(t, arms)
+		assert.InDelta(t, 10.0, arms.Timer, 0.001, "残りの治療量で次に深い腕を浅くする")
+		assert.NotNil(t, hs.Parts[BodyPartHead].GetCondition(ConditionInjury), "治療量が尽きた負傷は持ち越す")
+	})
+
+	t.Run("治療量が余れば全部治して残りは使わない", func(t *testing.T) {
+		t.Parallel()
+		hs := &HealthStatus{}
+		hs.UpdateInjury(BodyPartTorso, 20)
+		hs.UpdateInjury(BodyPartArms, 15)
+
+		healed, used := hs.Tend(100)
+
+		assert.Equal(t, 2, healed)
+		assert.InDelta(t, 35.0, used, 0.001)
+		assert.False(t, hs.HasInjury())
+	})
+
+	t.Run("体温の状態には触れない", func(t *testing.T) {
+		t.Parallel()
+		hs := &HealthStatus{}
+		hs.Parts[BodyPartWholeBody].SetCondition(HealthCondition{Type: ConditionHypothermia, Timer: 30})
+
+		healed, used := hs.Tend(100)
+
+		assert.Equal(t, 0, healed)
+		assert.Zero(t, used)
+		assert.NotNil(t, hs.Parts[BodyPartWholeBody].GetCondition(ConditionHypothermia))
+	})
+}
@@ -83,6 +83,10 @@ const (
 	InteractionCubePanel InteractionKind = "CUBE_PANEL"
 	// InteractionAuction は通信販売の出荷場所。専用メニューを開いて積荷の出荷と状況確認をする
 	InteractionAuction InteractionKind = "AUCTION"
+	// InteractionPickLock は工具で錠を静かに解く相互作用。錠が掛かっている間だけ付く
+	InteractionPickLock InteractionKind = "PICK_LOCK"
+	// InteractionForceLock は工具で錠をこじ開ける相互作用。解錠より易しいが音を立てる
+	InteractionForceLock InteractionKind = "FORCE_LOCK"
 )
 
 // Config は種類に応じた相互作用設定を返す。未知の種類はゼロ値の無効な Config を返す。
@@ -96,7 +100,7 @@ func (k InteractionKind) Config() InteractionConfig {
 		return InteractionConfig{ActivationRange: ActivationRangeSameTile, ActivationWay: ActivationWayManual, MenuUnit: MenuUnitEntity}
 	case InteractionDoor, InteractionTalk, InteractionMelee, InteractionCubePanel:
 		return InteractionConfig{ActivationRange: ActivationRangeAdjacent, ActivationWay: ActivationWayOnCollision, MenuUnit: MenuUnitEntity}
-	case InteractionStorage, InteractionDisassemble, InteractionEnterCube, InteractionPullCube, InteractionAuction:
+	case InteractionStorage, InteractionDisassemble, InteractionEnterCube, InteractionPullCube, InteractionAuction, InteractionPickLock, InteractionForceLock:
 		return InteractionConfig{ActivationRange: ActivationRangeAdjacent, ActivationWay: ActivationWayManual, MenuUnit: MenuUnitEntity}
 	case InteractionExitCube:
 		return InteractionConfig{ActivationRange: ActivationRangeSameTile, ActivationWay: ActivationWayManual, MenuUnit: MenuUnitEntity}
@@ -151,7 +151,7 @@ func TestInteractionKind_ConfigConsistency(t *testing.T) {
 		InteractionDoor, InteractionTalk, InteractionItem, InteractionItemAll,
 		InteractionStorage, InteractionMelee, InteractionDisassemble,
 		InteractionEnterCube, InteractionExitCube, InteractionPullCube, InteractionCubePanel,
-		InteractionAuction,
+		InteractionAuction, InteractionPickLock, InteractionForceLock,
 	}
 
 	for _, kind := range kinds {
@@ -0,0 +1,123 @@
+package components
+
+import (
+	"fmt"
+
+	"github.com/kijimaD/ruins/internal/consts"
+)
+
+// SettlementKind は商人が店を構える集落の種類。品揃えと値付けが変わる
+type SettlementKind int
+
+// 集落の種類
+const (
+	SettlementTown    SettlementKind = iota // 街。品揃えが広く値付けも素直
+	SettlementVillage                       // 村。生活用品が中心でやや割高
+	SettlementHamlet                        // 一軒家。行商の拠点で品数が少なく、買いは高く売りは安い
+)
+
+// String は集落の種類名を返す
+func (k SettlementKind) String() string {
+	switch k {
+	case SettlementTown:
+		return "Town"
+	case SettlementVillage:
+		return "Village"
+	case SettlementHamlet:
+		return "Hamlet"
+	}
+	panic(fmt.Sprintf("unknown SettlementKind: %d", k))
+}
+
+// BuyPricePercent はプレイヤーが買うときの集落による倍率を返す
+func (k SettlementKind) BuyPricePercent() consts.Percent {
+	switch k {
+	case SettlementTown:
+		return consts.PercentBase
+	case SettlementVillage:
+		return 110
+	case SettlementHamlet:
+		return 125
+	}
+	panic(fmt.Sprintf("unknown SettlementKind: %d", k))
+}
+
+// SellPricePercent はプレイヤーが売るときの集落による倍率を返す
+func (k SettlementKind) SellPricePercent() consts.Percent {
+	switch k {
+	case SettlementTown:
+		return consts.PercentBase
+	case SettlementVillage:
+		return 90
+	case SettlementHamlet:
+		return 80
+	}
+	panic(fmt.Sprintf("unknown SettlementKind: %d", k))
+}
+
+// 供給量による値動きの調整値
+const (
+	// SupplyPriceStep は供給量1あたりの値動き。品余りで下がり、品薄で上がる
+	SupplyPriceStep consts.Percent = 5
+	// SupplyPriceMin と SupplyPriceMax は値動きの下限と上限。売り込み続けても値が0にならない
+	SupplyPriceMin consts.Percent = 50
+	SupplyPriceMax consts.Percent = 150
+	// SupplyRecoveryPerDay は1日で供給量が平常の0へ戻る量
+	SupplyRecoveryPerDay = 2
+)
+
+// Merchant は商人の商売の状態。在庫そのものは商人所有の LocationInStorage で持ち、
+// ここには値付けと入荷に要る状態だけを置く
+type Merchant struct {
+	// Settlement は店を構える集落の種類
+	Settlement SettlementKind
+	// Supply は RawID ごとの供給量。0 が平常で、正は品余り、負は品薄を表す。
+	// プレイヤーが売ると増え、買うと減る。平常の品は持たない
+	Supply map[string]int
+	// RestockedDay は最後に入荷した日。GameTime.GetDayNumber と同じ数え方
+	RestockedDay int
+}
+
+// NewMerchant は day に入荷を済ませた商人の状態を作る
+func NewMerchant(settlement SettlementKind, day int) *Merchant {
+	return &Merchant{
+		Settlement:   settlement,
+		Supply:       map[string]int{},
+		RestockedDay: day,
+	}
+}
+
+// SupplyPricePercent は rawID の供給量による値動きの倍率を返す。等倍で平常
+func (m *Merchant) SupplyPricePercent(rawID string) consts.Percent {
+	p := consts.PercentBase - SupplyPriceStep*consts.Percent(m.Supply[rawID])
+	return max(SupplyPriceMin, min(SupplyPriceMax, p))
+}
+
+// AddSupply は rawID の供給量を delta だけ変える。平常に戻った品は消す
+func (m *Merchant) AddSupply(rawID string, delta int) {
+	if m.Supply == nil {
+		m.Supply = map[string]int{}
+	}
+	m.Supply[rawID] += delta
+	if m.Supply[rawID] == 0 {
+		delete(m.Supply, rawID)
+	}
+}
+
+// RecoverSupply は days 日ぶん供給量を平常の0へ近づける。1日に SupplyRecoveryPerDay ずつ戻る
+func (m *Merchant) RecoverSupply(days int) {
+	if days <= 0 {
+		return
+	}
+	step := days * SupplyRecoveryPerDay
+	for id, supply := range m.Supply {
+		switch {
+		case supply > step:
+			m.Supply[id] = supply - step
+		case supply < -step:
+			m.Supply[id] = supply + step
+		default:
+			delete(m.Supply, id)
+		}
+	}
+}
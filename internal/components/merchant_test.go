@@ -0,0 +1,55 @@
+package components
+
+import (
+	"testing"
+
+	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMerchant_SupplyPricePercent(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		supply int
+		want   consts.Percent
+	}{
+		{"平常は等倍", 0, 100},
+		{"品余りで値下がり", 2, 90},
+		{"品薄で値上がり", -2, 110},
+		{"下限で止まる", 50, SupplyPriceMin},
+		{"上限で止まる", -50, SupplyPriceMax},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := NewMerchant(SettlementTown, 1)
+			m.AddSupply("bread", tt.supply)
+			assert.Equal(t, tt.want, m.SupplyPricePercent("bread"))
+		})
+	}
+}
+
+func TestMerchant_AddSupply_平常に戻った品は消える(t *testing.T) {
+	t.Parallel()
+	m := NewMerchant(SettlementTown, 1)
+	m.AddSupply("bread", 3)
+	m.AddSupply("bread", -3)
+	assert.NotContains(t, m.Supply, "bread")
+}
+
+func TestMerchant_RecoverSupply(t *testing.T) {
+	t.Parallel()
+	m := NewMerchant(SettlementTown, 1)
+	m.AddSupply("surplus", 5)
+	m.AddSupply("scarce", -5)
+	m.AddSupply("small", 1)
+
+	m.RecoverSupply(1)
+	assert.Equal(t, 5-SupplyRecoveryPerDay, m.Supply["surplus"])
+	assert.Equal(t, -5+SupplyRecoveryPerDay, m.Supply["scarce"])
+	assert.NotContains(t, m.Supply, "small", "戻り幅を下回る偏りは平常になる")
+
+	m.RecoverSupply(10)
+	assert.Empty(t, m.Supply)
+}
@@ -224,14 +224,15 @@ func RecalculateCharModifiers(skills *Skills, abils *Abilities, hs *HealthStatus
 
 	// 健康状態によるペナルティ
 	if hs != nil {
-		wb := &hs.Parts[BodyPartWholeBody]
-		for _, cond := range wb.Conditions {
-			if penalty := temperatureMovePenalty(cond.Severity); penalty != 0 {
-				e.MoveCost += consts.Percent(penalty)
-				src[ModMoveCost] = append(src[ModMoveCost], ModifierSource{
-					Label: ConditionTypeDisplayName(cond.Type),
-					Value: penalty,
-				})
+		for _, part := range []BodyPart{BodyPartWholeBody, BodyPartLegs} {
+			for _, cond := range hs.Parts[part].Conditions {
+				if penalty := conditionMovePenalty(part, cond); penalty != 0 {
+					e.MoveCost += consts.Percent(penalty)
+					src[ModMoveCost] = append(src[ModMoveCost], ModifierSource{
+						Label: ConditionTypeDisplayName(cond.Type),
+						Value: penalty,
+					})
+				}
 			}
 		}
 	}
@@ -240,6 +241,22 @@ func RecalculateCharModifiers(skills *Skills, abils *Abilities, hs *HealthStatus
 	return e
 }
 
+// conditionMovePenalty は部位の状態による移動コスト増加量を返す。
+// 足取りが重くなるのは体温異常・凍結と脚の負傷だけで、他の状態は能力値で効く
+func conditionMovePenalty(part BodyPart, cond HealthCondition) int {
+	switch cond.Type {
+	case ConditionHypothermia, ConditionHyperthermia, ConditionFrozen:
+		return temperatureMovePenalty(cond.Severity)
+	case ConditionInjury:
+		if part != BodyPartLegs {
+			return 0
+		}
+		return temperatureMovePenalty(cond.Severity)
+	default:
+		return 0
+	}
+}
+
 // temperatureMovePenalty は体温異常の重症度に応じた移動コスト増加量を返す
 func temperatureMovePenalty(severity Severity) int {
 	switch severity {
@@ -144,6 +144,32 @@ func TestRecalculateCharModifiers_HealthPenalty(t *testing.T) {
 	assert.True(t, found, "MoveCostのSourcesに低体温が含まれる")
 }
 
+func TestRecalculateCharModifiers_凍結だけが移動コストを増やす(t *testing.T) {
+	t.Parallel()
+
+	skills := NewSkills()
+	hs := &HealthStatus{}
+	hs.Parts[BodyPartWholeBody].SetCondition(HealthCondition{Type: ConditionFrozen, Severity: SeverityMinor})
+	hs.Parts[BodyPartWholeBody].SetCondition(HealthCondition{Type: ConditionBurning, Severity: SeveritySevere})
+
+	mods := RecalculateCharModifiers(skills, nil, hs)
+
+	assert.Equal(t, 110, int(mods.MoveCost), "炎上は移動コストに影響しない")
+}
+
+func TestRecalculateCharModifiers_脚の負傷だけが移動コストを増やす(t *testing.T) {
+	t.Parallel()
+
+	skills := NewSkills()
+	hs := &HealthStatus{}
+	hs.UpdateInjury(BodyPartLegs, 55)
+	hs.UpdateInjury(BodyPartArms, 80)
+
+	mods := RecalculateCharModifiers(skills, nil, hs)
+
+	assert.Equal(t, 120, int(mods.MoveCost), "中度の脚の負傷だけが効く")
+}
+
 func TestTemperatureMovePenalty(t *testing.T) {
 	t.Parallel()
 
@@ -3,12 +3,51 @@ package components
 import "github.com/kijimaD/ruins/internal/consts"
 
 // Perishable は腐敗する食料が持つ。累積した劣化量から鮮度段階を求める。
-// 劣化は時間経過で進み、速度は置き場所で変わりうる。速度の適用と実効量の算出は
-// query 層が担い、ここは劣化量から段階を求める段階付けだけを持つ。
+// 劣化は時間経過で進み、速度は置き場所の気温で変わる。速度が変わるたびにそれまでの劣化を
+// RotAccrued へ畳み込み、以後は RotRate で進める。実効量の算出は query 層が担う。
 type Perishable struct {
-	RotAccrued     consts.Turn // 累積した劣化量。0 が生成直後
-	StageLength    consts.Turn // 1段階の長さ。新鮮 [0,SL) 劣化 [SL,2SL) 腐敗 [2SL,)
-	RotUpdatedTurn consts.Turn // RotAccrued を最後に前進させた GameTime.TotalTurns
+	RotAccrued     consts.Turn    // 累積した劣化量。0 が生成直後
+	StageLength    consts.Turn    // 1段階の長さ。新鮮 [0,SL) 劣化 [SL,2SL) 腐敗 [2SL,)
+	RotUpdatedTurn consts.Turn    // RotAccrued を最後に前進させた GameTime.TotalTurns
+	RotRate        consts.Percent // RotUpdatedTurn 以降の劣化速度。0 は未算出で等倍として扱う
+}
+
+// Rate は RotUpdatedTurn 以降に効いている劣化速度を返す。
+// 生成直後やこのフィールドを持たない古いセーブは 0 なので等倍とみなす
+func (p Perishable) Rate() consts.Percent {
+	if p.RotRate == 0 {
+		return consts.PercentBase
+	}
+	return p.RotRate
+}
+
+// StorageTemperature は中身の気温を一定に保つ収納が持つ。
+// 保冷箱の中の食料は置き場所の気温でなくこの気温で劣化する
+type StorageTemperature struct {
+	Celsius int
+}
+
+// PerishRateAt は気温から劣化速度を返す。20度前後で等倍、冷えるほど遅く、暑いほど速い。
+// 氷点下では凍ってほとんど進まないが、0 は未算出と区別できないので最小値を残す
+func PerishRateAt(celsius int) consts.Percent {
+	switch {
+	case celsius <= 0:
+		return 10
+	case celsius <= 5:
+		return 35
+	case celsius <= 10:
+		return 50
+	case celsius <= 15:
+		return 70
+	case celsius <= 22:
+		return consts.PercentBase
+	case celsius <= 28:
+		return 140
+	case celsius <= 34:
+		return 200
+	default:
+		return 280
+	}
 }
 
 // FreshnessStage は鮮度の段階
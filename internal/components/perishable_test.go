@@ -54,3 +54,22 @@ func TestFreshnessStage_未知の段階はpanicする(t *testing.T) {
 	assert.Panics(t, func() { FreshnessStage("bogus").Rank() })
 	assert.Panics(t, func() { FreshnessStage("bogus").Label() })
 }
+
+func TestPerishable_Rate(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, consts.PercentBase, Perishable{}.Rate(), "未算出は等倍とみなす")
+	assert.Equal(t, consts.Percent(35), Perishable{RotRate: 35}.Rate())
+}
+
+func TestPerishRateAt(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, consts.PercentBase, PerishRateAt(20), "20度前後は等倍")
+	assert.Less(t, PerishRateAt(3), PerishRateAt(20), "冷えるほど遅い")
+	assert.Greater(t, PerishRateAt(32), PerishRateAt(20), "暑いほど速い")
+	assert.Positive(t, PerishRateAt(-20), "凍っても0にはしない")
+
+	// 気温に対して単調非減少
+	for c := -30; c < 50; c++ {
+		assert.LessOrEqual(t, PerishRateAt(c), PerishRateAt(c+1), "%d度と%d度", c, c+1)
+	}
+}
@@ -0,0 +1,49 @@
+package components
+
+// QuestStatus はクエストの進み具合
+type QuestStatus string
+
+const (
+	// QuestStatusActive は受けて進行中のクエスト
+	QuestStatusActive = QuestStatus("active")
+	// QuestStatusCompleted は目標をすべて果たして報酬を受け取ったクエスト
+	QuestStatusCompleted = QuestStatus("completed")
+)
+
+// QuestProgress は受けたクエスト1つの進み具合
+type QuestProgress struct {
+	ID       string      `json:"id"`        // ローデータの quests の id
+	Status   QuestStatus `json:"status"`    // 進行中か達成済みか
+	Progress []int       `json:"progress"`  // 目標ごとの達成量。ローデータの objectives と同じ添字で並べる
+	StartDay int         `json:"start_day"` // 受けた日の日数。生存目標の起点にする
+}
+
+// QuestLog は受けたクエストを受けた順に保持するシングルトン。serde 保存する
+type QuestLog struct {
+	Quests []QuestProgress `json:"quests"`
+}
+
+// Find は id のクエストの進み具合を返す。受けていなければ nil
+func (ql *QuestLog) Find(id string) *QuestProgress {
+	for i := range ql.Quests {
+		if ql.Quests[i].ID == id {
+			return &ql.Quests[i]
+		}
+	}
+	return nil
+}
+
+// Start は id のクエストを objectives 個の目標を持つ進行中のクエストとして受ける。
+// すでに受けていれば何もせず false を返す
+func (ql *QuestLog) Start(id string, objectives int, day int) bool {
+	if ql.Find(id) != nil {
+		return false
+	}
+	ql.Quests = append(ql.Quests, QuestProgress{
+		ID:       id,
+		Status:   QuestStatusActive,
+		Progress: make([]int, objectives),
+		StartDay: day,
+	})
+	return true
+}
@@ -0,0 +1,30 @@
+package components
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuestLog_受けたクエストを受けた順に持つ(t *testing.T) {
+	t.Parallel()
+
+	ql := &QuestLog{}
+	assert.Nil(t, ql.Find("rat_hunt"))
+
+	require.True(t, ql.Start("rat_hunt", 2, 3))
+	require.True(t, ql.Start("delivery", 1, 4))
+	assert.False(t, ql.Start("rat_hunt", 2, 5), "受けたクエストは受け直さない")
+
+	q := ql.Find("rat_hunt")
+	require.NotNil(t, q)
+	assert.Equal(t, QuestStatusActive, q.Status)
+	assert.Equal(t, []int{0, 0}, q.Progress)
+	assert.Equal(t, 3, q.StartDay)
+	assert.Equal(t, "delivery", ql.Quests[1].ID)
+
+	// Find はログの中身を指すので、書き換えが残る
+	q.Progress[0] = 1
+	assert.Equal(t, 1, ql.Find("rat_hunt").Progress[0])
+}
@@ -1,6 +1,10 @@
 package components
 
-import "github.com/kijimaD/ruins/internal/consts"
+import (
+	"strings"
+
+	"github.com/kijimaD/ruins/internal/consts"
+)
 
 // RunStats は run を通じて貯める統計を保持するシングルトン。run 中ずっと存在し serde 保存する。
 // 撃破・漁り・売上を積み上げ、決着時に死因を記録する。結果画面と道中の統計画面が読む。
@@ -9,5 +13,38 @@ type RunStats struct {
 	EnemiesKilled  int             // 倒した敵の数
 	ItemsScavenged int             // 漁ったアイテム数
 	SalesTotal     consts.Currency // 売上累計
-	Cause          string          // 死因。決着時に記録する。区別する値の集合が要るまで素の文字列
+	Cause          DeathCause      // 死因。プレイヤーが倒れたときに記録する
+	Demo           bool            // デモで始めた run。解放の条件を飛ばして始めるので、死の記録もプロフィールへの記録も残さない
+}
+
+// DeathCause は死因の内訳。致命傷を与えた相手と手段、そのとき患っていた状態を持つ。
+// 名前はどれも訳す前の英語原文で持ち、表示する側が現在言語へ訳す。分からない項目は空のまま残す
+type DeathCause struct {
+	Killer    string      // 倒した相手の名前。自傷や状態による死では空
+	Weapon    string      // 致命傷の攻撃手段。武器名や素手、投げた物の名前
+	Element   ElementType // 致命傷の元素。無属性は空
+	Condition string      // 倒れたときに患っていた状態。低体温・炎上・飢餓など
+}
+
+// IsZero は死因が記録されていないかを返す
+func (c DeathCause) IsZero() bool {
+	return c == DeathCause{}
+}
+
+// String は死因を英語の1行にまとめる。自動プレイの結果など訳さない出力に使う
+func (c DeathCause) String() string {
+	var parts []string
+	if c.Killer != "" {
+		parts = append(parts, "killed by "+c.Killer)
+	}
+	if c.Weapon != "" {
+		parts = append(parts, "with "+c.Weapon)
+	}
+	if c.Element != "" && c.Element != ElementTypeNone {
+		parts = append(parts, "("+c.Element.String()+")")
+	}
+	if c.Condition != "" {
+		parts = append(parts, "while "+c.Condition)
+	}
+	return strings.Join(parts, " ")
 }
@@ -0,0 +1,23 @@
+package components
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeathCause_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "", DeathCause{}.String())
+	assert.Equal(t, "killed by Bat with Fangs (Fire) while Starving",
+		DeathCause{Killer: "Bat", Weapon: "Fangs", Element: ElementTypeFire, Condition: "Starving"}.String())
+	assert.Equal(t, "with Fangs", DeathCause{Weapon: "Fangs", Element: ElementTypeNone}.String(), "無属性は書かない")
+}
+
+func TestDeathCause_IsZero(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, DeathCause{}.IsZero())
+	assert.False(t, DeathCause{Condition: "Starving"}.IsZero())
+}
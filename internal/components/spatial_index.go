@@ -13,6 +13,9 @@ type SpatialIndex struct {
 	MapWidth, MapHeight consts.Tile
 	// 静的障害物の位置。壁やドアなどBlockPassコンポーネントを持つ固定物が対象
 	BlockPass map[GridElement]bool
+	// 視線を遮るタイルの密なビット集合。壁や閉じたドアなどBlockViewコンポーネントを持つ物が対象。
+	// 視界・光源・AIの視線判定がタイルごとに引くので、map でなくマップ寸法のビット列で持つ
+	BlockView BitGrid
 	// キャラクター位置のインデックス。プレイヤー・敵・中立NPCの位置
 	Characters map[GridElement]ecs.Entity
 	// プレイヤーエンティティのキャッシュ。プレイヤーが存在しない場合はnil
@@ -38,6 +41,35 @@ func (si *SpatialIndex) IsBlockPass(pos consts.Coord[consts.Tile]) bool {
 	return si.BlockPass[GridElement{Coord: pos}]
 }
 
+// IsBlockView は指定タイルが視線を遮るかをO(1)で判定する。
+// 未構築の場合とマップ外はfalseを返す
+func (si *SpatialIndex) IsBlockView(pos consts.Coord[consts.Tile]) bool {
+	if !si.Built {
+		return false
+	}
+	return si.BlockView.Has(pos)
+}
+
+// IsOpaque は IsBlockView を int 座標で引く。geometry.OpaqueFunc としてシャドウキャストへ渡す
+func (si *SpatialIndex) IsOpaque(pos consts.Coord[int]) bool {
+	return si.IsBlockView(consts.Coord[consts.Tile]{X: consts.Tile(pos.X), Y: consts.Tile(pos.Y)})
+}
+
+// SetBlocker は指定タイルの通行・視線の遮蔽を増分更新する。
+// ドアの開閉や物の移動で全再構築せずに済ませる。未構築の場合は何もしない
+func (si *SpatialIndex) SetBlocker(pos consts.Coord[consts.Tile], blockPass, blockView bool) {
+	if !si.Built {
+		return
+	}
+	key := GridElement{Coord: pos}
+	if blockPass {
+		si.BlockPass[key] = true
+	} else {
+		delete(si.BlockPass, key)
+	}
+	si.BlockView.Set(pos, blockView)
+}
+
 // CharacterAt は指定タイルのキャラクターを返す
 func (si *SpatialIndex) CharacterAt(pos consts.Coord[consts.Tile]) (ecs.Entity, bool) {
 	entity, ok := si.Characters[GridElement{Coord: pos}]
@@ -64,6 +96,48 @@ func (si *SpatialIndex) MoveCharacter(from, to consts.Coord[consts.Tile], e ecs.
 func (si *SpatialIndex) Invalidate() {
 	si.Built = false
 	si.BlockPass = nil
+	si.BlockView = BitGrid{}
 	si.Characters = nil
 	si.PlayerEntity = nil
 }
+
+// BitGrid はマップ寸法の密なビット集合。タイルごとの真偽を map より省メモリかつ高速に引く
+type BitGrid struct {
+	Width, Height consts.Tile
+	bits          []uint64
+}
+
+// NewBitGrid は全ビットが偽の BitGrid を作成する
+func NewBitGrid(width, height consts.Tile) BitGrid {
+	n := max(int(width)*int(height), 0)
+	return BitGrid{Width: width, Height: height, bits: make([]uint64, (n+63)/64)}
+}
+
+// Has は指定タイルのビットを返す。マップ外はfalse
+func (g *BitGrid) Has(pos consts.Coord[consts.Tile]) bool {
+	i, ok := g.index(pos)
+	if !ok {
+		return false
+	}
+	return g.bits[i/64]&(1<<(i%64)) != 0
+}
+
+// Set は指定タイルのビットを設定する。マップ外は無視する
+func (g *BitGrid) Set(pos consts.Coord[consts.Tile], v bool) {
+	i, ok := g.index(pos)
+	if !ok {
+		return
+	}
+	if v {
+		g.bits[i/64] |= 1 << (i % 64)
+	} else {
+		g.bits[i/64] &^= 1 << (i % 64)
+	}
+}
+
+func (g *BitGrid) index(pos consts.Coord[consts.Tile]) (int, bool) {
+	if pos.X < 0 || pos.Y < 0 || pos.X >= g.Width || pos.Y >= g.Height {
+		return 0, false
+	}
+	return int(pos.Y)*int(g.Width) + int(pos.X), true
+}
@@ -7,6 +7,9 @@ type VisionState struct {
 	VisibleTiles map[GridElement]bool
 	// LightSourceCache は視界内タイルの光源情報。視界更新のたびに再構築される
 	LightSourceCache map[GridElement]LightInfo
+	// PlayerDarkness はプレイヤーの足元の暗さ。0が明るく1が真っ暗。暗いほど AI に見つかりにくい。
+	// 視界の閾値に関わらず足元は必ず計算する。視界計算の前は0で、明るいものとして扱う
+	PlayerDarkness float64
 	// pendingUpdate は次フレームで視界を再計算するか。遮蔽が変わる操作も変わらない操作も
 	// 一律に再計算を要求する。更新の強さを段階に分けず常に作り直すことで、更新種別の取り違えで
 	// 古い遮蔽が残り幽霊影が出る不具合を構造的に無くす。RequestUpdate で上げ ConsumePendingUpdate
@@ -0,0 +1,159 @@
+package components
+
+import (
+	"fmt"
+
+	"github.com/kijimaD/ruins/internal/consts"
+)
+
+// Weather は地上の天候を表す
+type Weather int
+
+// 天候定数。並びは weatherWeights の抽選順を兼ねる
+const (
+	WeatherClear        Weather = iota // 晴れ
+	WeatherOvercast                    // 曇り
+	WeatherRain                        // 雨
+	WeatherThunderstorm                // 雷雨
+	WeatherSnow                        // 雪
+	WeatherFog                         // 霧
+)
+
+// String は天候名を返す
+func (wt Weather) String() string {
+	switch wt {
+	case WeatherClear:
+		return "Clear"
+	case WeatherOvercast:
+		return "Overcast"
+	case WeatherRain:
+		return "Rain"
+	case WeatherThunderstorm:
+		return "Thunderstorm"
+	case WeatherSnow:
+		return "Snow"
+	case WeatherFog:
+		return "Fog"
+	}
+	panic(fmt.Sprintf("unknown Weather: %d", wt))
+}
+
+// WeatherPeriodTurns は天候が続くターン数。時間帯の区切りと揃え、区切りごとに天候が変わり得る
+const WeatherPeriodTurns = turnsPerTimeOfDay
+
+// weatherWeights は天候ごとの出やすさ。添字は Weather の値
+var weatherWeights = [...]int{
+	WeatherClear:        36,
+	WeatherOvercast:     24,
+	WeatherRain:         14,
+	WeatherThunderstorm: 6,
+	WeatherSnow:         10,
+	WeatherFog:          10,
+}
+
+// WeatherAt は runSeed と経過ターンからその時点の天候を返す。
+// 天候は WeatherPeriodTurns ごとの期間単位で決まり、同じ runSeed と期間なら常に同じ天候になる。
+// セーブに天候を持たなくても、ロードや予報で同じ結果を再現できる
+func WeatherAt(runSeed uint64, turn consts.Turn) Weather {
+	return weatherOfPeriod(runSeed, uint64(turn/WeatherPeriodTurns))
+}
+
+// weatherOfPeriod は期間の添字から天候を抽選する。
+// 添字を大きな奇数で混ぜてから splitmix64 の finalizer で撹拌し、隣り合う期間を無相関にする
+func weatherOfPeriod(runSeed, period uint64) Weather {
+	x := runSeed + period*0x9E3779B97F4A7C15
+	x ^= x >> 30
+	x *= 0xBF58476D1CE4E5B9
+	x ^= x >> 27
+	x *= 0x94D049BB133111EB
+	x ^= x >> 31
+
+	total := 0
+	for _, weight := range weatherWeights {
+		total += weight
+	}
+	roll := int(x % uint64(total))
+	for i, weight := range weatherWeights {
+		if roll < weight {
+			return Weather(i)
+		}
+		roll -= weight
+	}
+	return WeatherClear
+}
+
+// WeatherPeriod は予報の1区切り。Start ターンから WeatherPeriodTurns のあいだ Weather が続く
+type WeatherPeriod struct {
+	Start   consts.Turn
+	Weather Weather
+}
+
+// WeatherForecast は turn を含む期間から count 期間ぶんの天候を返す。先頭は現在の天候
+func WeatherForecast(runSeed uint64, turn consts.Turn, count int) []WeatherPeriod {
+	first := turn / WeatherPeriodTurns
+	periods := make([]WeatherPeriod, 0, count)
+	for i := range count {
+		period := first + consts.Turn(i)
+		periods = append(periods, WeatherPeriod{
+			Start:   period * WeatherPeriodTurns,
+			Weather: weatherOfPeriod(runSeed, uint64(period)),
+		})
+	}
+	return periods
+}
+
+// TemperatureModifier は天候による気温修正値を返す。
+// default を置かず全 case を列挙する。天候を足したら exhaustive linter がここの漏れを検知する。
+func (wt Weather) TemperatureModifier() int {
+	switch wt {
+	case WeatherClear:
+		return 0
+	case WeatherOvercast:
+		return -2
+	case WeatherRain:
+		return -4
+	case WeatherThunderstorm:
+		return -6
+	case WeatherSnow:
+		return -12
+	case WeatherFog:
+		return -2
+	}
+	panic(fmt.Sprintf("unknown Weather: %d", wt))
+}
+
+// VisionRadiusPercent は天候による視界半径の倍率を返す。等倍で晴天と同じ
+func (wt Weather) VisionRadiusPercent() consts.Percent {
+	switch wt {
+	case WeatherClear, WeatherOvercast:
+		return consts.PercentBase
+	case WeatherRain:
+		return 75
+	case WeatherThunderstorm:
+		return 60
+	case WeatherSnow:
+		return 60
+	case WeatherFog:
+		return 30
+	}
+	panic(fmt.Sprintf("unknown Weather: %d", wt))
+}
+
+// DaylightFactor は天候が日照を遮る割合を返す。地上の環境光に掛ける。1.0で晴天と同じ
+func (wt Weather) DaylightFactor() float64 {
+	switch wt {
+	case WeatherClear:
+		return 1.0
+	case WeatherOvercast:
+		return 0.8
+	case WeatherRain:
+		return 0.7
+	case WeatherThunderstorm:
+		return 0.55
+	case WeatherSnow:
+		return 0.8
+	case WeatherFog:
+		return 0.75
+	}
+	panic(fmt.Sprintf("unknown Weather: %d", wt))
+}
@@ -0,0 +1,83 @@
+package components
+
+import (
+	"testing"
+
+	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeatherAt_決定的(t *testing.T) {
+	t.Parallel()
+
+	for turn := consts.Turn(0); turn < 20*WeatherPeriodTurns; turn += 37 {
+		assert.Equal(t, WeatherAt(42, turn), WeatherAt(42, turn), "同じ入力は同じ天候を返す")
+	}
+}
+
+func TestWeatherAt_期間内は同じ天候(t *testing.T) {
+	t.Parallel()
+
+	for period := consts.Turn(0); period < 20; period++ {
+		start := period * WeatherPeriodTurns
+		want := WeatherAt(7, start)
+		assert.Equal(t, want, WeatherAt(7, start+WeatherPeriodTurns/2))
+		assert.Equal(t, want, WeatherAt(7, start+WeatherPeriodTurns-1))
+	}
+}
+
+func TestWeatherAt_全天候が出る(t *testing.T) {
+	t.Parallel()
+
+	seen := map[Weather]bool{}
+	for period := consts.Turn(0); period < 500; period++ {
+		seen[WeatherAt(1, period*WeatherPeriodTurns)] = true
+	}
+	for i := range weatherWeights {
+		assert.True(t, seen[Weather(i)], "%s が一度も出ない", Weather(i))
+	}
+}
+
+func TestWeatherAt_シードで変わる(t *testing.T) {
+	t.Parallel()
+
+	differs := false
+	for period := consts.Turn(0); period < 20; period++ {
+		if WeatherAt(1, period*WeatherPeriodTurns) != WeatherAt(2, period*WeatherPeriodTurns) {
+			differs = true
+			break
+		}
+	}
+	assert.True(t, differs, "別の runSeed では別の天候の並びになる")
+}
+
+func TestWeatherForecast(t *testing.T) {
+	t.Parallel()
+
+	turn := 3*WeatherPeriodTurns + 10
+	forecast := WeatherForecast(99, turn, 4)
+	require.Len(t, forecast, 4)
+	for i, p := range forecast {
+		assert.Equal(t, (3+consts.Turn(i))*WeatherPeriodTurns, p.Start, "期間の開始ターン")
+		assert.Equal(t, WeatherAt(99, p.Start), p.Weather, "予報は WeatherAt と一致する")
+	}
+	assert.Equal(t, WeatherAt(99, turn), forecast[0].Weather, "先頭は現在の天候")
+}
+
+func TestWeather_修正値(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 0, WeatherClear.TemperatureModifier(), "晴れは気温を変えない")
+	assert.Equal(t, consts.PercentBase, WeatherClear.VisionRadiusPercent(), "晴れは視界を縮めない")
+	assert.InDelta(t, 1.0, WeatherClear.DaylightFactor(), 1e-9, "晴れは日照を遮らない")
+
+	for i := range weatherWeights {
+		wt := Weather(i)
+		assert.LessOrEqual(t, wt.TemperatureModifier(), 0, "%s は気温を上げない", wt)
+		assert.LessOrEqual(t, wt.VisionRadiusPercent(), consts.PercentBase, "%s は視界を広げない", wt)
+		assert.LessOrEqual(t, wt.DaylightFactor(), 1.0, "%s は日照を増やさない", wt)
+	}
+	assert.Less(t, WeatherSnow.TemperatureModifier(), WeatherRain.TemperatureModifier(), "雪は雨より冷える")
+	assert.Less(t, WeatherFog.VisionRadiusPercent(), WeatherRain.VisionRadiusPercent(), "霧は雨より見通せない")
+}
@@ -72,6 +72,9 @@ type Config struct {
 	ProfileTrace bool `env:"RUINS_PROFILE_TRACE"`
 	// プロファイル出力先のディレクトリパス
 	ProfilePath string `env:"RUINS_PROFILE_PATH"`
+
+	// MODのローデータを置いたディレクトリ。指定すると本体の定義に重ねて読み込む
+	ModDir string `env:"RUINS_MOD_DIR"`
 }
 
 // UserConfig はプレイヤーが変更してファイルに永続化するユーザー設定を表す。
@@ -84,6 +87,12 @@ type UserConfig struct {
 	WindowHeight int `env:"RUINS_WINDOW_HEIGHT" toml:"window_height"`
 	// 表示言語の言語コード。"ja" / "en"
 	Language string `env:"RUINS_LANGUAGE" toml:"language"`
+	// 全体の音量（%）。曲と効果音の両方に掛かる
+	MasterVolume int `env:"RUINS_MASTER_VOLUME" toml:"master_volume"`
+	// 曲の音量（%）
+	MusicVolume int `env:"RUINS_MUSIC_VOLUME" toml:"music_volume"`
+	// 効果音の音量（%）
+	SEVolume int `env:"RUINS_SE_VOLUME" toml:"se_volume"`
 }
 
 // DefaultUserConfig はユーザー設定のデフォルト値を返す。
@@ -93,6 +102,9 @@ func DefaultUserConfig() UserConfig {
 		WindowWidth:  960,
 		WindowHeight: 720,
 		Language:     "en",
+		MasterVolume: 80,
+		MusicVolume:  70,
+		SEVolume:     80,
 	}
 }
 
@@ -243,6 +255,7 @@ var (
 	errTargetFPSInvalid     = errors.New("invalid target FPS")
 	errPProfPortOutOfRange  = errors.New("pprof port out of range")
 	errUnsupportedLanguage  = errors.New("unsupported language")
+	errVolumeOutOfRange     = errors.New("volume out of range")
 )
 
 // Validate は設定値が妥当な範囲にあるか検証する。
@@ -257,6 +270,18 @@ func (c *Config) Validate() error {
 	if !i18n.IsSupportedLang(c.User.Language) {
 		return fmt.Errorf("%w: %q", errUnsupportedLanguage, c.User.Language)
 	}
+	for _, v := range []struct {
+		name  string
+		value int
+	}{
+		{"master", c.User.MasterVolume},
+		{"music", c.User.MusicVolume},
+		{"se", c.User.SEVolume},
+	} {
+		if v.value < 0 || v.value > 100 {
+			return fmt.Errorf("%w: %s %d, range 0 to 100", errVolumeOutOfRange, v.name, v.value)
+		}
+	}
 	if c.TargetFPS < 1 {
 		return fmt.Errorf("%w: %d, at least 1", errTargetFPSInvalid, c.TargetFPS)
 	}
@@ -57,6 +57,7 @@ func TestConfig_StringGolden(t *testing.T) {
 		TargetFPS:   30,
 		PProfPort:   6060,
 		ProfilePath: ".",
+		ModDir:      "mods",
 	}
 
 	goldie.New(t).Assert(t, "config_string", []byte(c.String()))
@@ -110,6 +111,9 @@ func TestValidate(t *testing.T) {
 			{"pprofポートが上限超過", func(c *Config) { c.PProfPort = 70000 }, errPProfPortOutOfRange},
 			{"未対応の言語", func(c *Config) { c.User.Language = "zh" }, errUnsupportedLanguage},
 			{"言語が空", func(c *Config) { c.User.Language = "" }, errUnsupportedLanguage},
+			{"全体音量が負", func(c *Config) { c.User.MasterVolume = -1 }, errVolumeOutOfRange},
+			{"曲の音量が上限超過", func(c *Config) { c.User.MusicVolume = 101 }, errVolumeOutOfRange},
+			{"効果音の音量が上限超過", func(c *Config) { c.User.SEVolume = 150 }, errVolumeOutOfRange},
 		}
 		for _, tc := range cases {
 			t.Run(tc.name, func(t *testing.T) {
@@ -6,8 +6,8 @@
 //     フラグ、プロファイリング設定など。github.com/caarlos0/env/v11 で
 //     環境変数から読み込む。永続化しない。
 //   - ユーザー設定 (UserConfig): プレイヤーがゲーム内で変更し、次回起動時も
-//     保持したい設定。解像度など。設定ファイルに永続化する。将来的に音量や
-//     キーバインドを追加する。
+//     保持したい設定。解像度、言語、音量など。設定ファイルに永続化する。
+//     将来的にキーバインドを追加する。
 //
 // どちらも Config 構造体に集約され、実行時の設定の真実は Config 単一である。
 // 永続化する対象は UserConfig の境界で表現し、この構造体に含めたフィールド
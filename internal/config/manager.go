@@ -59,7 +59,8 @@ func (c *Config) String() string {
 	Seed: %d,
 	TargetFPS: %d,
 	ProfileMemory: %t, ProfileCPU: %t, ProfileMutex: %t, ProfileTrace: %t,
-	ProfilePath: %s
+	ProfilePath: %s,
+	ModDir: %s
 }`,
 		c.Profile,
 		c.User.WindowWidth, c.User.WindowHeight,
@@ -67,5 +68,6 @@ func (c *Config) String() string {
 		c.Seed,
 		c.TargetFPS,
 		c.ProfileMemory, c.ProfileCPU, c.ProfileMutex, c.ProfileTrace,
-		c.ProfilePath)
+		c.ProfilePath,
+		c.ModDir)
 }
@@ -11,7 +11,7 @@ import (
 func TestUserConfigEncodeDecode_ラウンドトリップ(t *testing.T) {
 	t.Parallel()
 
-	src := &Config{User: UserConfig{WindowWidth: 1280, WindowHeight: 960, Language: "en"}}
+	src := &Config{User: UserConfig{WindowWidth: 1280, WindowHeight: 960, Language: "en", MasterVolume: 50, MusicVolume: 0, SEVolume: 100}}
 	data, err := src.encodeUserConfig()
 	require.NoError(t, err)
 
@@ -30,4 +30,5 @@ func TestUserConfig_保存に無いフィールドはデフォルトが残る(t
 	assert.Equal(t, 1024, dst.User.WindowWidth) // 保存値で上書き
 	assert.Equal(t, 720, dst.User.WindowHeight) // デフォルトが残る
 	assert.Equal(t, "en", dst.User.Language)    // デフォルトが残る
+	assert.Equal(t, 70, dst.User.MusicVolume)   // デフォルトが残る
 }
@@ -0,0 +1,21 @@
+// Package dialogue はローデータの会話グラフを解釈し、メッセージウィンドウで進める。
+//
+// # 責務
+//
+// 会話の中身はローデータの dialogues に書く。1つの会話はノードの並びで、各ノードが1ページになる。
+// ノードは選択肢を持ち、選択肢は表示の条件と選んだときの効果を持つ。このパッケージの Runner は
+// 会話グラフを messagedata.MessageData に変換し、既存の messagewindow がそのまま表示する。
+//
+// # 条件と効果
+//
+// 条件はアイテムの所持数、ゲーム進行イベントの視聴、スキル値、話し手との派閥関係、クエストの状態を見る。
+// 効果はアイテムの授受、イベントの視聴、所持金の増減、店を開くこと、クエストを受けることができる。
+// 取り上げる品や払う金が足りない選択肢と、受けたことのあるクエストを受ける選択肢は、条件と同じく表示しない。
+//
+// 条件はページを組む時点の状態で評価する。遷移先のページは選択肢を選んで効果を適用したあとに組むので、
+// 直前の選択で受け取った品やイベントが次のページの条件に反映される。
+//
+// # ステートとの境界
+//
+// 店を開くなどステートの遷移を伴う効果は Hooks で呼び出し側に任せる。このパッケージは states に依存しない。
+package dialogue
@@ -0,0 +1,224 @@
+package dialogue
+
+import (
+	"fmt"
+
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/messagedata"
+	"github.com/kijimaD/ruins/internal/oapi"
+	"github.com/kijimaD/ruins/internal/quest"
+	"github.com/kijimaD/ruins/internal/raw"
+	w "github.com/kijimaD/ruins/internal/world"
+	"github.com/kijimaD/ruins/internal/world/lifecycle"
+	"github.com/kijimaD/ruins/internal/world/query"
+	"github.com/mlange-42/ark/ecs"
+)
+
+// Hooks はステートの遷移を伴う効果を呼び出し側に任せる口
+type Hooks struct {
+	// OpenShop は openShop の効果で呼ぶ。会話を閉じたあとに話し手の店を開く。nil なら店を開く選択肢を出さない
+	OpenShop func(world w.World) error
+}
+
+// Runner は1つの会話グラフを話し手とプレイヤーに結びつけて進める
+type Runner struct {
+	world       w.World
+	def         oapi.Dialogue
+	nodes       map[string]oapi.DialogueNode
+	speaker     ecs.Entity
+	speakerName string
+	player      ecs.Entity
+	hooks       Hooks
+}
+
+// NewRunner は会話グラフ def を speaker との会話として進める Runner を作る。
+// ノードの参照整合はロード時に検証済みとして扱う
+func NewRunner(world w.World, def oapi.Dialogue, speaker ecs.Entity, hooks Hooks) (*Runner, error) {
+	if len(def.Nodes) == 0 {
+		return nil, fmt.Errorf("dialogue %q has no nodes", def.Id)
+	}
+	player, err := query.GetPlayerEntity(world)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start dialogue %q: %w", def.Id, err)
+	}
+	nodes := make(map[string]oapi.DialogueNode, len(def.Nodes))
+	for _, node := range def.Nodes {
+		nodes[node.Id] = node
+	}
+	return &Runner{
+		world:       world,
+		def:         def,
+		nodes:       nodes,
+		speaker:     speaker,
+		speakerName: query.GetEntityName(speaker, world),
+		player:      player,
+		hooks:       hooks,
+	}, nil
+}
+
+// Start は先頭のノードのページを組んで返す
+func (r *Runner) Start() *messagedata.MessageData {
+	return r.page(r.def.Nodes[0].Id)
+}
+
+// page はノードを1ページに組む。条件を満たす選択肢だけを並べ、選択肢が残らなければ next へ続ける。
+// 遷移先は空のページを置いておき、選んだ時点、閉じた時点で中身を組んで書き込む。
+// messagewindow は選択肢の MessageData をポインタで持つので、書き込んだ中身がそのまま表示される
+func (r *Runner) page(id string) *messagedata.MessageData {
+	node := r.nodes[id]
+	msg := messagedata.NewDialogMessage("", r.speakerName).
+		AddMarkup(query.T(r.world, node.Text))
+
+	for _, choice := range raw.PtrSlice(node.Choices) {
+		if !r.available(choice) {
+			continue
+		}
+		var next *messagedata.MessageData
+		if choice.Next != nil {
+			next = &messagedata.MessageData{}
+		}
+		msg.Choices = append(msg.Choices, messagedata.Choice{
+			Text:        query.T(r.world, choice.Text),
+			Action:      r.choose(choice, next),
+			MessageData: next,
+		})
+	}
+
+	if len(msg.Choices) == 0 && node.Next != nil {
+		nextID := *node.Next
+		next := &messagedata.MessageData{}
+		msg.WithOnComplete(func() { *next = *r.page(nextID) })
+		messagedata.ChainMessages(msg, next)
+	}
+	return msg
+}
+
+// choose は選択肢を選んだときの処理を返す。効果を順に適用してから遷移先のページを組む
+func (r *Runner) choose(choice oapi.DialogueChoice, next *messagedata.MessageData) func(w.World) error {
+	return func(world w.World) error {
+		for _, eff := range raw.PtrSlice(choice.Effects) {
+			if err := r.apply(world, eff); err != nil {
+				return fmt.Errorf("dialogue %q: %w", r.def.Id, err)
+			}
+		}
+		if next != nil {
+			*next = *r.page(*choice.Next)
+		}
+		return nil
+	}
+}
+
+// available は選択肢を出せるかを返す。条件をすべて満たし、効果をすべて適用できるときに出す
+func (r *Runner) available(choice oapi.DialogueChoice) bool {
+	for _, cond := range raw.PtrSlice(choice.Conditions) {
+		if !r.holds(cond) {
+			return false
+		}
+	}
+	for _, eff := range raw.PtrSlice(choice.Effects) {
+		if !r.applicable(eff) {
+			return false
+		}
+	}
+	return true
+}
+
+// holds は条件を満たすかを返す。not が立っていれば結果を反転する
+func (r *Runner) holds(cond oapi.DialogueCondition) bool {
+	ok := r.test(cond)
+	if cond.Not != nil && *cond.Not {
+		return !ok
+	}
+	return ok
+}
+
+// test は条件を反転せずに評価する
+func (r *Runner) test(cond oapi.DialogueCondition) bool {
+	switch {
+	case cond.Item != nil:
+		return r.itemCount(*cond.Item) >= countOrOne(cond.Count)
+	case cond.Event != nil:
+		return query.GetGameProgress(r.world).IsEventSeen(*cond.Event)
+	case cond.Skill != nil:
+		skills := r.world.Components.Skills.Get(r.player)
+		if skills == nil {
+			return false
+		}
+		return skills.Get(gc.SkillID(*cond.Skill)).Value >= countOrOne(cond.Level)
+	case cond.Relation != nil:
+		return string(query.FactionRelation(r.world, r.player, r.speaker)) == string(*cond.Relation)
+	case cond.Quest != nil:
+		ql := query.GetQuestLog(r.world)
+		if ql == nil {
+			return false
+		}
+		q := ql.Find(*cond.Quest)
+		if q == nil {
+			return false
+		}
+		return cond.Status == nil || string(q.Status) == string(*cond.Status)
+	}
+	return false
+}
+
+// applicable は効果を今の状態で適用できるかを返す
+func (r *Runner) applicable(eff oapi.DialogueEffect) bool {
+	switch {
+	case eff.TakeItem != nil:
+		return r.itemCount(*eff.TakeItem) >= countOrOne(eff.Count)
+	case eff.Currency != nil && *eff.Currency < 0:
+		return query.HasCurrency(r.world, r.player, consts.Currency(-*eff.Currency))
+	case eff.OpenShop != nil && *eff.OpenShop:
+		return r.hooks.OpenShop != nil && r.world.Components.Merchant.Has(r.speaker)
+	case eff.StartQuest != nil:
+		ql := query.GetQuestLog(r.world)
+		return ql != nil && ql.Find(*eff.StartQuest) == nil
+	}
+	return true
+}
+
+// apply は効果を1つ適用する
+func (r *Runner) apply(world w.World, eff oapi.DialogueEffect) error {
+	switch {
+	case eff.GiveItem != nil:
+		return lifecycle.ChangeStackCount(world, *eff.GiveItem, countOrOne(eff.Count))
+	case eff.TakeItem != nil:
+		return lifecycle.ChangeStackCount(world, *eff.TakeItem, -countOrOne(eff.Count))
+	case eff.SetEvent != nil:
+		query.GetGameProgress(world).MarkEventSeen(*eff.SetEvent)
+	case eff.Currency != nil:
+		amount := consts.Currency(*eff.Currency)
+		if amount >= 0 {
+			return query.AddCurrency(world, r.player, amount)
+		}
+		if !query.ConsumeCurrency(world, r.player, -amount) {
+			return fmt.Errorf("insufficient currency: %d", -amount)
+		}
+	case eff.OpenShop != nil && *eff.OpenShop:
+		if r.hooks.OpenShop == nil {
+			return fmt.Errorf("no hook to open shop")
+		}
+		return r.hooks.OpenShop(world)
+	case eff.StartQuest != nil:
+		return quest.Start(world, *eff.StartQuest)
+	}
+	return nil
+}
+
+// itemCount はプレイヤーのバックパックにある id の品の個数を返す
+func (r *Runner) itemCount(id string) int {
+	entity, found := query.FindStackInInventory(r.world, id)
+	if !found {
+		return 0
+	}
+	return query.GetEntityCount(r.world, entity)
+}
+
+// countOrOne は省略された個数や必要値を1として読む
+func countOrOne(n *int) int {
+	if n == nil {
+		return 1
+	}
+	return *n
+}
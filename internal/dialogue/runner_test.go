@@ -0,0 +1,292 @@
+package dialogue
+
+import (
+	"strings"
+	"testing"
+
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/messagedata"
+	"github.com/kijimaD/ruins/internal/oapi"
+	"github.com/kijimaD/ruins/internal/raw"
+	"github.com/kijimaD/ruins/internal/testutil"
+	w "github.com/kijimaD/ruins/internal/world"
+	"github.com/kijimaD/ruins/internal/world/lifecycle"
+	"github.com/kijimaD/ruins/internal/world/query"
+	"github.com/mlange-42/ark/ecs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupTalk はプレイヤーと話し手を置いた world を返す
+func setupTalk(t *testing.T, speakerName string) (w.World, ecs.Entity, ecs.Entity) {
+	t.Helper()
+	world := testutil.InitTestWorld(t)
+	player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 10, Y: 10}, "ash")
+	require.NoError(t, err)
+	speaker, err := lifecycle.SpawnNeutralNPC(world, consts.Coord[consts.Tile]{X: 11, Y: 10}, speakerName)
+	require.NoError(t, err)
+	return world, player, speaker
+}
+
+// pageText はページの本文を行ごとに連結して返す
+func pageText(msg *messagedata.MessageData) string {
+	lines := make([]string, 0, len(msg.TextSegmentLines))
+	for _, line := range msg.TextSegmentLines {
+		var b strings.Builder
+		for _, seg := range line {
+			b.WriteString(seg.Text)
+		}
+		lines = append(lines, b.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// choiceTexts はページに出ている選択肢の文言を返す
+func choiceTexts(msg *messagedata.MessageData) []string {
+	texts := make([]string, 0, len(msg.Choices))
+	for _, c := range msg.Choices {
+		texts = append(texts, c.Text)
+	}
+	return texts
+}
+
+// selectChoice は文言の一致する選択肢を選び、遷移先のページを返す
+func selectChoice(t *testing.T, world w.World, msg *messagedata.MessageData, text string) *messagedata.MessageData {
+	t.Helper()
+	for _, c := range msg.Choices {
+		if c.Text == text {
+			require.NoError(t, c.Action(world))
+			return c.MessageData
+		}
+	}
+	require.Failf(t, "選択肢がない", "%q not in %v", text, choiceTexts(msg))
+	return nil
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestRunner_実データの会話をページ順に進める(t *testing.T) {
+	t.Parallel()
+
+	world, _, speaker := setupTalk(t, "old_soldier")
+	def, err := raw.FindDialogue(world.Resources.RawMaster, "old_soldier_greeting")
+	require.NoError(t, err)
+	runner, err := NewRunner(world, def, speaker, Hooks{})
+	require.NoError(t, err)
+
+	first := runner.Start()
+	assert.Equal(t, "Old Soldier", first.Speaker)
+	assert.Contains(t, pageText(first), "delver")
+	assert.Empty(t, first.Choices)
+	require.True(t, first.HasNextMessages(), "2ページ目が続くべき")
+
+	// 2ページ目は1ページ目を閉じたときに組む
+	first.OnComplete()
+	second := first.GetNextMessages()[0]
+	assert.Equal(t, "Old Soldier", second.Speaker)
+	assert.Contains(t, pageText(second), "Hollow")
+	assert.False(t, second.HasNextMessages(), "最後のページで終わるべき")
+}
+
+func TestRunner_条件を満たす選択肢だけを出す(t *testing.T) {
+	t.Parallel()
+
+	world, player, speaker := setupTalk(t, "old_soldier")
+	def := oapi.Dialogue{Id: "test", Nodes: []oapi.DialogueNode{{
+		Id:   "start",
+		Text: "Hello.",
+		Choices: &[]oapi.DialogueChoice{
+			{Text: "Always"},
+			{Text: "Iron", Conditions: &[]oapi.DialogueCondition{{Item: ptr("iron"), Count: ptr(2)}}},
+			{Text: "Met", Conditions: &[]oapi.DialogueCondition{{Event: ptr("met_soldier")}}},
+			{Text: "Not met", Conditions: &[]oapi.DialogueCondition{{Event: ptr("met_soldier"), Not: ptr(true)}}},
+			{Text: "Skilled", Conditions: &[]oapi.DialogueCondition{{Skill: ptr("negotiation"), Level: ptr(5)}}},
+			{Text: "Neutral", Conditions: &[]oapi.DialogueCondition{{Relation: ptr(oapi.Neutral)}}},
+			{Text: "Hostile", Conditions: &[]oapi.DialogueCondition{{Relation: ptr(oapi.Hostile)}}},
+		},
+	}}}
+	runner, err := NewRunner(world, def, speaker, Hooks{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Always", "Not met", "Neutral"}, choiceTexts(runner.Start()))
+
+	_, err = lifecycle.SpawnBackpackItem(world, "iron", 1)
+	require.NoError(t, err)
+	assert.NotContains(t, choiceTexts(runner.Start()), "Iron", "個数が足りなければ出さない")
+
+	_, err = lifecycle.SpawnBackpackItem(world, "iron", 1)
+	require.NoError(t, err)
+	query.GetGameProgress(world).MarkEventSeen("met_soldier")
+	world.Components.Skills.Get(player).Get(gc.SkillNegotiation).Value = 5
+
+	assert.Equal(t, []string{"Always", "Iron", "Met", "Skilled", "Neutral"}, choiceTexts(runner.Start()))
+}
+
+func TestRunner_選んだ効果を適用してから次のページを組む(t *testing.T) {
+	t.Parallel()
+
+	world, player, speaker := setupTalk(t, "old_soldier")
+	def := oapi.Dialogue{Id: "test", Nodes: []oapi.DialogueNode{
+		{
+			Id:   "start",
+			Text: "Want some iron?",
+			Choices: &[]oapi.DialogueChoice{
+				{
+					Text: "Buy",
+					Next: ptr("after"),
+					Effects: &[]oapi.DialogueEffect{
+						{Currency: ptr(-100)},
+						{GiveItem: ptr("iron"), Count: ptr(2)},
+						{SetEvent: ptr("bought_iron")},
+					},
+				},
+				{Text: "Sell", Effects: &[]oapi.DialogueEffect{{TakeItem: ptr("iron")}, {Currency: ptr(30)}}},
+			},
+		},
+		{
+			Id:   "after",
+			Text: "Thanks.",
+			Choices: &[]oapi.DialogueChoice{
+				{Text: "Again", Conditions: &[]oapi.DialogueCondition{{Event: ptr("bought_iron")}}},
+			},
+		},
+	}}
+	runner, err := NewRunner(world, def, speaker, Hooks{})
+	require.NoError(t, err)
+
+	start := runner.Start()
+	assert.Equal(t, []string{"Buy"}, choiceTexts(start), "手持ちにない品は取り上げられないので出さない")
+
+	before := query.GetCurrency(world, player)
+	next := selectChoice(t, world, start, "Buy")
+	assert.Equal(t, before-100, query.GetCurrency(world, player))
+	iron, found := query.FindStackInInventory(world, "iron")
+	require.True(t, found)
+	assert.Equal(t, 2, query.GetEntityCount(world, iron))
+
+	// 遷移先は選んだあとの状態で組むので、立てたイベントの条件を満たす
+	require.NotNil(t, next)
+	assert.Contains(t, pageText(next), "Thanks.")
+	assert.Equal(t, []string{"Again"}, choiceTexts(next))
+
+	again := runner.Start()
+	assert.Equal(t, []string{"Buy", "Sell"}, choiceTexts(again))
+	assert.Nil(t, selectChoice(t, world, again, "Sell"), "next を省略した選択肢は会話を終えるべき")
+	assert.Equal(t, before-100+30, query.GetCurrency(world, player))
+	iron, found = query.FindStackInInventory(world, "iron")
+	require.True(t, found)
+	assert.Equal(t, 1, query.GetEntityCount(world, iron))
+}
+
+func TestRunner_払えない選択肢は出さない(t *testing.T) {
+	t.Parallel()
+
+	world, player, speaker := setupTalk(t, "old_soldier")
+	def := oapi.Dialogue{Id: "test", Nodes: []oapi.DialogueNode{{
+		Id:      "start",
+		Text:    "Pay up.",
+		Choices: &[]oapi.DialogueChoice{{Text: "Pay", Effects: &[]oapi.DialogueEffect{{Currency: ptr(-100)}}}},
+		Next:    ptr("broke"),
+	}, {
+		Id:   "broke",
+		Text: "Come back with money.",
+	}}}
+	runner, err := NewRunner(world, def, speaker, Hooks{})
+	require.NoError(t, err)
+
+	world.Components.Wallet.Get(player).Currency = 99
+	start := runner.Start()
+	assert.Empty(t, start.Choices)
+	require.True(t, start.HasNextMessages(), "選択肢が残らなければ next へ続くべき")
+	start.OnComplete()
+	assert.Contains(t, pageText(start.GetNextMessages()[0]), "Come back with money.")
+}
+
+func TestRunner_店を開く効果はフックに任せる(t *testing.T) {
+	t.Parallel()
+
+	def := oapi.Dialogue{Id: "test", Nodes: []oapi.DialogueNode{{
+		Id:   "start",
+		Text: "Welcome.",
+		Choices: &[]oapi.DialogueChoice{
+			{Text: "Look", Effects: &[]oapi.DialogueEffect{{OpenShop: ptr(true)}}},
+			{Text: "Leave"},
+		},
+	}}}
+
+	t.Run("商人なら店を開く", func(t *testing.T) {
+		t.Parallel()
+		world, _, merchant := setupTalk(t, "merchant")
+		opened := 0
+		runner, err := NewRunner(world, def, merchant, Hooks{OpenShop: func(_ w.World) error {
+			opened++
+			return nil
+		}})
+		require.NoError(t, err)
+
+		selectChoice(t, world, runner.Start(), "Look")
+		assert.Equal(t, 1, opened)
+	})
+
+	t.Run("店を持たない話し手には出さない", func(t *testing.T) {
+		t.Parallel()
+		world, _, speaker := setupTalk(t, "old_soldier")
+		runner, err := NewRunner(world, def, speaker, Hooks{OpenShop: func(_ w.World) error { return nil }})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Leave"}, choiceTexts(runner.Start()))
+	})
+
+	t.Run("フックがなければ出さない", func(t *testing.T) {
+		t.Parallel()
+		world, _, merchant := setupTalk(t, "merchant")
+		runner, err := NewRunner(world, def, merchant, Hooks{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Leave"}, choiceTexts(runner.Start()))
+	})
+}
+
+func TestRunner_クエストを受けて状態で選択肢を切り替える(t *testing.T) {
+	t.Parallel()
+
+	world, _, merchant := setupTalk(t, "merchant")
+	def, err := raw.FindDialogue(world.Resources.RawMaster, "merchant_greeting")
+	require.NoError(t, err)
+	runner, err := NewRunner(world, def, merchant, Hooks{})
+	require.NoError(t, err)
+
+	start := runner.Start()
+	assert.Equal(t, []string{"Any work?", "No business"}, choiceTexts(start))
+	job := selectChoice(t, world, start, "Any work?")
+	assert.Equal(t, []string{"Leave it to me", "Not now"}, choiceTexts(job))
+	selectChoice(t, world, job, "Leave it to me")
+
+	q := query.GetQuestLog(world).Find("rat_hunt")
+	require.NotNil(t, q, "startQuest の効果でクエストを受ける")
+	assert.Equal(t, gc.QuestStatusActive, q.Status)
+	assert.Equal(t, []string{"About the rats", "No business"}, choiceTexts(runner.Start()))
+
+	q.Status = gc.QuestStatusCompleted
+	assert.Equal(t, []string{"No business"}, choiceTexts(runner.Start()), "status を指定した条件は状態が違えば満たさない")
+}
+
+func TestRunner_受けたクエストを受ける選択肢は出さない(t *testing.T) {
+	t.Parallel()
+
+	world, _, speaker := setupTalk(t, "old_soldier")
+	def := oapi.Dialogue{Id: "test", Nodes: []oapi.DialogueNode{{
+		Id:   "start",
+		Text: "Help me.",
+		Choices: &[]oapi.DialogueChoice{
+			{Text: "Accept", Effects: &[]oapi.DialogueEffect{{StartQuest: ptr("rat_hunt")}}},
+			{Text: "Taken", Conditions: &[]oapi.DialogueCondition{{Quest: ptr("rat_hunt")}}},
+		},
+	}}}
+	runner, err := NewRunner(world, def, speaker, Hooks{})
+	require.NoError(t, err)
+
+	selectChoice(t, world, runner.Start(), "Accept")
+	assert.Equal(t, []string{"Taken"}, choiceTexts(runner.Start()))
+}
@@ -1,14 +1,23 @@
 package dungeon
 
 import (
+	"errors"
 	"fmt"
 	"math/rand/v2"
 
 	"github.com/kijimaD/ruins/internal/consts"
 	"github.com/kijimaD/ruins/internal/mapplanner"
+	"github.com/kijimaD/ruins/internal/oapi"
 	"github.com/kijimaD/ruins/internal/raw"
 )
 
+var (
+	// ErrUnknownPlanner はダンジョン定義が存在しないプランナー名を指したときのエラー
+	ErrUnknownPlanner = errors.New("unknown planner")
+	// ErrReservedStageName はダンジョン定義が内部用ステージと同じ名前を使ったときのエラー
+	ErrReservedStageName = errors.New("stage name is reserved")
+)
+
 // StageDefinition はステージ種別の静的マスタ。セーブに含めず StageKey.Name で引く。
 // マスタ、すなわち不変の設定と、プレイ固有データ、すなわち StageKey・StageField・SeamlessBand などの
 // 可変でセーブ対象のデータを分ける境界。種別はフラグでなく実装する型で表す。
@@ -43,6 +52,73 @@ type DungeonDefinition struct {
 	baseTemp    int
 	// bossPlanner は最終階で使うボスフロアプランナー。nil ならボスフロアなし
 	bossPlanner *mapplanner.PlannerType
+	// music と bossMusic は通常階とボスフロアで流す曲の ID。空なら既定の曲に任せる
+	music     string
+	bossMusic string
+}
+
+// NewDungeonDefinitions はローデータの dungeons からダンジョン定義を定義順に組む。
+// プランナー名は mapplanner.PlannerTypeByName で引き、引けなければ ErrUnknownPlanner を返す。
+// テーブルの参照はローデータの読み込み時に raw.ValidateReferences が検証している
+func NewDungeonDefinitions(master raw.Master) ([]*DungeonDefinition, error) {
+	dungeons := raw.PtrSlice(master.Dungeons)
+	defs := make([]*DungeonDefinition, 0, len(dungeons))
+	for i := range dungeons {
+		def, err := newDungeonDefinition(dungeons[i])
+		if err != nil {
+			return nil, err
+		}
+		defs = append(defs, def)
+	}
+	return defs, nil
+}
+
+// newDungeonDefinition は1件のダンジョンのローデータを定義にする
+func newDungeonDefinition(d oapi.Dungeon) (*DungeonDefinition, error) {
+	for _, k := range internalDefinitions {
+		if k.Name() == d.Name {
+			return nil, fmt.Errorf("dungeon %q: %w", d.Name, ErrReservedStageName)
+		}
+	}
+
+	def := &DungeonDefinition{
+		name:        d.Name,
+		totalFloors: d.TotalFloors,
+		baseTemp:    d.BaseTemperature,
+	}
+	if d.Description != nil {
+		def.description = *d.Description
+	}
+	if d.ImageKey != nil {
+		def.imageKey = *d.ImageKey
+	}
+	if d.EnemyTableId != nil {
+		def.enemyTable = *d.EnemyTableId
+	}
+	if d.ItemTableId != nil {
+		def.itemTable = *d.ItemTableId
+	}
+	if d.Music != nil {
+		def.music = *d.Music
+	}
+	if d.BossMusic != nil {
+		def.bossMusic = *d.BossMusic
+	}
+	for _, p := range d.Planners {
+		pt, ok := mapplanner.PlannerTypeByName(p.Planner)
+		if !ok {
+			return nil, fmt.Errorf("dungeon %q planner %q: %w", d.Name, p.Planner, ErrUnknownPlanner)
+		}
+		def.plannerPool = append(def.plannerPool, PlannerWeight{PlannerType: pt, Weight: p.Weight})
+	}
+	if d.BossPlanner != nil {
+		pt, ok := mapplanner.PlannerTypeByName(*d.BossPlanner)
+		if !ok {
+			return nil, fmt.Errorf("dungeon %q boss planner %q: %w", d.Name, *d.BossPlanner, ErrUnknownPlanner)
+		}
+		def.bossPlanner = &pt
+	}
+	return def, nil
 }
 
 // Name はダンジョン名を返す
@@ -78,6 +154,15 @@ func (d *DungeonDefinition) BossPlanner(depth int) (mapplanner.PlannerType, bool
 	return mapplanner.PlannerType{}, false
 }
 
+// Music は depth の階で流す曲の ID を返す。ボスフロアではボス曲を返す。
+// 定義が曲を指定していなければ空文字を返し、既定の曲の選択は呼び出し側に任せる
+func (d *DungeonDefinition) Music(depth int) string {
+	if _, isBoss := d.BossPlanner(depth); isBoss {
+		return d.bossMusic
+	}
+	return d.music
+}
+
 // SelectPlanner は PlannerPool から重み付き抽選で PlannerType を選ぶ。
 // プランナー抽選はフロアを生成するダンジョン固有の振る舞いなのでこの型のメソッドにする。
 func (d *DungeonDefinition) SelectPlanner(rng *rand.Rand) (mapplanner.PlannerType, error) {
@@ -6,6 +6,8 @@ import (
 
 	"github.com/kijimaD/ruins/internal/consts"
 	"github.com/kijimaD/ruins/internal/mapplanner"
+	"github.com/kijimaD/ruins/internal/oapi"
+	"github.com/kijimaD/ruins/internal/raw"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -170,6 +172,25 @@ func TestDungeonDefinition_BossPlanner(t *testing.T) {
 	})
 }
 
+func TestDungeonDefinition_Music(t *testing.T) {
+	t.Parallel()
+
+	boss := mapplanner.PlannerTypeBossFloor
+	def := DungeonDefinition{
+		name:        "テスト",
+		totalFloors: 5,
+		bossPlanner: &boss,
+		music:       "forest_theme",
+		bossMusic:   "forest_boss",
+	}
+	assert.Equal(t, "forest_theme", def.Music(1))
+	assert.Equal(t, "forest_boss", def.Music(5), "ボスフロアはボス曲を返す")
+
+	noMusic := DungeonDefinition{name: "テスト", totalFloors: 5, bossPlanner: &boss}
+	assert.Empty(t, noMusic.Music(1), "曲の指定が無ければ空文字")
+	assert.Empty(t, noMusic.Music(5))
+}
+
 func TestNewOverworldDefinition_フィールドを保持する(t *testing.T) {
 	t.Parallel()
 
@@ -183,3 +204,57 @@ func TestNewOverworldDefinition_フィールドを保持する(t *testing.T) {
 	assert.Equal(t, consts.Chunk(2), cols)
 	assert.Equal(t, consts.Chunk(3), rows)
 }
+
+func TestNewDungeonDefinitions(t *testing.T) {
+	t.Parallel()
+
+	bossPlanner := mapplanner.PlannerTypeBossFloor.Name
+	enemyTable := "cave"
+	valid := oapi.Dungeon{
+		Name:            "Test cave",
+		TotalFloors:     5,
+		EnemyTableId:    &enemyTable,
+		BaseTemperature: 3,
+		BossPlanner:     &bossPlanner,
+		Planners: []oapi.DungeonPlanner{
+			{Planner: mapplanner.PlannerTypeCave.Name, Weight: 2},
+			{Planner: mapplanner.PlannerTypeSmallRoom.Name, Weight: 1},
+		},
+	}
+
+	t.Run("ローデータからダンジョン定義を組める", func(t *testing.T) {
+		t.Parallel()
+		defs, err := NewDungeonDefinitions(raw.NewMaster(oapi.Raws{Dungeons: &[]oapi.Dungeon{valid}}))
+		require.NoError(t, err)
+		require.Len(t, defs, 1)
+		d := defs[0]
+		assert.Equal(t, "Test cave", d.Name())
+		assert.Equal(t, "cave", d.EnemyTableName())
+		assert.Empty(t, d.ItemTableName(), "省略したテーブルは空になるべき")
+		assert.Equal(t, 3, d.BaseTemperature())
+		require.Len(t, d.PlannerPool(), 2)
+		assert.Equal(t, mapplanner.PlannerTypeCave.Name, d.PlannerPool()[0].PlannerType.Name)
+
+		pt, ok := d.BossPlanner(5)
+		require.True(t, ok)
+		assert.Equal(t, mapplanner.PlannerTypeBossFloor.Name, pt.Name)
+		_, ok = d.BossPlanner(4)
+		assert.False(t, ok)
+	})
+
+	t.Run("存在しないプランナー名はエラー", func(t *testing.T) {
+		t.Parallel()
+		broken := valid
+		broken.Planners = []oapi.DungeonPlanner{{Planner: "No Such Planner", Weight: 1}}
+		_, err := NewDungeonDefinitions(raw.NewMaster(oapi.Raws{Dungeons: &[]oapi.Dungeon{broken}}))
+		require.ErrorIs(t, err, ErrUnknownPlanner)
+	})
+
+	t.Run("内部用ステージと同じ名前はエラー", func(t *testing.T) {
+		t.Parallel()
+		broken := valid
+		broken.Name = DungeonOverworld.Name()
+		_, err := NewDungeonDefinitions(raw.NewMaster(oapi.Raws{Dungeons: &[]oapi.Dungeon{broken}}))
+		require.ErrorIs(t, err, ErrReservedStageName)
+	})
+}
@@ -21,6 +21,8 @@
 //
 // # 使い分け
 //
+// 選択画面に出すダンジョンはローデータの dungeons に書き、Register で登録する。
+// デバッグ用やオーバーワールドなど、コードと結びついた内部用の定義だけを Go で持つ。
 // ダンジョン選択画面では registry から全ダンジョンを取得して表示する。
 // ダンジョン開始時は DungeonDefinition.SelectPlanner でマップ種類を抽選する。
 package dungeon
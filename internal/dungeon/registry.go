@@ -1,8 +1,13 @@
 package dungeon
 
-import "github.com/kijimaD/ruins/internal/mapplanner"
+import (
+	"sync"
 
-// 全ステージのマスタ定義
+	"github.com/kijimaD/ruins/internal/mapplanner"
+	"github.com/kijimaD/ruins/internal/raw"
+)
+
+// 内部用ステージのマスタ定義。選択画面に出すダンジョンはローデータの dungeons から組む
 var (
 	// DungeonDebug はデバッグ用ダンジョン定義
 	DungeonDebug = &DungeonDefinition{
@@ -29,40 +34,6 @@ var (
 		},
 	}
 
-	// DungeonForest は森ダンジョン定義
-	DungeonForest = &DungeonDefinition{
-		name:        "Dead forest",
-		description: "Hunters once ventured into this frozen forest.\nFew returned. The cold reaches the bone.",
-		imageKey:    "forest1",
-		totalFloors: 20,
-		enemyTable:  "forest",
-		itemTable:   "forest",
-		baseTemp:    0, // 寒い
-		bossPlanner: &mapplanner.PlannerTypeBossFloor,
-		plannerPool: []PlannerWeight{
-			{PlannerType: mapplanner.PlannerTypeForest, Weight: 5},
-			{PlannerType: mapplanner.PlannerTypeSmallRoom, Weight: 2},
-			{PlannerType: mapplanner.PlannerTypeBigRoom, Weight: 1},
-		},
-	}
-
-	// DungeonCave は洞窟ダンジョン定義
-	DungeonCave = &DungeonDefinition{
-		name:        "Ash cave",
-		description: "Frost crystals run like veins through the gray rock.\nThe deeper you go, the quieter it grows.",
-		imageKey:    "cave1",
-		totalFloors: 20,
-		enemyTable:  "cave",
-		itemTable:   "cave",
-		baseTemp:    5, // 寒い
-		bossPlanner: &mapplanner.PlannerTypeBossFloor,
-		plannerPool: []PlannerWeight{
-			{PlannerType: mapplanner.PlannerTypeCave, Weight: 6},
-			{PlannerType: mapplanner.PlannerTypeSmallRoom, Weight: 1},
-			{PlannerType: mapplanner.PlannerTypeBigRoom, Weight: 2},
-		},
-	}
-
 	// DungeonOverworld はオーバーワールド帯を表す定義。
 	// フロアを作り直さず帯をスライドさせ続ける。ダンジョン専用フィールドを持たない別の型。
 	// 帯形状 24x24 のチャンクを横7枚・縦9枚並べる。1チャンク=1建物=地図の1マスの縮尺。
@@ -71,23 +42,6 @@ var (
 	// この形状はマスタの設定で、RunSeed だけがプレイごとに変わる。
 	DungeonOverworld = NewOverworldDefinition("Overworld", 0, 24, 24, 7, 9)
 
-	// DungeonRuins は廃墟ダンジョン定義
-	DungeonRuins = &DungeonDefinition{
-		name:        "Forgotten ruins",
-		description: "An ancient city stands frozen in place.\nWho forgot what, no one remembers now.",
-		imageKey:    "city1",
-		totalFloors: 20,
-		enemyTable:  "ruins_area",
-		itemTable:   "ruins_area",
-		baseTemp:    15, // やや快適
-		bossPlanner: &mapplanner.PlannerTypeBossFloor,
-		plannerPool: []PlannerWeight{
-			{PlannerType: mapplanner.PlannerTypeSmallRoom, Weight: 4},
-			{PlannerType: mapplanner.PlannerTypeRuins, Weight: 3},
-			{PlannerType: mapplanner.PlannerTypeBigRoom, Weight: 2},
-		},
-	}
-
 	// DungeonCubeInterior は移動拠点キューブの内部の定義。選択画面にも地上の入口にも出さない
 	// 内部用ステージなので internalDefinitions に置く。実体の生成は enterCube の SwapTo が
 	// テンプレートから行い、この定義のフロア生成やテーブルは使わない。定義を持たせる目的は、
@@ -107,24 +61,45 @@ var (
 	}
 )
 
-// allDungeons は選択画面に表示する登録済みダンジョンの一覧
-var allDungeons = []*DungeonDefinition{
-	DungeonForest,
-	DungeonCave,
-	DungeonRuins,
+var (
+	registryMu sync.RWMutex
+	// allDungeons は選択画面に表示する登録済みダンジョンの一覧。Register で置き換える
+	allDungeons []*DungeonDefinition
+)
+
+// Register は master のダンジョン定義を検証し、選択画面に表示するダンジョンの一覧として登録する。
+// ローデータを読み込んだ直後に呼ぶ。MOD で足したダンジョンもここで加わる。
+// 不正な定義は起動時のエラーとして返し、一覧は書き換えない
+func Register(master raw.Master) error {
+	defs, err := NewDungeonDefinitions(master)
+	if err != nil {
+		return err
+	}
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	allDungeons = defs
+	return nil
+}
+
+// registeredDungeons は登録済みの一覧を返す。Register より前は空
+func registeredDungeons() []*DungeonDefinition {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	return allDungeons
 }
 
 // GetAllDungeons は選択画面に表示する全ダンジョン定義を返す。
 // オーバーワールドやデバッグなどの内部用の定義は含まない。
 func GetAllDungeons() []*DungeonDefinition {
-	return allDungeons
+	return registeredDungeons()
 }
 
 // GetAllDungeonNames は全ダンジョン名のスライスを返す
 func GetAllDungeonNames() []string {
-	names := make([]string, len(allDungeons))
-	for i := range allDungeons {
-		names[i] = allDungeons[i].Name()
+	dungeons := registeredDungeons()
+	names := make([]string, len(dungeons))
+	for i := range dungeons {
+		names[i] = dungeons[i].Name()
 	}
 	return names
 }
@@ -145,7 +120,7 @@ func GetStageDefinition(name string) (StageDefinition, bool) {
 			return k, true
 		}
 	}
-	for _, d := range allDungeons {
+	for _, d := range registeredDungeons() {
 		if d.Name() == name {
 			return d, true
 		}
@@ -1,13 +1,30 @@
 package dungeon
 
 import (
+	"fmt"
+	"os"
 	"testing"
 
 	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/raw"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+// TestMain は本番の起動と同じく、埋め込みのローデータのダンジョンを登録してから走らせる
+func TestMain(m *testing.M) {
+	master, err := raw.LoadFromDir(raw.DefaultDir, "")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to load raw data: %v\n", err)
+		os.Exit(1)
+	}
+	if err := Register(master); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to register dungeons: %v\n", err)
+		os.Exit(1)
+	}
+	os.Exit(m.Run())
+}
+
 func TestGetAllDungeons(t *testing.T) {
 	t.Parallel()
 
@@ -64,43 +81,56 @@ func TestGetDungeonByName(t *testing.T) {
 	})
 }
 
+// findDungeon は登録済みのダンジョン定義を名前で引く
+func findDungeon(t *testing.T, name string) *DungeonDefinition {
+	t.Helper()
+	def, found := GetStageDefinition(name)
+	require.True(t, found)
+	d, ok := def.(*DungeonDefinition)
+	require.True(t, ok)
+	return d
+}
+
 func TestDefinitions(t *testing.T) {
 	t.Parallel()
 
-	t.Run("DungeonForestの設定が正しい", func(t *testing.T) {
+	t.Run("Dead forestの設定が正しい", func(t *testing.T) {
 		t.Parallel()
-		assert.Equal(t, "Dead forest", DungeonForest.Name())
-		assert.Equal(t, 20, DungeonForest.TotalFloors())
-		assert.Equal(t, "forest", DungeonForest.EnemyTableName())
-		assert.Equal(t, "forest", DungeonForest.ItemTableName())
-		assert.Equal(t, "Hunters once ventured into this frozen forest.\nFew returned. The cold reaches the bone.", DungeonForest.Description())
-		assert.Equal(t, "forest1", DungeonForest.ImageKey())
-		assert.Equal(t, 0, DungeonForest.BaseTemperature())
-		assert.NotEmpty(t, DungeonForest.PlannerPool())
+		forest := findDungeon(t, "Dead forest")
+		assert.Equal(t, "Dead forest", forest.Name())
+		assert.Equal(t, 20, forest.TotalFloors())
+		assert.Equal(t, "forest", forest.EnemyTableName())
+		assert.Equal(t, "forest", forest.ItemTableName())
+		assert.Equal(t, "Hunters once ventured into this frozen forest.\nFew returned. The cold reaches the bone.", forest.Description())
+		assert.Equal(t, "forest1", forest.ImageKey())
+		assert.Equal(t, 0, forest.BaseTemperature())
+		assert.NotEmpty(t, forest.PlannerPool())
 	})
 
-	t.Run("DungeonCaveの設定が正しい", func(t *testing.T) {
+	t.Run("Ash caveの設定が正しい", func(t *testing.T) {
 		t.Parallel()
-		assert.Equal(t, "Ash cave", DungeonCave.Name())
-		assert.Equal(t, 20, DungeonCave.TotalFloors())
-		assert.Equal(t, "cave", DungeonCave.EnemyTableName())
-		assert.Equal(t, "cave", DungeonCave.ItemTableName())
-		assert.Equal(t, "Frost crystals run like veins through the gray rock.\nThe deeper you go, the quieter it grows.", DungeonCave.Description())
-		assert.Equal(t, "cave1", DungeonCave.ImageKey())
-		assert.Equal(t, 5, DungeonCave.BaseTemperature())
-		assert.NotEmpty(t, DungeonCave.PlannerPool())
+		cave := findDungeon(t, "Ash cave")
+		assert.Equal(t, "Ash cave", cave.Name())
+		assert.Equal(t, 20, cave.TotalFloors())
+		assert.Equal(t, "cave", cave.EnemyTableName())
+		assert.Equal(t, "cave", cave.ItemTableName())
+		assert.Equal(t, "Frost crystals run like veins through the gray rock.\nThe deeper you go, the quieter it grows.", cave.Description())
+		assert.Equal(t, "cave1", cave.ImageKey())
+		assert.Equal(t, 5, cave.BaseTemperature())
+		assert.NotEmpty(t, cave.PlannerPool())
 	})
 
-	t.Run("DungeonRuinsの設定が正しい", func(t *testing.T) {
+	t.Run("Forgotten ruinsの設定が正しい", func(t *testing.T) {
 		t.Parallel()
-		assert.Equal(t, "Forgotten ruins", DungeonRuins.Name())
-		assert.Equal(t, 20, DungeonRuins.TotalFloors())
-		assert.Equal(t, "ruins_area", DungeonRuins.EnemyTableName())
-		assert.Equal(t, "ruins_area", DungeonRuins.ItemTableName())
-		assert.Equal(t, "An ancient city stands frozen in place.\nWho forgot what, no one remembers now.", DungeonRuins.Description())
-		assert.Equal(t, "city1", DungeonRuins.ImageKey())
-		assert.Equal(t, 15, DungeonRuins.BaseTemperature())
-		assert.NotEmpty(t, DungeonRuins.PlannerPool())
+		ruins := findDungeon(t, "Forgotten ruins")
+		assert.Equal(t, "Forgotten ruins", ruins.Name())
+		assert.Equal(t, 20, ruins.TotalFloors())
+		assert.Equal(t, "ruins_area", ruins.EnemyTableName())
+		assert.Equal(t, "ruins_area", ruins.ItemTableName())
+		assert.Equal(t, "An ancient city stands frozen in place.\nWho forgot what, no one remembers now.", ruins.Description())
+		assert.Equal(t, "city1", ruins.ImageKey())
+		assert.Equal(t, 15, ruins.BaseTemperature())
+		assert.NotEmpty(t, ruins.PlannerPool())
 	})
 
 	t.Run("DungeonOverworldの設定が正しい", func(t *testing.T) {
@@ -0,0 +1,149 @@
+package editorapi
+
+import (
+	"fmt"
+
+	"github.com/kijimaD/ruins/internal/oapi"
+)
+
+// collection はoapi.Raws内の1つの配列を指す。
+// 各エンドポイントはこれを通してインデックス指定のCRUDを行う
+type collection[T any] struct {
+	name  string
+	slice func(*oapi.Raws) **[]T
+}
+
+var (
+	itemsCollection = collection[oapi.Item]{
+		name: "items", slice: func(r *oapi.Raws) **[]oapi.Item { return &r.Items }}
+	membersCollection = collection[oapi.Member]{
+		name: "members", slice: func(r *oapi.Raws) **[]oapi.Member { return &r.Members }}
+	recipesCollection = collection[oapi.Recipe]{
+		name: "recipes", slice: func(r *oapi.Raws) **[]oapi.Recipe { return &r.Recipes }}
+	tilesCollection = collection[oapi.Tile]{
+		name: "tiles", slice: func(r *oapi.Raws) **[]oapi.Tile { return &r.Tiles }}
+	propsCollection = collection[oapi.Prop]{
+		name: "props", slice: func(r *oapi.Raws) **[]oapi.Prop { return &r.Props }}
+	professionsCollection = collection[oapi.Profession]{
+		name: "professions", slice: func(r *oapi.Raws) **[]oapi.Profession { return &r.Professions }}
+	commandTablesCollection = collection[oapi.CommandTable]{
+		name: "commandTables", slice: func(r *oapi.Raws) **[]oapi.CommandTable { return &r.CommandTables }}
+	dropTablesCollection = collection[oapi.DropTable]{
+		name: "dropTables", slice: func(r *oapi.Raws) **[]oapi.DropTable { return &r.DropTables }}
+	itemGroupsCollection = collection[oapi.ItemGroup]{
+		name: "itemGroups", slice: func(r *oapi.Raws) **[]oapi.ItemGroup { return &r.ItemGroups }}
+	itemTablesCollection = collection[oapi.ItemTable]{
+		name: "itemTables", slice: func(r *oapi.Raws) **[]oapi.ItemTable { return &r.ItemTables }}
+	enemyTablesCollection = collection[oapi.EnemyTable]{
+		name: "enemyTables", slice: func(r *oapi.Raws) **[]oapi.EnemyTable { return &r.EnemyTables }}
+	spriteSheetsCollection = collection[oapi.SpriteSheet]{
+		name: "spriteSheets", slice: func(r *oapi.Raws) **[]oapi.SpriteSheet { return &r.SpriteSheets }}
+	dungeonsCollection = collection[oapi.Dungeon]{
+		name: "dungeons", slice: func(r *oapi.Raws) **[]oapi.Dungeon { return &r.Dungeons }}
+	dialoguesCollection = collection[oapi.Dialogue]{
+		name: "dialogues", slice: func(r *oapi.Raws) **[]oapi.Dialogue { return &r.Dialogues }}
+	questsCollection = collection[oapi.Quest]{
+		name: "quests", slice: func(r *oapi.Raws) **[]oapi.Quest { return &r.Quests }}
+)
+
+// list は配列全体を返す。未定義の配列は空として扱う
+func list[T any](s *Server, c collection[T]) ([]T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	raws, err := s.loadRaws()
+	if err != nil {
+		return nil, err
+	}
+	data := *c.slice(&raws)
+	if data == nil {
+		return []T{}, nil
+	}
+	return *data, nil
+}
+
+// get は指定インデックスの要素を返す
+func get[T any](s *Server, c collection[T], index int) (T, error) {
+	var zero T
+	data, err := list(s, c)
+	if err != nil {
+		return zero, err
+	}
+	if index < 0 || index >= len(data) {
+		return zero, c.outOfRange(index)
+	}
+	return data[index], nil
+}
+
+// create は要素を追加して保存する。保存時に正規順へ並べ替えられる
+func create[T any](s *Server, c collection[T], body *T) (T, error) {
+	var zero T
+	if body == nil {
+		return zero, fmt.Errorf("%w: request body is required", errBadRequest)
+	}
+	err := s.modify(func(raws *oapi.Raws) error {
+		p := c.slice(raws)
+		if *p == nil {
+			*p = &[]T{}
+		}
+		**p = append(**p, *body)
+		return nil
+	})
+	if err != nil {
+		return zero, err
+	}
+	return *body, nil
+}
+
+// update は指定インデックスの要素を置き換えて保存する。保存時に正規順へ並べ替えられるので、
+// 名前など並びの鍵を書き換えた要素は別のインデックスへ移る。ダンジョンは定義順のまま動かない
+func update[T any](s *Server, c collection[T], index int, body *T) (T, error) {
+	var zero T
+	if body == nil {
+		return zero, fmt.Errorf("%w: request body is required", errBadRequest)
+	}
+	err := s.modify(func(raws *oapi.Raws) error {
+		p := c.slice(raws)
+		if *p == nil || index < 0 || index >= len(**p) {
+			return c.outOfRange(index)
+		}
+		(**p)[index] = *body
+		return nil
+	})
+	if err != nil {
+		return zero, err
+	}
+	return *body, nil
+}
+
+// remove は指定インデックスの要素を削除して保存する
+func remove[T any](s *Server, c collection[T], index int) error {
+	return s.modify(func(raws *oapi.Raws) error {
+		p := c.slice(raws)
+		if *p == nil || index < 0 || index >= len(**p) {
+			return c.outOfRange(index)
+		}
+		data := **p
+		**p = append(data[:index:index], data[index+1:]...)
+		return nil
+	})
+}
+
+// modify は読み込み・変更・検証・書き戻しを1つのロック内で行う
+func (s *Server) modify(fn func(*oapi.Raws) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	raws, err := s.loadRaws()
+	if err != nil {
+		return err
+	}
+	if err := fn(&raws); err != nil {
+		return err
+	}
+	return s.saveRaws(raws)
+}
+
+func (c collection[T]) outOfRange(index int) error {
+	return fmt.Errorf("%w: %s index out of range: %d", errNotFound, c.name, index)
+}
@@ -0,0 +1,7 @@
+// Package editorapi はゲームデータエディタ向けREST API(oas/openapi.yml)のGo実装を提供する。
+//
+// ローデータは raw.LayoutFiles の配置で断片ごとに読み書きする。変換はゲーム本体と同じ
+// raw.DecodeRaws / raw.EncodeRaws を使い、書き込み前に raw.ValidateRaws と
+// raw.ValidateReferences で検証する。
+// ゲームのロード時に弾かれるデータはエディタからも保存できない。
+package editorapi
@@ -0,0 +1,209 @@
+package editorapi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/kijimaD/ruins/internal/maptemplate"
+	"github.com/kijimaD/ruins/internal/oapi"
+	"github.com/pelletier/go-toml/v2"
+)
+
+// PalettesList はパレット一覧をID順で返す
+func (s *Server) PalettesList(_ context.Context, _ oapi.PalettesListRequestObject) (oapi.PalettesListResponseObject, error) {
+	data, err := s.listPalettes()
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.PalettesListdefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.PalettesList200JSONResponse(oapi.PaletteList{Data: data, TotalCount: len(data)}), nil
+}
+
+// PalettesGet はパレットを1件返す
+func (s *Server) PalettesGet(_ context.Context, req oapi.PalettesGetRequestObject) (oapi.PalettesGetResponseObject, error) {
+	p, err := s.loadPalette(req.Id)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.PalettesGetdefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.PalettesGet200JSONResponse(toAPIPalette(p)), nil
+}
+
+// PalettesCreate はパレットを新規保存する。同じIDのファイルがあれば上書きする
+func (s *Server) PalettesCreate(_ context.Context, req oapi.PalettesCreateRequestObject) (oapi.PalettesCreateResponseObject, error) {
+	if req.Body == nil {
+		body, status := errorResponse(fmt.Errorf("%w: request body is required", errBadRequest))
+		return oapi.PalettesCreatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	if err := s.savePalette(*req.Body); err != nil {
+		body, status := errorResponse(err)
+		return oapi.PalettesCreatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.PalettesCreate201JSONResponse(*req.Body), nil
+}
+
+// PalettesUpdate はパレットを上書き保存する。IDはパスの値を優先する
+func (s *Server) PalettesUpdate(_ context.Context, req oapi.PalettesUpdateRequestObject) (oapi.PalettesUpdateResponseObject, error) {
+	if req.Body == nil {
+		body, status := errorResponse(fmt.Errorf("%w: request body is required", errBadRequest))
+		return oapi.PalettesUpdatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	palette := *req.Body
+	palette.Id = req.Id
+	if err := s.savePalette(palette); err != nil {
+		body, status := errorResponse(err)
+		return oapi.PalettesUpdatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.PalettesUpdate200JSONResponse(palette), nil
+}
+
+// PalettesDelete はパレットファイルを削除する
+func (s *Server) PalettesDelete(_ context.Context, req oapi.PalettesDeleteRequestObject) (oapi.PalettesDeleteResponseObject, error) {
+	if err := s.deletePalette(req.Id); err != nil {
+		body, status := errorResponse(err)
+		return oapi.PalettesDeletedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.PalettesDelete204Response{}, nil
+}
+
+func (s *Server) listPalettes() ([]oapi.Palette, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries, err := os.ReadDir(s.paletteDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", s.paletteDir, err)
+	}
+	palettes := []oapi.Palette{}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".toml") {
+			continue
+		}
+		p, err := readPaletteFile(filepath.Join(s.paletteDir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		palettes = append(palettes, toAPIPalette(p))
+	}
+	slices.SortFunc(palettes, func(a, b oapi.Palette) int { return strings.Compare(a.Id, b.Id) })
+	return palettes, nil
+}
+
+func (s *Server) loadPalette(id string) (*maptemplate.Palette, error) {
+	path, err := s.palettePath(id)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return readPaletteFile(path)
+}
+
+// savePalette はゲームと同じパレット検証を通したうえで書き出す
+func (s *Server) savePalette(palette oapi.Palette) error {
+	path, err := s.palettePath(palette.Id)
+	if err != nil {
+		return err
+	}
+	content, err := toml.Marshal(maptemplate.PaletteFile{Palette: fromAPIPalette(palette)})
+	if err != nil {
+		return fmt.Errorf("failed to encode palette: %w", err)
+	}
+	if _, err := maptemplate.NewPaletteLoader().Load(bytes.NewReader(content)); err != nil {
+		return fmt.Errorf("%w: %w", errBadRequest, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return writeFileAtomic(path, content)
+}
+
+func (s *Server) deletePalette(id string) error {
+	path, err := s.palettePath(id)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := os.Remove(path); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("%w: palette %q", errNotFound, id)
+		}
+		return err
+	}
+	return nil
+}
+
+// palettePath はパレットIDをファイルパスにする。ディレクトリ外を指すIDは弾く
+func (s *Server) palettePath(id string) (string, error) {
+	if id == "" || id == "." || id == ".." || filepath.Base(id) != id {
+		return "", fmt.Errorf("%w: invalid palette id %q", errBadRequest, id)
+	}
+	return filepath.Join(s.paletteDir, id+".toml"), nil
+}
+
+func readPaletteFile(path string) (*maptemplate.Palette, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %s", errNotFound, filepath.Base(path))
+		}
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	p, err := maptemplate.NewPaletteLoader().Load(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return p, nil
+}
+
+func toAPIPalette(p *maptemplate.Palette) oapi.Palette {
+	convert := func(src map[string]maptemplate.PaletteEntry) map[string]oapi.PaletteEntry {
+		dst := make(map[string]oapi.PaletteEntry, len(src))
+		for k, v := range src {
+			dst[k] = oapi.PaletteEntry{Id: v.ID, Tile: v.Tile}
+		}
+		return dst
+	}
+	terrain := make(map[string]oapi.EntityName, len(p.Terrain))
+	for k, v := range p.Terrain {
+		terrain[k] = v
+	}
+	return oapi.Palette{
+		Id:          p.ID,
+		Description: p.Description,
+		Terrain:     terrain,
+		Props:       convert(p.Props),
+		Npcs:        convert(p.NPCs),
+	}
+}
+
+func fromAPIPalette(p oapi.Palette) maptemplate.Palette {
+	convert := func(src map[string]oapi.PaletteEntry) map[string]maptemplate.PaletteEntry {
+		dst := make(map[string]maptemplate.PaletteEntry, len(src))
+		for k, v := range src {
+			dst[k] = maptemplate.PaletteEntry{ID: v.Id, Tile: v.Tile}
+		}
+		return dst
+	}
+	terrain := make(map[string]string, len(p.Terrain))
+	for k, v := range p.Terrain {
+		terrain[k] = v
+	}
+	return maptemplate.Palette{
+		ID:          p.Id,
+		Description: p.Description,
+		Terrain:     terrain,
+		Props:       convert(p.Props),
+		NPCs:        convert(p.Npcs),
+	}
+}
@@ -0,0 +1,642 @@
+package editorapi
+
+import (
+	"context"
+
+	"github.com/kijimaD/ruins/internal/oapi"
+)
+
+// ItemsList はアイテム一覧を返す
+func (s *Server) ItemsList(_ context.Context, _ oapi.ItemsListRequestObject) (oapi.ItemsListResponseObject, error) {
+	data, err := list(s, itemsCollection)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.ItemsListdefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.ItemsList200JSONResponse(oapi.ItemList{Data: data, TotalCount: len(data)}), nil
+}
+
+// ItemsGet はアイテムを1件返す
+func (s *Server) ItemsGet(_ context.Context, req oapi.ItemsGetRequestObject) (oapi.ItemsGetResponseObject, error) {
+	v, err := get(s, itemsCollection, req.Index)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.ItemsGetdefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.ItemsGet200JSONResponse(v), nil
+}
+
+// ItemsCreate はアイテムを末尾に追加する
+func (s *Server) ItemsCreate(_ context.Context, req oapi.ItemsCreateRequestObject) (oapi.ItemsCreateResponseObject, error) {
+	v, err := create(s, itemsCollection, req.Body)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.ItemsCreatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.ItemsCreate201JSONResponse(v), nil
+}
+
+// ItemsUpdate はアイテムを置き換える
+func (s *Server) ItemsUpdate(_ context.Context, req oapi.ItemsUpdateRequestObject) (oapi.ItemsUpdateResponseObject, error) {
+	v, err := update(s, itemsCollection, req.Index, req.Body)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.ItemsUpdatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.ItemsUpdate200JSONResponse(v), nil
+}
+
+// ItemsDelete はアイテムを削除する
+func (s *Server) ItemsDelete(_ context.Context, req oapi.ItemsDeleteRequestObject) (oapi.ItemsDeleteResponseObject, error) {
+	if err := remove(s, itemsCollection, req.Index); err != nil {
+		body, status := errorResponse(err)
+		return oapi.ItemsDeletedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.ItemsDelete204Response{}, nil
+}
+
+// MembersList はメンバー一覧を返す
+func (s *Server) MembersList(_ context.Context, _ oapi.MembersListRequestObject) (oapi.MembersListResponseObject, error) {
+	data, err := list(s, membersCollection)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.MembersListdefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.MembersList200JSONResponse(oapi.MemberList{Data: data, TotalCount: len(data)}), nil
+}
+
+// MembersGet はメンバーを1件返す
+func (s *Server) MembersGet(_ context.Context, req oapi.MembersGetRequestObject) (oapi.MembersGetResponseObject, error) {
+	v, err := get(s, membersCollection, req.Index)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.MembersGetdefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.MembersGet200JSONResponse(v), nil
+}
+
+// MembersCreate はメンバーを末尾に追加する
+func (s *Server) MembersCreate(_ context.Context, req oapi.MembersCreateRequestObject) (oapi.MembersCreateResponseObject, error) {
+	v, err := create(s, membersCollection, req.Body)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.MembersCreatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.MembersCreate201JSONResponse(v), nil
+}
+
+// MembersUpdate はメンバーを置き換える
+func (s *Server) MembersUpdate(_ context.Context, req oapi.MembersUpdateRequestObject) (oapi.MembersUpdateResponseObject, error) {
+	v, err := update(s, membersCollection, req.Index, req.Body)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.MembersUpdatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.MembersUpdate200JSONResponse(v), nil
+}
+
+// MembersDelete はメンバーを削除する
+func (s *Server) MembersDelete(_ context.Context, req oapi.MembersDeleteRequestObject) (oapi.MembersDeleteResponseObject, error) {
+	if err := remove(s, membersCollection, req.Index); err != nil {
+		body, status := errorResponse(err)
+		return oapi.MembersDeletedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.MembersDelete204Response{}, nil
+}
+
+// RecipesList はレシピ一覧を返す
+func (s *Server) RecipesList(_ context.Context, _ oapi.RecipesListRequestObject) (oapi.RecipesListResponseObject, error) {
+	data, err := list(s, recipesCollection)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.RecipesListdefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.RecipesList200JSONResponse(oapi.RecipeList{Data: data, TotalCount: len(data)}), nil
+}
+
+// RecipesGet はレシピを1件返す
+func (s *Server) RecipesGet(_ context.Context, req oapi.RecipesGetRequestObject) (oapi.RecipesGetResponseObject, error) {
+	v, err := get(s, recipesCollection, req.Index)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.RecipesGetdefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.RecipesGet200JSONResponse(v), nil
+}
+
+// RecipesCreate はレシピを末尾に追加する
+func (s *Server) RecipesCreate(_ context.Context, req oapi.RecipesCreateRequestObject) (oapi.RecipesCreateResponseObject, error) {
+	v, err := create(s, recipesCollection, req.Body)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.RecipesCreatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.RecipesCreate201JSONResponse(v), nil
+}
+
+// RecipesUpdate はレシピを置き換える
+func (s *Server) RecipesUpdate(_ context.Context, req oapi.RecipesUpdateRequestObject) (oapi.RecipesUpdateResponseObject, error) {
+	v, err := update(s, recipesCollection, req.Index, req.Body)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.RecipesUpdatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.RecipesUpdate200JSONResponse(v), nil
+}
+
+// RecipesDelete はレシピを削除する
+func (s *Server) RecipesDelete(_ context.Context, req oapi.RecipesDeleteRequestObject) (oapi.RecipesDeleteResponseObject, error) {
+	if err := remove(s, recipesCollection, req.Index); err != nil {
+		body, status := errorResponse(err)
+		return oapi.RecipesDeletedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.RecipesDelete204Response{}, nil
+}
+
+// TilesList はタイル一覧を返す
+func (s *Server) TilesList(_ context.Context, _ oapi.TilesListRequestObject) (oapi.TilesListResponseObject, error) {
+	data, err := list(s, tilesCollection)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.TilesListdefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.TilesList200JSONResponse(oapi.TileList{Data: data, TotalCount: len(data)}), nil
+}
+
+// TilesGet はタイルを1件返す
+func (s *Server) TilesGet(_ context.Context, req oapi.TilesGetRequestObject) (oapi.TilesGetResponseObject, error) {
+	v, err := get(s, tilesCollection, req.Index)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.TilesGetdefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.TilesGet200JSONResponse(v), nil
+}
+
+// TilesCreate はタイルを末尾に追加する
+func (s *Server) TilesCreate(_ context.Context, req oapi.TilesCreateRequestObject) (oapi.TilesCreateResponseObject, error) {
+	v, err := create(s, tilesCollection, req.Body)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.TilesCreatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.TilesCreate201JSONResponse(v), nil
+}
+
+// TilesUpdate はタイルを置き換える
+func (s *Server) TilesUpdate(_ context.Context, req oapi.TilesUpdateRequestObject) (oapi.TilesUpdateResponseObject, error) {
+	v, err := update(s, tilesCollection, req.Index, req.Body)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.TilesUpdatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.TilesUpdate200JSONResponse(v), nil
+}
+
+// TilesDelete はタイルを削除する
+func (s *Server) TilesDelete(_ context.Context, req oapi.TilesDeleteRequestObject) (oapi.TilesDeleteResponseObject, error) {
+	if err := remove(s, tilesCollection, req.Index); err != nil {
+		body, status := errorResponse(err)
+		return oapi.TilesDeletedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.TilesDelete204Response{}, nil
+}
+
+// PropsList は置物一覧を返す
+func (s *Server) PropsList(_ context.Context, _ oapi.PropsListRequestObject) (oapi.PropsListResponseObject, error) {
+	data, err := list(s, propsCollection)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.PropsListdefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.PropsList200JSONResponse(oapi.PropList{Data: data, TotalCount: len(data)}), nil
+}
+
+// PropsGet は置物を1件返す
+func (s *Server) PropsGet(_ context.Context, req oapi.PropsGetRequestObject) (oapi.PropsGetResponseObject, error) {
+	v, err := get(s, propsCollection, req.Index)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.PropsGetdefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.PropsGet200JSONResponse(v), nil
+}
+
+// PropsCreate は置物を末尾に追加する
+func (s *Server) PropsCreate(_ context.Context, req oapi.PropsCreateRequestObject) (oapi.PropsCreateResponseObject, error) {
+	v, err := create(s, propsCollection, req.Body)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.PropsCreatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.PropsCreate201JSONResponse(v), nil
+}
+
+// PropsUpdate は置物を置き換える
+func (s *Server) PropsUpdate(_ context.Context, req oapi.PropsUpdateRequestObject) (oapi.PropsUpdateResponseObject, error) {
+	v, err := update(s, propsCollection, req.Index, req.Body)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.PropsUpdatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.PropsUpdate200JSONResponse(v), nil
+}
+
+// PropsDelete は置物を削除する
+func (s *Server) PropsDelete(_ context.Context, req oapi.PropsDeleteRequestObject) (oapi.PropsDeleteResponseObject, error) {
+	if err := remove(s, propsCollection, req.Index); err != nil {
+		body, status := errorResponse(err)
+		return oapi.PropsDeletedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.PropsDelete204Response{}, nil
+}
+
+// ProfessionsList は職業一覧を返す
+func (s *Server) ProfessionsList(_ context.Context, _ oapi.ProfessionsListRequestObject) (oapi.ProfessionsListResponseObject, error) {
+	data, err := list(s, professionsCollection)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.ProfessionsListdefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.ProfessionsList200JSONResponse(oapi.ProfessionList{Data: data, TotalCount: len(data)}), nil
+}
+
+// ProfessionsCreate は職業を末尾に追加する
+func (s *Server) ProfessionsCreate(_ context.Context, req oapi.ProfessionsCreateRequestObject) (oapi.ProfessionsCreateResponseObject, error) {
+	v, err := create(s, professionsCollection, req.Body)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.ProfessionsCreatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.ProfessionsCreate201JSONResponse(v), nil
+}
+
+// ProfessionsUpdate は職業を置き換える
+func (s *Server) ProfessionsUpdate(_ context.Context, req oapi.ProfessionsUpdateRequestObject) (oapi.ProfessionsUpdateResponseObject, error) {
+	v, err := update(s, professionsCollection, req.Index, req.Body)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.ProfessionsUpdatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.ProfessionsUpdate200JSONResponse(v), nil
+}
+
+// ProfessionsDelete は職業を削除する
+func (s *Server) ProfessionsDelete(_ context.Context, req oapi.ProfessionsDeleteRequestObject) (oapi.ProfessionsDeleteResponseObject, error) {
+	if err := remove(s, professionsCollection, req.Index); err != nil {
+		body, status := errorResponse(err)
+		return oapi.ProfessionsDeletedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.ProfessionsDelete204Response{}, nil
+}
+
+// CommandTablesList はコマンドテーブル一覧を返す
+func (s *Server) CommandTablesList(_ context.Context, _ oapi.CommandTablesListRequestObject) (oapi.CommandTablesListResponseObject, error) {
+	data, err := list(s, commandTablesCollection)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.CommandTablesListdefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.CommandTablesList200JSONResponse(oapi.CommandTableList{Data: data, TotalCount: len(data)}), nil
+}
+
+// CommandTablesCreate はコマンドテーブルを末尾に追加する
+func (s *Server) CommandTablesCreate(_ context.Context, req oapi.CommandTablesCreateRequestObject) (oapi.CommandTablesCreateResponseObject, error) {
+	v, err := create(s, commandTablesCollection, req.Body)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.CommandTablesCreatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.CommandTablesCreate201JSONResponse(v), nil
+}
+
+// CommandTablesUpdate はコマンドテーブルを置き換える
+func (s *Server) CommandTablesUpdate(_ context.Context, req oapi.CommandTablesUpdateRequestObject) (oapi.CommandTablesUpdateResponseObject, error) {
+	v, err := update(s, commandTablesCollection, req.Index, req.Body)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.CommandTablesUpdatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.CommandTablesUpdate200JSONResponse(v), nil
+}
+
+// CommandTablesDelete はコマンドテーブルを削除する
+func (s *Server) CommandTablesDelete(_ context.Context, req oapi.CommandTablesDeleteRequestObject) (oapi.CommandTablesDeleteResponseObject, error) {
+	if err := remove(s, commandTablesCollection, req.Index); err != nil {
+		body, status := errorResponse(err)
+		return oapi.CommandTablesDeletedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.CommandTablesDelete204Response{}, nil
+}
+
+// DropTablesList はドロップテーブル一覧を返す
+func (s *Server) DropTablesList(_ context.Context, _ oapi.DropTablesListRequestObject) (oapi.DropTablesListResponseObject, error) {
+	data, err := list(s, dropTablesCollection)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.DropTablesListdefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.DropTablesList200JSONResponse(oapi.DropTableList{Data: data, TotalCount: len(data)}), nil
+}
+
+// DropTablesCreate はドロップテーブルを末尾に追加する
+func (s *Server) DropTablesCreate(_ context.Context, req oapi.DropTablesCreateRequestObject) (oapi.DropTablesCreateResponseObject, error) {
+	v, err := create(s, dropTablesCollection, req.Body)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.DropTablesCreatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.DropTablesCreate201JSONResponse(v), nil
+}
+
+// DropTablesUpdate はドロップテーブルを置き換える
+func (s *Server) DropTablesUpdate(_ context.Context, req oapi.DropTablesUpdateRequestObject) (oapi.DropTablesUpdateResponseObject, error) {
+	v, err := update(s, dropTablesCollection, req.Index, req.Body)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.DropTablesUpdatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.DropTablesUpdate200JSONResponse(v), nil
+}
+
+// DropTablesDelete はドロップテーブルを削除する
+func (s *Server) DropTablesDelete(_ context.Context, req oapi.DropTablesDeleteRequestObject) (oapi.DropTablesDeleteResponseObject, error) {
+	if err := remove(s, dropTablesCollection, req.Index); err != nil {
+		body, status := errorResponse(err)
+		return oapi.DropTablesDeletedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.DropTablesDelete204Response{}, nil
+}
+
+// ItemGroupsList はアイテムグループ一覧を返す
+func (s *Server) ItemGroupsList(_ context.Context, _ oapi.ItemGroupsListRequestObject) (oapi.ItemGroupsListResponseObject, error) {
+	data, err := list(s, itemGroupsCollection)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.ItemGroupsListdefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.ItemGroupsList200JSONResponse(oapi.ItemGroupList{Data: data, TotalCount: len(data)}), nil
+}
+
+// ItemGroupsCreate はアイテムグループを末尾に追加する
+func (s *Server) ItemGroupsCreate(_ context.Context, req oapi.ItemGroupsCreateRequestObject) (oapi.ItemGroupsCreateResponseObject, error) {
+	v, err := create(s, itemGroupsCollection, req.Body)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.ItemGroupsCreatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.ItemGroupsCreate201JSONResponse(v), nil
+}
+
+// ItemGroupsUpdate はアイテムグループを置き換える
+func (s *Server) ItemGroupsUpdate(_ context.Context, req oapi.ItemGroupsUpdateRequestObject) (oapi.ItemGroupsUpdateResponseObject, error) {
+	v, err := update(s, itemGroupsCollection, req.Index, req.Body)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.ItemGroupsUpdatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.ItemGroupsUpdate200JSONResponse(v), nil
+}
+
+// ItemGroupsDelete はアイテムグループを削除する
+func (s *Server) ItemGroupsDelete(_ context.Context, req oapi.ItemGroupsDeleteRequestObject) (oapi.ItemGroupsDeleteResponseObject, error) {
+	if err := remove(s, itemGroupsCollection, req.Index); err != nil {
+		body, status := errorResponse(err)
+		return oapi.ItemGroupsDeletedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.ItemGroupsDelete204Response{}, nil
+}
+
+// ItemTablesList はアイテムテーブル一覧を返す
+func (s *Server) ItemTablesList(_ context.Context, _ oapi.ItemTablesListRequestObject) (oapi.ItemTablesListResponseObject, error) {
+	data, err := list(s, itemTablesCollection)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.ItemTablesListdefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.ItemTablesList200JSONResponse(oapi.ItemTableList{Data: data, TotalCount: len(data)}), nil
+}
+
+// ItemTablesCreate はアイテムテーブルを末尾に追加する
+func (s *Server) ItemTablesCreate(_ context.Context, req oapi.ItemTablesCreateRequestObject) (oapi.ItemTablesCreateResponseObject, error) {
+	v, err := create(s, itemTablesCollection, req.Body)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.ItemTablesCreatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.ItemTablesCreate201JSONResponse(v), nil
+}
+
+// ItemTablesUpdate はアイテムテーブルを置き換える
+func (s *Server) ItemTablesUpdate(_ context.Context, req oapi.ItemTablesUpdateRequestObject) (oapi.ItemTablesUpdateResponseObject, error) {
+	v, err := update(s, itemTablesCollection, req.Index, req.Body)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.ItemTablesUpdatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.ItemTablesUpdate200JSONResponse(v), nil
+}
+
+// ItemTablesDelete はアイテムテーブルを削除する
+func (s *Server) ItemTablesDelete(_ context.Context, req oapi.ItemTablesDeleteRequestObject) (oapi.ItemTablesDeleteResponseObject, error) {
+	if err := remove(s, itemTablesCollection, req.Index); err != nil {
+		body, status := errorResponse(err)
+		return oapi.ItemTablesDeletedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.ItemTablesDelete204Response{}, nil
+}
+
+// EnemyTablesList は敵テーブル一覧を返す
+func (s *Server) EnemyTablesList(_ context.Context, _ oapi.EnemyTablesListRequestObject) (oapi.EnemyTablesListResponseObject, error) {
+	data, err := list(s, enemyTablesCollection)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.EnemyTablesListdefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.EnemyTablesList200JSONResponse(oapi.EnemyTableList{Data: data, TotalCount: len(data)}), nil
+}
+
+// EnemyTablesCreate は敵テーブルを末尾に追加する
+func (s *Server) EnemyTablesCreate(_ context.Context, req oapi.EnemyTablesCreateRequestObject) (oapi.EnemyTablesCreateResponseObject, error) {
+	v, err := create(s, enemyTablesCollection, req.Body)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.EnemyTablesCreatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.EnemyTablesCreate201JSONResponse(v), nil
+}
+
+// EnemyTablesUpdate は敵テーブルを置き換える
+func (s *Server) EnemyTablesUpdate(_ context.Context, req oapi.EnemyTablesUpdateRequestObject) (oapi.EnemyTablesUpdateResponseObject, error) {
+	v, err := update(s, enemyTablesCollection, req.Index, req.Body)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.EnemyTablesUpdatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.EnemyTablesUpdate200JSONResponse(v), nil
+}
+
+// EnemyTablesDelete は敵テーブルを削除する
+func (s *Server) EnemyTablesDelete(_ context.Context, req oapi.EnemyTablesDeleteRequestObject) (oapi.EnemyTablesDeleteResponseObject, error) {
+	if err := remove(s, enemyTablesCollection, req.Index); err != nil {
+		body, status := errorResponse(err)
+		return oapi.EnemyTablesDeletedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.EnemyTablesDelete204Response{}, nil
+}
+
+// SpriteSheetsList はスプライトシート一覧を返す
+func (s *Server) SpriteSheetsList(_ context.Context, _ oapi.SpriteSheetsListRequestObject) (oapi.SpriteSheetsListResponseObject, error) {
+	data, err := list(s, spriteSheetsCollection)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.SpriteSheetsListdefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.SpriteSheetsList200JSONResponse(oapi.SpriteSheetList{Data: data, TotalCount: len(data)}), nil
+}
+
+// SpriteSheetsCreate はスプライトシートを末尾に追加する
+func (s *Server) SpriteSheetsCreate(_ context.Context, req oapi.SpriteSheetsCreateRequestObject) (oapi.SpriteSheetsCreateResponseObject, error) {
+	v, err := create(s, spriteSheetsCollection, req.Body)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.SpriteSheetsCreatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.SpriteSheetsCreate201JSONResponse(v), nil
+}
+
+// SpriteSheetsUpdate はスプライトシートを置き換える
+func (s *Server) SpriteSheetsUpdate(_ context.Context, req oapi.SpriteSheetsUpdateRequestObject) (oapi.SpriteSheetsUpdateResponseObject, error) {
+	v, err := update(s, spriteSheetsCollection, req.Index, req.Body)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.SpriteSheetsUpdatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.SpriteSheetsUpdate200JSONResponse(v), nil
+}
+
+// SpriteSheetsDelete はスプライトシートを削除する
+func (s *Server) SpriteSheetsDelete(_ context.Context, req oapi.SpriteSheetsDeleteRequestObject) (oapi.SpriteSheetsDeleteResponseObject, error) {
+	if err := remove(s, spriteSheetsCollection, req.Index); err != nil {
+		body, status := errorResponse(err)
+		return oapi.SpriteSheetsDeletedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.SpriteSheetsDelete204Response{}, nil
+}
+
+// DungeonsList はダンジョン一覧を返す
+func (s *Server) DungeonsList(_ context.Context, _ oapi.DungeonsListRequestObject) (oapi.DungeonsListResponseObject, error) {
+	data, err := list(s, dungeonsCollection)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.DungeonsListdefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.DungeonsList200JSONResponse(oapi.DungeonList{Data: data, TotalCount: len(data)}), nil
+}
+
+// DungeonsCreate はダンジョンを末尾に追加する
+func (s *Server) DungeonsCreate(_ context.Context, req oapi.DungeonsCreateRequestObject) (oapi.DungeonsCreateResponseObject, error) {
+	v, err := create(s, dungeonsCollection, req.Body)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.DungeonsCreatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.DungeonsCreate201JSONResponse(v), nil
+}
+
+// DungeonsUpdate はダンジョンを置き換える
+func (s *Server) DungeonsUpdate(_ context.Context, req oapi.DungeonsUpdateRequestObject) (oapi.DungeonsUpdateResponseObject, error) {
+	v, err := update(s, dungeonsCollection, req.Index, req.Body)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.DungeonsUpdatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.DungeonsUpdate200JSONResponse(v), nil
+}
+
+// DungeonsDelete はダンジョンを削除する
+func (s *Server) DungeonsDelete(_ context.Context, req oapi.DungeonsDeleteRequestObject) (oapi.DungeonsDeleteResponseObject, error) {
+	if err := remove(s, dungeonsCollection, req.Index); err != nil {
+		body, status := errorResponse(err)
+		return oapi.DungeonsDeletedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.DungeonsDelete204Response{}, nil
+}
+
+// DialoguesList は会話一覧を返す
+func (s *Server) DialoguesList(_ context.Context, _ oapi.DialoguesListRequestObject) (oapi.DialoguesListResponseObject, error) {
+	data, err := list(s, dialoguesCollection)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.DialoguesListdefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.DialoguesList200JSONResponse(oapi.DialogueList{Data: data, TotalCount: len(data)}), nil
+}
+
+// DialoguesCreate は会話を末尾に追加する
+func (s *Server) DialoguesCreate(_ context.Context, req oapi.DialoguesCreateRequestObject) (oapi.DialoguesCreateResponseObject, error) {
+	v, err := create(s, dialoguesCollection, req.Body)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.DialoguesCreatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.DialoguesCreate201JSONResponse(v), nil
+}
+
+// DialoguesUpdate は会話を置き換える
+func (s *Server) DialoguesUpdate(_ context.Context, req oapi.DialoguesUpdateRequestObject) (oapi.DialoguesUpdateResponseObject, error) {
+	v, err := update(s, dialoguesCollection, req.Index, req.Body)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.DialoguesUpdatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.DialoguesUpdate200JSONResponse(v), nil
+}
+
+// DialoguesDelete は会話を削除する
+func (s *Server) DialoguesDelete(_ context.Context, req oapi.DialoguesDeleteRequestObject) (oapi.DialoguesDeleteResponseObject, error) {
+	if err := remove(s, dialoguesCollection, req.Index); err != nil {
+		body, status := errorResponse(err)
+		return oapi.DialoguesDeletedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.DialoguesDelete204Response{}, nil
+}
+
+// QuestsList はクエスト一覧を返す
+func (s *Server) QuestsList(_ context.Context, _ oapi.QuestsListRequestObject) (oapi.QuestsListResponseObject, error) {
+	data, err := list(s, questsCollection)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.QuestsListdefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.QuestsList200JSONResponse(oapi.QuestList{Data: data, TotalCount: len(data)}), nil
+}
+
+// QuestsCreate はクエストを末尾に追加する
+func (s *Server) QuestsCreate(_ context.Context, req oapi.QuestsCreateRequestObject) (oapi.QuestsCreateResponseObject, error) {
+	v, err := create(s, questsCollection, req.Body)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.QuestsCreatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.QuestsCreate201JSONResponse(v), nil
+}
+
+// QuestsUpdate はクエストを置き換える
+func (s *Server) QuestsUpdate(_ context.Context, req oapi.QuestsUpdateRequestObject) (oapi.QuestsUpdateResponseObject, error) {
+	v, err := update(s, questsCollection, req.Index, req.Body)
+	if err != nil {
+		body, status := errorResponse(err)
+		return oapi.QuestsUpdatedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.QuestsUpdate200JSONResponse(v), nil
+}
+
+// QuestsDelete はクエストを削除する
+func (s *Server) QuestsDelete(_ context.Context, req oapi.QuestsDeleteRequestObject) (oapi.QuestsDeleteResponseObject, error) {
+	if err := remove(s, questsCollection, req.Index); err != nil {
+		body, status := errorResponse(err)
+		return oapi.QuestsDeletedefaultJSONResponse{Body: body, StatusCode: status}, nil
+	}
+	return oapi.QuestsDelete204Response{}, nil
+}
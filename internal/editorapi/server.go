@@ -0,0 +1,141 @@
+package editorapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/kijimaD/ruins/internal/dungeon"
+	"github.com/kijimaD/ruins/internal/oapi"
+	"github.com/kijimaD/ruins/internal/raw"
+)
+
+// 既定のデータ配置。リポジトリルートで起動する前提の相対パス
+const (
+	DefaultRawDir     = "assets/metadata/entities/raw"
+	DefaultPaletteDir = "assets/levels/palettes"
+)
+
+var (
+	errNotFound   = errors.New("not found")
+	errBadRequest = errors.New("bad request")
+)
+
+// Server は oapi.StrictServerInterface の実装。
+// 外部エディタでの編集と競合しないよう、リクエストごとにファイルから読み直す
+type Server struct {
+	rawDir     string
+	paletteDir string
+	// 読み込みから書き戻しまでを直列化する
+	mu sync.Mutex
+}
+
+var _ oapi.StrictServerInterface = (*Server)(nil)
+
+// NewServer はServerを生成する。rawDir は本体の配置（raw.LayoutFiles）で断片を置くディレクトリ
+func NewServer(rawDir, paletteDir string) *Server {
+	return &Server{rawDir: rawDir, paletteDir: paletteDir}
+}
+
+// Handler は生成コードのルーティングを通した http.Handler を返す
+func (s *Server) Handler() http.Handler {
+	return oapi.Handler(oapi.NewStrictHandler(s, nil))
+}
+
+// loadRaws は本体の配置どおりの断片を読んで結合し、正規順に並べ替える。スキーマ検証はしない。
+// 壊れたデータでもエディタから開いて直せるようにするため。配置に無い断片は編集の対象にしない
+func (s *Server) loadRaws() (oapi.Raws, error) {
+	frags := map[string]oapi.Raws{}
+	for _, file := range raw.LayoutFiles() {
+		p := filepath.Join(s.rawDir, filepath.FromSlash(file))
+		bs, err := os.ReadFile(p)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return oapi.Raws{}, fmt.Errorf("failed to read %s: %w", p, err)
+		}
+		frag, err := raw.DecodeRaws(string(bs))
+		if err != nil {
+			return oapi.Raws{}, fmt.Errorf("%s: %w", p, err)
+		}
+		frags[file] = frag
+	}
+	raws := raw.JoinRaws(frags)
+	raw.SortRaws(&raws)
+	return raws, nil
+}
+
+// saveRaws はゲームのロード時と同じ検証を通したうえで、本体の配置どおりの断片へ書き戻す。
+// ダンジョンのプランナー名は raw では引けないため、起動時と同じく定義を組んで確かめる
+func (s *Server) saveRaws(raws oapi.Raws) error {
+	if err := raw.ValidateRaws(raws); err != nil {
+		return fmt.Errorf("%w: %w", errBadRequest, err)
+	}
+	if err := raw.ValidateReferences(raws, nil); err != nil {
+		return fmt.Errorf("%w: %w", errBadRequest, err)
+	}
+	if _, err := dungeon.NewDungeonDefinitions(raw.NewMaster(raws)); err != nil {
+		return fmt.Errorf("%w: %w", errBadRequest, err)
+	}
+	raw.SortRaws(&raws)
+	// 全断片をエンコードできてから書き始め、途中のエンコード失敗でファイルが食い違わないようにする
+	frags := raw.SplitRaws(raws)
+	contents := make(map[string]string, len(frags))
+	for file, frag := range frags {
+		content, err := raw.EncodeRaws(frag)
+		if err != nil {
+			return err
+		}
+		contents[file] = content
+	}
+	for _, file := range raw.LayoutFiles() {
+		p := filepath.Join(s.rawDir, filepath.FromSlash(file))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			return fmt.Errorf("failed to create %s: %w", filepath.Dir(p), err)
+		}
+		if err := writeFileAtomic(p, []byte(contents[file])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeFileAtomic は書き込み途中で中断しても元ファイルを壊さないよう、一時ファイル経由で置き換える
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".editorapi-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write temp file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close temp file: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return fmt.Errorf("failed to chmod temp file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to replace %s: %w", path, err)
+	}
+	return nil
+}
+
+// errorResponse はエラーをレスポンスのステータスコードと本文に変換する
+func errorResponse(err error) (oapi.Error, int) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, errNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, errBadRequest):
+		status = http.StatusBadRequest
+	}
+	return oapi.Error{Message: err.Error()}, status
+}
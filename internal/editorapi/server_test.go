@@ -0,0 +1,237 @@
+package editorapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kijimaD/ruins/assets"
+	"github.com/kijimaD/ruins/internal/oapi"
+	"github.com/kijimaD/ruins/internal/raw"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestServer は同梱データの複製を参照するサーバを作る
+func newTestServer(t *testing.T) (http.Handler, string, string) {
+	t.Helper()
+	dir := t.TempDir()
+
+	rawDir := filepath.Join(dir, "raw")
+	sub, err := fs.Sub(assets.FS, raw.DefaultDir)
+	require.NoError(t, err)
+	require.NoError(t, os.CopyFS(rawDir, sub))
+
+	paletteDir := filepath.Join(dir, "palettes")
+	require.NoError(t, os.Mkdir(paletteDir, 0755))
+	bs, err := assets.FS.ReadFile("levels/palettes/town.toml")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(paletteDir, "town.toml"), bs, 0644))
+
+	return NewServer(rawDir, paletteDir).Handler(), rawDir, paletteDir
+}
+
+// readRawDir は断片のパスから内容への対応を返す。書き戻しの有無を比べるのに使う
+func readRawDir(t *testing.T, dir string) map[string]string {
+	t.Helper()
+	contents := map[string]string{}
+	for _, file := range raw.LayoutFiles() {
+		bs, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(file)))
+		require.NoError(t, err)
+		contents[file] = string(bs)
+	}
+	return contents
+}
+
+func doJSON(t *testing.T, h http.Handler, method, path string, body any, out any) int {
+	t.Helper()
+	var reader *bytes.Reader
+	if body != nil {
+		bs, err := json.Marshal(body)
+		require.NoError(t, err)
+		reader = bytes.NewReader(bs)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+	req := httptest.NewRequest(method, path, reader)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if out != nil && rec.Code < 300 {
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), out))
+	}
+	return rec.Code
+}
+
+func TestItems_一覧と取得ができる(t *testing.T) {
+	t.Parallel()
+	h, _, _ := newTestServer(t)
+
+	var list oapi.ItemList
+	require.Equal(t, http.StatusOK, doJSON(t, h, http.MethodGet, "/api/v1/items", nil, &list))
+	require.NotEmpty(t, list.Data)
+	assert.Equal(t, len(list.Data), list.TotalCount)
+
+	var item oapi.Item
+	require.Equal(t, http.StatusOK, doJSON(t, h, http.MethodGet, "/api/v1/items/0", nil, &item))
+	assert.Equal(t, list.Data[0].Name, item.Name)
+
+	assert.Equal(t, http.StatusNotFound, doJSON(t, h, http.MethodGet, "/api/v1/items/100000", nil, nil))
+}
+
+func TestItems_更新内容がファイルに書き戻される(t *testing.T) {
+	t.Parallel()
+	h, rawDir, _ := newTestServer(t)
+
+	var item oapi.Item
+	require.Equal(t, http.StatusOK, doJSON(t, h, http.MethodGet, "/api/v1/items/0", nil, &item))
+	item.Description = "書き換えた説明"
+	require.Equal(t, http.StatusOK, doJSON(t, h, http.MethodPut, "/api/v1/items/0", item, nil))
+
+	var got oapi.Item
+	require.Equal(t, http.StatusOK, doJSON(t, h, http.MethodGet, "/api/v1/items/0", nil, &got))
+	assert.Equal(t, "書き換えた説明", got.Description)
+
+	contents := readRawDir(t, rawDir)
+	assert.Contains(t, contents["items/items.toml"], `description = "書き換えた説明"`)
+	assert.NotContains(t, contents["props/props.toml"], `[[items]]`, "アイテムは配置どおりの断片にだけ書く")
+}
+
+func TestItemTables_参照切れは400で拒否されファイルは変わらない(t *testing.T) {
+	t.Parallel()
+	h, rawDir, _ := newTestServer(t)
+	before := readRawDir(t, rawDir)
+
+	var list oapi.ItemTableList
+	require.Equal(t, http.StatusOK, doJSON(t, h, http.MethodGet, "/api/v1/item-tables", nil, &list))
+	require.NotEmpty(t, list.Data)
+	table := list.Data[0]
+	table.Entries = append(table.Entries, oapi.ItemTableEntry{Id: "存在しないグループ", Weight: 1, MinDanger: 1, MaxDanger: 1})
+
+	assert.Equal(t, http.StatusBadRequest, doJSON(t, h, http.MethodPut, "/api/v1/item-tables/0", table, nil))
+
+	assert.Equal(t, before, readRawDir(t, rawDir))
+}
+
+func TestDungeons_未登録のプランナーは400で拒否されファイルは変わらない(t *testing.T) {
+	t.Parallel()
+	h, rawDir, _ := newTestServer(t)
+	before := readRawDir(t, rawDir)
+
+	var list oapi.DungeonList
+	require.Equal(t, http.StatusOK, doJSON(t, h, http.MethodGet, "/api/v1/dungeons", nil, &list))
+	require.NotEmpty(t, list.Data)
+
+	planners := list.Data[0]
+	planners.Planners = append(planners.Planners, oapi.DungeonPlanner{Planner: "存在しないプランナー", Weight: 1})
+	assert.Equal(t, http.StatusBadRequest, doJSON(t, h, http.MethodPut, "/api/v1/dungeons/0", planners, nil))
+
+	boss := list.Data[0]
+	bossPlanner := "存在しないボス"
+	boss.BossPlanner = &bossPlanner
+	assert.Equal(t, http.StatusBadRequest, doJSON(t, h, http.MethodPut, "/api/v1/dungeons/0", boss, nil))
+
+	assert.Equal(t, before, readRawDir(t, rawDir))
+}
+
+func TestDungeons_保存しても定義順を保つ(t *testing.T) {
+	t.Parallel()
+	h, _, _ := newTestServer(t)
+
+	var before oapi.DungeonList
+	require.Equal(t, http.StatusOK, doJSON(t, h, http.MethodGet, "/api/v1/dungeons", nil, &before))
+	require.NotEmpty(t, before.Data)
+	assert.Equal(t, "Dead forest", before.Data[0].Name, "名前順ではなく raw の定義順で返す")
+	require.Equal(t, http.StatusOK, doJSON(t, h, http.MethodPut, "/api/v1/dungeons/0", before.Data[0], nil))
+
+	var after oapi.DungeonList
+	require.Equal(t, http.StatusOK, doJSON(t, h, http.MethodGet, "/api/v1/dungeons", nil, &after))
+	assert.Equal(t, before.Data, after.Data)
+}
+
+func TestSpriteSheets_名前を変えると正規順の位置へ移る(t *testing.T) {
+	t.Parallel()
+	h, _, _ := newTestServer(t)
+
+	var before oapi.SpriteSheetList
+	require.Equal(t, http.StatusOK, doJSON(t, h, http.MethodGet, "/api/v1/sprite-sheets", nil, &before))
+	require.Greater(t, len(before.Data), 1)
+	renamed := before.Data[0]
+	renamed.Name = "zzz_renamed"
+	require.Equal(t, http.StatusOK, doJSON(t, h, http.MethodPut, "/api/v1/sprite-sheets/0", renamed, nil))
+
+	var after oapi.SpriteSheetList
+	require.Equal(t, http.StatusOK, doJSON(t, h, http.MethodGet, "/api/v1/sprite-sheets", nil, &after))
+	assert.Equal(t, before.Data[1], after.Data[0], "更新した要素は元の位置に残らない")
+	assert.Equal(t, renamed, after.Data[len(after.Data)-1], "名前順の末尾へ移る")
+}
+
+func TestSpriteSheets_追加と削除ができる(t *testing.T) {
+	t.Parallel()
+	h, _, _ := newTestServer(t)
+
+	var before oapi.SpriteSheetList
+	require.Equal(t, http.StatusOK, doJSON(t, h, http.MethodGet, "/api/v1/sprite-sheets", nil, &before))
+
+	sheet := oapi.SpriteSheet{Name: "aaa_test", Path: "file/textures/test.png"}
+	require.Equal(t, http.StatusCreated, doJSON(t, h, http.MethodPost, "/api/v1/sprite-sheets", sheet, nil))
+
+	var after oapi.SpriteSheetList
+	require.Equal(t, http.StatusOK, doJSON(t, h, http.MethodGet, "/api/v1/sprite-sheets", nil, &after))
+	require.Equal(t, before.TotalCount+1, after.TotalCount)
+	// 名前順に並べ替えられて先頭に入る
+	assert.Equal(t, "aaa_test", after.Data[0].Name)
+
+	require.Equal(t, http.StatusNoContent, doJSON(t, h, http.MethodDelete, "/api/v1/sprite-sheets/0", nil, nil))
+	require.Equal(t, http.StatusOK, doJSON(t, h, http.MethodGet, "/api/v1/sprite-sheets", nil, &after))
+	assert.Equal(t, before.Data, after.Data)
+}
+
+func TestPalettes_読み書きできる(t *testing.T) {
+	t.Parallel()
+	h, _, paletteDir := newTestServer(t)
+
+	var town oapi.Palette
+	require.Equal(t, http.StatusOK, doJSON(t, h, http.MethodGet, "/api/v1/palettes/town", nil, &town))
+	assert.Equal(t, "town", town.Id)
+	assert.Equal(t, "floor", town.Terrain["r"])
+
+	created := oapi.Palette{
+		Id:          "cave",
+		Description: "洞窟",
+		Terrain:     map[string]oapi.EntityName{"#": "wall", ".": "floor"},
+		Props:       map[string]oapi.PaletteEntry{},
+		Npcs:        map[string]oapi.PaletteEntry{},
+	}
+	require.Equal(t, http.StatusCreated, doJSON(t, h, http.MethodPost, "/api/v1/palettes", created, nil))
+	assert.FileExists(t, filepath.Join(paletteDir, "cave.toml"))
+
+	var list oapi.PaletteList
+	require.Equal(t, http.StatusOK, doJSON(t, h, http.MethodGet, "/api/v1/palettes", nil, &list))
+	require.Equal(t, 2, list.TotalCount)
+	assert.Equal(t, "cave", list.Data[0].Id)
+	assert.Equal(t, created.Terrain, list.Data[0].Terrain)
+
+	require.Equal(t, http.StatusNoContent, doJSON(t, h, http.MethodDelete, "/api/v1/palettes/cave", nil, nil))
+	assert.Equal(t, http.StatusNotFound, doJSON(t, h, http.MethodGet, "/api/v1/palettes/cave", nil, nil))
+}
+
+func TestPalettes_不正なパレットは400で拒否される(t *testing.T) {
+	t.Parallel()
+	h, _, paletteDir := newTestServer(t)
+
+	invalid := oapi.Palette{
+		Id:      "broken",
+		Terrain: map[string]oapi.EntityName{"##": "wall"},
+	}
+	assert.Equal(t, http.StatusBadRequest, doJSON(t, h, http.MethodPost, "/api/v1/palettes", invalid, nil))
+	assert.NoFileExists(t, filepath.Join(paletteDir, "broken.toml"))
+
+	escape := oapi.Palette{Id: "../escape", Terrain: map[string]oapi.EntityName{".": "floor"}}
+	assert.Equal(t, http.StatusBadRequest, doJSON(t, h, http.MethodPost, "/api/v1/palettes", escape, nil))
+}
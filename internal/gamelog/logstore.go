@@ -73,6 +73,18 @@ func (s *SafeSlice) Clear() {
 	s.version = 0
 }
 
+// Restore は全ログを与えられたエントリで置き換える。セーブからの復元に使う。
+// maxSize を超える分は古いものから捨てる。表示側が更新を検知できるよう Version は復元件数にする
+func (s *SafeSlice) Restore(entries []LogEntry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	start := max(0, len(entries)-s.maxSize)
+	s.coloredEntries = make([]LogEntry, len(entries)-start, s.maxSize)
+	copy(s.coloredEntries, entries[start:])
+	s.version = len(s.coloredEntries)
+}
+
 // Count は現在のログ行数を返す
 func (s *SafeSlice) Count() int {
 	s.mu.Lock()
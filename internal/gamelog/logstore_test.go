@@ -242,6 +242,37 @@ func TestSafeSliceVersion(t *testing.T) {
 	})
 }
 
+func TestSafeSliceRestore(t *testing.T) {
+	t.Parallel()
+
+	t.Run("既存のログを置き換える", func(t *testing.T) {
+		t.Parallel()
+		log := NewSafeSlice(10)
+		log.Push("old")
+
+		log.Restore([]LogEntry{
+			{Fragments: []LogFragment{{Text: "a", Color: ColorWhite}}},
+			{Fragments: []LogFragment{{Text: "b"}, {Text: "c"}}},
+		})
+
+		assert.Equal(t, []string{"a", "bc"}, log.GetHistory())
+		assert.Equal(t, 2, log.Version())
+	})
+
+	t.Run("maxSizeを超える分は古いものから捨てる", func(t *testing.T) {
+		t.Parallel()
+		log := NewSafeSlice(2)
+		entries := make([]LogEntry, 0, 5)
+		for i := range 5 {
+			entries = append(entries, LogEntry{Fragments: []LogFragment{{Text: fmt.Sprintf("msg%d", i)}}})
+		}
+
+		log.Restore(entries)
+
+		assert.Equal(t, []string{"msg3", "msg4"}, log.GetHistory())
+	})
+}
+
 func TestSafeSliceColoredEntries(t *testing.T) {
 	t.Parallel()
 
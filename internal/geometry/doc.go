@@ -7,4 +7,5 @@
 //   - 2点間のユークリッド距離計算
 //   - Bresenhamアルゴリズムによる線分座標列挙
 //   - 隣接判定（チェビシェフ距離）
+//   - 対称シャドウキャストによる視野計算と視線判定
 package geometry
@@ -0,0 +1,184 @@
+package geometry
+
+import "github.com/kijimaD/ruins/internal/consts"
+
+// OpaqueFunc はタイルが視線を遮るかを返す。マップ外の扱いは呼び出し側が決める
+type OpaqueFunc func(consts.Coord[int]) bool
+
+// ShadowCast は origin から半径 radius タイル以内の可視タイルを対称シャドウキャストで列挙する。
+// 各タイルを visit へちょうど1回渡す。origin 自身と、視線を遮るタイル自体も可視に含める。
+// 対称性を持つので「A から B が見える」と「B から A が見える」が常に一致する。
+// 光源の照射と視界で同じ判定を共有しても、片側だけ照らされる・見えるといった食い違いが出ない。
+// 半径はユークリッド距離で切る。dx²+dy² <= radius² のタイルだけを返す。
+func ShadowCast(origin consts.Coord[int], radius int, isOpaque OpaqueFunc, visit func(consts.Coord[int])) {
+	if radius < 0 {
+		return
+	}
+	// 象限の境界である軸と対角線は2つの象限から走査されるので、訪問済みを局所ビット集合で弾く
+	side := 2*radius + 1
+	seen := make([]uint64, (side*side+63)/64)
+	reveal := func(c consts.Coord[int]) {
+		dx, dy := c.X-origin.X, c.Y-origin.Y
+		if dx*dx+dy*dy > radius*radius {
+			return
+		}
+		i := (dy+radius)*side + (dx + radius)
+		if seen[i/64]&(1<<(i%64)) != 0 {
+			return
+		}
+		seen[i/64] |= 1 << (i % 64)
+		visit(c)
+	}
+
+	reveal(origin)
+	for _, q := range quadrants {
+		sc := shadowCaster{origin: origin, quadrant: q, maxDepth: radius, isOpaque: isOpaque, reveal: reveal}
+		sc.scan(shadowRow{depth: 1, start: slope{-1, 1}, end: slope{1, 1}})
+	}
+}
+
+// InFieldOfView は origin から target が見えるかを返す。ShadowCast と同じ判定で、距離の上限は設けない。
+// target を含む象限だけを target の深さまで走査するので、視界全体を求めるより安い。
+func InFieldOfView(origin, target consts.Coord[int], isOpaque OpaqueFunc) bool {
+	if origin == target {
+		return true
+	}
+	dx, dy := target.X-origin.X, target.Y-origin.Y
+	found := false
+	reveal := func(c consts.Coord[int]) {
+		if c == target {
+			found = true
+		}
+	}
+	// 対角線上のタイルは2象限にまたがる。どちらかで見えれば見える
+	for _, q := range quadrants {
+		depth, col := q.local(dx, dy)
+		if depth <= 0 || Abs(col) > depth {
+			continue
+		}
+		sc := shadowCaster{origin: origin, quadrant: q, maxDepth: depth, isOpaque: isOpaque, reveal: reveal}
+		sc.scan(shadowRow{depth: 1, start: slope{-1, 1}, end: slope{1, 1}})
+		if found {
+			return true
+		}
+	}
+	return false
+}
+
+// quadrant は origin を中心に平面を4分割した走査方向
+type quadrant int
+
+const (
+	quadrantNorth quadrant = iota
+	quadrantEast
+	quadrantSouth
+	quadrantWest
+)
+
+// quadrants は走査する全象限
+var quadrants = [...]quadrant{quadrantNorth, quadrantEast, quadrantSouth, quadrantWest}
+
+// world は象限内の (深さ, 列) を絶対座標へ写す
+func (q quadrant) world(origin consts.Coord[int], depth, col int) consts.Coord[int] {
+	switch q {
+	case quadrantNorth:
+		return consts.Coord[int]{X: origin.X + col, Y: origin.Y - depth}
+	case quadrantSouth:
+		return consts.Coord[int]{X: origin.X + col, Y: origin.Y + depth}
+	case quadrantEast:
+		return consts.Coord[int]{X: origin.X + depth, Y: origin.Y + col}
+	case quadrantWest:
+		return consts.Coord[int]{X: origin.X - depth, Y: origin.Y + col}
+	}
+	panic("unknown quadrant")
+}
+
+// local は origin からの相対座標を象限内の (深さ, 列) へ写す。world の逆写像
+func (q quadrant) local(dx, dy int) (depth, col int) {
+	switch q {
+	case quadrantNorth:
+		return -dy, dx
+	case quadrantSouth:
+		return dy, dx
+	case quadrantEast:
+		return dx, dy
+	case quadrantWest:
+		return -dx, dy
+	}
+	panic("unknown quadrant")
+}
+
+// slope は傾き num/den を有理数で持つ。浮動小数の丸め誤差で境界タイルの可視が揺れないようにする。den は常に正
+type slope struct {
+	num, den int
+}
+
+// tileSlope は列 col の左端を通る傾き (2col-1)/(2depth) を返す
+func tileSlope(depth, col int) slope {
+	return slope{num: 2*col - 1, den: 2 * depth}
+}
+
+// shadowRow は象限内の1行。start..end の傾きの範囲が影に入っていない部分
+type shadowRow struct {
+	depth      int
+	start, end slope
+}
+
+// minCol は行に含まれる最小の列を返す。depth*start を0.5切り上げで丸める
+func (r shadowRow) minCol() int {
+	return floorDiv(2*r.depth*r.start.num+r.start.den, 2*r.start.den)
+}
+
+// maxCol は行に含まれる最大の列を返す。depth*end を0.5切り下げで丸める
+func (r shadowRow) maxCol() int {
+	return -floorDiv(-(2*r.depth*r.end.num - r.end.den), 2*r.end.den)
+}
+
+// isSymmetric は列 col の中心が start..end の範囲に入るかを返す。入るタイルだけを見えるとみなすことで対称性を保つ
+func (r shadowRow) isSymmetric(col int) bool {
+	return col*r.start.den >= r.depth*r.start.num && col*r.end.den <= r.depth*r.end.num
+}
+
+// shadowCaster は1象限ぶんの走査状態
+type shadowCaster struct {
+	origin   consts.Coord[int]
+	quadrant quadrant
+	maxDepth int
+	isOpaque OpaqueFunc
+	reveal   func(consts.Coord[int])
+}
+
+// scan は行を走査し、遮蔽物の切れ目ごとに次の行を再帰で走査する
+func (sc *shadowCaster) scan(row shadowRow) {
+	if row.depth > sc.maxDepth {
+		return
+	}
+	prevKnown, prevOpaque := false, false
+	for col := row.minCol(); col <= row.maxCol(); col++ {
+		pos := sc.quadrant.world(sc.origin, row.depth, col)
+		opaque := sc.isOpaque(pos)
+		if opaque || row.isSymmetric(col) {
+			sc.reveal(pos)
+		}
+		if prevKnown && prevOpaque && !opaque {
+			row.start = tileSlope(row.depth, col)
+		}
+		if prevKnown && !prevOpaque && opaque {
+			next := shadowRow{depth: row.depth + 1, start: row.start, end: tileSlope(row.depth, col)}
+			sc.scan(next)
+		}
+		prevKnown, prevOpaque = true, opaque
+	}
+	if prevKnown && !prevOpaque {
+		sc.scan(shadowRow{depth: row.depth + 1, start: row.start, end: row.end})
+	}
+}
+
+// floorDiv は b > 0 のとき a/b を負の無限大方向へ丸めた商を返す
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && a < 0 {
+		q--
+	}
+	return q
+}
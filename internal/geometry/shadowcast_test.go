@@ -0,0 +1,107 @@
+package geometry
+
+import (
+	"testing"
+
+	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// opaqueAt は指定座標だけが視線を遮る OpaqueFunc を返す
+func opaqueAt(walls ...consts.Coord[int]) OpaqueFunc {
+	set := map[consts.Coord[int]]bool{}
+	for _, w := range walls {
+		set[w] = true
+	}
+	return func(c consts.Coord[int]) bool { return set[c] }
+}
+
+// castSet は ShadowCast の結果を集合で返す。同じタイルが2回渡されたら失敗させる
+func castSet(t *testing.T, origin consts.Coord[int], radius int, isOpaque OpaqueFunc) map[consts.Coord[int]]bool {
+	t.Helper()
+	visible := map[consts.Coord[int]]bool{}
+	ShadowCast(origin, radius, isOpaque, func(c consts.Coord[int]) {
+		require.False(t, visible[c], "%v が重複して渡された", c)
+		visible[c] = true
+	})
+	return visible
+}
+
+func TestShadowCast_遮蔽が無ければ円内が全て見える(t *testing.T) {
+	t.Parallel()
+
+	origin := consts.Coord[int]{X: 3, Y: -2}
+	const radius = 6
+	visible := castSet(t, origin, radius, opaqueAt())
+
+	for dy := -radius - 1; dy <= radius+1; dy++ {
+		for dx := -radius - 1; dx <= radius+1; dx++ {
+			c := consts.Coord[int]{X: origin.X + dx, Y: origin.Y + dy}
+			assert.Equal(t, dx*dx+dy*dy <= radius*radius, visible[c], "(%d,%d)", dx, dy)
+		}
+	}
+}
+
+func TestShadowCast_壁の裏は見えず壁自体は見える(t *testing.T) {
+	t.Parallel()
+
+	origin := consts.Coord[int]{X: 0, Y: 0}
+	wall := consts.Coord[int]{X: 2, Y: 0}
+	visible := castSet(t, origin, 8, opaqueAt(wall))
+
+	assert.True(t, visible[wall], "遮蔽物自体は見える")
+	assert.True(t, visible[consts.Coord[int]{X: 1, Y: 0}], "壁の手前は見える")
+	assert.False(t, visible[consts.Coord[int]{X: 3, Y: 0}], "壁の真裏は見えない")
+	assert.False(t, visible[consts.Coord[int]{X: 6, Y: 0}], "壁の影は奥まで伸びる")
+	assert.True(t, visible[consts.Coord[int]{X: 6, Y: 3}], "影から外れた方向は見える")
+}
+
+func TestShadowCast_半径が負なら何も返さない(t *testing.T) {
+	t.Parallel()
+
+	called := false
+	ShadowCast(consts.Coord[int]{}, -1, opaqueAt(), func(consts.Coord[int]) { called = true })
+	assert.False(t, called)
+}
+
+func TestInFieldOfView(t *testing.T) {
+	t.Parallel()
+
+	origin := consts.Coord[int]{X: 0, Y: 0}
+	wall := opaqueAt(consts.Coord[int]{X: 0, Y: 2})
+
+	assert.True(t, InFieldOfView(origin, origin, wall), "同じタイルは見える")
+	assert.True(t, InFieldOfView(origin, consts.Coord[int]{X: 0, Y: 1}, wall), "隣接タイルは見える")
+	assert.True(t, InFieldOfView(origin, consts.Coord[int]{X: 0, Y: 2}, wall), "壁自体は見える")
+	assert.False(t, InFieldOfView(origin, consts.Coord[int]{X: 0, Y: 5}, wall), "壁の裏は見えない")
+	assert.True(t, InFieldOfView(origin, consts.Coord[int]{X: 4, Y: 4}, wall), "対角線上は遮られない")
+}
+
+// TestShadowCast_対称性と視線判定の一致 は市松に柱を立てた部屋で、床同士の可視が双方向で一致し、
+// InFieldOfView が ShadowCast の結果と食い違わないことを固定する
+func TestShadowCast_対称性と視線判定の一致(t *testing.T) {
+	t.Parallel()
+
+	const size = 11
+	isOpaque := func(c consts.Coord[int]) bool {
+		return c.X%3 == 1 && c.Y%4 == 2
+	}
+	var floors []consts.Coord[int]
+	for y := range size {
+		for x := range size {
+			c := consts.Coord[int]{X: x, Y: y}
+			if !isOpaque(c) {
+				floors = append(floors, c)
+			}
+		}
+	}
+
+	for _, a := range floors {
+		visible := castSet(t, a, size*2, isOpaque)
+		for _, b := range floors {
+			require.Equal(t, visible[b], InFieldOfView(a, b, isOpaque), "%v→%v の判定が ShadowCast と食い違う", a, b)
+			require.Equal(t, InFieldOfView(a, b, isOpaque), InFieldOfView(b, a, isOpaque), "%v と %v の可視が非対称", a, b)
+		}
+	}
+}
@@ -9,14 +9,14 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-// TestRawTranslationCoverage は raw.toml の表示される name/description が英語原文の msgid で ja.po に
+// TestRawTranslationCoverage はローデータの表示される name/description が英語原文の msgid で ja.po に
 // 訳を持つことを検証する完全性ゲート。既定言語が ja のため、日本語のまま残す、または英語化しても
 // ja.po へ訳を入れ忘れると日本語プレイヤーへ英語が漏れる。それを fail-closed で止める。
 func TestRawTranslationCoverage(t *testing.T) {
 	t.Parallel()
 
 	defined := parsePoMsgids(t)
-	raws, err := raw.LoadFromFile("metadata/entities/raw/raw.toml")
+	raws, err := raw.LoadFromDir(raw.DefaultDir, "")
 	require.NoError(t, err)
 
 	// プレイヤーに表示される文字列だけを収集する。Prop/Tile の Description は spawn 時に
@@ -39,6 +39,19 @@ func TestRawTranslationCoverage(t *testing.T) {
 	for _, pr := range profs {
 		texts = append(texts, pr.Name, pr.Description)
 	}
+	// 会話の本文と選択肢は dialogue.Runner が query.T で訳す
+	for _, d := range raw.PtrSlice(raws.Dialogues) {
+		for _, node := range d.Nodes {
+			texts = append(texts, node.Text)
+			for _, choice := range raw.PtrSlice(node.Choices) {
+				texts = append(texts, choice.Text)
+			}
+		}
+	}
+	// クエストの名前はジャーナルとゲームログに出る。説明を読み出す表示経路は無いので対象外
+	for _, q := range raw.PtrSlice(raws.Quests) {
+		texts = append(texts, q.Name)
+	}
 
 	// 表示される文字列は全て英語原文の msgid で ja.po に訳を持たねばならない。日本語のままの name は
 	// msgid にならず ja.po に無いのでここで落ちる。英語化して訳を入れ忘れた場合も同様に落ちる。
@@ -10,7 +10,7 @@
 //
 // 使用例:
 //
-//	rw, err := loader.LoadRaws()
+//	rw, err := loader.LoadRaws("")
 //	sprites, err := loader.LoadSpriteSheets(rw)
 //	fonts, err := loader.LoadFonts()
 package loader
@@ -14,7 +14,6 @@ import (
 
 const (
 	fontsPath = "metadata/fonts/fonts.toml"
-	rawsPath  = "metadata/entities/raw/raw.toml"
 )
 
 // LoadFonts はフォントリソースを読み込む
@@ -91,7 +90,7 @@ func BuildFaces(fonts map[string]resources.Font) map[string]text.Face {
 	}
 }
 
-// LoadRaws はRawデータを読み込む
-func LoadRaws() (oapi.Raws, error) {
-	return raw.LoadFromFile(rawsPath)
+// LoadRaws はRawデータを読み込む。modDir が空でなければ、そのディレクトリの定義を本体の上に重ねる
+func LoadRaws(modDir string) (raw.Master, error) {
+	return raw.LoadFromDir(raw.DefaultDir, modDir)
 }
@@ -30,10 +30,10 @@ func TestLoadSpriteSheets(t *testing.T) {
 	t.Parallel()
 	t.Run("正常にスプライトシートを読み込める", func(t *testing.T) {
 		t.Parallel()
-		rw, err := LoadRaws()
+		rw, err := LoadRaws("")
 		require.NoError(t, err)
 
-		sprites, err := LoadSpriteSheets(rw)
+		sprites, err := LoadSpriteSheets(rw.Raws)
 
 		require.NoError(t, err)
 		assert.NotNil(t, sprites)
@@ -47,10 +47,10 @@ func TestLoadSpriteSheets(t *testing.T) {
 
 	t.Run("tileスプライトシートに全てのタイルが含まれる", func(t *testing.T) {
 		t.Parallel()
-		rw, err := LoadRaws()
+		rw, err := LoadRaws("")
 		require.NoError(t, err)
 
-		sprites, err := LoadSpriteSheets(rw)
+		sprites, err := LoadSpriteSheets(rw.Raws)
 		require.NoError(t, err)
 
 		tileSheet, ok := sprites["tile"]
@@ -141,7 +141,7 @@ func TestLoadRaws(t *testing.T) {
 	t.Parallel()
 	t.Run("正常にRawデータを読み込める", func(t *testing.T) {
 		t.Parallel()
-		rawMaster, err := LoadRaws()
+		rawMaster, err := LoadRaws("")
 
 		require.NoError(t, err)
 		assert.NotEmpty(t, rawMaster.Items)
@@ -238,7 +238,7 @@ var knownOrphanSprites = []string{}
 func TestSpriteOrphan(t *testing.T) {
 	t.Parallel()
 
-	rawMaster, err := LoadRaws()
+	rawMaster, err := LoadRaws("")
 	require.NoError(t, err)
 
 	keys := map[string]bool{}
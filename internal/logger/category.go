@@ -54,4 +54,7 @@ const (
 
 	// CategorySave はセーブ処理のログカテゴリ
 	CategorySave Category = "save" // セーブ処理
+
+	// CategoryAudio は音声再生のログカテゴリ
+	CategoryAudio Category = "audio" // 音声再生
 )
@@ -11,6 +11,7 @@ import (
 	gc "github.com/kijimaD/ruins/internal/components"
 	"github.com/kijimaD/ruins/internal/config"
 	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/dungeon"
 	es "github.com/kijimaD/ruins/internal/engine/states"
 	"github.com/kijimaD/ruins/internal/loader"
 	"github.com/kijimaD/ruins/internal/screeneffect"
@@ -140,14 +141,18 @@ func InitWorld(cfg *config.Config) (w.World, error) {
 	world.Resources.SetScreenDimensions(consts.GameWidth, consts.GameHeight)
 
 	// Rawデータを読み込む
-	rw, err := loader.LoadRaws()
+	rw, err := loader.LoadRaws(cfg.ModDir)
 	if err != nil {
 		return w.World{}, err
 	}
 	world.Resources.RawMaster = rw
+	// ローデータのダンジョン定義を選択画面の一覧として登録する
+	if err := dungeon.Register(rw); err != nil {
+		return w.World{}, err
+	}
 
 	// スプライトシートを読み込む
-	spriteSheets, err := loader.LoadSpriteSheets(rw)
+	spriteSheets, err := loader.LoadSpriteSheets(rw.Raws)
 	if err != nil {
 		return w.World{}, err
 	}
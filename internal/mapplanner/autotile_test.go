@@ -160,7 +160,7 @@ func TestIsValidIndex(t *testing.T) {
 }
 
 // createTestRawMaster はテスト用のrawマスターを作成する
-func createTestRawMaster(t *testing.T) *oapi.Raws {
+func createTestRawMaster(t *testing.T) *raw.Master {
 	t.Helper()
 
 	rawData := `
@@ -190,5 +190,6 @@ BlockPass = true
 	raws, err := raw.DecodeRaws(rawData)
 	require.NoError(t, err)
 
-	return &raws
+	master := raw.NewMaster(raws)
+	return &master
 }
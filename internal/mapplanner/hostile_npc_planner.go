@@ -24,6 +24,9 @@ const (
 type NPCSpec struct {
 	consts.Coord[consts.Tile]
 	Name string // NPCタイプ
+	// Pack は同じ群れとして配置された個体に共通の番号。0 は群れに属さない。
+	// 番号は1つの MetaPlan の中でだけ一意
+	Pack int
 }
 
 // HostileNPCPlanner は敵NPC配置を担当するプランナー
@@ -73,6 +76,7 @@ func (n *HostileNPCPlanner) planWithRoomCluster(planData *MetaPlan, entries []Sp
 	failCount := 0
 	// 部屋ごとに割り当てられた敵種を記録する
 	roomSpecies := map[int]SpawnEntry{}
+	pack := 0
 
 	for placed < total && failCount <= maxHostileNPCFailCount {
 		room, roomIdx, _ := planData.selectRoom()
@@ -104,9 +108,11 @@ func (n *HostileNPCPlanner) planWithRoomCluster(planData *MetaPlan, entries []Sp
 			failCount++
 			continue
 		}
+		pack++
 		planData.NPCs = append(planData.NPCs, NPCSpec{
 			Coord: consts.Coord[consts.Tile]{X: anchorX, Y: anchorY},
 			Name:  entry.Name,
+			Pack:  pack,
 		})
 		placed++
 		failCount = 0
@@ -121,6 +127,7 @@ func (n *HostileNPCPlanner) planWithRoomCluster(planData *MetaPlan, entries []Sp
 			planData.NPCs = append(planData.NPCs, NPCSpec{
 				Coord: consts.Coord[consts.Tile]{X: tx, Y: ty},
 				Name:  entry.Name,
+				Pack:  pack,
 			})
 			placed++
 			failCount = 0
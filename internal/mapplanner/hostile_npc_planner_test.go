@@ -157,6 +157,42 @@ func TestHostileNPCPlanner_PlanMeta(t *testing.T) {
 		}
 	})
 
+	t.Run("部屋ベースの配置では群れごとに番号が振られる", func(t *testing.T) {
+		t.Parallel()
+		world := testutil.InitTestWorld(t)
+
+		plannerType := PlannerType{
+			Name:           "test_pack_number",
+			EnemyTableName: "normal",
+			Danger:         1,
+		}
+
+		chain, err := NewSmallRoomPlanner(30, 30, 12345)
+		require.NoError(t, err)
+		chain.PlanData.RawMaster = CreateTestRawMaster()
+		err = chain.Plan()
+		require.NoError(t, err)
+		require.NotEmpty(t, chain.PlanData.Rooms)
+
+		planner := NewHostileNPCPlanner(world, plannerType)
+		err = planner.PlanMeta(&chain.PlanData)
+		require.NoError(t, err)
+
+		// 群れは連続して追加されるので、番号は1から単調に増える。同じ番号の個体は同じ敵種
+		prev := 0
+		species := map[int]string{}
+		for _, npc := range chain.PlanData.NPCs {
+			assert.Positive(t, npc.Pack, "部屋ベースの配置では全員がいずれかの群れに属する")
+			assert.GreaterOrEqual(t, npc.Pack, prev)
+			assert.LessOrEqual(t, npc.Pack, prev+1)
+			prev = npc.Pack
+			if name, ok := species[npc.Pack]; ok {
+				assert.Equal(t, name, npc.Name)
+			}
+			species[npc.Pack] = npc.Name
+		}
+	})
+
 	t.Run("大部屋でもクラスタメンバーが密集して配置される", func(t *testing.T) {
 		t.Parallel()
 		world := testutil.InitTestWorld(t)
@@ -196,3 +196,31 @@ func TestFurnishBuilding_民家は浴室とトイレを持ち居間より小さ
 		}
 	}
 }
+
+// TestSite_LockableDoorways_値打ちのある行き止まりの部屋の戸口だけを返す は、錠を掛けてよい戸口が
+// 倉庫・薬局・事務所の戸口1つの部屋に限られ、建物入口を含まず、鍵の ID が部屋の役割になることを固定する。
+func TestSite_LockableDoorways_値打ちのある行き止まりの部屋の戸口だけを返す(t *testing.T) {
+	t.Parallel()
+
+	found := 0
+	for _, facility := range []FacilityKind{"store", "clinic"} {
+		for seed := range uint64(20) {
+			footprint := Rect{X: 0, Y: 0, W: 18, H: 18}
+			site, _ := FurnishBuilding(seed, footprint, Vec{X: 9, Y: 0}, facility)
+			// 戸口1つの部屋だけを役割ごとに引けるようにする。通り抜けの部屋の戸口は錠の候補に入らない
+			rooms := map[Vec][]roleName{}
+			for _, r := range site.Rooms {
+				if len(r.Room.Doorways) == 1 {
+					rooms[r.Room.Doorways[0]] = append(rooms[r.Room.Doorways[0]], r.Role)
+				}
+			}
+			for _, ld := range site.LockableDoorways() {
+				found++
+				assert.NotEqualf(t, site.Door, ld.Pos, "%s seed=%d は入口に錠を掛けない", facility, seed)
+				assert.Containsf(t, []string{"storeroom", "pharmacy", "office"}, ld.Key, "%s seed=%d", facility, seed)
+				assert.Containsf(t, rooms[ld.Pos], roleName(ld.Key), "%s seed=%d の戸口 %v は鍵と同じ役割の行き止まりの部屋のもの", facility, seed, ld.Pos)
+			}
+		}
+	}
+	assert.Positive(t, found, "店と診療所のどこかに錠を掛けられる戸口がある")
+}
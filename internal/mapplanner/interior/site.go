@@ -234,6 +234,29 @@ func (s Site) doorSet() map[Vec]bool {
 	return door
 }
 
+// LockableDoorway は錠を掛けてよい戸口。Key は合う鍵の ID で、部屋の役割名をそのまま使う。
+type LockableDoorway struct {
+	Pos Vec
+	Key string
+}
+
+// lockableRoles は戸口に錠を掛ける値打ちのある部屋の役割。品物や薬、書類を抱える奥室に限る。
+var lockableRoles = map[roleName]bool{"storeroom": true, "pharmacy": true, "office": true}
+
+// LockableDoorways は錠を掛けてよい戸口を返す。倉庫・薬局・事務所のうち戸口が1つの行き止まりの部屋に限り、
+// 錠が他の部屋への通り道を塞がないようにする。建物入口の戸口は含めない。掛けるかどうかと錠の難度は
+// 扉を立てる overworld が決める。
+func (s Site) LockableDoorways() []LockableDoorway {
+	var out []LockableDoorway
+	for _, hr := range s.Rooms {
+		if !lockableRoles[hr.Role] || len(hr.Room.Doorways) != 1 || hr.Room.Doorways[0] == s.Door {
+			continue
+		}
+		out = append(out, LockableDoorway{Pos: hr.Room.Doorways[0], Key: string(hr.Role)})
+	}
+	return out
+}
+
 // Walls は Site の全壁タイルを footprint 内で返す。建物外周・間仕切り・ポーチの側壁。庭・床・戸口は除く。
 // overworld が壁タイルを描き、敵配置が壁を避けるのに使う。庭は footprint から建物を引いた集合なので、
 // 非庭タイルは必ず建物内側にあり、床でも戸口でもなければ壁になる。ポーチの側壁は床の上に足す壁。
@@ -60,7 +60,7 @@ type MetaPlan struct {
 	// SpawnPoints はプレイヤーのスポーン地点リスト
 	SpawnPoints []maptemplate.SpawnPoint
 	// RawMaster はタイル生成に使用するマスターデータ
-	RawMaster *oapi.Raws
+	RawMaster *raw.Master
 }
 
 // IsSpawnableTile は指定タイル座標がスポーン可能かを返す
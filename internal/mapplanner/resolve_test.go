@@ -4,13 +4,14 @@ import (
 	"testing"
 
 	"github.com/kijimaD/ruins/internal/oapi"
+	"github.com/kijimaD/ruins/internal/raw"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 // dangerBoundaryRaws は危険度境界の検証用に、低層・単一危険度・高層の3エントリを持つ敵テーブルと
 // アイテムテーブルを1つずつ持つ raws を作る。MinDanger/MaxDanger の一致と範囲外の判定を厳密に見る。
-func dangerBoundaryRaws() *oapi.Raws {
+func dangerBoundaryRaws() *raw.Master {
 	enemyTables := []oapi.EnemyTable{{
 		Id:   "danger_enemies",
 		Name: "danger_enemies",
@@ -29,7 +30,8 @@ func dangerBoundaryRaws() *oapi.Raws {
 			{Id: "high", MinDanger: 8, MaxDanger: 10, Weight: 1},
 		},
 	}}
-	return &oapi.Raws{EnemyTables: &enemyTables, ItemTables: &itemTables}
+	master := raw.NewMaster(oapi.Raws{EnemyTables: &enemyTables, ItemTables: &itemTables})
+	return &master
 }
 
 var dangerBoundaryCases = []struct {
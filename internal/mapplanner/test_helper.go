@@ -2,10 +2,11 @@ package mapplanner
 
 import (
 	"github.com/kijimaD/ruins/internal/oapi"
+	"github.com/kijimaD/ruins/internal/raw"
 )
 
-// CreateTestRawMaster はテスト用の oapi.Raws インスタンスを作成する
-func CreateTestRawMaster() *oapi.Raws {
+// CreateTestRawMaster はテスト用の raw.Master インスタンスを作成する
+func CreateTestRawMaster() *raw.Master {
 	// テスト用の基本的なタイルデータを定義
 	testTiles := []oapi.Tile{
 		{Id: "wall", Name: "wall", BlockPass: true},
@@ -118,7 +119,7 @@ func CreateTestRawMaster() *oapi.Raws {
 
 	// テスト用のアイテム定義（スタック判定に必要）
 
-	return &oapi.Raws{
+	master := raw.NewMaster(oapi.Raws{
 		Tiles: &testTiles,
 		Items: &[]oapi.Item{
 			{Id: "healing_potion", Name: "healing_potion", Description: "restores HP"},
@@ -131,5 +132,6 @@ func CreateTestRawMaster() *oapi.Raws {
 		ItemGroups:  &testItemGroups,
 		ItemTables:  &testItemTables,
 		EnemyTables: &testEnemyTables,
-	}
+	})
+	return &master
 }
@@ -123,8 +123,10 @@ func spawnTile(world w.World, metaPlan *mapplanner.MetaPlan, tile oapi.Tile, i g
 	return lifecycle.SpawnTile(world, spec.spawnName, tileX, tileY, indexPtr)
 }
 
-// spawnNPCs はNPCを生成する
+// spawnNPCs はNPCを生成する。分隊で動く敵は群れごとに分隊を組み、群れの最初の1体を頭にする
 func spawnNPCs(world w.World, metaPlan *mapplanner.MetaPlan, offsetX, offsetY consts.Tile) error {
+	// 群れ番号は MetaPlan の中でしか一意でないので、ステージをまたいで一意な分隊 ID に振り直す
+	squads := map[int]uint64{}
 	for _, npc := range metaPlan.NPCs {
 		member, err := raw.FindMember(world.Resources.RawMaster, npc.Name)
 		if err != nil {
@@ -142,6 +144,14 @@ func spawnNPCs(world w.World, metaPlan *mapplanner.MetaPlan, offsetX, offsetY co
 			if member.IsBoss {
 				opts = append(opts, lifecycle.WithBoss())
 			}
+			if npc.Pack != 0 && member.Planner != nil && *member.Planner == oapi.Squad {
+				id, formed := squads[npc.Pack]
+				if !formed {
+					id = world.Resources.Config.RNG.Uint64()
+					squads[npc.Pack] = id
+				}
+				opts = append(opts, lifecycle.WithSquad(id, !formed))
+			}
 			_, err := lifecycle.SpawnEnemy(world, consts.Coord[consts.Tile]{X: consts.Tile(x), Y: consts.Tile(y)}, npc.Name, opts...)
 			if err != nil {
 				return fmt.Errorf("failed to spawn enemy NPC (%d, %d): %w", x, y, err)
@@ -106,6 +106,43 @@ func TestSpawnNPCs_ボス敵NPCにBossコンポーネントが付く(t *testing.
 	assert.Equal(t, 1, bossCount, "isBoss=trueのNPCにはBossコンポーネントが付く")
 }
 
+func TestSpawnNPCs_分隊で動く敵は群れごとに分隊を組む(t *testing.T) {
+	t.Parallel()
+
+	world := testutil.InitTestWorld(t)
+	plan := newTestSpawnPlan(world)
+	// stray_dog は planner = "squad"。群れ1と群れ2に分け、群れに属さない1体も置く
+	plan.NPCs = []mapplanner.NPCSpec{
+		{Coord: consts.Coord[consts.Tile]{X: 1, Y: 1}, Name: "stray_dog", Pack: 1},
+		{Coord: consts.Coord[consts.Tile]{X: 2, Y: 1}, Name: "stray_dog", Pack: 1},
+		{Coord: consts.Coord[consts.Tile]{X: 6, Y: 6}, Name: "stray_dog", Pack: 2},
+		{Coord: consts.Coord[consts.Tile]{X: 8, Y: 8}, Name: "stray_dog"},
+		{Coord: consts.Coord[consts.Tile]{X: 3, Y: 3}, Name: "glow_bug", Pack: 3},
+	}
+
+	err := spawnNPCs(world, plan, 0, 0)
+	require.NoError(t, err)
+
+	squads := map[consts.Coord[consts.Tile]]gc.Squad{}
+	query := ecs.NewFilter2[gc.FactionEnemy, gc.GridElement](world.ECS).Query()
+	for query.Next() {
+		_, grid := query.Get()
+		if world.Components.Squad.Has(query.Entity()) {
+			squads[grid.Coord] = *world.Components.Squad.Get(query.Entity())
+		}
+	}
+	require.Len(t, squads, 3, "群れに属する分隊型の敵だけが分隊を組む")
+
+	first := squads[consts.Coord[consts.Tile]{X: 1, Y: 1}]
+	second := squads[consts.Coord[consts.Tile]{X: 2, Y: 1}]
+	other := squads[consts.Coord[consts.Tile]{X: 6, Y: 6}]
+	assert.Equal(t, first.ID, second.ID, "同じ群れは同じ分隊")
+	assert.NotEqual(t, first.ID, other.ID, "別の群れは別の分隊")
+	assert.True(t, first.Leader, "群れの最初の1体が頭になる")
+	assert.False(t, second.Leader)
+	assert.True(t, other.Leader)
+}
+
 func TestSpawnNPCs_オフセットが座標に加算される(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,20 @@
+// Package morgue はプレイヤーが倒れた run の記録（死の記録）を平文で書き出し、読み返す。
+//
+// 死の記録には人物の能力・装備・スキル、死因、生存日数、run 統計、最後のログを並べる。
+// 見出しやラベルは記録した時点の言語で書き、あとから言語を変えても書き直さない。
+//
+// # 保存先
+//
+// ストレージ層はプラットフォームごとに実装が異なる。デスクトップは設定と同じ OS 標準の
+// 設定ディレクトリ配下（Linuxでは ~/.config/ruins/morgue/）へ1件1ファイルで置き
+// （store_desktop.go）、WASMはローカルストレージに1件1キーで置く（store_wasm.go）。
+//
+//   - writeEntry(name string, data []byte) error: 1件を永続化する
+//   - readEntry(name string) ([]byte, error): 1件を読み込む
+//   - listEntries() ([]string, error): 保存済みの名前を返す。並びは問わない
+//
+// Write は書き出しを Resources.Morgue に任せる。既定は何も書かない resources.NullMorgueWriter で、
+// ウィンドウ付きの起動でだけ上の保存先へ書く Store を差す。自動プレイやテストで利用者の記録を増やさない。
+//
+// 名前は記録した時刻から作るので、辞書順の逆が新しい順になる。
+package morgue
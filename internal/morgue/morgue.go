@@ -0,0 +1,257 @@
+package morgue
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/raw"
+	w "github.com/kijimaD/ruins/internal/world"
+	"github.com/kijimaD/ruins/internal/world/query"
+	"github.com/mlange-42/ark/ecs"
+)
+
+// MessageLines は死の記録に残す最後のログの行数
+const MessageLines = 30
+
+// nameLayout は記録の名前にする時刻の書式。辞書順が時刻順になる
+const nameLayout = "20060102-150405"
+
+// timeLayout は本文と一覧に出す時刻の書式
+const timeLayout = "2006-01-02 15:04:05"
+
+// labelWidth はラベルと値を並べる行でラベルを詰める幅
+const labelWidth = 20
+
+// Store はプラットフォームの保存先へ書き出す resources.MorgueWriter。ウィンドウ付きの起動でだけ Resources.Morgue に差す
+type Store struct{}
+
+// WriteEntry は name の死の記録を保存先へ書き出す
+func (Store) WriteEntry(name string, data []byte) error {
+	return writeEntry(name, data)
+}
+
+// Write は world から死の記録を組んで Resources.Morgue へ書き出し、付けた名前を返す。名前は now から作る
+func Write(world w.World, now time.Time) (string, error) {
+	name := now.Format(nameLayout)
+	if err := world.Resources.Morgue.WriteEntry(name, []byte(Compose(world, now))); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// List は保存済みの死の記録の名前を新しい順に返す
+func List() ([]string, error) {
+	names, err := listEntries()
+	if err != nil {
+		return nil, err
+	}
+	slices.Sort(names)
+	slices.Reverse(names)
+	return names, nil
+}
+
+// Label は一覧に出す name の表示。名前を記録した時刻として読めなければ名前をそのまま返す
+func Label(name string) string {
+	t, err := time.Parse(nameLayout, name)
+	if err != nil {
+		return name
+	}
+	return t.Format(timeLayout)
+}
+
+// Read は name の死の記録の本文を返す
+func Read(name string) (string, error) {
+	data, err := readEntry(name)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// Compose は world から死の記録の本文を組む。プレイヤーが居なければ人物の節を省く
+func Compose(world w.World, now time.Time) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Ruins %s\n%s\n", consts.AppVersion, now.Format(timeLayout))
+
+	player, err := query.GetPlayerEntity(world)
+	hasPlayer := err == nil
+	if hasPlayer {
+		b.WriteString("\n")
+		writeIdentity(&b, world, player)
+	}
+
+	stats := query.GetRunStats(world)
+	if stats != nil && !stats.Cause.IsZero() {
+		fmt.Fprintf(&b, "%s: %s\n", query.T(world, "Cause of death"), CauseText(world, stats.Cause))
+	}
+
+	if hasPlayer {
+		writeSection(&b, query.T(world, "Abilities"), abilityRows(world, player))
+		writeSection(&b, query.T(world, "Equipment"), equipmentRows(world, player))
+		writeSection(&b, query.T(world, "Skills"), skillRows(world, player))
+	}
+	writeSection(&b, query.T(world, "Statistics"), statRows(world, stats))
+
+	writeHeading(&b, query.T(world, "Last messages"))
+	if store := query.GetGameLog(world); store != nil {
+		for _, line := range store.GetRecent(MessageLines) {
+			b.WriteString(line)
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+// CauseText は死因を現在言語の1文にする。倒した相手・手段・状態のうち記録された項目だけを使う
+func CauseText(world w.World, cause gc.DeathCause) string {
+	weapon := ""
+	if cause.Weapon != "" {
+		weapon = query.T(world, cause.Weapon)
+		if cause.Element != "" && cause.Element != gc.ElementTypeNone {
+			weapon = fmt.Sprintf("%s (%s)", weapon, query.T(world, cause.Element.String()))
+		}
+	} else if cause.Element != "" && cause.Element != gc.ElementTypeNone {
+		weapon = query.T(world, cause.Element.String())
+	}
+	condition := ""
+	if cause.Condition != "" {
+		condition = query.T(world, cause.Condition)
+	}
+
+	var text string
+	switch {
+	case cause.Killer != "" && weapon != "":
+		text = query.T(world, "Killed by %s with %s", query.T(world, cause.Killer), weapon)
+	case cause.Killer != "":
+		text = query.T(world, "Killed by %s", query.T(world, cause.Killer))
+	case weapon != "":
+		text = query.T(world, "Killed by %s", weapon)
+	case condition != "":
+		return query.T(world, "Died of %s", condition)
+	default:
+		return query.T(world, "Unknown")
+	}
+	if condition != "" {
+		text = query.T(world, "%s, while %s", text, condition)
+	}
+	return text
+}
+
+// row は節の中のラベルと値の1行
+type row struct {
+	Label string
+	Value string
+}
+
+// writeIdentity は名前・職業と生存日数を書く
+func writeIdentity(b *strings.Builder, world w.World, player ecs.Entity) {
+	name := query.GetEntityName(player, world)
+	if world.Components.Profession.Has(player) {
+		if prof, err := raw.GetProfession(world.Resources.RawMaster, world.Components.Profession.Get(player).ID); err == nil {
+			name = fmt.Sprintf("%s (%s)", name, query.T(world, prof.Name))
+		}
+	}
+	b.WriteString(name)
+	b.WriteString("\n")
+	if gt := query.GetGameTime(world); gt != nil {
+		fmt.Fprintf(b, "%s: %d  %s: %d\n", query.T(world, "Days"), gt.GetDayNumber(), query.T(world, "Turns"), int(gt.TotalTurns))
+	}
+}
+
+// writeHeading は節の見出しを書く
+func writeHeading(b *strings.Builder, heading string) {
+	fmt.Fprintf(b, "\n== %s ==\n", heading)
+}
+
+// writeSection は見出しと行を書く。行が無い節は見出しごと省く
+func writeSection(b *strings.Builder, heading string, rows []row) {
+	if len(rows) == 0 {
+		return
+	}
+	writeHeading(b, heading)
+	for _, r := range rows {
+		fmt.Fprintf(b, "%-*s %s\n", labelWidth, r.Label, r.Value)
+	}
+}
+
+// abilityRows は HP・能力値・空腹の行を組む
+func abilityRows(world w.World, player ecs.Entity) []row {
+	var rows []row
+	if world.Components.HP.Has(player) {
+		hp := world.Components.HP.Get(player)
+		rows = append(rows, row{Label: "HP", Value: fmt.Sprintf("%d/%d", hp.Current, hp.Max)})
+	}
+	if world.Components.Abilities.Has(player) {
+		abils := world.Components.Abilities.Get(player)
+		rows = append(rows,
+			row{Label: query.T(world, "Vitality"), Value: fmt.Sprintf("%d", abils.Vitality.Total)},
+			row{Label: query.T(world, "Strength"), Value: fmt.Sprintf("%d", abils.Strength.Total)},
+			row{Label: query.T(world, "Sensation"), Value: fmt.Sprintf("%d", abils.Sensation.Total)},
+			row{Label: query.T(world, "Dexterity"), Value: fmt.Sprintf("%d", abils.Dexterity.Total)},
+			row{Label: query.T(world, "Agility"), Value: fmt.Sprintf("%d", abils.Agility.Total)},
+			row{Label: query.T(world, "Defense"), Value: fmt.Sprintf("%d", abils.Defense.Total)},
+		)
+	}
+	if world.Components.Hunger.Has(player) {
+		rows = append(rows, row{Label: query.T(world, "Hunger"), Value: query.T(world, world.Components.Hunger.Get(player).GetLevel().String())})
+	}
+	return rows
+}
+
+// equipmentRows は装備中のアイテムをスロット順に組む。空きスロットは書かない
+func equipmentRows(world w.World, player ecs.Entity) []row {
+	type equipped struct {
+		slot gc.EquipmentSlotNumber
+		name string
+	}
+	var items []equipped
+	q := ecs.NewFilter1[gc.LocationEquipped](world.ECS).Query()
+	for q.Next() {
+		loc := q.Get()
+		if loc.Owner != player {
+			continue
+		}
+		items = append(items, equipped{slot: loc.EquipmentSlot, name: query.GetEntityName(q.Entity(), world)})
+	}
+	slices.SortFunc(items, func(a, b equipped) int { return cmp.Compare(a.slot, b.slot) })
+
+	rows := make([]row, len(items))
+	for i, it := range items {
+		rows[i] = row{Label: query.T(world, it.slot.String()), Value: it.name}
+	}
+	return rows
+}
+
+// skillRows は育ったスキルを定義順に組む。初期値のままのスキルは書かない
+func skillRows(world w.World, player ecs.Entity) []row {
+	if !world.Components.Skills.Has(player) {
+		return nil
+	}
+	skills := world.Components.Skills.Get(player)
+	var rows []row
+	for _, id := range gc.AllSkillIDs {
+		s := skills.Get(id)
+		if s.Value == 0 && s.Exp.Current == 0 {
+			continue
+		}
+		rows = append(rows, row{Label: query.T(world, gc.SkillName(id)), Value: fmt.Sprintf("%d", s.Value)})
+	}
+	return rows
+}
+
+// statRows は run 統計の行を組む
+func statRows(world w.World, stats *gc.RunStats) []row {
+	if stats == nil {
+		return nil
+	}
+	return []row{
+		{Label: query.T(world, "Enemies killed"), Value: fmt.Sprintf("%d", stats.EnemiesKilled)},
+		{Label: query.T(world, "Items scavenged"), Value: fmt.Sprintf("%d", stats.ItemsScavenged)},
+		{Label: query.T(world, "Sales"), Value: fmt.Sprintf("%d", stats.SalesTotal)},
+	}
+}
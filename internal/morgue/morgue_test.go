@@ -0,0 +1,95 @@
+package morgue
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/gamelog"
+	"github.com/kijimaD/ruins/internal/testutil"
+	"github.com/kijimaD/ruins/internal/world/lifecycle"
+	"github.com/kijimaD/ruins/internal/world/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompose(t *testing.T) {
+	t.Parallel()
+
+	world := testutil.InitTestWorld(t)
+	player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 5, Y: 5}, "ash")
+	require.NoError(t, err)
+	stats := query.GetRunStats(world)
+	stats.EnemiesKilled = 42
+	stats.Cause = gc.DeathCause{Killer: "Bat", Condition: "Starving"}
+	for i := range MessageLines + 5 {
+		gamelog.New(query.GetGameLog(world)).Markup(fmt.Sprintf("line%02d", i)).Log()
+	}
+
+	text := Compose(world, time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC))
+
+	assert.Contains(t, text, "2026-10-17 12:00:00")
+	assert.Contains(t, text, query.GetEntityName(player, world))
+	assert.Contains(t, text, CauseText(world, stats.Cause))
+	assert.Contains(t, text, "42")
+	assert.Contains(t, text, "line05", "最後の MessageLines 行を残す")
+	assert.NotContains(t, text, "line04", "それより古い行は残さない")
+}
+
+// recordWriter は書き出された死の記録を手元に残す resources.MorgueWriter
+type recordWriter map[string]string
+
+func (r recordWriter) WriteEntry(name string, data []byte) error {
+	r[name] = string(data)
+	return nil
+}
+
+func TestWrite_Resourcesの書き出し先へ書く(t *testing.T) {
+	t.Parallel()
+
+	world := testutil.InitTestWorld(t)
+	written := recordWriter{}
+	world.Resources.Morgue = written
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	name, err := Write(world, now)
+	require.NoError(t, err)
+
+	assert.Equal(t, "20261017-120000", name)
+	assert.Equal(t, map[string]string{name: Compose(world, now)}, map[string]string(written))
+}
+
+func TestCauseText(t *testing.T) {
+	t.Parallel()
+
+	world := testutil.InitTestWorld(t)
+	tests := []struct {
+		name  string
+		cause gc.DeathCause
+		want  string
+	}{
+		{"相手と手段", gc.DeathCause{Killer: "Bat", Weapon: "Fangs"}, "Killed by Bat with Fangs"},
+		{"元素つきの手段", gc.DeathCause{Killer: "Bat", Weapon: "Fangs", Element: gc.ElementTypeFire}, "Killed by Bat with Fangs (Fire)"},
+		{"相手のみ", gc.DeathCause{Killer: "Bat"}, "Killed by Bat"},
+		{"元素のみ", gc.DeathCause{Element: gc.ElementTypeFire}, "Killed by Fire"},
+		{"状態を添える", gc.DeathCause{Killer: "Bat", Condition: "Starving"}, "Killed by Bat, while Starving"},
+		{"状態のみ", gc.DeathCause{Condition: "Hypothermia"}, "Died of Hypothermia"},
+		{"記録なし", gc.DeathCause{}, "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, CauseText(world, tt.cause))
+		})
+	}
+}
+
+func TestLabel(t *testing.T) {
+	t.Parallel()
+
+	name := time.Date(2026, 10, 17, 9, 5, 3, 0, time.UTC).Format(nameLayout)
+	assert.Equal(t, "2026-10-17 09:05:03", Label(name))
+	assert.Equal(t, "hand-made", Label("hand-made"), "時刻として読めない名前はそのまま")
+}
@@ -0,0 +1,106 @@
+//go:build !js || !wasm
+
+package morgue
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// morgueAppDirName は設定ディレクトリ配下のアプリ専用サブディレクトリ名。設定ファイルと同じ場所
+const morgueAppDirName = "ruins"
+
+// morgueDirName は死の記録を置くサブディレクトリ名
+const morgueDirName = "morgue"
+
+// morgueFileExt は死の記録の拡張子。中身は平文なのでそのままエディタで開ける
+const morgueFileExt = ".txt"
+
+// morgueDir は死の記録を置くディレクトリの絶対パスを返す。
+// Linuxでは ~/.config/ruins/morgue となる。
+func morgueDir() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get config directory: %w", err)
+	}
+	return filepath.Join(dir, morgueAppDirName, morgueDirName), nil
+}
+
+// writeEntry は死の記録を1件書き込む。ディレクトリが無ければ作成する。
+func writeEntry(name string, data []byte) error {
+	dir, err := morgueDir()
+	if err != nil {
+		return err
+	}
+	return writeEntryTo(dir, name, data)
+}
+
+// writeEntryTo は指定ディレクトリへ書き込む。一時ファイルへ書いてから rename することで、
+// 書き込み途中のクラッシュによる破損を防ぐ。ディレクトリを引数に取ることでテストできる。
+func writeEntryTo(dir, name string, data []byte) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create morgue directory: %w", err)
+	}
+	path := filepath.Join(dir, name+morgueFileExt)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write morgue file: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("failed to replace morgue file: %w", err)
+	}
+	return nil
+}
+
+// readEntry は死の記録を1件読み込む。
+func readEntry(name string) ([]byte, error) {
+	dir, err := morgueDir()
+	if err != nil {
+		return nil, err
+	}
+	return readEntryFrom(dir, name)
+}
+
+// readEntryFrom は指定ディレクトリから読み込む。ディレクトリを引数に取ることでテストできる。
+func readEntryFrom(dir, name string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(dir, name+morgueFileExt))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read morgue file: %w", err)
+	}
+	return data, nil
+}
+
+// listEntries は保存済みの死の記録の名前を返す。
+func listEntries() ([]string, error) {
+	dir, err := morgueDir()
+	if err != nil {
+		return nil, err
+	}
+	return listEntriesIn(dir)
+}
+
+// listEntriesIn は指定ディレクトリの死の記録の名前を返す。ディレクトリが無ければ空を返す。
+// ディレクトリを引数に取ることでテストできる。
+func listEntriesIn(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read morgue directory: %w", err)
+	}
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		if before, ok := strings.CutSuffix(entry.Name(), morgueFileExt); ok {
+			names = append(names, before)
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,54 @@
+//go:build !js || !wasm
+
+package morgue
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteReadEntry_ラウンドトリップ(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(t.TempDir(), "morgue")
+	require.NoError(t, writeEntryTo(dir, "20261017-120000", []byte("died\n")))
+	assert.FileExists(t, filepath.Join(dir, "20261017-120000.txt"))
+
+	data, err := readEntryFrom(dir, "20261017-120000")
+	require.NoError(t, err)
+	assert.Equal(t, "died\n", string(data))
+}
+
+func TestReadEntry_無ければエラー(t *testing.T) {
+	t.Parallel()
+
+	_, err := readEntryFrom(t.TempDir(), "missing")
+	assert.Error(t, err)
+}
+
+func TestListEntriesIn(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ディレクトリが無ければ空", func(t *testing.T) {
+		t.Parallel()
+		names, err := listEntriesIn(filepath.Join(t.TempDir(), "morgue"))
+		require.NoError(t, err)
+		assert.Empty(t, names)
+	})
+
+	t.Run("死の記録の拡張子だけを名前にする", func(t *testing.T) {
+		t.Parallel()
+		dir := t.TempDir()
+		require.NoError(t, writeEntryTo(dir, "a", []byte("x")))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "note.md"), []byte("x"), 0o644))
+		require.NoError(t, os.Mkdir(filepath.Join(dir, "sub.txt"), 0o755))
+
+		names, err := listEntriesIn(dir)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a"}, names)
+	})
+}
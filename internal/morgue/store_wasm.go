@@ -0,0 +1,52 @@
+//go:build js && wasm
+
+package morgue
+
+import (
+	"fmt"
+	"strings"
+	"syscall/js"
+)
+
+// morgueStoragePrefix はローカルストレージ上の死の記録のキーの接頭辞
+const morgueStoragePrefix = "ruins-morgue-"
+
+// writeEntry はローカルストレージへ死の記録を1件書き込む。
+func writeEntry(name string, data []byte) error {
+	localStorage := js.Global().Get("localStorage")
+	if localStorage.IsUndefined() {
+		return fmt.Errorf("localStorage is not available")
+	}
+	localStorage.Call("setItem", morgueStoragePrefix+name, string(data))
+	return nil
+}
+
+// readEntry はローカルストレージから死の記録を1件読み込む。
+func readEntry(name string) ([]byte, error) {
+	localStorage := js.Global().Get("localStorage")
+	if localStorage.IsUndefined() {
+		return nil, fmt.Errorf("localStorage is not available")
+	}
+	item := localStorage.Call("getItem", morgueStoragePrefix+name)
+	if item.IsNull() {
+		return nil, fmt.Errorf("morgue entry not found: %s", name)
+	}
+	return []byte(item.String()), nil
+}
+
+// listEntries はローカルストレージの死の記録の名前を返す。
+func listEntries() ([]string, error) {
+	localStorage := js.Global().Get("localStorage")
+	if localStorage.IsUndefined() {
+		return nil, fmt.Errorf("localStorage is not available")
+	}
+	length := localStorage.Get("length").Int()
+	var names []string
+	for i := 0; i < length; i++ {
+		key := localStorage.Call("key", i).String()
+		if strings.HasPrefix(key, morgueStoragePrefix) {
+			names = append(names, strings.TrimPrefix(key, morgueStoragePrefix))
+		}
+	}
+	return names, nil
+}
@@ -85,6 +85,27 @@ func (e CombatPolicyType) Valid() bool {
 	}
 }
 
+// Defines values for CraftStation.
+const (
+	CraftStationFire      CraftStation = "fire"
+	CraftStationForge     CraftStation = "forge"
+	CraftStationWorkbench CraftStation = "workbench"
+)
+
+// Valid indicates whether the value is a known member of the CraftStation enum.
+func (e CraftStation) Valid() bool {
+	switch e {
+	case CraftStationFire:
+		return true
+	case CraftStationForge:
+		return true
+	case CraftStationWorkbench:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for Element.
 const (
 	ElementCHILL   Element = "CHILL"
@@ -205,6 +226,27 @@ func (e FactionMemberType) Valid() bool {
 	}
 }
 
+// Defines values for FactionRelation.
+const (
+	Friendly FactionRelation = "friendly"
+	Hostile  FactionRelation = "hostile"
+	Neutral  FactionRelation = "neutral"
+)
+
+// Valid indicates whether the value is a known member of the FactionRelation enum.
+func (e FactionRelation) Valid() bool {
+	switch e {
+	case Friendly:
+		return true
+	case Hostile:
+		return true
+	case Neutral:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for FoliageType.
 const (
 	FoliageTypeMinus1 FoliageType = -1
@@ -265,6 +307,24 @@ func (e ItemGroupSubtype) Valid() bool {
 	}
 }
 
+// Defines values for MemberPlannerType.
+const (
+	Solo  MemberPlannerType = "solo"
+	Squad MemberPlannerType = "squad"
+)
+
+// Valid indicates whether the value is a known member of the MemberPlannerType enum.
+func (e MemberPlannerType) Valid() bool {
+	switch e {
+	case Solo:
+		return true
+	case Squad:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for MovementPatternType.
 const (
 	Patrol      MovementPatternType = "patrol"
@@ -298,6 +358,24 @@ func (e MovementPatternType) Valid() bool {
 	}
 }
 
+// Defines values for QuestStatus.
+const (
+	Active    QuestStatus = "active"
+	Completed QuestStatus = "completed"
+)
+
+// Valid indicates whether the value is a known member of the QuestStatus enum.
+func (e QuestStatus) Valid() bool {
+	switch e {
+	case Active:
+		return true
+	case Completed:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for SaveDataAttackRangeType.
 const (
 	MELEE  SaveDataAttackRangeType = "MELEE"
@@ -861,6 +939,9 @@ type BlocksPassage = bool
 // BlocksView 視線を遮るかどうか
 type BlocksView = bool
 
+// BreaksOnImpact 着弾時に壊れるかどうか
+type BreaksOnImpact = bool
+
 // Book 本の設定
 type Book struct {
 	// Skill スキル本設定
@@ -870,6 +951,18 @@ type Book struct {
 	TotalEffort ReadingEffort `json:"totalEffort"`
 }
 
+// ChunkCoord オーバーワールドのチャンク座標
+type ChunkCoord struct {
+	// X チャンク座標の成分。東西は東進したチャンク数、南北は帯の行
+	X ChunkIndex `json:"x"`
+
+	// Y チャンク座標の成分。東西は東進したチャンク数、南北は帯の行
+	Y ChunkIndex `json:"y"`
+}
+
+// ChunkIndex チャンク座標の成分。東西は東進したチャンク数、南北は帯の行
+type ChunkIndex = int
+
 // ColorChannel RGBA色チャネル値 (0-255)
 type ColorChannel = uint8
 
@@ -914,9 +1007,18 @@ type Consumable struct {
 	UsableScene UsableScene `json:"usableScene"`
 }
 
+// CraftStation 合成に使う作業場の種類
+type CraftStation string
+
+// CraftWorkAP 合成の作業量。100が標準1ターンに相当する
+type CraftWorkAP = int
+
 // CubePanelTriggerRaw 移動拠点キューブのコントロールパネルトリガー
 type CubePanelTriggerRaw = map[string]interface{}
 
+// CurrencyDelta 会話で増減させる所持金。負の値で支払わせる
+type CurrencyDelta = int
+
 // DamageBonus ダメージ補正値
 type DamageBonus = int
 
@@ -935,6 +1037,108 @@ type Dialog struct {
 	MessageKey MessageKey `json:"messageKey"`
 }
 
+// Dialogue 選択肢で分岐する会話。id はメンバーの dialog.messageKey から引き、先頭のノードから始める
+type Dialogue struct {
+	// Id メッセージリソースのキー
+	Id    MessageKey     `json:"id"`
+	Nodes []DialogueNode `json:"nodes"`
+}
+
+// DialogueChoice 会話の選択肢
+type DialogueChoice struct {
+	// Conditions すべて満たすときだけ選択肢を出す
+	Conditions *[]DialogueCondition `json:"conditions,omitempty"`
+
+	// Effects 選んだときに順に適用する効果
+	Effects *[]DialogueEffect `json:"effects,omitempty"`
+
+	// Next 選んだあとに進むノード。省略すると会話を終える
+	Next *DialogueNodeId `json:"next,omitempty"`
+
+	// Text 会話の本文や選択肢の文言。英語原文で書き、ja.po の msgid にする。強調語は <keyword> で囲む
+	Text DialogueText `json:"text"`
+}
+
+// DialogueCondition 選択肢を出す条件。item、event、skill、relation、quest のどれか1つを指定する
+type DialogueCondition struct {
+	// Count item の必要数。省略すると1
+	Count *ItemCount `json:"count,omitempty"`
+
+	// Event このゲーム進行イベントを見ている
+	Event *EventId `json:"event,omitempty"`
+
+	// Item このアイテムを count 個以上持っている
+	Item *EntityID `json:"item,omitempty"`
+
+	// Level skill の必要値。省略すると1
+	Level *SkillLevel `json:"level,omitempty"`
+
+	// Not 条件を反転するかどうか
+	Not *NegateCondition `json:"not,omitempty"`
+
+	// Quest このクエストを受けている。status を指定するとその状態のときだけ満たす
+	Quest *QuestId `json:"quest,omitempty"`
+
+	// Relation 話し手との派閥関係がこれに一致する
+	Relation *FactionRelation `json:"relation,omitempty"`
+
+	// Skill このスキルの値が level 以上
+	Skill *SkillId `json:"skill,omitempty"`
+
+	// Status quest の状態。省略すると受けていればどの状態でも満たす
+	Status *QuestStatus `json:"status,omitempty"`
+}
+
+// DialogueEffect 選択肢を選んだときの効果。giveItem、takeItem、setEvent、currency、openShop、startQuest のどれか1つを指定する
+type DialogueEffect struct {
+	// Count giveItem と takeItem の個数。省略すると1
+	Count *ItemCount `json:"count,omitempty"`
+
+	// Currency プレイヤーの所持金を増減する。払えなければ選択肢を出さない
+	Currency *CurrencyDelta `json:"currency,omitempty"`
+
+	// GiveItem プレイヤーにこのアイテムを count 個与える
+	GiveItem *EntityID `json:"giveItem,omitempty"`
+
+	// OpenShop 話し手の店を開くかどうか
+	OpenShop *OpensShop `json:"openShop,omitempty"`
+
+	// SetEvent このゲーム進行イベントを見たことにする
+	SetEvent *EventId `json:"setEvent,omitempty"`
+
+	// StartQuest このクエストを受ける。受けたことがあれば選択肢を出さない
+	StartQuest *QuestId `json:"startQuest,omitempty"`
+
+	// TakeItem プレイヤーからこのアイテムを count 個取り上げる。足りなければ選択肢を出さない
+	TakeItem *EntityID `json:"takeItem,omitempty"`
+}
+
+// DialogueList 会話一覧
+type DialogueList struct {
+	Data       []Dialogue `json:"data"`
+	TotalCount int        `json:"totalCount"`
+}
+
+// DialogueNode 会話の1ページ
+type DialogueNode struct {
+	Choices *[]DialogueChoice `json:"choices,omitempty"`
+
+	// Id 会話ノードの ID。1つの会話の中で一意にする
+	Id DialogueNodeId `json:"id"`
+
+	// Next 選択肢がないときに続けて表示するノード。省略すると会話を終える
+	Next *DialogueNodeId `json:"next,omitempty"`
+
+	// Text 会話の本文や選択肢の文言。英語原文で書き、ja.po の msgid にする。強調語は <keyword> で囲む
+	Text DialogueText `json:"text"`
+}
+
+// DialogueNodeId 会話ノードの ID。1つの会話の中で一意にする
+type DialogueNodeId = string
+
+// DialogueText 会話の本文や選択肢の文言。英語原文で書き、ja.po の msgid にする。強調語は <keyword> で囲む
+type DialogueText = string
+
 // Dice ダイス表記の個数指定。固定値も 1d1 のように書く。consts.ParseDice でパースする
 type Dice = string
 
@@ -1019,6 +1223,58 @@ type DropTableList struct {
 	TotalCount int         `json:"totalCount"`
 }
 
+// Dungeon フロアを生成して潜るダンジョン。name はセーブに残るステージ名で、定義を引くキーになる
+type Dungeon struct {
+	// BaseTemperature ダンジョンの基本気温（摂氏）
+	BaseTemperature DungeonCelsius `json:"baseTemperature"`
+
+	// BossMusic ボスフロアで流す曲。省略すると既定のボス曲を流す
+	BossMusic *SoundId `json:"bossMusic,omitempty"`
+
+	// BossPlanner 最終階で使うプランナー。省略するとボスフロアなし
+	BossPlanner *PlannerName `json:"bossPlanner,omitempty"`
+
+	// Description 説明文
+	Description *EntityDescription `json:"description,omitempty"`
+
+	// EnemyTableId 敵を湧かせるテーブルの id。省略すると敵が湧かない
+	EnemyTableId *EntityID `json:"enemyTableId,omitempty"`
+
+	// ImageKey 選択画面の背景画像のスプライトキー
+	ImageKey *SpriteKey `json:"imageKey,omitempty"`
+
+	// ItemTableId アイテムを置くテーブルの id。省略するとアイテムを置かない
+	ItemTableId *EntityID `json:"itemTableId,omitempty"`
+
+	// Music 各階で流す曲。省略すると既定のダンジョン曲を流す
+	Music *SoundId `json:"music,omitempty"`
+
+	// Name エンティティ名
+	Name     EntityName       `json:"name"`
+	Planners []DungeonPlanner `json:"planners"`
+
+	// TotalFloors ダンジョンの総階層数。最終階がボスフロアになる
+	TotalFloors TotalFloors `json:"totalFloors"`
+}
+
+// DungeonCelsius ダンジョンの基本気温（摂氏）
+type DungeonCelsius = int
+
+// DungeonList ダンジョン一覧
+type DungeonList struct {
+	Data       []Dungeon `json:"data"`
+	TotalCount int       `json:"totalCount"`
+}
+
+// DungeonPlanner ダンジョンで使うマップ種類と出現重み
+type DungeonPlanner struct {
+	// Planner マップ生成プランナーの名前。mapplanner.PlannerTypeByName で引ける名前を書く
+	Planner PlannerName `json:"planner"`
+
+	// Weight テーブルエントリの重み。大きいほど選ばれやすい
+	Weight EntryWeight `json:"weight"`
+}
+
 // Element 攻撃属性
 type Element string
 
@@ -1099,9 +1355,15 @@ type Error struct {
 	Message string `json:"message"`
 }
 
+// EventId ゲーム進行イベントの ID
+type EventId = string
+
 // FactionMemberType 派閥タイプ
 type FactionMemberType string
 
+// FactionRelation プレイヤーから見た話し手の派閥関係
+type FactionRelation string
+
 // Fire 遠距離攻撃設定
 type Fire struct {
 	// Accuracy 命中率。0で必中なし、100で必中
@@ -1201,6 +1463,9 @@ type Item struct {
 	// InflictsDamage 基本ダメージ
 	InflictsDamage *BaseDamage `json:"inflictsDamage,omitempty"`
 
+	// Key 鍵として開けられる錠の ID
+	Key *LockKeyId `json:"key,omitempty"`
+
 	// LightSource 携行光源。装備すると owner を照らす
 	LightSource *LightSource `json:"lightSource,omitempty"`
 
@@ -1216,6 +1481,12 @@ type Item struct {
 	// ProvidesNutrition 栄養価
 	ProvidesNutrition *NutritionAmount `json:"providesNutrition,omitempty"`
 
+	// ProvidesTreatment 手当ての治療量。使うと負傷を深い順に治す
+	ProvidesTreatment *TreatmentAmount `json:"providesTreatment,omitempty"`
+
+	// Sounds 命中や拾得などで鳴らす効果音。省略した出来事は既定の音を鳴らす
+	Sounds *SoundSet `json:"sounds,omitempty"`
+
 	// SpriteKey スプライトキー
 	SpriteKey SpriteKey `json:"spriteKey"`
 
@@ -1225,6 +1496,9 @@ type Item struct {
 	// StageLength 1段階の長さ。この経過ターンごとに新鮮→劣化→腐敗と進む。省略すると腐敗しない
 	StageLength *StageLengthTurns `json:"stageLength,omitempty"`
 
+	// Throwable 投擲設定。投げるの対象になる
+	Throwable *Throwable `json:"throwable,omitempty"`
+
 	// Value 売買価格
 	Value ItemValue `json:"value"`
 
@@ -1332,6 +1606,21 @@ type LightSource struct {
 	Radius LightRadius `json:"radius"`
 }
 
+// LockDifficulty 錠の難度。機械スキルと工具のグレードを足した値と競う
+type LockDifficulty = int
+
+// LockKeyId 錠と鍵を対応づける ID。錠の keyId と鍵の key が一致すれば開く
+type LockKeyId = string
+
+// LockRaw 錠ローデータ。付いた置物は鍵で開けるか、解錠か破錠で錠を外すまで開かない
+type LockRaw struct {
+	// Difficulty 錠の難度。機械スキルと工具のグレードを足した値と競う
+	Difficulty LockDifficulty `json:"difficulty"`
+
+	// KeyId 合う鍵の ID。省略すると鍵では開かない
+	KeyId *LockKeyId `json:"keyId,omitempty"`
+}
+
 // MagazineSize マガジン容量
 type MagazineSize = int
 
@@ -1401,9 +1690,15 @@ type Member struct {
 	// Name エンティティ名
 	Name EntityName `json:"name"`
 
+	// Planner 行動計画の種類。群れで配置されたときの振る舞いを定義する
+	Planner *MemberPlannerType `json:"planner,omitempty"`
+
 	// Player プレイヤーキャラクターかどうか
 	Player *IsPlayer `json:"player,omitempty"`
 
+	// Sounds 素手や牙での攻撃の効果音。武器が音を持てばそちらを優先する
+	Sounds *SoundSet `json:"sounds,omitempty"`
+
 	// SpriteKey スプライトキー
 	SpriteKey SpriteKey `json:"spriteKey"`
 
@@ -1420,15 +1715,39 @@ type MemberList struct {
 	TotalCount int      `json:"totalCount"`
 }
 
+// MemberPlannerType 行動計画の種類。群れで配置されたときの振る舞いを定義する
+type MemberPlannerType string
+
 // MessageKey メッセージリソースのキー
 type MessageKey = string
 
+// Milestone 過去の run の記録から判定する節目。指定した項目をすべて満たすと到達する
+type Milestone struct {
+	// Days 最も長く生き延びた日数がこれ以上
+	Days *MilestoneValue `json:"days,omitempty"`
+
+	// Runs 終えた run の数がこれ以上
+	Runs *MilestoneValue `json:"runs,omitempty"`
+
+	// Score 最高スコアがこれ以上
+	Score *MilestoneValue `json:"score,omitempty"`
+}
+
+// MilestoneValue 節目の到達に要る値。run 数・生存日数・スコアで共用する
+type MilestoneValue = int
+
 // MovementPatternType 非戦闘時の移動パターン
 type MovementPatternType string
 
+// NegateCondition 条件を反転するかどうか
+type NegateCondition = bool
+
 // NutritionAmount 栄養価
 type NutritionAmount = int
 
+// OpensShop 話し手の店を開くかどうか
+type OpensShop = bool
+
 // Palette パレット
 type Palette struct {
 	// Description 説明文
@@ -1462,6 +1781,9 @@ type PaletteList struct {
 // PassCost 通行コスト加算値。0で変化なし、50でベースコスト+50
 type PassCost = int
 
+// PlannerName マップ生成プランナーの名前。mapplanner.PlannerTypeByName で引ける名前を書く
+type PlannerName = string
+
 // Profession 職業
 type Profession struct {
 	// Abilities 能力値
@@ -1478,6 +1800,9 @@ type Profession struct {
 	// Name エンティティ名
 	Name   EntityName         `json:"name"`
 	Skills *[]ProfessionSkill `json:"skills,omitempty"`
+
+	// Unlock 到達すると選べるようになる節目。省略すると最初から選べる
+	Unlock *Milestone `json:"unlock,omitempty"`
 }
 
 // ProfessionEquip 職業初期装備
@@ -1499,6 +1824,9 @@ type ProfessionItem struct {
 
 	// Name エンティティ名
 	Name EntityName `json:"name"`
+
+	// Unlock 到達すると初期所持に加わる節目。省略すると最初から持つ
+	Unlock *Milestone `json:"unlock,omitempty"`
 }
 
 // ProfessionList 職業一覧
@@ -1526,6 +1854,9 @@ type Prop struct {
 	// BlockView 視線を遮るかどうか
 	BlockView BlocksView `json:"blockView"`
 
+	// CraftStation 合成の作業場として使えること
+	CraftStation *CraftStation `json:"craftStation,omitempty"`
+
 	// CubePanelTrigger 移動拠点キューブのコントロールパネルトリガー
 	CubePanelTrigger *CubePanelTriggerRaw `json:"cubePanelTrigger,omitempty"`
 
@@ -1547,6 +1878,9 @@ type Prop struct {
 	// LightSource 光源設定
 	LightSource *LightSource `json:"lightSource,omitempty"`
 
+	// Lock 錠。収納に付けると中を覗く前に外す必要がある
+	Lock *LockRaw `json:"lock,omitempty"`
+
 	// Name エンティティ名
 	Name EntityName `json:"name"`
 
@@ -1556,6 +1890,9 @@ type Prop struct {
 	// ShippingStation 通信販売の出荷場所ローデータ。収納の中身を集荷対象にし、出荷場所メニューを開く相互作用が付く。積載量は storage で持つ
 	ShippingStation *ShippingStationRaw `json:"shippingStation,omitempty"`
 
+	// Sounds 扉を開けたときなどに鳴らす効果音
+	Sounds *SoundSet `json:"sounds,omitempty"`
+
 	// SpriteRender スプライトレンダー設定
 	SpriteRender SpriteRender `json:"spriteRender"`
 
@@ -1590,6 +1927,74 @@ type ProvidesHealing struct {
 	ValueType HealingValueType `json:"valueType"`
 }
 
+// Quest 目標を並べたクエスト。会話の startQuest で受け、目標をすべて満たすと報酬を受け取って終わる
+type Quest struct {
+	// Description 説明文
+	Description EntityDescription `json:"description"`
+
+	// Id クエストの ID
+	Id QuestId `json:"id"`
+
+	// Name エンティティ名
+	Name       EntityName       `json:"name"`
+	Objectives []QuestObjective `json:"objectives"`
+
+	// Reward クエスト報酬
+	Reward *QuestReward `json:"reward,omitempty"`
+}
+
+// QuestId クエストの ID
+type QuestId = string
+
+// QuestList クエスト一覧
+type QuestList struct {
+	Data       []Quest `json:"data"`
+	TotalCount int     `json:"totalCount"`
+}
+
+// QuestObjective クエストの目標。collect、deliver、kill、reach、surviveDays のどれか1つを指定する
+type QuestObjective struct {
+	// Collect このアイテムを count 個持っている
+	Collect *EntityID `json:"collect,omitempty"`
+
+	// Count collect、deliver、kill の数。省略すると1
+	Count *ItemCount `json:"count,omitempty"`
+
+	// Deliver このアイテムを count 個、to のメンバーに話しかけて渡す
+	Deliver *EntityID `json:"deliver,omitempty"`
+
+	// Kill このメンバーを count 体倒す
+	Kill *EntityID `json:"kill,omitempty"`
+
+	// Reach オーバーワールドのこのチャンクに着く
+	Reach *ChunkCoord `json:"reach,omitempty"`
+
+	// SurviveDays クエストを受けてから生き延びる日数
+	SurviveDays *SurviveDays `json:"surviveDays,omitempty"`
+
+	// To deliver の渡し先のメンバー id
+	To *EntityID `json:"to,omitempty"`
+}
+
+// QuestReward クエスト報酬
+type QuestReward struct {
+	// Currency クエスト達成で受け取る所持金
+	Currency *RewardCurrency    `json:"currency,omitempty"`
+	Items    *[]QuestRewardItem `json:"items,omitempty"`
+}
+
+// QuestRewardItem クエスト報酬のアイテム
+type QuestRewardItem struct {
+	// Count アイテム所持数
+	Count ItemCount `json:"count"`
+
+	// Id エンティティの英語 id
+	Id EntityID `json:"id"`
+}
+
+// QuestStatus クエストの進み具合
+type QuestStatus string
+
 // RGBAColor RGBA色
 type RGBAColor struct {
 	// A RGBA色チャネル値 (0-255)
@@ -1608,7 +2013,9 @@ type RGBAColor struct {
 // Raws ローデータ全体。TOMLファイルのルート構造を定義する
 type Raws struct {
 	CommandTables *[]CommandTable `json:"commandTables,omitempty"`
+	Dialogues     *[]Dialogue     `json:"dialogues,omitempty"`
 	DropTables    *[]DropTable    `json:"dropTables,omitempty"`
+	Dungeons      *[]Dungeon      `json:"dungeons,omitempty"`
 	EnemyTables   *[]EnemyTable   `json:"enemyTables,omitempty"`
 	ItemGroups    *[]ItemGroup    `json:"itemGroups,omitempty"`
 	ItemTables    *[]ItemTable    `json:"itemTables,omitempty"`
@@ -1616,6 +2023,7 @@ type Raws struct {
 	Members       *[]Member       `json:"members,omitempty"`
 	Professions   *[]Profession   `json:"professions,omitempty"`
 	Props         *[]Prop         `json:"props,omitempty"`
+	Quests        *[]Quest        `json:"quests,omitempty"`
 	Recipes       *[]Recipe       `json:"recipes,omitempty"`
 	SpriteSheets  *[]SpriteSheet  `json:"spriteSheets,omitempty"`
 	Tiles         *[]Tile         `json:"tiles,omitempty"`
@@ -1632,6 +2040,15 @@ type Recipe struct {
 
 	// Name エンティティ名
 	Name EntityName `json:"name"`
+
+	// Station 近くに要る作業場。省略するとどこでも合成できる
+	Station *CraftStation `json:"station,omitempty"`
+
+	// Tool 所持品に要る工具。省略すると工具は要らない
+	Tool *RecipeTool `json:"tool,omitempty"`
+
+	// WorkAP 合成にかかる作業量。省略すると即座に仕上がる
+	WorkAP *CraftWorkAP `json:"workAP,omitempty"`
 }
 
 // RecipeInput レシピ素材
@@ -1643,6 +2060,15 @@ type RecipeInput struct {
 	Id EntityID `json:"id"`
 }
 
+// RecipeTool レシピの必要工具。分解工具の分類とグレードで判定する
+type RecipeTool struct {
+	// Category 分解工具の分類
+	Category ToolCategory `json:"category"`
+
+	// Grade 必要な最低グレード
+	Grade ToolGrade `json:"grade"`
+}
+
 // RecipeList レシピ一覧レスポンス
 type RecipeList struct {
 	Data       []Recipe `json:"data"`
@@ -1652,6 +2078,9 @@ type RecipeList struct {
 // ReloadEffort リロードに必要な行動力
 type ReloadEffort = int
 
+// RewardCurrency クエスト達成で受け取る所持金
+type RewardCurrency = int
+
 // SaveDataAbilitiesComponent 能力値
 type SaveDataAbilitiesComponent struct {
 	// Agility 単一能力値。基本値・修正値・合計値を持つ
@@ -2374,6 +2803,30 @@ type SkillId = string
 // SkillLevel スキルレベル
 type SkillLevel = int
 
+// SoundId 音の ID。assets/file/sounds 配下の同名の音声ファイルを鳴らす
+type SoundId = string
+
+// SoundSet 出来事ごとの効果音の ID。省略した出来事は既定の音を鳴らす
+type SoundSet struct {
+	// Hit 攻撃が当たったとき
+	Hit *SoundId `json:"hit,omitempty"`
+
+	// Miss 攻撃が外れたとき
+	Miss *SoundId `json:"miss,omitempty"`
+
+	// Open 扉を開けたとき
+	Open *SoundId `json:"open,omitempty"`
+
+	// Pickup 拾ったとき
+	Pickup *SoundId `json:"pickup,omitempty"`
+
+	// Reload 装填し終えたとき
+	Reload *SoundId `json:"reload,omitempty"`
+}
+
+// SplashRadius 着弾点からの巻き込み半径（タイル単位）。0で着弾点だけ
+type SplashRadius = int
+
 // SpriteDepth スプライト描画深度
 type SpriteDepth float32
 
@@ -2413,6 +2866,9 @@ type SpriteSheetName = string
 // StageLengthTurns 1段階の長さ。この経過ターンごとに新鮮→劣化→腐敗と進む。省略すると腐敗しない
 type StageLengthTurns = int
 
+// StorageCelsius 収納内を保つ気温（摂氏）。中身の食料はこの気温で劣化する
+type StorageCelsius = int
+
 // StorageRaw 収納ローデータ
 type StorageRaw struct {
 	// LootCount 初期アイテム数のダイス表記。省略時は1
@@ -2423,17 +2879,41 @@ type StorageRaw struct {
 
 	// MaxWeight 収納の最大格納重量
 	MaxWeight Weight `json:"maxWeight"`
+
+	// Temperature 収納内を保つ気温。省略すると置き場所の気温と同じになる
+	Temperature *StorageCelsius `json:"temperature,omitempty"`
 }
 
 // Strength 筋力。物理ダメージに影響する
 type Strength = int
 
+// SurviveDays クエストを受けてから生き延びる日数
+type SurviveDays = int
+
 // TargetGroup ターゲットグループ
 type TargetGroup string
 
 // TargetNum ターゲット数
 type TargetNum string
 
+// ThrowRange 投擲の射程（タイル単位）
+type ThrowRange = int
+
+// Throwable 投擲設定
+type Throwable struct {
+	// BreaksOnImpact 着弾時に壊れるかどうか
+	BreaksOnImpact BreaksOnImpact `json:"breaksOnImpact"`
+
+	// Damage 基本ダメージ
+	Damage BaseDamage `json:"damage"`
+
+	// Range 投擲の射程（タイル単位）
+	Range ThrowRange `json:"range"`
+
+	// Splash 着弾点からの巻き込み半径（タイル単位）。0で着弾点だけ
+	Splash *SplashRadius `json:"splash,omitempty"`
+}
+
 // Tile タイル
 type Tile struct {
 	// BlockPass 通行を妨げるかどうか
@@ -2476,6 +2956,12 @@ type ToolCategory string
 // ToolGrade 分解工具のグレード。高いほど速く多く得る
 type ToolGrade = int
 
+// TotalFloors ダンジョンの総階層数。最終階がボスフロアになる
+type TotalFloors = int
+
+// TreatmentAmount 1回の手当ての治療量。負傷の深さ（0-100）と同じ尺度
+type TreatmentAmount = int
+
 // UsableScene 使用可能シーン
 type UsableScene string
 
@@ -2521,12 +3007,24 @@ type CommandTablesCreateJSONRequestBody = CommandTable
 // CommandTablesUpdateJSONRequestBody defines body for CommandTablesUpdate for application/json ContentType.
 type CommandTablesUpdateJSONRequestBody = CommandTable
 
+// DialoguesCreateJSONRequestBody defines body for DialoguesCreate for application/json ContentType.
+type DialoguesCreateJSONRequestBody = Dialogue
+
+// DialoguesUpdateJSONRequestBody defines body for DialoguesUpdate for application/json ContentType.
+type DialoguesUpdateJSONRequestBody = Dialogue
+
 // DropTablesCreateJSONRequestBody defines body for DropTablesCreate for application/json ContentType.
 type DropTablesCreateJSONRequestBody = DropTable
 
 // DropTablesUpdateJSONRequestBody defines body for DropTablesUpdate for application/json ContentType.
 type DropTablesUpdateJSONRequestBody = DropTable
 
+// DungeonsCreateJSONRequestBody defines body for DungeonsCreate for application/json ContentType.
+type DungeonsCreateJSONRequestBody = Dungeon
+
+// DungeonsUpdateJSONRequestBody defines body for DungeonsUpdate for application/json ContentType.
+type DungeonsUpdateJSONRequestBody = Dungeon
+
 // EnemyTablesCreateJSONRequestBody defines body for EnemyTablesCreate for application/json ContentType.
 type EnemyTablesCreateJSONRequestBody = EnemyTable
 
@@ -2575,6 +3073,12 @@ type PropsCreateJSONRequestBody = Prop
 // PropsUpdateJSONRequestBody defines body for PropsUpdate for application/json ContentType.
 type PropsUpdateJSONRequestBody = Prop
 
+// QuestsCreateJSONRequestBody defines body for QuestsCreate for application/json ContentType.
+type QuestsCreateJSONRequestBody = Quest
+
+// QuestsUpdateJSONRequestBody defines body for QuestsUpdate for application/json ContentType.
+type QuestsUpdateJSONRequestBody = Quest
+
 // RecipesCreateJSONRequestBody defines body for RecipesCreate for application/json ContentType.
 type RecipesCreateJSONRequestBody = Recipe
 
@@ -30,6 +30,18 @@ type ServerInterface interface {
 	// (PUT /api/v1/command-tables/{index})
 	CommandTablesUpdate(w http.ResponseWriter, r *http.Request, index int)
 
+	// (GET /api/v1/dialogues)
+	DialoguesList(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/v1/dialogues)
+	DialoguesCreate(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /api/v1/dialogues/{index})
+	DialoguesDelete(w http.ResponseWriter, r *http.Request, index int)
+
+	// (PUT /api/v1/dialogues/{index})
+	DialoguesUpdate(w http.ResponseWriter, r *http.Request, index int)
+
 	// (GET /api/v1/drop-tables)
 	DropTablesList(w http.ResponseWriter, r *http.Request)
 
@@ -42,6 +54,18 @@ type ServerInterface interface {
 	// (PUT /api/v1/drop-tables/{index})
 	DropTablesUpdate(w http.ResponseWriter, r *http.Request, index int)
 
+	// (GET /api/v1/dungeons)
+	DungeonsList(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/v1/dungeons)
+	DungeonsCreate(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /api/v1/dungeons/{index})
+	DungeonsDelete(w http.ResponseWriter, r *http.Request, index int)
+
+	// (PUT /api/v1/dungeons/{index})
+	DungeonsUpdate(w http.ResponseWriter, r *http.Request, index int)
+
 	// (GET /api/v1/enemy-tables)
 	EnemyTablesList(w http.ResponseWriter, r *http.Request)
 
@@ -150,6 +174,18 @@ type ServerInterface interface {
 	// (PUT /api/v1/props/{index})
 	PropsUpdate(w http.ResponseWriter, r *http.Request, index int)
 
+	// (GET /api/v1/quests)
+	QuestsList(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/v1/quests)
+	QuestsCreate(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /api/v1/quests/{index})
+	QuestsDelete(w http.ResponseWriter, r *http.Request, index int)
+
+	// (PUT /api/v1/quests/{index})
+	QuestsUpdate(w http.ResponseWriter, r *http.Request, index int)
+
 	// (GET /api/v1/recipes)
 	RecipesList(w http.ResponseWriter, r *http.Request)
 
@@ -282,6 +318,86 @@ func (siw *ServerInterfaceWrapper) CommandTablesUpdate(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
+// DialoguesList operation middleware
+func (siw *ServerInterfaceWrapper) DialoguesList(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DialoguesList(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DialoguesCreate operation middleware
+func (siw *ServerInterfaceWrapper) DialoguesCreate(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DialoguesCreate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DialoguesDelete operation middleware
+func (siw *ServerInterfaceWrapper) DialoguesDelete(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "index" -------------
+	var index int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "index", r.PathValue("index"), &index, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "index", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DialoguesDelete(w, r, index)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DialoguesUpdate operation middleware
+func (siw *ServerInterfaceWrapper) DialoguesUpdate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "index" -------------
+	var index int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "index", r.PathValue("index"), &index, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "index", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DialoguesUpdate(w, r, index)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // DropTablesList operation middleware
 func (siw *ServerInterfaceWrapper) DropTablesList(w http.ResponseWriter, r *http.Request) {
 
@@ -362,6 +478,86 @@ func (siw *ServerInterfaceWrapper) DropTablesUpdate(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
+// DungeonsList operation middleware
+func (siw *ServerInterfaceWrapper) DungeonsList(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DungeonsList(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DungeonsCreate operation middleware
+func (siw *ServerInterfaceWrapper) DungeonsCreate(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DungeonsCreate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DungeonsDelete operation middleware
+func (siw *ServerInterfaceWrapper) DungeonsDelete(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "index" -------------
+	var index int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "index", r.PathValue("index"), &index, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "index", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DungeonsDelete(w, r, index)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DungeonsUpdate operation middleware
+func (siw *ServerInterfaceWrapper) DungeonsUpdate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "index" -------------
+	var index int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "index", r.PathValue("index"), &index, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "index", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DungeonsUpdate(w, r, index)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // EnemyTablesList operation middleware
 func (siw *ServerInterfaceWrapper) EnemyTablesList(w http.ResponseWriter, r *http.Request) {
 
@@ -1106,6 +1302,86 @@ func (siw *ServerInterfaceWrapper) PropsUpdate(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
+// QuestsList operation middleware
+func (siw *ServerInterfaceWrapper) QuestsList(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.QuestsList(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// QuestsCreate operation middleware
+func (siw *ServerInterfaceWrapper) QuestsCreate(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.QuestsCreate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// QuestsDelete operation middleware
+func (siw *ServerInterfaceWrapper) QuestsDelete(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "index" -------------
+	var index int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "index", r.PathValue("index"), &index, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "index", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.QuestsDelete(w, r, index)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// QuestsUpdate operation middleware
+func (siw *ServerInterfaceWrapper) QuestsUpdate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "index" -------------
+	var index int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "index", r.PathValue("index"), &index, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "index", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.QuestsUpdate(w, r, index)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // RecipesList operation middleware
 func (siw *ServerInterfaceWrapper) RecipesList(w http.ResponseWriter, r *http.Request) {
 
@@ -1522,10 +1798,18 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/api/v1/command-tables", wrapper.CommandTablesCreate)
 	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/api/v1/command-tables/{index}", wrapper.CommandTablesDelete)
 	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/api/v1/command-tables/{index}", wrapper.CommandTablesUpdate)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/api/v1/dialogues", wrapper.DialoguesList)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/api/v1/dialogues", wrapper.DialoguesCreate)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/api/v1/dialogues/{index}", wrapper.DialoguesDelete)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/api/v1/dialogues/{index}", wrapper.DialoguesUpdate)
 	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/api/v1/drop-tables", wrapper.DropTablesList)
 	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/api/v1/drop-tables", wrapper.DropTablesCreate)
 	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/api/v1/drop-tables/{index}", wrapper.DropTablesDelete)
 	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/api/v1/drop-tables/{index}", wrapper.DropTablesUpdate)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/api/v1/dungeons", wrapper.DungeonsList)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/api/v1/dungeons", wrapper.DungeonsCreate)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/api/v1/dungeons/{index}", wrapper.DungeonsDelete)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/api/v1/dungeons/{index}", wrapper.DungeonsUpdate)
 	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/api/v1/enemy-tables", wrapper.EnemyTablesList)
 	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/api/v1/enemy-tables", wrapper.EnemyTablesCreate)
 	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/api/v1/enemy-tables/{index}", wrapper.EnemyTablesDelete)
@@ -1562,6 +1846,10 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/api/v1/props/{index}", wrapper.PropsDelete)
 	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/api/v1/props/{index}", wrapper.PropsGet)
 	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/api/v1/props/{index}", wrapper.PropsUpdate)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/api/v1/quests", wrapper.QuestsList)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/api/v1/quests", wrapper.QuestsCreate)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/api/v1/quests/{index}", wrapper.QuestsDelete)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/api/v1/quests/{index}", wrapper.QuestsUpdate)
 	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/api/v1/recipes", wrapper.RecipesList)
 	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/api/v1/recipes", wrapper.RecipesCreate)
 	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/api/v1/recipes/{index}", wrapper.RecipesDelete)
@@ -1730,16 +2018,16 @@ func (response CommandTablesUpdatedefaultJSONResponse) VisitCommandTablesUpdateR
 	return err
 }
 
-type DropTablesListRequestObject struct {
+type DialoguesListRequestObject struct {
 }
 
-type DropTablesListResponseObject interface {
-	VisitDropTablesListResponse(w http.ResponseWriter) error
+type DialoguesListResponseObject interface {
+	VisitDialoguesListResponse(w http.ResponseWriter) error
 }
 
-type DropTablesList200JSONResponse DropTableList
+type DialoguesList200JSONResponse DialogueList
 
-func (response DropTablesList200JSONResponse) VisitDropTablesListResponse(w http.ResponseWriter) error {
+func (response DialoguesList200JSONResponse) VisitDialoguesListResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -1751,12 +2039,12 @@ func (response DropTablesList200JSONResponse) VisitDropTablesListResponse(w http
 	return err
 }
 
-type DropTablesListdefaultJSONResponse struct {
+type DialoguesListdefaultJSONResponse struct {
 	Body       Error
 	StatusCode int
 }
 
-func (response DropTablesListdefaultJSONResponse) VisitDropTablesListResponse(w http.ResponseWriter) error {
+func (response DialoguesListdefaultJSONResponse) VisitDialoguesListResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
@@ -1768,17 +2056,17 @@ func (response DropTablesListdefaultJSONResponse) VisitDropTablesListResponse(w
 	return err
 }
 
-type DropTablesCreateRequestObject struct {
-	Body *DropTablesCreateJSONRequestBody
+type DialoguesCreateRequestObject struct {
+	Body *DialoguesCreateJSONRequestBody
 }
 
-type DropTablesCreateResponseObject interface {
-	VisitDropTablesCreateResponse(w http.ResponseWriter) error
+type DialoguesCreateResponseObject interface {
+	VisitDialoguesCreateResponse(w http.ResponseWriter) error
 }
 
-type DropTablesCreate201JSONResponse DropTable
+type DialoguesCreate201JSONResponse Dialogue
 
-func (response DropTablesCreate201JSONResponse) VisitDropTablesCreateResponse(w http.ResponseWriter) error {
+func (response DialoguesCreate201JSONResponse) VisitDialoguesCreateResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -1790,12 +2078,12 @@ func (response DropTablesCreate201JSONResponse) VisitDropTablesCreateResponse(w
 	return err
 }
 
-type DropTablesCreatedefaultJSONResponse struct {
+type DialoguesCreatedefaultJSONResponse struct {
 	Body       Error
 	StatusCode int
 }
 
-func (response DropTablesCreatedefaultJSONResponse) VisitDropTablesCreateResponse(w http.ResponseWriter) error {
+func (response DialoguesCreatedefaultJSONResponse) VisitDialoguesCreateResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
@@ -1807,28 +2095,28 @@ func (response DropTablesCreatedefaultJSONResponse) VisitDropTablesCreateRespons
 	return err
 }
 
-type DropTablesDeleteRequestObject struct {
+type DialoguesDeleteRequestObject struct {
 	Index int `json:"index"`
 }
 
-type DropTablesDeleteResponseObject interface {
-	VisitDropTablesDeleteResponse(w http.ResponseWriter) error
+type DialoguesDeleteResponseObject interface {
+	VisitDialoguesDeleteResponse(w http.ResponseWriter) error
 }
 
-type DropTablesDelete204Response struct {
+type DialoguesDelete204Response struct {
 }
 
-func (response DropTablesDelete204Response) VisitDropTablesDeleteResponse(w http.ResponseWriter) error {
+func (response DialoguesDelete204Response) VisitDialoguesDeleteResponse(w http.ResponseWriter) error {
 	w.WriteHeader(204)
 	return nil
 }
 
-type DropTablesDeletedefaultJSONResponse struct {
+type DialoguesDeletedefaultJSONResponse struct {
 	Body       Error
 	StatusCode int
 }
 
-func (response DropTablesDeletedefaultJSONResponse) VisitDropTablesDeleteResponse(w http.ResponseWriter) error {
+func (response DialoguesDeletedefaultJSONResponse) VisitDialoguesDeleteResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
@@ -1840,18 +2128,18 @@ func (response DropTablesDeletedefaultJSONResponse) VisitDropTablesDeleteRespons
 	return err
 }
 
-type DropTablesUpdateRequestObject struct {
+type DialoguesUpdateRequestObject struct {
 	Index int `json:"index"`
-	Body  *DropTablesUpdateJSONRequestBody
+	Body  *DialoguesUpdateJSONRequestBody
 }
 
-type DropTablesUpdateResponseObject interface {
-	VisitDropTablesUpdateResponse(w http.ResponseWriter) error
+type DialoguesUpdateResponseObject interface {
+	VisitDialoguesUpdateResponse(w http.ResponseWriter) error
 }
 
-type DropTablesUpdate200JSONResponse DropTable
+type DialoguesUpdate200JSONResponse Dialogue
 
-func (response DropTablesUpdate200JSONResponse) VisitDropTablesUpdateResponse(w http.ResponseWriter) error {
+func (response DialoguesUpdate200JSONResponse) VisitDialoguesUpdateResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -1863,12 +2151,12 @@ func (response DropTablesUpdate200JSONResponse) VisitDropTablesUpdateResponse(w
 	return err
 }
 
-type DropTablesUpdatedefaultJSONResponse struct {
+type DialoguesUpdatedefaultJSONResponse struct {
 	Body       Error
 	StatusCode int
 }
 
-func (response DropTablesUpdatedefaultJSONResponse) VisitDropTablesUpdateResponse(w http.ResponseWriter) error {
+func (response DialoguesUpdatedefaultJSONResponse) VisitDialoguesUpdateResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
@@ -1880,16 +2168,316 @@ func (response DropTablesUpdatedefaultJSONResponse) VisitDropTablesUpdateRespons
 	return err
 }
 
-type EnemyTablesListRequestObject struct {
+type DropTablesListRequestObject struct {
 }
 
-type EnemyTablesListResponseObject interface {
-	VisitEnemyTablesListResponse(w http.ResponseWriter) error
+type DropTablesListResponseObject interface {
+	VisitDropTablesListResponse(w http.ResponseWriter) error
 }
 
-type EnemyTablesList200JSONResponse EnemyTableList
+type DropTablesList200JSONResponse DropTableList
 
-func (response EnemyTablesList200JSONResponse) VisitEnemyTablesListResponse(w http.ResponseWriter) error {
+func (response DropTablesList200JSONResponse) VisitDropTablesListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DropTablesListdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response DropTablesListdefaultJSONResponse) VisitDropTablesListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DropTablesCreateRequestObject struct {
+	Body *DropTablesCreateJSONRequestBody
+}
+
+type DropTablesCreateResponseObject interface {
+	VisitDropTablesCreateResponse(w http.ResponseWriter) error
+}
+
+type DropTablesCreate201JSONResponse DropTable
+
+func (response DropTablesCreate201JSONResponse) VisitDropTablesCreateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DropTablesCreatedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response DropTablesCreatedefaultJSONResponse) VisitDropTablesCreateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DropTablesDeleteRequestObject struct {
+	Index int `json:"index"`
+}
+
+type DropTablesDeleteResponseObject interface {
+	VisitDropTablesDeleteResponse(w http.ResponseWriter) error
+}
+
+type DropTablesDelete204Response struct {
+}
+
+func (response DropTablesDelete204Response) VisitDropTablesDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DropTablesDeletedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response DropTablesDeletedefaultJSONResponse) VisitDropTablesDeleteResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DropTablesUpdateRequestObject struct {
+	Index int `json:"index"`
+	Body  *DropTablesUpdateJSONRequestBody
+}
+
+type DropTablesUpdateResponseObject interface {
+	VisitDropTablesUpdateResponse(w http.ResponseWriter) error
+}
+
+type DropTablesUpdate200JSONResponse DropTable
+
+func (response DropTablesUpdate200JSONResponse) VisitDropTablesUpdateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DropTablesUpdatedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response DropTablesUpdatedefaultJSONResponse) VisitDropTablesUpdateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DungeonsListRequestObject struct {
+}
+
+type DungeonsListResponseObject interface {
+	VisitDungeonsListResponse(w http.ResponseWriter) error
+}
+
+type DungeonsList200JSONResponse DungeonList
+
+func (response DungeonsList200JSONResponse) VisitDungeonsListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DungeonsListdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response DungeonsListdefaultJSONResponse) VisitDungeonsListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DungeonsCreateRequestObject struct {
+	Body *DungeonsCreateJSONRequestBody
+}
+
+type DungeonsCreateResponseObject interface {
+	VisitDungeonsCreateResponse(w http.ResponseWriter) error
+}
+
+type DungeonsCreate201JSONResponse Dungeon
+
+func (response DungeonsCreate201JSONResponse) VisitDungeonsCreateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DungeonsCreatedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response DungeonsCreatedefaultJSONResponse) VisitDungeonsCreateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DungeonsDeleteRequestObject struct {
+	Index int `json:"index"`
+}
+
+type DungeonsDeleteResponseObject interface {
+	VisitDungeonsDeleteResponse(w http.ResponseWriter) error
+}
+
+type DungeonsDelete204Response struct {
+}
+
+func (response DungeonsDelete204Response) VisitDungeonsDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DungeonsDeletedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response DungeonsDeletedefaultJSONResponse) VisitDungeonsDeleteResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DungeonsUpdateRequestObject struct {
+	Index int `json:"index"`
+	Body  *DungeonsUpdateJSONRequestBody
+}
+
+type DungeonsUpdateResponseObject interface {
+	VisitDungeonsUpdateResponse(w http.ResponseWriter) error
+}
+
+type DungeonsUpdate200JSONResponse Dungeon
+
+func (response DungeonsUpdate200JSONResponse) VisitDungeonsUpdateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DungeonsUpdatedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response DungeonsUpdatedefaultJSONResponse) VisitDungeonsUpdateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type EnemyTablesListRequestObject struct {
+}
+
+type EnemyTablesListResponseObject interface {
+	VisitEnemyTablesListResponse(w http.ResponseWriter) error
+}
+
+type EnemyTablesList200JSONResponse EnemyTableList
+
+func (response EnemyTablesList200JSONResponse) VisitEnemyTablesListResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -3236,6 +3824,156 @@ func (response PropsUpdatedefaultJSONResponse) VisitPropsUpdateResponse(w http.R
 	return err
 }
 
+type QuestsListRequestObject struct {
+}
+
+type QuestsListResponseObject interface {
+	VisitQuestsListResponse(w http.ResponseWriter) error
+}
+
+type QuestsList200JSONResponse QuestList
+
+func (response QuestsList200JSONResponse) VisitQuestsListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type QuestsListdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response QuestsListdefaultJSONResponse) VisitQuestsListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type QuestsCreateRequestObject struct {
+	Body *QuestsCreateJSONRequestBody
+}
+
+type QuestsCreateResponseObject interface {
+	VisitQuestsCreateResponse(w http.ResponseWriter) error
+}
+
+type QuestsCreate201JSONResponse Quest
+
+func (response QuestsCreate201JSONResponse) VisitQuestsCreateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type QuestsCreatedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response QuestsCreatedefaultJSONResponse) VisitQuestsCreateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type QuestsDeleteRequestObject struct {
+	Index int `json:"index"`
+}
+
+type QuestsDeleteResponseObject interface {
+	VisitQuestsDeleteResponse(w http.ResponseWriter) error
+}
+
+type QuestsDelete204Response struct {
+}
+
+func (response QuestsDelete204Response) VisitQuestsDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type QuestsDeletedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response QuestsDeletedefaultJSONResponse) VisitQuestsDeleteResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type QuestsUpdateRequestObject struct {
+	Index int `json:"index"`
+	Body  *QuestsUpdateJSONRequestBody
+}
+
+type QuestsUpdateResponseObject interface {
+	VisitQuestsUpdateResponse(w http.ResponseWriter) error
+}
+
+type QuestsUpdate200JSONResponse Quest
+
+func (response QuestsUpdate200JSONResponse) VisitQuestsUpdateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type QuestsUpdatedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response QuestsUpdatedefaultJSONResponse) VisitQuestsUpdateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
 type RecipesListRequestObject struct {
 }
 
@@ -3779,6 +4517,18 @@ type StrictServerInterface interface {
 	// (PUT /api/v1/command-tables/{index})
 	CommandTablesUpdate(ctx context.Context, request CommandTablesUpdateRequestObject) (CommandTablesUpdateResponseObject, error)
 
+	// (GET /api/v1/dialogues)
+	DialoguesList(ctx context.Context, request DialoguesListRequestObject) (DialoguesListResponseObject, error)
+
+	// (POST /api/v1/dialogues)
+	DialoguesCreate(ctx context.Context, request DialoguesCreateRequestObject) (DialoguesCreateResponseObject, error)
+
+	// (DELETE /api/v1/dialogues/{index})
+	DialoguesDelete(ctx context.Context, request DialoguesDeleteRequestObject) (DialoguesDeleteResponseObject, error)
+
+	// (PUT /api/v1/dialogues/{index})
+	DialoguesUpdate(ctx context.Context, request DialoguesUpdateRequestObject) (DialoguesUpdateResponseObject, error)
+
 	// (GET /api/v1/drop-tables)
 	DropTablesList(ctx context.Context, request DropTablesListRequestObject) (DropTablesListResponseObject, error)
 
@@ -3791,6 +4541,18 @@ type StrictServerInterface interface {
 	// (PUT /api/v1/drop-tables/{index})
 	DropTablesUpdate(ctx context.Context, request DropTablesUpdateRequestObject) (DropTablesUpdateResponseObject, error)
 
+	// (GET /api/v1/dungeons)
+	DungeonsList(ctx context.Context, request DungeonsListRequestObject) (DungeonsListResponseObject, error)
+
+	// (POST /api/v1/dungeons)
+	DungeonsCreate(ctx context.Context, request DungeonsCreateRequestObject) (DungeonsCreateResponseObject, error)
+
+	// (DELETE /api/v1/dungeons/{index})
+	DungeonsDelete(ctx context.Context, request DungeonsDeleteRequestObject) (DungeonsDeleteResponseObject, error)
+
+	// (PUT /api/v1/dungeons/{index})
+	DungeonsUpdate(ctx context.Context, request DungeonsUpdateRequestObject) (DungeonsUpdateResponseObject, error)
+
 	// (GET /api/v1/enemy-tables)
 	EnemyTablesList(ctx context.Context, request EnemyTablesListRequestObject) (EnemyTablesListResponseObject, error)
 
@@ -3899,6 +4661,18 @@ type StrictServerInterface interface {
 	// (PUT /api/v1/props/{index})
 	PropsUpdate(ctx context.Context, request PropsUpdateRequestObject) (PropsUpdateResponseObject, error)
 
+	// (GET /api/v1/quests)
+	QuestsList(ctx context.Context, request QuestsListRequestObject) (QuestsListResponseObject, error)
+
+	// (POST /api/v1/quests)
+	QuestsCreate(ctx context.Context, request QuestsCreateRequestObject) (QuestsCreateResponseObject, error)
+
+	// (DELETE /api/v1/quests/{index})
+	QuestsDelete(ctx context.Context, request QuestsDeleteRequestObject) (QuestsDeleteResponseObject, error)
+
+	// (PUT /api/v1/quests/{index})
+	QuestsUpdate(ctx context.Context, request QuestsUpdateRequestObject) (QuestsUpdateResponseObject, error)
+
 	// (GET /api/v1/recipes)
 	RecipesList(ctx context.Context, request RecipesListRequestObject) (RecipesListResponseObject, error)
 
@@ -4085,6 +4859,120 @@ func (sh *strictHandler) CommandTablesUpdate(w http.ResponseWriter, r *http.Requ
 	}
 }
 
+// DialoguesList operation middleware
+func (sh *strictHandler) DialoguesList(w http.ResponseWriter, r *http.Request) {
+	var request DialoguesListRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DialoguesList(ctx, request.(DialoguesListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DialoguesList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DialoguesListResponseObject); ok {
+		if err := validResponse.VisitDialoguesListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DialoguesCreate operation middleware
+func (sh *strictHandler) DialoguesCreate(w http.ResponseWriter, r *http.Request) {
+	var request DialoguesCreateRequestObject
+
+	var body DialoguesCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DialoguesCreate(ctx, request.(DialoguesCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DialoguesCreate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DialoguesCreateResponseObject); ok {
+		if err := validResponse.VisitDialoguesCreateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DialoguesDelete operation middleware
+func (sh *strictHandler) DialoguesDelete(w http.ResponseWriter, r *http.Request, index int) {
+	var request DialoguesDeleteRequestObject
+
+	request.Index = index
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DialoguesDelete(ctx, request.(DialoguesDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DialoguesDelete")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DialoguesDeleteResponseObject); ok {
+		if err := validResponse.VisitDialoguesDeleteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DialoguesUpdate operation middleware
+func (sh *strictHandler) DialoguesUpdate(w http.ResponseWriter, r *http.Request, index int) {
+	var request DialoguesUpdateRequestObject
+
+	request.Index = index
+
+	var body DialoguesUpdateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DialoguesUpdate(ctx, request.(DialoguesUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DialoguesUpdate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DialoguesUpdateResponseObject); ok {
+		if err := validResponse.VisitDialoguesUpdateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // DropTablesList operation middleware
 func (sh *strictHandler) DropTablesList(w http.ResponseWriter, r *http.Request) {
 	var request DropTablesListRequestObject
@@ -4199,6 +5087,120 @@ func (sh *strictHandler) DropTablesUpdate(w http.ResponseWriter, r *http.Request
 	}
 }
 
+// DungeonsList operation middleware
+func (sh *strictHandler) DungeonsList(w http.ResponseWriter, r *http.Request) {
+	var request DungeonsListRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DungeonsList(ctx, request.(DungeonsListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DungeonsList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DungeonsListResponseObject); ok {
+		if err := validResponse.VisitDungeonsListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DungeonsCreate operation middleware
+func (sh *strictHandler) DungeonsCreate(w http.ResponseWriter, r *http.Request) {
+	var request DungeonsCreateRequestObject
+
+	var body DungeonsCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DungeonsCreate(ctx, request.(DungeonsCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DungeonsCreate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DungeonsCreateResponseObject); ok {
+		if err := validResponse.VisitDungeonsCreateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DungeonsDelete operation middleware
+func (sh *strictHandler) DungeonsDelete(w http.ResponseWriter, r *http.Request, index int) {
+	var request DungeonsDeleteRequestObject
+
+	request.Index = index
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DungeonsDelete(ctx, request.(DungeonsDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DungeonsDelete")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DungeonsDeleteResponseObject); ok {
+		if err := validResponse.VisitDungeonsDeleteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DungeonsUpdate operation middleware
+func (sh *strictHandler) DungeonsUpdate(w http.ResponseWriter, r *http.Request, index int) {
+	var request DungeonsUpdateRequestObject
+
+	request.Index = index
+
+	var body DungeonsUpdateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DungeonsUpdate(ctx, request.(DungeonsUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DungeonsUpdate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DungeonsUpdateResponseObject); ok {
+		if err := validResponse.VisitDungeonsUpdateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // EnemyTablesList operation middleware
 func (sh *strictHandler) EnemyTablesList(w http.ResponseWriter, r *http.Request) {
 	var request EnemyTablesListRequestObject
@@ -5215,6 +6217,120 @@ func (sh *strictHandler) PropsUpdate(w http.ResponseWriter, r *http.Request, ind
 	}
 }
 
+// QuestsList operation middleware
+func (sh *strictHandler) QuestsList(w http.ResponseWriter, r *http.Request) {
+	var request QuestsListRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.QuestsList(ctx, request.(QuestsListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QuestsList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(QuestsListResponseObject); ok {
+		if err := validResponse.VisitQuestsListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// QuestsCreate operation middleware
+func (sh *strictHandler) QuestsCreate(w http.ResponseWriter, r *http.Request) {
+	var request QuestsCreateRequestObject
+
+	var body QuestsCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.QuestsCreate(ctx, request.(QuestsCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QuestsCreate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(QuestsCreateResponseObject); ok {
+		if err := validResponse.VisitQuestsCreateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// QuestsDelete operation middleware
+func (sh *strictHandler) QuestsDelete(w http.ResponseWriter, r *http.Request, index int) {
+	var request QuestsDeleteRequestObject
+
+	request.Index = index
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.QuestsDelete(ctx, request.(QuestsDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QuestsDelete")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(QuestsDeleteResponseObject); ok {
+		if err := validResponse.VisitQuestsDeleteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// QuestsUpdate operation middleware
+func (sh *strictHandler) QuestsUpdate(w http.ResponseWriter, r *http.Request, index int) {
+	var request QuestsUpdateRequestObject
+
+	request.Index = index
+
+	var body QuestsUpdateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.QuestsUpdate(ctx, request.(QuestsUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QuestsUpdate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(QuestsUpdateResponseObject); ok {
+		if err := validResponse.VisitQuestsUpdateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // RecipesList operation middleware
 func (sh *strictHandler) RecipesList(w http.ResponseWriter, r *http.Request) {
 	var request RecipesListRequestObject
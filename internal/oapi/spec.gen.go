@@ -20,236 +20,307 @@ import (
 // const string: with thousands of chunks the chained `+` fold is several
 // times slower for the Go compiler than parsing a slice literal.
 var swaggerSpec = []string{
-	"7L3bVxTJljD+r9TK3zx0/7pU6B6/NfIyC4VWZhRZQHfP+Y5+Z6VVAeR0VWVNVpaX47BWZRUqCIii4I1W",
-	"aFGw0cLr4aLgw/efTJJVxdP5F74VEXmJzIyIzCxuB/WlGyEjdsSOHXvv2NfLQkJOZ+UMyKg5oemykEv0",
-	"gbSIfmw+J6UkVQLoH0mQSyhSVpXkjNAk1EprxvWHRmFWiAtZRc4CxfpO7IWDLsEf/0kBPUKT8P8dciAc",
-	"Mqc/1Gx+1h8XkqAHZHIgaESL+RkacVEFSggoLfaH/XEhBzI5Ea+fP6rL/hCOUhWQ6VX7AgdZ3/XHhfOS",
-	"KoZBws/Wd/39cUEB/5WXFJAUmv7sTEDAJzdAoiBuo9xB5dm4oF7KAqFJkM/9J0iocFHNiUReEROX/Idp",
-	"3FrbWH5ZvXFNLxQbdG3O+HRlY/mlrv2ha3f1gtbY4PxSiAtp8aKUzqeFpsaGhriQljL4Xw02SCmjgl6g",
-	"kDCPypl8jgO49mSq8vIJJifG/AcaGxgg4GTH5JxKodKZEWN4Qi++1Ysremkw6uIdSnZPW5kYq4wuVQpz",
-	"eqGIQWxOX9W1BWPt9ebjT7p2Xy8O17GRdFqmoOjjem3yRW3+pVF+4L9rXvRyb5zr4/64IKbTcrfYGzjO",
-	"/AzeOzEt9oJQ0FqIT73UTU4T92yCSrnOQmnI0Yuf9OIrIS6ADMTvn4Uj6bQQFxSpJwXgrekDqRT6K1B6",
-	"L/0lAf/lQMmpipRBm2tW0rLS4vAiN6jNe2+MK0u6VoY/rM8Y1x+SJ3zkyJFAalJVMfHrMVEFvbJCI6o7",
-	"Hyrjpep82Rh8Suzlx7aubiEudP1yurMF/r+jtblTiAsnmttbjv/ULsSFzrYfT7YKceFYc/tp+O+jp3+h",
-	"bw/Dl/MZykVphDgsfdRLb3WtqGuP9eJ1XSvjJRkPH1UmXrnpmdhsI22zR8WUmEmAg1iCXOpKyArgCBG9",
-	"UNRLd/XSC704q5dm4Uq0MrqzV+HP8HxXBC6ccLwNXr2ITMCCcFRU1RQ4BVRFSlAO7+Uz4/78/71bmXgP",
-	"8Tb4bPPuPb24pJce66WncF9wF0t6aU4vva2+v1l5NOW7y8ls4J2y1tLS0QWXBjIgfSnsmGNy+pyoihm1",
-	"XUwjGSrlOsVML0iGnQB//WMKc4JsSrwElLqBXwBiVs6EHf4L+hqP9bAScx32jBZW4gifxC5pfIW+PP/h",
-	"ouM0bs8YN0cplFocwKceU8QLMSkZ04vjlZFrunZfoNxC8gj9t7A6N+66fzfmjfIDvVTQSzMI0rIQF3pk",
-	"JS2qQpOQlPPnEIOjEHAmnz7npl/Mj/0wydk3r40JYe9DCxDVPkQNlMvwYWN1BuoO2mNdG9a157p2VdeG",
-	"HXyck+UUEDPu+bJqH2WupdfG6jO9UDSmXm0sX9eL4426No9F7OaDG8br2Qgrzqp9XapIY38mGK1cff+6",
-	"Nj9YKV0xpl/7r6i1xlCXFH3cHxfSICmJGQf/oQbjr+3RJzrCjjzRQY46CnpkBZwAYqqu8UBMQboNO9T8",
-	"3Bmfz/SGZxLm1/bof5dSqe68kgnNFp0B9hyYc9SL++zhqHjPHq4f59nD9eIre3hruMoe3iKejkRH1JGt",
-	"YOpI/ag6slVcHdkisnL5ZBJkEPvsFFUQXvyqfumHGRLBJEyatY6Eygh8VOo/Df8qqRfKRzl+9Pgvs5tc",
-	"PSfi4RzEpSBP3cNTveyKJ+pboXbQLZ5LAYasn3hvKp2lSb20YNwc5UlxZ7bOfIamVWuzUIy754QPxcV1",
-	"LMKi6oj1UU0cE0qOKV61O0iomtJPiAuSCtK5SJIOCdZ+G1eioojI6JIWL7ZsRWzWNTZjnm2YQR6KgFtQ",
-	"JDHVIqoi5cXy/FltZsTB153HeukafqJExVo3hNIJcvkUFW9oEdEmw287L49AuCDOwZ7ajeI4QVw2vZC4",
-	"4N6qi1mQUEGS8bqsTD021q8YhWH8jqxTg/1RTKDHZFuSAmFyrTb/Ej+eY1KSd2etaU7KMlUTJGaCSn1K",
-	"ltWYMXjVWC75rmOPOVXYUyJ2AA9JltOUK7lZmjdeDxtrK8bQG5vQ0DKiUlinLKfRLneSvGwcEHSFd8Yj",
-	"mBMd/o2f6IhhU0BlqmDMzsGfiwOYI6Gfx2szSPEvFGvzg9XyXfhLbbH2ZlrXFqCtEj6ZrkKeqpV1ba46",
-	"MGMM/q36bgD91bTIsR8HhKrrsR88fGSsP4/yMnJUE/dM1eertSsrxuoz7kLaVJCmiya9+Dt6dF7VS9P4",
-	"JYpok/x11FcoBPazmMoHQNO1svHkTe3N0sb6jGmjDYUJl6blubDjper0O11bqD3TLFFoWqBMY1MoCJC8",
-	"4SYYzzovbhDCbCHnvszAy8PEVOp0j9D055BixDW8/2zcx1iePHa97UmeGFFk2TTSjzZxLvpqsV7AX2Sj",
-	"URje+PB0Y/m6cW1VLw5Xf1+t3riG3BsWyUQD6lCbH7KLvhgiDG017jkoazU8btOBjERtmR6ZZv8IsDsy",
-	"bAEh3VxUQ2h4nxd7eEgHGGuCvmy0J1cU8vRZ+0I73lirDeuDo4+nU1NfdjudaxQrqV+ya9O1pd82H/6O",
-	"rcWhTWOWwu+5MUNvjJuD0Kp9sOFA48EGumJlW7pDKlmdICsrFFZ6zvz7f+bkTAyxr8LG8vWNtdHK3PBm",
-	"YRqaRblPGePK/MbabVuI+67TOcK8ngtpX4dmy0G99IdeXMT+gUjakcugT9GQgP0uyAU+E209LfA9F2mJ",
-	"7ucl7XElJ+vgw/iMT8lJGiNGC/8N7WwQvnCwP8pl8Y8GjWC+NGgB3FcvFKt3HlcGbyJz8h+6NqCXfker",
-	"G9K1OV1brE5pujZm6dN0pd6t05MqtaPZRzoY1xuCci6OeyMarrDphIEri2uUsUQKjRkP+0M04ybvuOf6",
-	"Ebjk8jqHjpouhyIjjirayTy9RveLiP8ks4+Rp81uydrhUjkpZ6/IqXrupCynO+FI/7mT2/edJoJm7YB7",
-	"WBYA7oMz4O1MvAMZphHHWRxKf0cT8hwzlsGl7LhottU5E1UP6svWa7Tuq9NivW2uUstYjBQf1z4I76m5",
-	"Sh4loTNryyTBRbZ9rIxu21tkHCth+Qw1FWNuWNfW9eL1aARiWsk41MG0l1pmz+imOa5VMymFd5w7nlLo",
-	"dbfwFnodGNPoLMVEH0jWYxH1UAJehGdGc1O27Y9HAoScYqhq7HsqEqEaoXR563s7/ii6zyXK64Vzg8yX",
-	"Q9JyO9hbCcYVw9uAcIWNOfjn+qw5OcBy7xuPVytTLzwhBGTYUmDc0tGUnPg11yHmclQAm4UH8AoWx41n",
-	"87o2juxvQe8aNOPPErhAYSDPJqtLD/Ti+KZWDjWXLP9KMzi/0LUyI2gu96uUCuTdXfAjNDm8/bIqplp7",
-	"esyXEW9cJxCTUqbX/NhLQORENJo5Jqdk5VifmMmAlH9XncePNteG3uglTS890Uuj0D9VmI1903Dg+8OH",
-	"vyXff3kpo/4L1cyI3+YdckpKXOpGf6VHuyDl7Q/0fvmIHnfziJ1f1YtPzP9az7DK/SLENQqDrEyubF77",
-	"DRJD+UF1/bltd7WC2UQUgwZ/cV5EWqjUm5EVQI1XOyan02ImiVRUmkL3Vi89QmsaIl9ivtMGGVXx6oa8",
-	"AyTBtmZU5RKN/UuBzL81o0JDf0tY7oO/p3IdpJKZrMfaDZ14vCsPjTXreOFr2ofBcAoIueELQOrtU0OM",
-	"UC79gj/17tnWR8ypgvZ7Usqp4be7sVyoPZvzawumyy8ynVAdLLJKqMrei+gLiFVFwTWGvuFMLp+m34fK",
-	"3wZrbz4YY4u10ppHhjDYoCoqvUA9rsj5QBW4m/gUbg39sz2fDjcOftgfF/I5uPKuBMgEXoafiE+9qCKn",
-	"ibs2QS6Mir78OdAhZkCqW5F6e4HSKVLkT3XuA+Rjw9PV4opefIksOYhqoDh6a12Sl+iXC3rpFmbDlh0K",
-	"2nwECugWd9w0OwJvC3HwLdDyqJwE52miwxh9vXl/FUfSVd+PbGo3KnefViZeQflaHKq8XkVq+XDE2Fhu",
-	"sDSKkYbOut8XyB1CE+CHtcryw3rD5FtI07dnl/fnq3fmdW0CSaxFeCRYVhUX9NICjAHeaox+iySmZIpp",
-	"d+Pjg9rz12QsgPuypQFSn/4dBKq9p5wvfZYb5080+m6REoz4zuKsXlypzczX5u9BVx7yOVVGrsHY0kLR",
-	"eLhqlB8gD2sx1phsjCFSH0Q610Ll4bKujemFYkLO5NTcwQ5RyQEIKAZtTJD8P8KnnS3nL4rpLGRPQmPy",
-	"h+8aIRpEVQUKXMj/+XPDgSNnv0vi/33z5+8OnMU/fvuv/0TTaluknJjLgfS5FO2kB6/W5p6YWkahqGu3",
-	"9eIIUpI1XZuNQeTrpQ+Qkcd0bdFYXDc+TRlLT1EE/xwejIxko3jdXlt0DjQHxrMR6zuKB/THhXPWFQ8l",
-	"Q8gprKQMvxyRU2TSAJffkt/2x4VLEkgl61rOn+BI/3J86iwBL27hzYZLJ1Iv1hhnCwn18Wp19oaxhBhV",
-	"oYjSkEYq8/crq5NkvsJC9eGysXbbf6U9r5tG+oX2HAF7OXppCoG8rhdXqneeQKdooZiWMuipENO14Vha",
-	"yhxXxCS8HGXj1S1klR2DtsZicWO5UJlcwc84eO/QYvVCcWN5pjK5Yv0SRkwb0++gX0dbxH+CQ1YLKJL6",
-	"vvHpCnKXj0AfLYVwE9Fc14hh+O2NeG+YS6BtuxhIdPXXwkr4hUE6xkMojmJ8i4uvTA8LtHaPwPsPGdss",
-	"9lfD8A98QNqCcX1aL45BbOGldFmvv3BLQZ9joUqxyD9/XPn9lW5qCguhF0JT8BNMtY8gUfg0TAAWjVqg",
-	"ythTD0X9/XVj8Cr02jMlXdCtgGfB5L/4LApFY/Dq5swjuHPEaRHJEwdk8WU/wWLmEeV95uVxXn7Za5Fa",
-	"SALznAWxImuugDPBrJLNNsxTIV5YUJyig4xVp7TqxFPoq/kdCuHKo2k/iuwjD8m6TRrpj/9DsoOIpC/L",
-	"dDW9MjRkaeGOyuUfrshZlv2ghCcoIQ/kNtoPbJj7y3jgWXZofHEtB2kRaupiKmA6JAur76Yrv93EptDq",
-	"89XK5DXj5V1dW3R/iTKSi+OVgTHj1ltvWJ+jO27dAGEvnWuCsLHGsD8wkLYd9gcb9C4ZH1pTIA2owb04",
-	"SfT1o0phjjD1tZ9ubxXiwo9tnfB/3Sd+am9phYmrx060nTwpxIWOE6e7T7dTLX9O2ENg4MWWr6sDa3/d",
-	"V++6gxDFvacR9TnxIrY1BKeAOxYJrHzVNS4LTcZblWKN1fVZV/jlxHumPNs690CnaE5DbpxEnrkx/uHS",
-	"+YrnbLeDnTggd4ufIIpqIffl8wX98aJy70Zl0tReT5ohgN+b+qv170YqCzHplWITpngyasOva388wsEP",
-	"BKTDYQGxAra9oHA6UWQINp1RJAz9isM6BddGde0TVM5nkaFDG9C1D7r2fFNb1rVXyFwyACWoNhA9JaP1",
-	"v/JSlvFerj25YhTvk4/lbSkR87XgCyUmlXqx4Nl0pWSVeTTFFUsrgeGjuLDFZml+Y2009l3MckM73xBC",
-	"/URrcwuU5qc7u04LcaG581SXWYsC/v9k63H4vx9bW7uFuPBLa3PH6fZG+6fv7Z9+sH/6Z/unw3RNAO4F",
-	"qh3sshl4T3j5da7031p/aT3Z+Sf6ChRFVhhX+zmi8BcIV7/Bq0chddNmy51hBsXIfLDd855F0K3A1LP/",
-	"UUTFcE4BeFEZ7t1365uTT1Fw5axeuktWGsGD20FeVUR6iZQfJQXw4pqRKhhQqyZsmZr6KtSIvior3IHu",
-	"r53xlqgLMRh9il7bueAhTq2iCNEsdnRHf1wAjhrOFefmZ0hd6xX/KmVAl/TXQFinyG8R3aVkMRk28oH4",
-	"tj++y/5FzyWxiY2M1iHOy0Gkj2TMk2T7Fj0o9WCJei3llCT2AsaFnB2FaaT+C9kQP9AYP/DDWYr8hVF7",
-	"zWl6HCZOWMNeHTNtLUq4D5y6E8oc5sxE3oFemNpy5oGZeYcSg+gYslPwkDMbpv5VJleMj2MOqoSO1s5j",
-	"re3dzcfhY7P5aNfpkz91wx/bfzrV2tl8ksrMTkhqhyyZVe88QqVwc2PlCs5GxOzMNNhr89Xpd8aT66aj",
-	"3Uw8jOx1aIMk2SHSCp5U73wwSmN6aUIvziCKQF7m4opbb6TowKSnTTzw1+YD/7vhwJG/HDpz5sDZ786c",
-	"Oej87ux3VH9bWyaXT4mYP9EMmrXCTWNxvFKYq5XWojtOndlPAFqgb61ws/LgVt2z547KOaprfQpJ59+x",
-	"dDadRkExZW25DjvXgZ+uAM3+T6AYLy6GnVsFaX54ul9wmnXhgiQgEl8ZKf3v4FJ4+0dXVpFU7G32P/vO",
-	"mcF1XPFkxsglXMEp/MgZ+8t+zzM9jBGCfDLC8W43cUgjuWek5eQIORp9DoWx6xnEXbrzZX9c6DHVKN4I",
-	"pGpFtj5JmZ6UlFBzLXWoFin4xOyS80oigqvuJDGI4iC7uQoDA68MVVZvQlaK3x8WK43JFzJAgbGt1Stz",
-	"enEIhreiwg8pEKypoI/qsLeh23VeSoJcyOpGHZ7PiRna86oihaFc+0NTaMNXpn3xotxQPKqrDwC7Vlnw",
-	"WOdzOIMq9oKTIR+49qd2+Rs79Zc3kEj0RakFShi28Iv1ncsCF44KLTPc2TiHsW5eG4X6EM/iSg72I5s8",
-	"NF7aMdw+I0mGXE9lqFAZ0TylFUNpDypI23o1e37kgl1AL8y7KCDKndB/bbU6to6encgQ4IvZ3Zpd3V7j",
-	"HpnV40Iuf069lA0cZC+0y/yeRx/WnHzbvGfv4Q9p+2z022MzN16/Jm3mPB9w1Bvrsp77ICclqJeey8N/",
-	"NsVgfM/ium3PTMipFEiYf0KRDn//OIhKe/7941CAKZ5pcrfPjBVJTD+y7TC926B3yfLuo/jwBAojA99D",
-	"azPFfESeGXpEW6dEfXzBRQSjGqM3yMYWGdu7iGhm+gKB4e30Z9ow95c707Ps0PjyhNWwOeu4MVaEWiZd",
-	"uknJSJzLxI4/No2AwblD0M+0B37U3XFrck83BHPdZr+mDXoX7zyjopJd4qYy/dGr8gWa5dA7qzUDd0IL",
-	"NsMvLG2kMjVkXF8JtEKg2TrFpEQNeL0yBIX94KtN7Y4xet1YH/j7x0GL4y8Yo/c21kahpI0WUnjS/bqk",
-	"rZ/hOEjApLhA6/Pxo80oew6X7rDxFPh2tXAKT9tGSOAoE3e+bHz867i5ZmclNFI55THMe01Nj2A+SXFZ",
-	"L701yiseS24IhJ8yI5hYdmIz4AqFFPseIYGTW290jxXv063Kjafb7wb66tTxOHU+W+cK9aIgdybtiswg",
-	"4X8TZ1156IzsXcM9PvvDbbefJohk2xD5hO7EXDzezjFs2x4VJYQF26wdU514ir0c2FZq5T3x35HoK/i9",
-	"FZ24t8vuwS7t7hB2AL/rPLrx1fZCcDUS/JXf4BrazBoX0vJ5dME6LJ9PgLHU/bm1uzpMp6G6Ptj+kz00",
-	"c56XwIUWKaeGCZ7/mfyW94IxDzhOMBe+lZDNzVgBww5H24G3Lwa9S5rwKVfKI2WfTswLyp1ds3IJy+h2",
-	"f3Q7PBsDHZ5n4X+gy/Ps/0/1b9IugT+U5bdHZFUDnA2MHLBmuhlh8FDETFJO4xxHRUaR4mIqdSLfKyAr",
-	"O5xRRCLvgphJoleSChRFUmUzrjx3QVTSVOOI113gjxmYHticvb6xPhPVu98hpoCqUtXNWyhfxwz48hDZ",
-	"Fn10wWzUXBcujJzJ4hKAYjKJsCCmOlzLCTGR1/rhkCXc2c7NDk9YlDL1zu/mtp7ZaYzJ7bCwoFu7NFFJ",
-	"u52unXCpYYtWadfJqlJqy9YkNAdnS21J/n7aWoJCcP2RFGeJiAoGezGhs/i6A387rBomsF3i5LD2D72/",
-	"nlX8x+yvZ1yfNutym30EZ4eMkUm7j+DhBpQ0ft9k9Nao7w43RGZjitwDcjl61Li2VHn6cpveA1vlfBDX",
-	"2fCvCWdfKGCgPvOtMwm+dDbkiEtgWcrrcMXBJNp6loCTdYPS0NkeXFJPw6DtI6ESugf9DNoyBn+rTD3G",
-	"wQw+OqsDO2asdmD8CArqZtQkQ5Pw99SWZG0oMksM0rU8RMTFI3aDcyOgEmEsN47XfetuCxOp7MRUZ4N0",
-	"ho83uC2s3oa0W9zec/sYe7Oz3vEp0joBB7MqBAFzqVCBJWQ2Po0JsGMyoA5GsYGulatDz/2yYnuj6GC5",
-	"OyhDA42Crkp71kirTl7wSPQlNBt5Ch0Fmp4ohZH2NjJPDjb4W6nhoWq4OqG+kY069Rtp6jCvEIoWX/0z",
-	"v4PSo0/KZqVMb5caLtPJ/bmJQWyx6ATonRqK1s1v4VhVVkJYvLvwZybAC6KShWTXelFSQ1LpL/4hxGTt",
-	"4GKUiYjPiUk6FHA+wiTE52iSiIFlJgpJBkFeeRYXowsczMm2SeBkd0/UeCMxqRkH11doxX3FdBi1gEiV",
-	"gGu0MhuChuAUCEsuhTEg+/IYfCl+9l/i1tqtBdGQ43g0WYVA/RgJdjIQ9UWhgIk6oDfqACXaAK8/VYAg",
-	"4TrjAr0DWad4gRr376oNYjafKBS7T5866Uqt0MpWYMag2cgiIA6SdMnktq1YpO0uyW1P/QdP74ptyACX",
-	"rKCx3PbEtUlWXEZue0I5oj1yWU/bNDKQ57bBoJ61tejcNqn6tuFyS1xcAQkpGwHpneh72kyE3yOqoowG",
-	"0eaEtr3wk3VLNFqgmWXdVZkphQZebqzCcodWgbU/qktjtT9eVh4u8zPoqLERJsqoLTFgPeU7W4ztlTLZ",
-	"vBr1BNvgoO2w5vDcY3hhVDZNLIKNGBySUqeg98S7bENdKhMuez8sY6+5nR3w4LGv4w6oZ52e7F/vNv+w",
-	"xOwQeXVwJXDj+sOI8Upd4nkAG40etA2wx6z9U25sac24/pBmdGgOV1vCA+2Sna7SEq4xG2d8yEoVzBm6",
-	"QletYM4QtoIFa4KfQ5azYEzgoTZ7NmJl5DZJpMXtA3SOgkabXsgwoIpDJriaaGXqBSaZQOpjFQN1JnTX",
-	"Nwme8JSclHokoPDm1MpW8ZQP1et/q1wZthwoC7Agb3F441PZrg4dDLEb3m4+OOitgRUjJ2A5GO1xdWzd",
-	"mJoPOz8rzHX0HmR8NpRC0ca8Xvpgb0EvfTBuDtq9XRkFIq1jDVmsk0YUlDDth6uVqSEXSaD4AOeA6gNn",
-	"z+AHaVVeGQg41/64YB9bfYvAwyllHFlH7b2tCGkEOqwV8W9hOi1z2LXxcb02+YIREWpFeoZKpHV/3B8X",
-	"msOVCHGt1KwV4qnKzg+ldz71Isya0T1h3LOvQPR1i70sxEFSHXtirA+gKOxXKNHhgxUmb75xcQDcxocP",
-	"lYExXDzRGIR5D7X510Z5hV0q0VmDK1KT3v4cR/bazdzc53hSPAfqIlwEGBpG8AyU2zMzX51drd7BFXPu",
-	"wzwZ9PTP9IK64aHR2E5zNk4NYz5k17WB+B9ZNQavovt5KVs/VDi4LUkBicsu2W3Hay/vGYNPjZc3fdRm",
-	"GpDw5uMm0vnE5dkvq4Ljq4HqPCxJDWfKHVRzWajVmfV8IfXAuCdU4hj1GoRJgZpNhhZLnWM0fjnVerK1",
-	"FS67uf14aws16ImOqabLYREVbkqbSNmEjbrZ+AiON/1ROXmpQ1RUaAKk1fRo1DUobXGRKkhM2lNjdQmL",
-	"At81OiZncOAQza6l3SbmWdj4cG9j+YbF05/BYykO42nhZ1dGjcG7Ydsb2pvBm7BXEe6BbY8+JqaBInJE",
-	"AerFMKOXnjO235UQU+G1bwwOj4HLgD90y/UOxyHv/xFxeIecszGFZ/jTFmbYCvT64XqYDMaIg8+48B8C",
-	"nN5BkbPVs8HkYMNhU4OxOleZv0+tJOSrGEQ9PvbUZlPZ4mvctAWrt5HA9IHEr7k8JY6h60Tzge8P/y/Y",
-	"kas4Z3U6fI9DGNjsgrQGc26K2YiL0cMIl5J03x1WGUreDPxic2hG/gnbVHZKzIYrtzmClX2rnw7uEjtq",
-	"BhlCi/wjs2RyoXgmY7y8Z0zNWzmVjBHaJyiYUNgwau16V9eeGTcXUFObEdxo4Uxm49NvcLLF9drrmaaY",
-	"N6OgoKGwa3SEpVv4B+PqFVduYkHDevzG8ktuvEozGWtWlxrvsn5QFBTb/GFqwPUAcqnslFcSqbPD9kno",
-	"stUByCsSaFU63DKB0v0tKkzqFaOoXYF3zNd3K/JKrMHcdTDadzno91TKjbgKYjSXruyKu04xyeZUyn4N",
-	"RIR6SlR+BQqXyG69he1PcE1KeAQfES18JItNRgQKh/FA0qtV9seF44qUJKqcRwRLjOaT+itkOC3ppSHU",
-	"IKS8sTZaXSujgnQddcA90cEDV73z2LgFeYVVaQ+3tM3Xw5nI4VwEm+qp2WXbpeyiSnDeElUR1+GegIts",
-	"ou+YGUTgz0SOCJ0YzSVsMqMZApUTyNiJAjmzIFkPZM8U3IuM5RQiLWr7TrNUQXEcisligVxiW+aomPg1",
-	"WgUZ3yKdSfgHRBO51p2ATXGHCpWpoVrhSqgd2FnJUfkUHMdFpz+vuT+OlaPo0OAwPouglQ2H4TJ29t22",
-	"82GvNuRhxZRAnYhL8MzAvTpk0A8B3FVtrU7w9hxcYYyyq2pPpqrvnznLcNy6EWHjgXzsmx5Dh7a67JD5",
-	"iNDwQD59Wf2qrOJfCKAnBDAqWGJ4EHBIa88RrQ1Wxsaqdz4Q21ZFNQejgXpBcmcIvbhianjQlLpizA5V",
-	"Hr6DIUFwRa/QEz6vZKANvB749lj+En5H78QlvTRnPmfMZvmDuja/WXhsrD5DTjjLvxJxEWgc94KRhUj6",
-	"48IvYioF6tF58EDuVULB/ZvXbiE4RCXAqJDMoVy9AxWyd2gJF7E5JmbFhOnKjAyUnCDMNu0Cg7z3sv89",
-	"ELqbL8ODgk0xYYIUHUq1h8B/whX+tD39ecm/xcmV8a0IeUUBmQStj41NQUFJYc5jBymGrNxZUik0o3tC",
-	"Tkt7Q3GblrhPqSV8PL0NkxJY78E3+TcuiqM0XOF4mJzeXBF8Sxj2cZABisgyBHqVno3lyY0PT6oTfxhj",
-	"S97e9j98H/7YzBifTBJcDAPW4sXXLHPeytaBR2gPszOYt/7J8tZTm+L410GLhjVJtz4bDWE5pMnJYPug",
-	"ZfNbpH5sPBqGKNXm9IKGAh4WjUfD1sN0oTrxyqzejsvinkuBv0j1SP0uNJZet4SGWKM8ZJQfUKLOnEXE",
-	"SdTyr7VdWZp+wCHa8dQZMmW74OsOebJnqDvkyZmhzpAne4J6Q57oMQH1hTwFH3QaZFSYBtqep5dE8nf4",
-	"sRloWI51HoJQRWqRCMgb79sXkeHBg2bD84GjR0w12Lwak4E163IAZAInxY7byvIgcglwZ/QVtUKrNuFw",
-	"T8JtYayzHU5zHXXQiDCROoz9cKSfQ22sfUI986EkoUabIMCRCrDxQkqcyeqoxnYscjW2eurgt0auxnZS",
-	"FpMg6YtjCndG7mF0U97MAnQ5aWXrgMy4MePW2sbyS1f0GF6KJ7IpZBVoYlCkZZAatWsxVoXDul7y5lCK",
-	"TRuFrpnBkh/XYe1CSjXFcPBcoyjvuqmCMTvnguMNig4HxzWKZgByYqmN8og/GQE3wRf6XS+qet963vg1",
-	"p5xfi1XOr9lVzq/VLufX7C3ndwyX8yNWRRy751Q8yIsTkXN+sqXfKj5fTsmwgh6tX3nlfRkGpb8aq0y8",
-	"qpomKBgMAE3M6IyRejhvR0WyI1LxS1UNjXy4HrQwayCi1YvRx8NB3qODv4vbS+Ii57iYBh2K3KsAaqOc",
-	"4huEkOnNwpvazAhDsidSQFRA8i/JfKYXmAFKrDJHfgHuhbiIyn79bgpryEPeolpgyCjGUvPdn7lCGJxN",
-	"g/P2k6SOIkw0Vag/ztE77IgrxsvE+ZK6Xs+Z+pBs74d/vjT/Y9PlkO7H2DdOgV8UifOt7/DDBybBvKxj",
-	"sqwko8UkEcM8KMHBR9ztn+jg7Nr2gu7Odd/CTd/iJYfelfZ8Gii0wH/cEs5uphb+SWD6bLBBjWFGsGZ1",
-	"bBY4kBRl2z4xmS1MFJojO7hB793gLIq5pGbbZpy91OGstjBBzQAgUWGtVRs5Y4E8I0A9Z/odbHWnLZBK",
-	"siuNPNyqiIRyhopxogMH25p6ONFoL/ZNw8GGA40HG74lV4kWwF+jWl+csuuwGfHRzIZ8XtJVAw2/fnCh",
-	"2/+5Q5XL3vx7ZORZRBd9xDb1RAxbxucct+mQG7fsDZxtuswIjHDH7RaHzfBghtBt7ekBCZUZDGwJH0sX",
-	"d/u3dNjsuWysvd58/ClyJDAUfRg6LeGwC5y3jT6efV4brd69Br1YNLbSLaVplguseBirzyxB9AjXAA0R",
-	"qEknG1sqV+fLmzOPAkMdTeXV3pa10kDy9cfEME+eFhLjPW8YSJ4LOUWldMWYfl1/rLcncD2o0BpeGhch",
-	"rOAcrhuGUeCjOVTeL93t40/SYaa5EmtnvR4m3sHGR7v7aNg7LSKoOwRqNeFqEBH7xtMfAgqr6sAMPFhY",
-	"SHexwe+zCKl8UOOtojWQsKuoRA3ccHpK+ESgVYIFNei3u01E6JlojaJpBLCHxyGMPpzoZB1FPbFq5mhq",
-	"iFrgMfrIyJwtbiLV2T2fopihawwLthkARvUAwVju07BzpE+3XGRGiWllU/IXNMtbAxWu2aHK2EMcLo7i",
-	"MeB71IxNKa4ydFKXHb6OQ6HZ8dkJoz5Dfn9cQJvvBD11AOe4qjBEGGqnlZ3PPMdvg4578BDq+GlBgU2X",
-	"A2MC/4GJYWeOwol6DHMUXNSTJthAM2popuyNs6J2r7ECCFkH5zwVTbn5WNceQE+tmfFRrkwNVQdmdG1a",
-	"127BrJGBMePWW1LI8raNi4EwPO/ugiPURJi4wAoPrafrTV3enq9OF57T5R/eEM69l+4g4HCxIcx8rwih",
-	"RNQiPsFZXqRCSu+KDvmurQnHvsEq87f8QhI+23i4qd22/G8jZvO5DOpsgLaGvy0Aw8MKhTdWMDW3gKJe",
-	"KOJXEFDMICezqcUESl7ENdnHjStLG2u3UZzMgjF4tTb3JKJIFKO92vzGRZ9nPsTTjRPf3XQ5OLy7vpen",
-	"r6t3HW/O4CqPsIDKzQGYYgo7Ho3qpYWGA98fPuxfc9TSjEejDjgedUDnloo/dgoQJFxnXGgOwKInxJ4t",
-	"8RnyuS1aTTUPYDTakrRcC0obu0Qac87I9dKa66yXFkmcuDQsqkCJh7oA7NBEWhxkuTJV2Fi+vrE2apbr",
-	"LBTd9TwXcJavbVv9t67T7Wb5z+J4bQbax/1uTSK/O2oMozWU5tM3V125s6Jrk3rxdmV2qvr4afXOvJ02",
-	"PoYeOEt66akZMaFKaZBTxTQlkxpvrHL3aeV+MfZN54/HfvjhhyPG2u/GxzG3RBJVcADOQ9NpzwMlx4i/",
-	"pSAbN6JynLKFovVoWIydERoPNhxsOCPEcAI2YTVHf6Dayi/ISqqeCMtf4DjrX1RcLzovP2sD/gK85uZJ",
-	"NFtrijtUwCdXmF7D6JpjFIahK8tOr2Gb9C9mmRpP7fZA9flo9f3I5vxtViWu1otZqkJhj8IXBesU1BkY",
-	"tbvstdPHeYMc0ST2cuLkvoKRmOOqv74UJV80vZWSvBUPP3Ga/cw0qbYWvTjuLi7w2Fmg67qY7v9AHz9a",
-	"PB9FWUBr9NvcAY928Ybdj6wOdy5O1WoBWVqpGmp+VmXpNcub45mV3u3NPaeJSnagO9lvzRj8W/XdgF4c",
-	"39SeI/fmXVx/iPtgpyejhdsr6wEfuSWEHzH90JGYSuEUKFXJA79TzT6VCFPjMdAumLXLBjFuhY/IW89J",
-	"Ksj0ShkQa1HEC23w1WtOg0TrC/QKLcACLVevbJbmbYbmp9zQjR6piOmK2PHRPYkzzlfJxvN3cqEWugMu",
-	"om9hAdS9hOuJ85M5tk7jll2w6XKUnANySYfsmMPNydtwWf6BxfGN5UJlYEzXFnClLcajr9eV2hPVIu7N",
-	"DqJEKRPJQOhBNIKyVrypOnCjV0eNQYTG4jgq2vbUCYmQrCSguhaIU4hC5Xj4FuajS7yUOIk4Phk6vng6",
-	"BTJ9/p7yWqpIMxOgjyHGxu5CYysljoDpRmcqE7xqpd5bCldlTcTFg8eE598JKu9TGbnGzpOsp2t299a7",
-	"ZpMzkhPyt2vHxFFO3RWwx5fOlMRkyoQh8pJ9crGjrgoe2NEeKTcaSwlQV142HucHx1GnfMacDsGCzz0x",
-	"T/Z102Ve8rUXmz+HaYIFmxcwCi0H3x9vyjY335YWxJAIL+DtAd512n/gL9WX8910mZfy7c+3tSt5h8zE",
-	"UNKyYo2i55evz5i1dJy0u3pdwE7OHtP7Sybt9RMeV9InFA60fygLKI4zwoV6cvmUiI2pUV7tnnEUQIWb",
-	"xuJ4pTBndml3BpwAoloPIDSOCqjy4JYFyJc4jQ+ahlfX+fow4VtxABnTqwg0XeYUEfjcQ4zcBp2myxx7",
-	"jmm9o+UjoyeKu3CNNo/RaNzWzMKAxXHLJEjVXAHU7Wh9rul6NA600wtFJ3JgzuxdXhq0rY6RA/E8adqU",
-	"cMteMQ3+kiUSOKLWJyPzP2iCl5YA4j1hG1nUwyWTeD2kPfC49uwBVNxRxlr1xjVdmzcePtrUPqGfF0wF",
-	"1DoiRt+IA+ifFDWnD6RUoDBaumvl2p01S1u669gpG+KH440NZ2muO3+TPFrr441PM7U3z40nb6C6fW21",
-	"NrpkTL+rDBU83afgtsduVN/Byr8byy9rqwvwqffwKvweaasweBc1SHZPMqOXhvXSUzTJ+ObksK6NVR8u",
-	"b6yOb6xNoWfiyMaHe7oGeUX1+Wjt4xAKkV6MmQ35Yro2x4mUgJavo7L8K8f4VplilWxHvWLP40LKYft1",
-	"OpRUx1AVqct2Q9JQPUU9tEtO4V1L3NnRWRay2pIcVG17B11i+2yoyD5jVqdmXBn6fanfAmjfncb49/Ef",
-	"qJcnoiHQjThr9VvAnKeQFX8JjoXrI4PWk6FMcm5LXC60MYy0geUi2sACTF85n+krR5i+kmzTlzMuvLVr",
-	"G/pgZ8VgPCMDZQf8kNGyOSsG74rRtomxs+3oqRnU9mz7ezdtxWy53axMFXsBHg7NEBRNq7FSfr/54AZU",
-	"ryaWoBJVKJrJLe9HNjXndW6XbqlMvtosl//n6rhx/YkxMvk/V8drV25WJu4iO8UbvViAMnFKq06Yxj9d",
-	"m7c+cGyrJMdsCNGfymlg63/UY/nulvs+kknJsmqfcsicfClBTcBCPbfJymAwPwF6oJBRtLhSm5mvzd+z",
-	"sYCD8BvhNuAiUFnktghPOqdPWoilQJPK9bVNbRknpenaVbtzo1nIMi1exK+i8Cswv6fAt1Qr/F6qTH+s",
-	"vhslKrGRV8eBS70yRAkZj1/15TBOHq0OPa/evEqmrmxZd/XYJP02PgjmDXaCouxd3K6U0GOF1vbWU7A2",
-	"f/PJk/B/v7Q2d5xuF+JC++n2Vqob3mXN5ELEUcEWoK629uMnWzEk+sQSvRK/aan03Yhdb42+1Z7mPXJK",
-	"ChHb+iP+DKdPRu04Hl1y5vD7J7j3t/NM2mLT7wuiGqZHtg0tdGtsei9sX8tsa8fWSpyTod1sSJYsoW+S",
-	"5nZIeXo/0h0R793QekNYAb08GcZtGktPjStLKMXnKvbcWPc4q1yCFxZuFiQkMyYmkVdV+FvqvZbl1HFF",
-	"TIJAWIhFvTC7QhaKmwv3ULOgD7r2fLPwWNfGjNkH8L/rdz3c8ocg+eupT0mrYmQVzMSqzFtix0ebu7sR",
-	"5/qxrfUkfKs1t/+JulNIbi1SThUzCQqY5rbas8nqxAiu7/T3j4OefKm/fxyK2PWSLDvm3dJtLHROdGxZ",
-	"yDB65/sF3dwHY3iiMjxdNZ+YT02Lm1bG/n9YU6sI08Y2CwVj+h16Qf2hF1/gd5yPTCmt9v22oRczmw9u",
-	"GK9nLaPf3TCzenrv+8lyaDTyrEwTUuXVO6r96MDh+AG6BYksdUuzsmNKZb44Q7Ugdbsr4gKguQciOgXi",
-	"guSz+kex9ZPjLWN+FBO+lxnaNnpAsdFLXrO8FGyWd/ROX846Nrvji4wsa6NOHz1tDruUzedEobixPtwU",
-	"OyMcbmiI9Z4RYmeE72O/4h8aY+neM4LgfiY1HDhy9rtvzpw5iH/69l9j36R7/7v3v3/t/ZbyZupHeOxB",
-	"FSZUSYVkJHTmpUwu1pqUVFmJNXe0CUQwp9CAIi7744KcBRkxKwlNwg/oV/hBjKjqkJiVDp1vPGR2sD+g",
-	"2t3Xe+kPfXpPFCwojbFJYx3eBUi3OLcvKTS5urbkkMSF55nLypkcBvV9Q4OA6oZmVKvNRjabknB64KH/",
-	"zGG1DFNHlKb6CBbCm3sX3X0gBgkK5NRYn5iL5fKJBABJkDyItcEeMZ9St21FrYoiK7RlNGdi+Qy4mAUJ",
-	"FSRjAH4Ws/ByEFG9KvbmkGuUxKBwFppF5FyU41mbqgze5B/MMQWIKjCNoCCnwioAO3IqGBXOhVaVPOj3",
-	"UUTjDsIOSQ0xMZOMibEMuACPBSWbow/OAZCJJRC6kjExFxPhn/Mpdb9QT3+cce0PXUYBSP2YsFJAjdI3",
-	"bOj65v1ZPom14Dkh+1HENFCBkkNPfAlOjGx01jvHjoRyk0mcQJpPYz7ro6F/9i+/uw8oICblYhk5Zp5P",
-	"TJVjOZBJxnpkJab2STmLGuKxc3k1pvaBWB8Qk0DJxdLipdg5EMvnQE8+dTC2f7hFPgKzqDx8V5l8xT/J",
-	"n7JJcYdP8h+BCTXsPRPah0wlqcjZQEWiNGTVMLgbWpFoUeTs7mgRNqTPRoVwcMfTH1inQtcfnDl3VHmw",
-	"wey25uAB/IWpDS6KoV/vUAoDg6YYCoMD9au2sCeMIR+BLzBUBWfKfaknROQ3DXvMb/Yb/wAZkL4UpB9U",
-	"Jt6HVgta4YS7oxc4oD4bxYDAHkcz8J4HXSEg5tpRjcCBs9sqgRfyF6YTuImFcanDaAUeemIoAwS4r9rA",
-	"3nCDfDAzYGgBxFT7Ug2IymQa9prJ7DumIakgfaAXBrnwPA5EDBER6cLVCGBoEQqe2XGFwIb02egDDu64",
-	"jgbGqdD1AmfOHVULbDC7rRV4AH9hSoGLYujXO5xngU5TDN3AgfpVNdgTxpCPwBcYKoIz5b7UECLym4Y9",
-	"5jf7kn8EBiQQBBfWYGBHHu+KevB5mQsc3IVVD4LNBs6cO64e7InRwAP4C1QPmNp/lMADOk1x1IOvloM9",
-	"ZAz5CHyBox7sYwNCRH7TsMf8Zj/yj3CKQaAysCt6wGelAoSV/hyJv/PCfi/k/Bcr4mm3M6pc58jyr2J8",
-	"ty93IFutPX9bffeKx1aPA3X3Dqxh7+7xPuDUQeoYRwXbv9rXXihenxXhEAw9DWAaEy+icwbFEsPi4FyF",
-	"6xSeaKdVLgzms1G6TKxxgzeJA6CrXeYkO6p4YRi7rXqRUL8w5cuhDP9tDReg6dANQwEzQXxVwXb9qgey",
-	"Wq4SZs60T9WwOu70vuDe+YAjZahi5hz7UhmLIhS+ElBYJp8VU0BVuVk2t1DdhZJdsYrBKDrMmXZaKTPh",
-	"fDZamYU3rlpGnAFdLbNm2VG9zASy24qZC+wXppkR1EG5tYcuS8kAxcyhHIZiZkGIopklw0gEu+jAV62M",
-	"dtUDuS1XLbOmCq2XbfXIGvb0Ou8P5p0POFGGVmZNEkUtq+M491gefCWgCPxdkXtALmc1XaKyipq2VHn6",
-	"kq+SOdPsuFZmg/p8FDPiENi6mXkMDK3MmWJnFTMbzq7rZh7IX5p65qIR+g0OY0DDVMTS0Jy5vprP9ubq",
-	"55k3nyXTnRn2pbUlKkdp2GuOsh85BCdnqbpWrg49D5Lu2d2Q69nPSaJzU5JMpDNleXbHpXh2D+R39kuV",
-	"3FnajQwjrTGdsKX113yiXb/QHCbKt6PAGfapcyvy3d0HfDnPPEa2opXdtypWdg+Uq8+LZAj2rYCElOV6",
-	"sl6gCt53uFpVJ55lp/UqDOaz0axMrHF9WBb26eqVOcOOKlgYxm6rWCTUL0zJcsjCf0/DxRWZRMPQtcz5",
-	"v2pbu37J+RyWq3KZ0+xTpauO27wvmHaed54M3cucYF9qX1FkwVfqCcvbcTedA7k+AFReYh2vIx6DbRCN",
-	"53ZcO/P29PsczovEHzfzjnE2dLWNnHVHdTcC0G4rcD7QX5gW56Ec1nUPl6nHaA5J1+9IyF+VvD1iE/kI",
-	"XIKhKJCT7kttITL3adhz7rP/uIkq8cv0uDrqMbQE2Cpvx9UDu+3f53AeCGNchcDCO10DQON3VPR3S7tf",
-	"b8eB+YUJe4scvPcynHS3mhbSxTma+6sc3+VrzeemXFMNmmSfGmoi3+B9wKDzvJNk6F5o+L5UusLz/a9U",
-	"w+bj/XEhB5Tz1om752sB50FKRv0vY/grIS7klZTQJPSparbp0KGUnBBTfXJObfqXhn9pQH3STRCXLYrB",
-	"mf79cfsXVqYZ8SvLWkT8yt3+ifgD0fWhP+6CYpZ49PzW/y1ZLpr4dbfk+QX2Irp/YUdrEb926azk51b0",
-	"dv/Z/v83AA==",
+	"7L1bVxTJ1ij6V2rU2Q/dp0uF7s89vmaccfZAoVv28sIHrtV77dWeNdKqBGpZVVkrM8vL52aMyiwvICCK",
+	"Aq2igKKgtIXX5o4P55/sJLOqnvov7DEj8hKZGXkrCljYvnQjZMSMmDFjzhnzejWe5LJ5LsfmRCHecjUu",
+	"JPvYLIN+bD2fzqTFNIv+kWKFJJ/Oi2kuF2+JV0ub6q1HanEunojneS7P8sZ3TC8MugI//hee7Ym3xP+v",
+	"IxaEI/r0R1r1z/oT8RTbw+YENmhEm/4ZGnFZZPkQUNrMD/sTcYHNCQxev/+obvNDGCXybK5X7AscZHzX",
+	"n4hfTItMGCT8xfiuvz8R59l/FtI8m4q3/M2agIBPboBEQcJEuYXKc4m4eCXPxlvi3Pl/sEkRFtWaTBZ4",
+	"JnnFfZjq3c3tldeV2zeVotykSPPqp+vbK68V6ZUiTSpFqbnJ+mU8Ec8yl9PZQjbe0tzUlIhn0zn8ryYT",
+	"ZDonsr0sT8I8xuUKgg/g6rMp7fUzTE4e8x9qbvIAAZMd5wSRQqWzw+rQuCK/V+RVpTQQdfEWJdun1cZH",
+	"tZFlrTivFGUMojZzQ5EW1c23telPivRAkYfq2Eg2y1FQtLFVnfi1uvBaLT903zUnen1vnO3j/kScyWa5",
+	"s0xv4Dj9M7h3TJbpZUNBayM+dVI3OU3CsQkq5VoLpSFHkT8p8pt4Is7mAL9/i3+fzcYTcT7dk2Hh1vSx",
+	"mQz6K8v3Xvl7Ev5lQRFEPp1Dm2vlsxzfZvEiO6jaL+/U68uKVIYftmbVW4/IE/7+++8DqUkUmeSF44zI",
+	"9nI8jajur2tjpcpCWR14Tuzlh47us/FEvPunM11t8P/O9taueCJ+ovV0249/Ph1PxLs6fjjZHk/Ej7ee",
+	"PgP/PnbmJ/r2MHyukKNclGbAYWlDKb1XJFmRphX5liKV8ZLUR0+08Td2eiY220zb7DEmw+SS7GEsQa50",
+	"Jzme9REiSlFWSpNK6VdFnlNKc7ASqYzu7A34Gc53Ne4LJxxvg6sXkQkYEI4xophhT7Ein05SDu/1C/XB",
+	"wv8/qY1/BLwNvKhN/qLIy0ppWik9h33BLpaV0rxSel/5eEd7MuW6y6l84J0y1tLW2Q1LY3Ns9krYMce5",
+	"7HlGZHLiaSaLZGha6GJyvWwq7AT46x8ymBPkM8wVlq8b+CWWyXO5sMN/Ql/jsQ5Woq/DnNHASgLhk9gl",
+	"ja/Ql+c+XHSc6r1Z9c4IhVLla/jUYzxzKZZOxRR5TBu+qUgP4pRbSB6h+xZW5sds9+/2glp+qJSKSmkW",
+	"QVqJJ+I9HJ9lxHhLPMUVziMGRyHgXCF73k6/mB+7YZKz126OxsPehzaWEfsQNVAuw/r22izoDtK0Ig0p",
+	"0ktFuqFIQxY+znNchmVy9vnyYh9lruW36toLpSirU2+2V24p8lizIi1gEVt7eFt9OxdhxXmxr1tkaOxP",
+	"ByOVKx/fVhcGtNJ1deat+4oaawx1SdHH/Yl4lk2lmZyF/1CD8dfm6BOdYUee6CRHHWN7OJ49wTKZusaz",
+	"TAboNuxQ/XNrfCHXG55J6F+bo/+UzmTOFvhcaLZoDTDnwJyjXtznj0bFe/5o/TjPH60XX/mjO8NV/ugO",
+	"8fR9dER9vxNMfV8/qr7fKa6+3yGyhEIqxeYQ++xiRDa8+BXd0g8zJIJJ6DRrHAmVEbio1H0a7lVSL5SL",
+	"ctzocV9mO7k6TsTBOYhLQZ66g6c62ZWfqG8H7eAscz7Desj68Y+60lmaUEqL6p0RPyluzdZVyNG0amkO",
+	"xLh9TngoLm1hERZVR6yPahKYUARP8SrdR0JVl37xRDwtslkhkqRDgrXfxBXD8wwyumSZy207EZt1jc3p",
+	"ZxtmkIMiYAt8msm0MSJDebG8fFGdHbbwdX9aKd3ET5SoWDsLULpYoZCh4g0tItpk+G3n5BEIF8Q5mFPb",
+	"UZwgiMukFxIXvrfqcp5NimzK43WpTU2rW9fV4hB+R9apwf7AJNFjsiNFgTCxWV14jR/PsXTK784a05zk",
+	"OKomSMwESn2G48SYOnBDXSm5rmOPPlXYUyJ2AIfEcVnKlayVFtS3Q+rmqjr4ziQ0tIyoFNbFcVm0y90k",
+	"LxMHBF3hnfkRzIlO98ZPdMawKUCbKqpz8/CzfA1zJPTzWHUWKf5FubowUClPwi+lpeq7GUVaBFslPJlu",
+	"AE+Vyoo0X7k2qw78VvlwDf1Vt8h5Pw4IVddhP3j0RN16GeVlZKkm9pkqL9eq11fVtRe+C+kQ2SxdNCny",
+	"U/TovKGUZvBLFNEm+euor1AA9hcmUwiApkhl9dm76rvl7a1Z3UYbChM2TctxYcdKlZkPirRYfSEZolC3",
+	"QOnGplAQgLxhEx7POiduEMJMIWe/zKyThzGZzJmeeMvfQooR2/D+cwkXY3k2bXvbkzwxosgyaaQfbeJ8",
+	"9NVivcB/kc1qcWh7/fn2yi315poiD1WerlVu30TuDYNkogG1qM0N2UZfHiIMbTXhOChjNX7cphMZiTpy",
+	"PRzN/hFgd/SwBYR0c1ENoeF9Xt7DQzrAvCboy0d7ckUhT5e1L7TjzWu1YX1w9PF0aurLN9K5RrGSuiW7",
+	"NFNdflx79BRbi0ObxgyF33FjBt+pdwbAqn246VDz4Sa6YmVaukMqWV1snuMprPS8/vd/CFwuhthXcXvl",
+	"1vbmiDY/VCvOgFnU9ymjXl/Y3rxnCnHXdTpPmNeFkPZ1MFsOKKVXiryE/QORtCObQZ+iIbHmu0AIfCaa",
+	"elrgey7SEu3PS9rjikvVwYfxGZ/iUjRGjBb+GO1sAF442B9ls/hHg0YwXxq0AO6rFOXK/Wlt4A4yJ79S",
+	"pGtK6Sla3aAizSvSUmVKUqRRQ5+mK/V2nZ5UqS3NPtLB2N4QlHOx3BvRcIVNJx64MrhGGUuk0JhxsD9E",
+	"M3byTjiuH4FLX15n0VHL1VBk5KOKdnmeXrP9ReT/JDOP0U+b3ZG1w6ZyUs6e5zL13EmOy3bBSPe5k9t3",
+	"nSaCZuzA97AMAL4PzoC3M/EO9DCNWM7iUPo7mtDPMWMYXMqWi6ahzpmoelBfvl6jdV+dFuuGuUoNYzFS",
+	"fGz7ILyn+ir9KAmdWUcuxV72to+V0W17j4xjJSyfQVNR54cUaUuRb0UjEN1K5kMdnvZSw+wZ3TTna9VM",
+	"pcM7zi1PKXjdDbyFXgfGNDpLJtnHpuqxiDooAS/CMaO+KdP250cChJzyUNW87ylDhGqE0uWN7834o+g+",
+	"lyivF58bpL8cUobbwdxKMK48vA0IV9iYg3+uz5ojsF7ufXV6TZv61RFCQIYtBcYtHctwyQtCJyMIVAC1",
+	"4kO4gvKY+mJBkcaQ/S3oXYNm/EuavURhIC8mKssPFXmsJpVDzcVxF2gG518VqewRNCdcSGcCeXc3fIQm",
+	"h9vPiUymvadHfxn5jetimVQ616t/7CQgciIqzfAsc0E4k+vI5pkkheFVHhfVjS3tgQw+pGe3FHk4DI6O",
+	"9xVyF45zHJ+iKUavkJJ2B/13Cf13EamQZaUkKaVnwMXlJXVtXltwv9oCORkCbXKwK1E+d6AO+BX9ohGD",
+	"KGqocw/o+XhHHbgBV+7x2+rzT4q0pD1+Wyu+0yNWiCHa+BulKKkjk+rwpCItqStLQFazw44YsiZXfGcT",
+	"PcTzOJfh+ON9TC7HZtyL7frxWGt18J2xgBHwPxbnYl81Hfr26NGvyfd9IZ0T/z1OhwC2l04uk05eOYv+",
+	"So9mQsr5K/Q+3UCP9wUkrm8o8jP9v8YzG1FbGYe5ahOrtZuP4bKXH1a2Xpp2dSNYkUExhvCLiwx6ZaR7",
+	"cxzPUuMRj3PZLJNLoScIjS7fK6UnaE2D5EvbbbbNibxT9/clMQJse07kr9DEezpQuLfnRHDktIWVLvh7",
+	"qlRBKrcuWozdUOnctfLQWDOOF6wlLgyGUzDJDV9i0719YogR/JWf8KfOPZv6pj5V0H5PpgUx/Ha3V4rV",
+	"F/NubVB36UamE6oDjROJp5DzIroCnkUmbhtD33BOKGTp90H7baD6bl0dXaqWNh06goeYExm+lxV/5LlC",
+	"4BPnLPEpbA3983QhG24cfNifiBcEWHl3ks0FXoY/E586UUVOk7BtglwYFX080yOCwq4bmh2K0J0BZCtZ",
+	"3N78pEg3tjentOev1ZkP2DhRm31CsLFLHH/hPJtLglLck+ZZzHp7PfgYgP2J4y+0dnpDLWN4tZujSlFG",
+	"+RTD2sIDbW2CDLxerDxaUTfvufMHwqhpxwvn2U4mx2bO8uneXpbvYijaVWV+Hbj40ExFXlXk18hOie4M",
+	"KFvvDRbx2tAC7mIhZFhZwaIZpyG+wPNsLnmljc3QYia2Nx5WX76FDJKnk9rKI0UaV6RHijykDRa1Yal2",
+	"8y54dN8hJyN4dOe1+0va4Lgij+LP3LKWImypKGmzZyt4x73uIPukDez9/En2Ik2gqyNvaw/WcPxq5eNw",
+	"TbqtTT4HnUIaUuRB7e0aegwPRYxI901RQJkJgNCni+QOwfC+vomwX19yShvpcHLs8sFC5f4CHCvoEUtA",
+	"KliDkBeV0iJE3u80M6YtzWS4Xk/KIiJw7Cwwy6JHy5/YQMXzlPWly15q/YnGdfDaaB70mrSi3XpSlZ8C",
+	"6Q/cUN/dwfvXV12U4V0nLaEzeq9r4FI5lkITHrbAxjC5qBvjijQC6uj1gdrMa6Sg3zbsveiD+SFFljCC",
+	"HUbRVJT9J+I5LhVBozIwcBo5FJySkqrsoPn9sHm8j0snWW9eUjaR69psksul0vA57dJLDxRpVZFeaGtF",
+	"FJz+AFmCRxRpRpHuWgcmj4HLG722I6HguAGb6lDq6WGTokAlFEW+h9aAF7OI08lq0kt0sYBo1FurERxI",
+	"xnraEUjaYnLs5QhBDuQRd6RoNmprCzLaxSK8p+SiRaJFuTIlVcaf4+0o0oJ+lPJY5aOsSANAt7BOfV1h",
+	"VnMWvnU9seGXvqRlnpHPjTUIQHs8u73+G9xVkc0qRYm9yOZEpSghG4JSlHg2g/QNpSj9s8AKYgwFH72E",
+	"h7k0hMI/keEGsjgM3uek1kjRJuB48IowgSUCfPXT9eoLCT1dnThvRnR4kY0Csh0+px66It1DisM7dMQz",
+	"teI7ZAmaU0oPdFVCHqu+GFKkF+CcwucLi4wA23x0eAInVGF5LIbQGTMDV7RhSZGe2RaQMUR1uBUgOxAW",
+	"7+41ICqwUI6j12goz3GBRH2a7WVEOwNBFBV+qf8Bn/se1BK8A1EmLBD46KQi3TVxoxRlQWTEghBzkCy6",
+	"zo9BTb71m3YdR9dZPNNkpNhCnTG173Br/oFB2btdxkD32hG7n9QG8TrK2oet2sTz2sTT7U+yIg3DzuCu",
+	"oSffzQ/6JetPWEa+CMfsizusLy8aWupwDBFSDNMZgoiwF/G4uvEgN1iTm+hYd9GV/fiGFekNMgKapzSv",
+	"yDJxOP0+HFGXEr7s0CWjylgiKUW5N32R7cDsUWQuGD8KrNiuM8uk/j5QihKXZ3PdfVwePhAZXvyP/Waa",
+	"xuJjirQQM5aPrjSKzPO4z8aOwq/B/kYKEwhhPY/kMePl9ABfVHgeSQPI6X8Xn71Lco3jkABYrLHFxrBd",
+	"5zoXgxjxym1LuhvHH8QMz+TZnIA+hIulU9Keiqxp9OWCFTyMb7hOsbvNlNEp6z9bSxkG5SrwuA0i3p3j",
+	"hodGwImroxOKfAuSNbE7qChXf3uPgl7D0Ksfo6JbAbEi2QibnwFnj+x9toeT91OnWSk9NF13DkaInknR",
+	"n2r686ouy7fzLbAbbwnj3TyMqcJ8F1V+e4iFXnV2oTK3pjPEPX9j4CCZoIeGvkFPq4X5eC/HOtrALIiz",
+	"xcxzRyVY5rdXitq1UTKJIctcPqlHwx7F1hPjn82JeJ4RRZYHMP/f35hD/3kO/tN06Pu/n/u//wvNdWzb",
+	"pjcJalO/ahM3FfkacTZlbeJmdaEI13vobfXVE/X2NHwjzWuPVrCh4h/M4TwHsjSWFXqRsWPRFGHqxnL1",
+	"1afqqyeKtBT7fy6wVy5xfOr/jYGp5BE8HO37bDaMfsROKXtJeuS3y3OKvFqdXagu/GJKdl3BKMrqozW1",
+	"/BBlmMix5lRzDPG2AeRPXUR7AZttkssJonC4k+EFFgDBSpGBdAN4t3E07GUmm8/AsppT333THLefR9Oh",
+	"7899k8L/++pv3xw6h3/8+r95HI3ACAKbPZ+h2dwGblTnn+leuKKsq8GgNkmKNBcDLqGU1vUX4ZK6tKV+",
+	"mlKXn6MKJvN4MGxAGqGpV+cZgW3tDL4h5vqO4QH9ifh5w9gakh1ZUxhFadx8l8uQRVN8/RHkt+BvTrOZ",
+	"VF3L+SuMDDRm2daWMPBmwqUzByfWPM4WCHV6rTJ3W11+jhXRnboNmummVccReC9HKU0hkLcUebVy/xmI",
+	"7aKcTee69VfwUCybzv3IMykW6c9v7iLmPQrMW5aBjU2sOp+WRXl7ZVabWDV+Cf53deYDxLVLS/hPMMR4",
+	"xuBntqEI7fhdgBiGW/7gven6v1R2MJDo7mEDK+EXBnSMh7hXp99i+Y0eYV4a1J/B6GGKn6OQ/oYPSFpU",
+	"b82AKwVLPuO0GmT/0F5Oa0/fEG/jkAuhidOkj5pkkiiETiRZLxo1QJVxphKoAw+21IEbkLXk6XMIuhVw",
+	"Fp78F59FUVYHbtRmn8DOEadFJE8ckMGX3QSLmUeU+AUnj3Pyy16D1EISmOMsiBUZcwWcCWaV3mxDPxUi",
+	"AgHEKTrImKGuLVWeghDWnsxQVFzjyEOybp1G+hP/kuwgIulzHN2Rqw0OGn5ay/nlHs5zea/4mhKeoIRe",
+	"eA2MrzFhHqzgGseyQ+PLN7Imy4DPlMkETIdkYeXDjPb4Dg4Frbxc0yZuqq8nkXuQ/BJVZJTHtGuj6t33",
+	"zrRmS3fceYCOuXTfEB0Tax7xOR5Ia8hb3QC9V491CIynOY2U0jhsEpkyiIScF9rmFHqcFlEo4QrOA1OK",
+	"MhAj8vvK60bwxaJWHoJvrdSnFSAEaR68vVjHl8eQ/3cUSdoNI+OdrrufZbN5lmfEAh/MN/GujrMZIY31",
+	"7/OcIJwqCOlkeMbZzRVyKbqNqzSFNmVgSJrXPkK6t/bonfuxrk0+RUpgGY+Cb+Qx/L2xsM4Mk8tFSULT",
+	"B2DW4NZfpoqVj3Lt4W14Z6OwIESoL9GNvoUjIx2LdO0IbmS83zFzGHbVRgywpRt2pMLvz8d0BymK8pi2",
+	"Oo/igx8hWiQzFsuxdIpyCJBgOWyMMmyK6awVRhGSJvJ8WkRDvAw8lfvrtcdgR6iWhrUHS5X762pp1EgA",
+	"RKcA1sUBTPCG67CRCHIYMCubZbhfIXBEG0ggK9uo26PeuYZpM9ylsTEa5+2JKkZRvidcnQiSH/MS4456",
+	"seUfMhzHh1BzrU890jDI2RIuxkdswIedG4yPHh5G8G30Ggcz2JtJbeXl7xsD2l1ZezP6+8Yg+bT4N1s0",
+	"03f0YCYM2Uti2sA2RFBieHsrJglGHYBXk/E+wXoCDsYEB+fNtcroVu3miCK5I7vy1vyh2X8j1CIDrq9W",
+	"1J5hsyy1DhKup/v2iVacJ8JNT5853R5PxH/o6IL/nT3x59Nt7VDj9/iJjpMn44l454kzZ8+cpgafWhni",
+	"gTnqO9bsLVgHS7V3rjsIUb4qfbQdQtEtJkzaJxlGiu00dY3LQ/bFTh+8zZWtOVulmvGPnk/fnd8odIr6",
+	"NOTGSeTpG/M/XDpDdZxtIxiqBXKPeKpbX3Snzb36VfvltjZx0+43+TaE28SkV0p6BSUpCDt7cJ64ryfK",
+	"A5BXbSsnKFx5MTIEk84okod+xSGYFAkZsOPNIZ8IeBzXFeklBL1Ib5Bn5RqKFb0WvXpd+z8L6byHab36",
+	"7LoqPyDt6g3ppvGlNwalfA/1YsHZdGc40fNo5FXDgAGVdnAPgFppYXtzJPZNzMjYtb4hhPqJ9tY2kOZn",
+	"urrPxBPx1q5T3XrZfvj/yfYf4X8/tLefjSfiP7W3dp453Wz+9K3503fmT/9m/nSUrgnAXkDt8O4wgPeE",
+	"l1/nSv97+0/tJ7v+Sl8Bz3O8x9V+iSj8V4Srx0gBXPWK1vedYRbwLK+b4RCORdBD9+lnr8cIUaB5Bwch",
+	"V32DXfB6COQpFjiGR8omCnpEBXHmlNIk2R0CDz7NFkSeobe1cIZYtlwNE+ODg6CI4Etb5CWxgj5OENOI",
+	"DfbwaTaXysCly/mtJ82zfrWxkI4c0O8kbKuT+rqcMK5OHb4D7V9b4w0dIMRg9CnyWAjBQ6x+NxEqIpgV",
+	"AvoTcdZ6n/jqOfpnSI/tZf4znWO70/8ZCOsU+S0OCuaYVNjseeLb/sQe5zA6uIdJbGTFB+K8LES6SEY/",
+	"Se/8RQdKHVii8asfuEya6WU9GMTcCJQidjOIpsSh5sSh785RFBOo/NKapdfywUVPcWSMXvo0Si4iTN0F",
+	"3MZzZqJ2nVKc2nH1Or16KyouSceQWcYVJcxC+VhtYlXdGLVQFe9s7zrefvps64/wCm891n3m5J/Pwo+n",
+	"/3yqvav1JJWZnUiLnVw6R8vqqRbvbK9exzkBmJ2ZRrvKzAf12S09mZcw5UeK3OgAkuxkaE0zdFtqaVyR",
+	"ZxFFoFxOedUuub5tChJdrYf+JwiuIz//fOjcNz//fNj63blvqLKsIycUsJg5ztGcwtXiHXVpTCvOV0ub",
+	"0dMArdlPsLRiUdXiHe3h3bpnF45xAtUSiC3+T7HaogfeBNXc6BA6zXp5/uIW7NvPkLl7KezcenSvtznb",
+	"LTj13mJBEhCJr1w6+yf2SnjDEGHrd7+Hz+sFWnzFk15nJWlLgPfPzje/bIDvJWUPtQsZaOAYaQSKhByN",
+	"PgdhbHsf+i7d+rJfz0sPGIFUrchmuXSuJ5NOikJbHarFhSg+opNc8sKf2Cv0IOCRjyjqFzyptYkhFDg+",
+	"iOvd1IZndEUc0rngsd/NFfhkhPiqk8QgN2jtzhpUO7k+qK3dAd6NX4IG745xl3IsD0lSlevzsCbsX8my",
+	"GTZYNUIf1eeN4bmL6RQrhGzJ0+n4nJjhdEHk02GuivmhriUQc5zlWUbMRsrJMIcYs7nxPjiEwhhRjb93",
+	"65UHMi6XoDsIpIXquxlVXga31vJbRbqGk2S1d+v6EQjgSBMiOt66WdpScNM46Ow1tKVu4biLl4o0X3v/",
+	"AR85Tn6qTb8nPHIoePHmmvb4+fbaEBQVMlxz8Jk8Zo5FazVZZhTeikd197Gs2akseKz1OU5h6WVPhrTZ",
+	"mJ+azW/EPp67ZPDnkOduDqGc+K1x7d47XTkqytqtcaOCGATSVd/OWsoRWXU8KNdLrzGOqhryYaTJT8Z3",
+	"Not2uP0ZZm1f93Lt5gio0X4eDHKw+6RJivGreG6luvnqBzi3zNHVMZTSKbJZ8znm505/g5TODVB2irLt",
+	"T1IZe/aQGaekpz3Zy0ntzE9lrnGf3FSJuFA4L17JBw4yF9qtf+9HH8ac/r4ux97DH1LjfF6N8UGpb9+S",
+	"Pii/8MuoN9bmjXJBTqXhOXO+AP9siUFo/dKW6R9IcpkMm9T/hIKMf98YQF1FIRDA37Xl6cIyz8yryBX9",
+	"yBrhyjJB75Eny0Xx4QkUAoM+gveGYgUlzwzZXoxTor7ZYRHBqMboDbJZR8b2HiLas7IegeFGxgeYMA9W",
+	"eIBj2aHx5Yho9+asY+qoDG8FunRLNyaYjYThc4fAb7sPcQl7Eybge7ohmGuD4wRM0Ht45z2aOZnddbSZ",
+	"DafKF2jNRa/l9hzshJbngd/J0rA2NajeWg00XqHZupgUNepOvQ7psOrAm5p0Xx25pW5d+31jwOD4i+rI",
+	"L9ubI46QuxDZPCftNgLa+j38TUmo1xrotPjxWCsq7IrDeE08BVogDJzCaZsICRyl485JI/oMCX3N1kpo",
+	"pAKWl7Z0T086WcjQSrphE0vt0TNcu86V57Vg5JKWHXlOkOmPH8JQJGWhsvibIt2IelymWYi+rgWwDclj",
+	"RqrVc1wwAedQ67ahCzA8pn+K/x1TpGGiSAwqQgB2pdEGu3Nh9dSUHViaPWUHbBvrv6Bok+nKZrky+FKR",
+	"ltCK5w2TFyruXJSq88/QzoYqMx/QD/PwX3lMnZuA3UhbxhAjDNnFuGyHHWSUI0gD2/SiRFz7WfVQYucN",
+	"/VA62gjjiW5dw7sHNJC7cfFDa3006j7l8FY67e9PoJQlRKC+V8urDvdWCPo8pafGeDnP9EweowqXnd8G",
+	"Tm7YER2ujU93tdvPG+8b/+Lpdni6P1uPM/WioJgT2hWxSlK66Qz1VtP/4Xt85ocNdyoliSrnIQo52yui",
+	"4/FmcedGZZMEu/X0pkyV8efY9YsdSEZp0+BqJOh7I+1tf5fdg4OKzoawcrnjm6J7pEzXrK++jb9yO4VC",
+	"u4IS8Sx3EV2wTkPoBzh07J8bu6s72SYQHkKintVgQLMas/ljR3dIN9ZTAsJucAga9Q4+QKRSxkLKLBCH",
+	"fSRGU5hh7AtB6fAvUM26x4o0i1x7Y+q1V+r1AaLc1j75SC6m2UttaUEMk3f+F/JbPwuETsIJgn36W/m9",
+	"+bVX5pDFs3fBdoVB79FL1k3mboUI9aOASJ7762YJd9Ant+aQcj9fuz6CEvPG0T+nzbKF2vCSIg9VB56g",
+	"uolejSwELsPBcfyzwKSoRsRTttLSlKOwwlRR7fRNo1JQWU9odNY32uGz41Q6wwoil6OGVt5Wb6+jZkIF",
+	"1LuzuvBLbfidXjR6YM6MTKosFSuPynBbiXIstZnr8Et5jFo7GT/VPUxbKeZKBCZj7sCrRbA2VVRkuTa+",
+	"rEijEO0mjajrvynSe0WaNsqq6/VAraKcfCHXyCXoZcSkaQOVdKgC6jvb0J3XFn9B7+/3ivzUBZFWM88x",
+	"qfuVgg/bsLbonbjlIRywBtuDvZXWK/en1de/6AgurROLmFevvzXrU7usSsEvHYrsdNPu4ydkFxrcvwAF",
+	"s+nlj4g7yzO5FJfFNbd4DlUuYDKZE4XeOC7OmuZyDNKULzG5FDIdiizPp0VOr3MgXGL4LPWyO2vzuuMv",
+	"cZloMPWOVNefGs/ZAFOYM8DCPe3Mtdrcre2t2agBmFb9TEqHPiukW10D8zQ2gwSutpPJsKJIfVDfRSYg",
+	"PQHCwQN2GJoVrCjq69JLEeZx92AmhY+KyXTalhNiIqf3wrpSsLPdmx2IkUnn6p3frk86ZqcpJvaAAwO6",
+	"sUsdlTTpbNuJLzXs0KtsO1mUZLBDbxCaw2dLHSn//UTO/Wg99D/PEYG0HrJbh+6l11nwG+GV0IHtkSYH",
+	"bQOPc4Lo3TfwPa59q96aqZQnsfBpAtkyN6gOT+gFb4rS0SZUb/GBrkUZo7452hSVNZIp4VTbIM5Cx4Vc",
+	"HNVApLJ6Z0QdhBI9WSavv9kOE3rqMUR8qIrlxjg24Ooj5DFcSjJyTmMnz/WwgkDP+pSWteevG2Sh2Smn",
+	"RoGs4e071r5QXGt97mJrEswkTMgRl+Dlma8j9Afq5dWzBFyXj7KGQg7aZdahTdLs74S6DtZ2yG9dhZ+N",
+	"eqc42s58CDiLjUwV1YHH+OFgjo0Q1Ua+fTF6TLKhMg8HiXjQvzrwWJuaxmG6rrtQxwlmgps1WImjHrVJ",
+	"0CT+e+pIeW2o4SmGDkL3xSMODfRNJkiGsfcTRffruUe7SPW2fRJ1KcNQvV7H0ePUvQv5WSdAl/L4BBoi",
+	"301IeyXiHSzMY2+m9xijHzdbi6oRmm06QkYDk9VLaVzKO5AWFG/Koxl5ad0Ct7EZM0D5oDgF+rpsnZmN",
+	"kUZf5eCR6EvwhjgaJ4bsaEGOorp6yc6HqNOins+BAvoH0OWCxgZoCY7uhYFOHUq3w/1NBOKCA0WMap79",
+	"qAd/wMdWZmFkd0n97o9oPNcIdaBk7wzPoAYWtysfoGw/inK4q5fkh7yKseqLSUUaBcVYWsRBDM7azvW5",
+	"UYjnhv8jSP8O5H1fOp9P53qJC+B7de2f6+fZQI8KlHbVjTJ3CcM1zkBZdGegWE6PLhbZtULxHv1bGCty",
+	"fAjHejf+TN/vJYbPwx1sv5wWQ17Zn9xDiMlOs5ejTER8TkzSybMXI0xCfI4miZidoaOQZNgkC/aSKnQF",
+	"AEuWBikA+b0T/c6kNGq2t9G70JmTGkaPJNLUYY1GVnnQEJx+bugJYfzUrhxyV90Z8y8JY+3GgmjIMRsF",
+	"OQ76URm60Mtj2ysvkD9l2tYCqCibTTditg5Z83o7oKJkzkD3yMy8rV3/1WwlBM14UAc8cF0gNXcfTLRm",
+	"E6R6eDrGaPpihEh0BO+MMY52GXj2EsOHW3cX/jQ8dyAW7EkX9MIzBB3sRqUZBNkrDtqC3QgmhEDtERdy",
+	"nHYQXvXrYybzKEUpxWbSF1leKUpmW08m2Qc96gr8xfRFto25ItTRpA5NvxfNLiltLhvYIs8LT4b/k94i",
+	"T/92L7YPjQdRnyF7K+VF7OpCji3oGaWtzOrZt9FaYAQvjARrLmx7855aHDM7YzLJvghvq75C7sJxjuOp",
+	"RbXlVwAIgystGc3aBxGB4vVIKIjrPVC+tFh5XATDM8pLNMk5UN0jPkXXtiH40okCUQ6cxiQK+LEhEGWo",
+	"9Htd8y6Tb3vfcSwB3feR6NnoX4MIQBj9GqNblYl10s3KAZvzqu7h3KDjRjTASrd7fSzIXqdB/BkaR0uf",
+	"1OvL6h2ynh6DuTtAyeYzrMjS43KsZAwXHPhTdfCdWw8NjiDNcDy0FcnhbKbzUQf0Rh3ARxvgOAw+DiBh",
+	"nYk4Qz2PLuYS7SDs6Qnq9YXtzXtKUT575tRJWzEhqWzklA1o80O14kxQCjcZbxv+Jh0nRtF0iZTevE5o",
+	"SINHM7JWaEwPihSuhC00olC31RxAaFCZ2rSRiSs0JlnYLM4vNCY/LhrP9fLfZVEgodCAKMe8aeUWGmSK",
+	"N6NJdvSqR32ihZ3r5TybTOcjHF4X+p42ExHUGtUgjgbR5oTAjQgtstI0mqKJ3S6WSaVzvVZxQmdV5dfb",
+	"a+AWNYyTryrLo9VXr7VHK/5V8agBbzrKKGz3V0VeVkr3d1h4IZ3LF8SoJ9gBgxrk+m6wB6H66S6KS9MD",
+	"Ey0/grshB5hG7+Ge54bfwWhuqbeQDL8qjBdcnotinAWvoXpPsuIljR5wjjXpv5eW0GeDVnuQSxx/obUz",
+	"/HoQln7Cg7zdLIvomTNk4gmXTnKuaeS9ujaPDPLjqDvfcJD/Xqcpqg5B0I83TeNkuDptf45MuwaoqDpc",
+	"7/14BWHp29mFyHpvTroLthKCuL03CXFOiOGR/Q2tdodS2Wh3aO9yKM2TweRevQ4jN3HtbWDvTIONa1PF",
+	"7c3b5Oq9GiFe8W2D2OWobetE6CtDpR4khQjOXVBvPYqYeOp4mfo+pGrSfcwGLTOwPIS5V+3mXQfgpqam",
+	"pqC4uW4GrAEic9gMGjtuIJ8iOEub6q1HNB9/a7h69g5oV8ySXm1sD5sT2B2MD1kd33OG7tCV8j1nCFs1",
+	"32uCv4Qsoe8xgYPSzdmIlZHbJJGWMA/QOgraxXBChrRcHzIx2ythkgmkPq9exdaE9p4KwROe4lLpnjTL",
+	"+80plY2GDeuVW79p14eMINVFiJ+Th7Y/lbXXz8JuAXW48gcHEbE318wcpsroljq1EHZ+r1IgI7+AEDOh",
+	"FGUT80pp3dyCUlpX7wxUFwbUIrZ0U/vXGsca0ulNIwoKl360pk0N2kgCpYtYB1QfOHMGiqqJz1W+FnCu",
+	"/Ym4eWz1LQIPp2QWeR2187YipBHoMFbkfwuzWc6HXasbW9WJXz3qChj1AkLVqLV/3J+It4arvm9bqV6G",
+	"H6fxhwJLfupEmDGjfcKEY1+B6NM3QUMckOroM3XrGqpU8wYVgzJ6e5q1PlAa9fb6unZtFPd2VQcg+aa6",
+	"8FYtr3p3crXWYMv3b9M1TFp/scpCWR147jrHk8x5ti7CRYDB741noNye2YXK3BqkgkGw/AOoJYZsjLle",
+	"tm54aDR2w9Mehtrt50fMlhGA/+E1deAGup9X8vVDhcHUwiU4fxljFuTA61/Ugefq6zsuatPjA/DmEzrS",
+	"/YnLsV+vrnFvrlUWoGM+zCQcFoU8qJR6DRygHjCUo/CpV7iijCJLJhkaLHXeI931VPvJ9nZYduvpH9vb",
+	"qIZ1OqZaroZFVLgpTSL1JmyY1U1wftMf41JXOhlehAgPWrl85M2VyrgxDhCT9FxdW8aiwHWNzCxAmgFd",
+	"ukfMA2Fv2yu3DZ6uO2fxtPDZ9RF1YDKeCGkgMzaDN2GuIpydyxx9nMmyPOMjChR5EXnjXnpsvzvJZMJr",
+	"3xgcHgPLgB/OcvUOx4VT/kfE4Z2cYGIKz/DXHcywE+j1w3UwGYwRC5+J+P+Iw/QWiqytngsmBxOONzWo",
+	"a/PawgNqkw5XMw7q8XlPrbf8ld9iRzZWbyOB6WOTF4QCxWnafaL10LdH/yv4w+V5lJK/hIqIzviyC9Lt",
+	"5HNTcF4ypKJB0th71OvcKiCI29fZ745X6zu/GfwbXKEZ/U/YpLJTTD5ci79hrOyjdb1HRq4NpTRidsAy",
+	"c+SUovxzTn39izq1YNSd9BghfUKmRty5XA8CV+8sQt0yVNEfzbT96TFMhup+t8ScdWmKEgoPQEdYuot/",
+	"UG9ct8WEFCWsx6MQYx/PeCuZH1eXGm+zflAUFNP8oWvA9QCyqeyUVxKps/cn4viy1QHIKRJoASd2mQDQ",
+	"iDtSD0zqFaOoXYF3DK2F7BoSeSXGYN91/DZQfbeuN+whSMtCv6M7Z8RVEKN96crs8mn1dWvNZMzXQESo",
+	"pxj+Asv7Etnd99rEqt5+Do5gA9HCBtnHLSJQGOYHkt4Irj8R/5FPp4jOyhHBEqP9Sf0NstqWlNIg8pCU",
+	"tzehjgzq9dRZB9wTnX7goLjGXeAVRhMrsc+KkokKiRjui2BdPb2PWLFd2UVNlpzdXyKuwz6BL7KhF/gs",
+	"LpKjx4i7q7VGhE6M9iVssuorrtqJjJ0osTPPpuqB7JjC9yJjOYVIC5kLXN1vcTlneQzEpFwkl9iRO8Yk",
+	"L0Srsu9apDWJ/wHRRK5xJ6BQ0GBRmxqsFq+H2oFZ2zIqn4Jxvuh0V8fsT2DlKDo0GObPImitinFlgSss",
+	"vyt82KkNOVgxJQ8j4hIcM/heHTKngwBu6ytUJ3hzDl9hjKriVJ9NVT6+sJZhRVdEhI0H+mNfd4xatNVt",
+	"pvlHhIYH+tOXUWbZaJCCADoyvKKCJYYHAcdFL4DWBrTR0cr9dWLbIiMKEHbYy6Z2h9DlVV3DA1Pqqjo3",
+	"qD36ALGHsKI36Alf4HNgA68HvjnWfwlP0TtxWSnN688Zec54yyzUitPq2gvkhDP8KxEXgcb5XjCyWHt/",
+	"Iv4Tk8mw9eg8eKDvVTIdwgCH6JYUFZI+1FfvQM2zLVrChf6PM3kmqbsyIwMlJwizTbMJk9972f0eaLka",
+	"7TngfG9iU0yYHDSLUs0h8E9Y4Z8FWFR3ks0FzkJ+6rQYkH9LkCvztyJ4xhzYQgrCBRBgxdCr5hmpFOpB",
+	"diGnpb2hWq56PqGcp9QWPg3OhEnJh3Pgm/ybL4rdU3mv3cfDVHm5ht1LUXxLGPaPbI7lvXqEu5Se7ZWJ",
+	"7fVnlfFX6ugyabArpHPid9+GPzY9XiuXYi+HAWvw4puGOW9158C97HIUPW93MG/808tbT7PSld3roIXd",
+	"66Rbn42GsBzS5GSwfdCw+S1RP1afQHUswF5RQgEPS+qTIeNhulgZf4OrEemxpecz7N/T9Uj9bjSWnr9F",
+	"Q6xaHlTLDykRhNYiEiRq/a+12bSVfsBGRIktXKUhIVOmC77ukCdzhrpDnqwZ6gx5MieoN+SJHhNQX8hT",
+	"8EGDeQnKQp0u0AvrG3EmqyguEHR7k4GG5VgXAYTIUAtxAm98YF5EDw8e45kmS44e1tVg/WpMBJYHFVg2",
+	"FzgpdtxqKwPIJeA7o6s1gp7+heD4noTdwthyNZSB0SvqJUo3DSJMpA5jP4x0c6jtzU9mjV1qtAkCHKmN",
+	"h19IiTVZHT09jkfu6VFPi+n2yD09TnJMik254pjCnZF9GN2UN7sILiepbByQHjeGuwjbosfwUhyRTSE7",
+	"ZRKDIi2D1KhtizH65NT1kteHUmzaKHRND5bc2IIOOJSePCGrupGjqHWx1bl5GxxnRHbYnA9iFM0AZAVy",
+	"q+Vhd06Quvxch9+At54zfs1qCtNmNIVptTWFaTebwrQ6m8Icx01hiFURx+44FQfyEkTknJts6bfKny9n",
+	"OOjDQks90D6WISL+zag2/qaim6BQVntRxmestxMzoiK9I1LxS1UMjXxYD1qYMRDR6uXo42GQ8+jgdwlz",
+	"Sb7I+ZHJsp0818uzAjVF+h1CyEyt+K46O+wh2ZMZluHZ1N/JzFOvUtJuAe7OJYC+BU91YQ085D1qZoCM",
+	"Yl5qvv0zWwiDtWn2ovkkqaPQNU0V6k/46B1mxJXHy8T6krpex5m6kGzux/98af7Hlqsh3Y+xr6wmiCgS",
+	"52vX4YcPTIL0SL22RJSYJGKYAyU4+Mh3+yc6fXZtekH35rrv4Kbv8JKDd+V0IcvytMB/9dGaWn6IvSuR",
+	"DF+6zwYb1DzMCMasZO9BmBKl9T8jSojMQ0uV8qQ2sapujIL3bmAOxVxSU7py1l7qcFYbmKBmAJCoMNYq",
+	"Df9sgPw5DnrOzAfUUHCRVJJtVcLCrYqoF+ahYpzoxMG2uh6OPWCD79Q7A7Gvmg43HWo+3PQ1uUq0AP81",
+	"ivXFKdsO2yM+2kKcVCaP00W6YqDh1w2u5WpIaPZQ5bKzvBoy8iyhiz5smnoihi3jc06YdOgbt+wMnG25",
+	"6hEYYY/blYf08GAPodve08MmRc9gYEP4GLq43b+lQBHtsrr5tjb9KXIkMIg+DJ2WPNrNXjSNPo593hyp",
+	"TN4ELxaNrZxNZ2mWC6x4QDtYXRA9wU2MQgRq0snGlMq4dVNgqKOuvJrbMlYaSL7umBjPk6eFxDjPGwLJ",
+	"hZBTaKXr6szb+mO9HYHrtFBvEkV4ab4I8QrO8XXDeNRvbA2Vw013+7iTdDxTlom1e70exj+g5kR7+mjY",
+	"Py0iqIM2asdta6Id+8rRQxuEVeXaLBws9FVaanL7LEIqH9R4q2hNts1yTVEDN6y+2y4RaNR6AnuR1ZE7",
+	"ZFljW29uikYAfc6PYPThRCfjKOqJVdNHU0PUAo/RRUb6bAkdqdbu/SnKM3TNw4KtB4BRPUAQy33mUo7l",
+	"XbrlkmeUmFTWJX9RMrw1qDD0oDb6CIeLo3gMeI/qsSnymodOarPD13EoNDu+d8Koy5APzbdg811sTx3A",
+	"fVxVGCKE2kll6zPH8ZugEw48hDp+WlBgy9XAmMB/YWLYnaOwoh7DHIUv6kkTbKAZNTRTdsZZ0fsc6QGE",
+	"XgdnPRV1uTmtSA/BU6tnfJS1qcHKtVlFmkHlNYe1a6Pq3fekkPXbNi7s4tWFyVY8hpoIk4h7hYfW0zu9",
+	"Lm/PF6eLn9PlX94Q7nsv7UHA4WJDPPO9IoQSUXvXBWd5kQop5TrpirGpCce+wirz1/6FJFy28XBT2235",
+	"X0fM5rMZ1L0Bmhp+QwCGhxUKb17B1L718ZWijF9BLK8HOek9TsdR8iLuezemXl+GwsIQJ7OIqzJFFIlM",
+	"tFeb27jo8syHeLr5xHe3XA0O767v5elsr1rPmzO4nCwUULlzzSi5PKKUFpsOfXv0qHvNUWvAHos64Meo",
+	"A7p2VGW2Kw4gYZ2JeGsAFh0h9t4S30M+d0QrbegAjEYbktbXgtLhXe7Oc87Ite9a66x9F0mc2DQsqkBJ",
+	"hLoA3qGJtDjIMhRWW7m1vTmi1wUuyvbCwYs4y9e0rf737jOn9TrD8lh1Fuzjbrcmkd8dNYbRGErz6eur",
+	"1u6vKtKEIt/T5qYq088r9xfMtPFR9MBZVkrP9YgJMZ1lBZHJUjKp8cagT/YDOfZV1w/Hv/vuu+/Vzafq",
+	"xqhdIjEiewjmoem0F1le8Ii/pSDbqDhvOGWLsvFoWIr9HG8+3HS46ec47lvwibCaoz9QbeWXOD5TT4Tl",
+	"TzDO+BcV10SVemMD7v4q+uZJNBtrSlhU4E+ukF7j0ZlYLQ6BK8tMr/E26V/Oe2o81XvXKi9HKh+Hawv3",
+	"vCpxtV/OUxUKcxS+KFinoM7gUbvLXDt9nDPIEU1iLidB7isYiYKv+utKUXJF0xspyTvx8BOn2e+ZJtXR",
+	"Bq1wbMUFpq0F2q6L7v4P9PGjxfujKM+ylOo3rZ1wtEu3zfb0dbhzcapWG5unlaqh5mdpy2+9vDmOWaHj",
+	"YNCcOiq9A93J9vvqwG+VD9egX5n0Erk3J/VCt34PdnoyWri9ej3gI3dgdCOmHxyJmQxOgRL5Aut2qpmn",
+	"EmFqPAY15TfLBnncCheRt59Pi2yuN51jY208c6kDXr36NEi0/opeoUUo0HLjeq20YDI0N+WS5x8dMUR9",
+	"7khqiHOcq5KN4+/kQg10B1xE18ICqHsZNy7wT+bYOY0bdsGWq1FyDsglHTFjDmsTuLi1ayB0EStq11CR",
+	"bFRpy+PR12tL7YlqEXdmB1GilIlkIPQgGkZZK85UHdjojRF1AKFRHkNF255bIRFpIwmorgXiFKJQOR6u",
+	"hbnoEi8lQSLOnwwtXzydAj19/o7yWiJDMxOgj82ebrQ4Ak83uqcy4Vet1HlLYVXGRL54cJjw3DtB5X1w",
+	"3yyPPEncYiKoSDTxqWlsPF3IhhsHH7riC4gZyQn9t2vGxFFO3Raw5y+dKYnJlAlD5CW75GJnXRU8sKM9",
+	"Um40lhJsXXnZeJwbnI865TLmdMYN+L4n5si+brnql3ztxOZfwvSchl4kHoWWg++PM2XbN9+WFsSQDC/g",
+	"zQHOdZp/8F+qK+e75apfyrc739as5B0yE4PPcrwxip5fvjWr19Kx0u7qdQFbOXue3l8yaa+f8LiSPqFw",
+	"oN1DvYDiOCNcqEcoZBhsTI3yaneMowAq3lGXxrTifLW0aQd0gmXEegChcVRA2sO7BiBX4jQ+aBpebefr",
+	"woRrxQFkTK8i0HLVp4jA5x5iZDfotFz1sefo1jtaPjJ6otgL10gLRAcVVBhQHjNMglTNlQXdjtaolq5H",
+	"40A7pShbkQPziryJVX7T6hg5EM+Rpk0Jt+xlsuzf80QCR9T6ZGT+B03w0hJAnCdsIot6uGQSr4O0r01X",
+	"XzwExR1lrFVu34RmMY+e1KRP6OdFXQE1Y8PoTSsOoX9S1Jw+NiOyPD0OtCaVq/c3DW1p0rJTNiWOJpqb",
+	"ztFcd+4W7O5piw+3P81W371Un70DdfvmWnVkWZ35oA0WHW3uiEb15e2V19W1RXjqPboB3yNtFTXXmYTI",
+	"B9sks0ppSCk9xz0/Ubv20cqjle21se3NKfRMHEZt71Ebnpcj1Y1BFCK9FNP7rUNLZ59ICbB8HeO4Cz7G",
+	"N23Kq2Q7ahp8ERdS9qU+gIK/JCipjqEiUpe7jb6qgQM73Ekk5BTOtSSsHZ3zQlZHygdVDW+lTGzfGyqy",
+	"z+jVqT2uDP2+cIVciraf2vR73BhaKcqMILCicKQnnWGPCDBAiNWuj2yvQEi1emcYFVYo16bfq8/e2Fww",
+	"8ljt/QfUkOpBo3ECq+hmaUr1zTXt8fPttSErPho5dsn9GK2qwHxLfL+kTT5FPYVgL47F24m+Lx0l3E9H",
+	"MSW4C8UJKdKwClJtGvVVnkaLRlpXNi0IjQUzN4FbPlgwuDybawiMwUGDNd21AcinkxcK+YaAGNpyYohH",
+	"SaSNmBznNCvSJPSulwYIKNQSTvkMI/R5xUVXHhfVjS3ILYUmaSiydnldkUaqWxvQbRbF1/6+MeAIsP19",
+	"Y1Apyk2KNE+Mh3g38kYfDbzP9Vv0TVnYnPg28R1VGEY07NsvvcGNdnDrHYXp/JdgWaw3PGRXKpSJ3W5Z",
+	"F0Ibt0mbthDRph1gyhZcpmyBMGWnvE3Z1rjw1msX2qK3a8wzwXhGDodO+NC52xzeYp4J3pVHSz2PneEO",
+	"eztrqRfUTbTxffV24oZotBgWmV4WDwezIoUXNmvlj7WHt0Gmji/Do6go68lqH4drkmVtM0sxaRNvauXy",
+	"/74xpt56pg5P/O8bY9Xrd7TxSWR3fKfIRXerSeMDy1fiaDgX1OyuG6vJx9mMQE90QTo7KgEPL0hFmtPe",
+	"TGorL3/fGNDuytqbUcy7dY1eKteeTWsTD8C1g7aKP4bUQrQl99Pm32wvm++afNZIfYDobwr7W8NF1hmO",
+	"E01KDFkHJJ2kJn0OPNampslqhJATBV5v5IiRV6uzC9WFX8yTwok/zbANWAQqxd6RakiXf/dSAOG3NmvS",
+	"Ck6EVaQbZvNnvXhulrmMLTHhV6B/T4FvPOewjUab2ah8GDGqPybiIpvNszwjFiLVL7eToxdQNz26rwYk",
+	"S0gj+jPSosQF9c6wIv2CXpuvaH1ZLRRRORBRYcuh+rwewrn1lcGXlTs3yMy+nT/tC/zFNJgwrgQ19jed",
+	"V9ILrIJV7k8DGtZ/U6T30Jty8rme42Cs4Lv/ejSQSzhcRm4XDGzzHY5RQcUVcNt6wswQbz/dfgpap7Se",
+	"PAn/+6m9tfPM6XgifvrM6XZqlJTN2eQLEW/IANTdcfrHk+0YEn3iPp67ZLbNcijat8a1e8iSgfpAUVVV",
+	"EnnfBqMOoBn1XmnAPBS08zzLXBDO5DqyeSYZaNA8Zv8aIhzqSD/gmVzwEAJ9SLuD50CwpkA8GpwXDkM1",
+	"V5xwbp12Dc+maQg1z8qNzQyXvNDJCIE6zTH4UIAvdZygkX9Js5fCjURfOgNMwrB4W3VTCGTMpEOc3w/4",
+	"M1yhIBGxk3kdvcexiTHwuAlLpPkCsN4uwTql/i3ESjIh4P3EmNB8mmyTR5IgKII8Y8dirR0bK7FOxoss",
+	"vfRwnTQboXjTO+/visZtaxPt1r5oDasJXpznrwDThc2yybQedposiCL8lsqbzbbSQbBsPbGLcm3xF9SP",
+	"b12RXtaK04o0qs49hP9uTTok7neBHBsw8kOG43jBI2HfVqGpsjxae3hbfTsHiiByWFU+ykjvHwbXJYjl",
+	"cdBP5aeWzkEs5/vvA9fDs4wIjjmvWs7N6qMnoOEMDiFj2gv4+d165YGMXWjVdzOqDCjTlt8q0vjvGwNN",
+	"oGeA4m5oQ+qbNWwIIfTyQMnmKJVNK6ho1O7Gr7D3BGUcaz17FknpHzraT4LZuPX0X6kUAdeyLS2ITC5J",
+	"AdPaUX0xURkfxqUmA8V1iO7fZAVU55buYQXvROeOFbqfGD5/vHCebb+cFs/y6d5elqc+byrz6+rQuDY0",
+	"U9Gt3c91559UxqGIpp2tViyqMx+Q8eeVIv+KTVCu6wxwT7OXfWFqv85igjb8j5NhZu3k2Yt+s6qDI5Fn",
+	"9fRmaW8+UF1Zh44mDtGdWWTVfZrDH1Oqp7EsVDd0e+REIs7SIhUixick4mlXAEKUsANyvBFXECWawCk0",
+	"zHABlhIukHZGCKSDIwSs56irfA5mX/giIyffiNXSV5rH0W36c68ob28NtcR+jh9taor1/hyP/Rz/NnYB",
+	"/9Acy/b+HI/bLTxNh74/981XP/98GP/09X+LfZXt/V+9/+tC79cUc08/wmMPKnYlpkUgo3hXIZ0TYu2p",
+	"tMjxsdbOjjiRVxJvQskfupOByafjLfHv0K+wLQ9R1REmnz5ysflIEndpO4Rc6XocqRi+gSFWKNTRCXUL",
+	"7gKX1yMpwcpgayAnIM0EzlPIczkBg/q2qSmOSpjnRKPjVz6fSeNKBUf+IWD1FVNHcB6bBQzBQniz7+Js",
+	"HxsDgmIFMdbHCDGhkEyybIpNHcZacw9TyIgNW1E7z3M8bRmtuVghx17Os0mRTcVY+Cxm4OUwdnwwvQKK",
+	"0iIxGD8HFl1OiHI8m1PawB3/gzkOEp7V/bGsIEJBol05FYwK60KLfIHtd1FE8y7CDkkNMSaXijGxHHsJ",
+	"jgXVvUEfnGfZXCyJ0JWKMUKMgT8XMuJBoZ7+hMe1P3IVxUL3Y8LKsGKUFqaDt2oP5vxJrA3PCeyHZ7Ks",
+	"yPICMsalYWLkXjDeg2ZQtp1MEgTSXC+Lcy4a+jf38s/2sTwbSwuxHBfTzycmcjGBzaViPRwfE/vSgkEN",
+	"idj5ghgT+9hYH8ukWF6IZZkrsfNsrCCwPYXM4djB4RaFCMxCe/RBm3jjf5J/zqeYXT7JfwUm1LT/TOgA",
+	"MpVUmslwvQUfNWJ742H15VtfpaHNmGS3FQYD0GejLJiY81EU9AOgqwXmBLuqEhhQ9lodsMP9g6kCJG3Q",
+	"bmwYDQDTjoe8NwF8kfX7cdkLnnfdQ6qb4w+kRI/GQ5r2l4ccNJ7Ac/lAc0Bp0CiKOBnaHNDGc/m9sQWY",
+	"kD4f2W7izs8K4HUqHuLenHN35b0BZs8Fvh3wH03ikxRDv96hnv0eNOWlBphQv+gB+8IYChH4gpdqYE55",
+	"MHWDaPymaZ/5zYHjH0TjIQ/dwOak9lcJ9Ml2XSHAcD4fdcA4BD9lwH4MHjqAPtHuagAYyJ7LfxLsH036",
+	"WwRCubvhBL+9zZeHvNdn/CLt9+HeF4KvvZeI1+c5mAI+Cjtp2ld2crDYA5tjs1eCnv7a+MfQL/52mHBv",
+	"nvwWqM9GyBPY85HzzvOgy3lirl0V9RacvZb2Tsh/MIFvJxaPSx1G7jvoyUPuE+C+iP794QaFYGbgIf2J",
+	"qQ6kAhCVyTTtN5M5cEwjLbLZQ72QceUXEkjk/hFpV74aAaQEokyuXVcITEifjT5g4c43EtDjVOh6gTXn",
+	"rqoFJpi91gocgP9gSoGNYujXO1zoH52mPHQDC+oX1WBfGEMhAl/wUBGsKQ+khhCR3zTtM785kPwjMGOA",
+	"ILiwBgOzYsCeqAefl7nAwl1Y9SDYbGDNuevqwb4YDRyA/4Dqgaf2HyUzgE5TPurBF8vBPjKGQgS+4KMe",
+	"HGADQkR+07TP/OYg8o9wikGgMrAnesBnpQKElf4+En/3hf1+yPk/rIin3c6oct1Hln8R43t9uQPZavXl",
+	"+8qHN35s9UdW3LsDa9q/e3wAOHWQOuajgh1c7Ws/FK/PinAIhp5loc6IX0DmLEr2hUaivgrXKTzRbqtc",
+	"GMxno3TpWPMNxSQOgK526ZPsquKFYey16kVC/YMpXxZluG9ruBBMi248FDAdxBcVbM+veiCr9VXC9JkO",
+	"qBpWx50+ENy7EHCkHqqYPseBVMaiCIUvBBSWyeeZDCuKvgm0d1EByZJZDd+DUXTqM+22UqbD+Wy0MgNv",
+	"vmoZcQZ0tcyYZVf1Mh3IXitmNrB/MM2MoA7KrT1yNZ0KUMwsyvFQzAwIUTSzVBiJYFYF/KKV0a56ILf1",
+	"VcuMqULrZTs9sqZ9vc4Hg3kXAk7UQyszJomiltVxnPssD74QUAT+znM9rCCk/bKXq9Ky9vy1v0pmTbPr",
+	"WpkJ6vNRzIhD8NbN9GPw0MqsKXZXMTPh7Llu5oD8R1PPbDRCv8FhDGiYirw0NGuuL+az/bn6Bc+b7yXT",
+	"rRkOpLUlKkdp2m+OchA5hE/OUmWzXBl8GSTd83sh1/Ofk0T3TUnSke4py/O7LsXz+yC/839UyZ2n3cgw",
+	"0hrTibe0/pJPtOcX2oeJ+ttRYIYD6tyKfHcPAF8ueB6jt6KVP7AqVn4flKvPi2QI9o1W7xfNbfX/9NWr",
+	"/gPNs9uKFYLy2WhWGGe+Ad0E9ukKFp5jVzUsBGKvVSwC6B9MxzKpwnVLw0V1WyTjoWthAF+UrT2/44WA",
+	"K+4hrPEUB1JaR2AdTfvIOg4SK+DZZDrvG3ryK+qJed9XXHfhWXZbXmMwn43A1rHmG3RiYJ8urvUZdlVe",
+	"Yxh7LbBJqH8wiW2RhfuehgsE1onGQ2Dr83+R2Ht+yf05rK+NRJ/mgFpJ6rjNB4JpF/zO00P/0ic4kApY",
+	"FFnwhXrC8nbcx/+Q0MeyvraTVdSR4SXK9RswOpX721G60dTdaObd1s4IWJ+Nikbiz9ey4nE2dLWNnHVX",
+	"dTcC0F4rcC7QfzAtzkE5Xtc9nBGGTl0e+h0J+YuSt09sohCBS3goCuSkB1JbiMx9mvad+xw8biKm/evq",
+	"fULE5l9I72x6D2roAZDPRi9AGPNVCAy80zUANH5XRT9A2GuZb8H8gwl7gxyc9zKcdNdJxUOco7m/yPE9",
+	"vtb+3NTXVIMmOaCGmsg3+AAw6ILfSXroXmj4gVS6wvP9L1Tjzcf7E3GB5S8aJ26fr429yGa4fBY4IP4q",
+	"nogX+Ey8Jd4nivmWI0cyXJLJ9HGC2PLvTf/eFO8/Z4K4alAMLs3TnzB/YaSGE78yrEXEr45z2SyTS+mF",
+	"FIk/EB0Y+xM2KHpNZsdv3d+S/R2IX59NO36Bw37svzDDq8kVGW2jyN+ZXaSJX+p+SeI3NnWXhGRkavWf",
+	"6/8/AwA=",
 }
 
 // decodeSpec returns the embedded OpenAPI spec as raw JSON bytes,
@@ -87,7 +87,7 @@ func (dr *Driver) restoreFromSave(world w.World, sb *gc.SeamlessBand) error {
 	// Rows がゼロ値なら1へ正規化して1行の帯として復元する
 	rows := max(sb.Rows, 1)
 	dr.band = worldstream.NewBandAt(sb.ChunkW, sb.ChunkH, sb.Cols, rows, sb.EastIndex)
-	dr.gen = NewChunkGen(world, sb.RunSeed, sb.ChunkW, sb.ChunkH, rows, dr.planner)
+	dr.gen = worldstream.TrackChunkDeltas(world, NewChunkGen(world, sb.RunSeed, sb.ChunkW, sb.ChunkH, rows, dr.planner), sb.ChunkW, sb.ChunkH)
 	dr.frontCfg = frontCfgFromBand(sb)
 	query.InvalidateSpatialIndex(world)
 	return nil
@@ -127,7 +127,7 @@ func (dr *Driver) startInitialBand(world w.World) error {
 	// 帯形状はマスタ、すなわち OverworldDefinition から取る。RunSeed だけがプレイ固有
 	chunkW, chunkH, cols, rows := dr.definition.BandShape()
 	dr.band = worldstream.NewBand(chunkW, chunkH, cols, rows)
-	dr.gen = NewChunkGen(world, p.RunSeed, chunkW, chunkH, rows, dr.planner)
+	dr.gen = worldstream.TrackChunkDeltas(world, NewChunkGen(world, p.RunSeed, chunkW, chunkH, rows, dr.planner), chunkW, chunkH)
 
 	// 帯データを現ステージ、すなわちオーバーワールドの StageField エンティティへ確保する。
 	// 以後この帯データの有無がオーバーワールド判定を兼ねる。値を書き込んでセーブに対応する
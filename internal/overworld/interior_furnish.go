@@ -60,18 +60,33 @@ func furnishBuilding(world w.World, g chunkGeom, footprint interior.Rect, door i
 	// 部屋間の戸口にも扉を置く。interior は戸口を壁の切れ目として持つが、扉エンティティは overworld が立てる。
 	// 入口と同じく閉状態で通行と視界を遮り、ぶつかると開く。同じ戸口は隣接2部屋が共有するので座標で重複排除し、
 	// 入口の扉とも重ねない
-	doorSeen := map[interior.Vec]bool{site.Door: true}
+	partitionDoors := map[interior.Vec]ecs.Entity{}
 	for _, hr := range site.Rooms {
 		for _, dw := range hr.Room.Doorways {
 			dv := dw
-			if doorSeen[dv] {
+			if _, seen := partitionDoors[dv]; seen || dv == site.Door {
 				continue
 			}
-			doorSeen[dv] = true
 			ic := consts.Coord[consts.Tile]{X: g.offsetX + dv.X, Y: g.offsetY + dv.Y}
-			if _, err := lifecycle.SpawnDoor(world, ic, doorOrientation(wallSet, dv)); err != nil {
+			doorEntity, err := lifecycle.SpawnDoor(world, ic, doorOrientation(wallSet, dv))
+			if err != nil {
 				return nil, nil, fmt.Errorf("failed to place interior partition door: %w", err)
 			}
+			partitionDoors[dv] = doorEntity
+		}
+	}
+
+	// 倉庫・薬局・事務所の行き止まりの戸口は半々で施錠する。難度と施錠の有無は別ストリーム 0x5 で引き、
+	// 再訪で一致させる。鍵は収納の戦利品から出るので、鍵が無ければ解錠か破錠で入る
+	lockRNG := rand.New(rand.NewPCG(seed, 0x5))
+	for _, ld := range site.LockableDoorways() {
+		doorEntity, ok := partitionDoors[ld.Pos]
+		if !ok || lockRNG.IntN(2) == 0 {
+			continue
+		}
+		lock := gc.Lock{Difficulty: 15 + lockRNG.IntN(30), KeyID: ld.Key}
+		if err := lifecycle.LockEntity(world, doorEntity, lock); err != nil {
+			return nil, nil, fmt.Errorf("failed to lock interior door: %w", err)
 		}
 	}
 
@@ -0,0 +1,25 @@
+package overworld_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/kijimaD/ruins/internal/dungeon"
+	"github.com/kijimaD/ruins/internal/loader"
+)
+
+// TestMain は本番の起動と同じく、ローデータのダンジョンを登録してから全テストを実行する。
+// ステージ定義を名前で引くテストが登録済みの一覧に依存するため必要
+func TestMain(m *testing.M) {
+	master, err := loader.LoadRaws("")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to load raw data: %v\n", err)
+		os.Exit(1)
+	}
+	if err := dungeon.Register(master); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to register dungeons: %v\n", err)
+		os.Exit(1)
+	}
+	os.Exit(m.Run())
+}
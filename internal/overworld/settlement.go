@@ -3,6 +3,7 @@ package overworld
 import (
 	"fmt"
 
+	gc "github.com/kijimaD/ruins/internal/components"
 	"github.com/kijimaD/ruins/internal/consts"
 	w "github.com/kijimaD/ruins/internal/world"
 	"github.com/kijimaD/ruins/internal/world/lifecycle"
@@ -69,16 +70,17 @@ func settlementVillageRoll(runSeed uint64, c consts.Coord[consts.Chunk]) bool {
 
 // spawnSettlement は小集落を構成する。center を集落の中心として会話NPCと生活感の prop を
 // 近傍へ決定的に配置する。村は全サービスのNPCが揃い、一軒家は商人だけの行商拠点になる。
+// 商人の品揃えと値付けは集落の規模に合わせる。
 // 集落はステージでなくオーバーワールドの地物なので、専用の State を持たず prop として常在する。
 // 帯への束縛は呼び出し元のチャンク生成が一括で行う。
 func spawnSettlement(world w.World, center consts.Coord[consts.Tile], village bool) error {
-	npcs, props := hamletNPCs, hamletProps
+	npcs, props, kind := hamletNPCs, hamletProps, gc.SettlementHamlet
 	if village {
-		npcs, props = villageNPCs, villageProps
+		npcs, props, kind = villageNPCs, villageProps, gc.SettlementVillage
 	}
 	for _, n := range npcs {
 		pos := consts.Coord[consts.Tile]{X: center.X + n.dx, Y: center.Y + n.dy}
-		if _, err := lifecycle.SpawnNeutralNPC(world, pos, n.name); err != nil {
+		if _, err := lifecycle.SpawnNeutralNPC(world, pos, n.name, lifecycle.WithSettlement(kind)); err != nil {
 			return fmt.Errorf("failed to place settlement NPC (%s): %w", n.name, err)
 		}
 	}
@@ -0,0 +1,19 @@
+// Package profile は run をまたいで残るプレイヤーのプロフィールを扱う。
+//
+// 各 run は独立していて、セーブデータは run ごとに捨てられる。プロフィールは終えた run の
+// 記録（名前・職業・生存日数・死因・スコア）を積み、その記録から節目（oapi.Milestone）への
+// 到達を判定する。節目に到達すると、raw で unlock を持つ職業や初期所持アイテムが解放される。
+// 解放状態は保存せず、読み込んだ記録から毎回判定する。raw の節目を変えても記録と食い違わない。
+//
+// 実行中のプロフィールは resources.Resources.Profile が持つ。既定は保存しないゼロ値で、
+// ウィンドウ付きの起動時だけ Load で読んだものへ差し替える。
+//
+// # 保存先
+//
+// ストレージ層はプラットフォームごとに実装が異なる。デスクトップは設定と同じ OS 標準の
+// 設定ディレクトリ配下（Linuxでは ~/.config/ruins/profile.toml）へ TOML で置き
+// （store_desktop.go）、WASMはローカルストレージに置く（store_wasm.go）。
+//
+//   - readProfile() ([]byte, bool, error): 保存済みの生データを返す。無ければ ok=false
+//   - writeProfile([]byte) error: 生データを永続化する
+package profile
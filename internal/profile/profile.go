@@ -0,0 +1,121 @@
+package profile
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/oapi"
+)
+
+// スコアの重み。生き延びた日数を主に、撃破・漁り・売上を加える
+const (
+	scorePerDay       = 100
+	scorePerKill      = 20
+	scorePerScavenged = 5
+	salesPerScore     = 10 // 売上はこの額ごとに1点
+)
+
+// Profile は run をまたいで残るプレイヤーの記録。ゼロ値はメモリ上だけの記録で、書き足しても保存しない。
+// テストやヘッドレス実行が利用者の記録を書き換えないよう、保存先を持つのは Load で読んだものだけにする
+type Profile struct {
+	Runs []RunRecord `toml:"runs"` // 終えた run の記録。終えた順
+
+	persistent bool // Add のたびに保存するか
+}
+
+// RunRecord は終えた run 1件の記録
+type RunRecord struct {
+	Name       string        `toml:"name"`       // 主人公の名前
+	Profession string        `toml:"profession"` // 職業ID
+	Days       int           `toml:"days"`       // 生き延びた日数
+	Cause      gc.DeathCause `toml:"cause"`      // 死因
+	Score      int           `toml:"score"`
+	EndedAt    time.Time     `toml:"ended_at"`
+}
+
+// Score は生存日数と run 統計からスコアを計算する
+func Score(days int, stats *gc.RunStats) int {
+	score := days * scorePerDay
+	if stats != nil {
+		score += stats.EnemiesKilled*scorePerKill + stats.ItemsScavenged*scorePerScavenged + int(stats.SalesTotal)/salesPerScore
+	}
+	return score
+}
+
+// Add は終えた run の記録を書き足す。Load で読んだプロフィールならそのまま保存し直す
+func (p *Profile) Add(rec RunRecord) error {
+	p.Runs = append(p.Runs, rec)
+	if !p.persistent {
+		return nil
+	}
+	return p.Save()
+}
+
+// RunCount は終えた run の数を返す
+func (p *Profile) RunCount() int {
+	return len(p.Runs)
+}
+
+// BestDays は最も長く生き延びた日数を返す。記録が無ければ0
+func (p *Profile) BestDays() int {
+	best := 0
+	for _, r := range p.Runs {
+		best = max(best, r.Days)
+	}
+	return best
+}
+
+// BestScore は最高スコアを返す。記録が無ければ0
+func (p *Profile) BestScore() int {
+	best := 0
+	for _, r := range p.Runs {
+		best = max(best, r.Score)
+	}
+	return best
+}
+
+// Ranking はスコアの高い順に最大 n 件の記録を返す。同点は先に終えた run を上にする。n が0以下なら全件
+func (p *Profile) Ranking(n int) []RunRecord {
+	runs := slices.Clone(p.Runs)
+	slices.SortStableFunc(runs, func(a, b RunRecord) int { return cmp.Compare(b.Score, a.Score) })
+	if n > 0 && len(runs) > n {
+		runs = runs[:n]
+	}
+	return runs
+}
+
+// Reached は節目 m に到達しているかを返す。指定された項目をすべて満たせば到達で、nil は常に到達
+func (p *Profile) Reached(m *oapi.Milestone) bool {
+	if m == nil {
+		return true
+	}
+	if m.Runs != nil && p.RunCount() < *m.Runs {
+		return false
+	}
+	if m.Days != nil && p.BestDays() < *m.Days {
+		return false
+	}
+	if m.Score != nil && p.BestScore() < *m.Score {
+		return false
+	}
+	return true
+}
+
+// Unlocked は職業 prof を選べるかを返す
+func (p *Profile) Unlocked(prof oapi.Profession) bool {
+	return p.Reached(prof.Unlock)
+}
+
+// Available は prof の初期所持から解放されていないアイテムを除いた写しを返す。元の prof は書き換えない
+func (p *Profile) Available(prof oapi.Profession) oapi.Profession {
+	items := make([]oapi.ProfessionItem, 0, len(prof.Items))
+	for _, it := range prof.Items {
+		if p.Reached(it.Unlock) {
+			items = append(items, it)
+		}
+	}
+	prof.Items = items
+	return prof
+}
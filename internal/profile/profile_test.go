@@ -0,0 +1,92 @@
+package profile
+
+import (
+	"testing"
+
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/oapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ptr(v int) *int { return &v }
+
+func TestScore(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 300, Score(3, nil))
+	assert.Equal(t, 300+2*scorePerKill+4*scorePerScavenged+15, Score(3, &gc.RunStats{EnemiesKilled: 2, ItemsScavenged: 4, SalesTotal: 155}))
+}
+
+func TestProfile_Add_ゼロ値は保存しない(t *testing.T) {
+	t.Parallel()
+
+	p := &Profile{}
+	require.NoError(t, p.Add(RunRecord{Name: "Ash"}))
+	assert.Equal(t, 1, p.RunCount())
+	assert.False(t, p.persistent)
+}
+
+func TestProfile_Reached(t *testing.T) {
+	t.Parallel()
+
+	p := &Profile{Runs: []RunRecord{{Days: 4, Score: 800}, {Days: 9, Score: 300}}}
+	tests := []struct {
+		name string
+		m    *oapi.Milestone
+		want bool
+	}{
+		{"節目なし", nil, true},
+		{"run 数を満たす", &oapi.Milestone{Runs: ptr(2)}, true},
+		{"run 数が足りない", &oapi.Milestone{Runs: ptr(3)}, false},
+		{"最長日数で判定する", &oapi.Milestone{Days: ptr(9)}, true},
+		{"最高スコアで判定する", &oapi.Milestone{Score: ptr(801)}, false},
+		{"すべて満たして到達", &oapi.Milestone{Runs: ptr(1), Days: ptr(5), Score: ptr(500)}, true},
+		{"1つでも欠けると未到達", &oapi.Milestone{Runs: ptr(1), Days: ptr(10)}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, p.Reached(tt.m))
+		})
+	}
+}
+
+func TestProfile_Ranking(t *testing.T) {
+	t.Parallel()
+
+	p := &Profile{Runs: []RunRecord{
+		{Name: "a", Score: 100},
+		{Name: "b", Score: 300},
+		{Name: "c", Score: 100},
+		{Name: "d", Score: 200},
+	}}
+
+	names := func(runs []RunRecord) []string {
+		out := make([]string, len(runs))
+		for i, r := range runs {
+			out[i] = r.Name
+		}
+		return out
+	}
+	assert.Equal(t, []string{"b", "d", "a", "c"}, names(p.Ranking(0)), "同点は先に終えた順")
+	assert.Equal(t, []string{"b", "d"}, names(p.Ranking(2)))
+	assert.Equal(t, "a", p.Runs[0].Name, "元の並びは変えない")
+}
+
+func TestProfile_Available(t *testing.T) {
+	t.Parallel()
+
+	prof := oapi.Profession{Items: []oapi.ProfessionItem{
+		{Name: "bread", Count: 1},
+		{Name: "healing_potion", Count: 2, Unlock: &oapi.Milestone{Runs: ptr(1)}},
+	}}
+
+	locked := (&Profile{}).Available(prof)
+	require.Len(t, locked.Items, 1)
+	assert.Equal(t, "bread", locked.Items[0].Name)
+	assert.Len(t, prof.Items, 2, "元の職業は書き換えない")
+
+	unlocked := (&Profile{Runs: []RunRecord{{}}}).Available(prof)
+	assert.Len(t, unlocked.Items, 2)
+}
@@ -0,0 +1,55 @@
+package profile
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/BurntSushi/toml"
+)
+
+// このファイルは TOML の変換と読み書きの高レベルAPIを提供し、プラットフォームに依存しない。
+// 生データの読み書きは doc.go に挙げたストレージ関数へ任せる。
+
+// Load は保存済みのプロフィールを読み込む。保存が無ければ記録の無いプロフィールを返す。
+// 返したプロフィールは Add のたびに保存し直す。
+func Load() (*Profile, error) {
+	data, ok, err := readProfile()
+	if err != nil {
+		return nil, err
+	}
+	p := &Profile{}
+	if ok {
+		if p, err = decode(data); err != nil {
+			return nil, err
+		}
+	}
+	p.persistent = true
+	return p, nil
+}
+
+// Save は p を永続化する。
+func (p *Profile) Save() error {
+	data, err := p.encode()
+	if err != nil {
+		return err
+	}
+	return writeProfile(data)
+}
+
+// decode は TOML からプロフィールを復元する。
+func decode(data []byte) (*Profile, error) {
+	p := &Profile{}
+	if err := toml.Unmarshal(data, p); err != nil {
+		return nil, fmt.Errorf("failed to parse profile: %w", err)
+	}
+	return p, nil
+}
+
+// encode は p を TOML にエンコードする。
+func (p *Profile) encode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := toml.NewEncoder(&buf).Encode(p); err != nil {
+		return nil, fmt.Errorf("failed to encode profile: %w", err)
+	}
+	return buf.Bytes(), nil
+}
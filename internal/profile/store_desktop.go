@@ -0,0 +1,73 @@
+//go:build !js || !wasm
+
+package profile
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// profileAppDirName は設定ディレクトリ配下のアプリ専用サブディレクトリ名。設定ファイルと同じ場所
+const profileAppDirName = "ruins"
+
+// profileFileName はプロフィールのファイル名
+const profileFileName = "profile.toml"
+
+// profilePath はプロフィールファイルの絶対パスを返す。
+// Linuxでは ~/.config/ruins/profile.toml となる。
+func profilePath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get config directory: %w", err)
+	}
+	return filepath.Join(dir, profileAppDirName, profileFileName), nil
+}
+
+// readProfile はプロフィールファイルの内容を返す。ファイルが無ければ ok=false を返す。
+func readProfile() ([]byte, bool, error) {
+	path, err := profilePath()
+	if err != nil {
+		return nil, false, err
+	}
+	return readProfileFrom(path)
+}
+
+// readProfileFrom は指定パスからプロフィールを読み込む。パスを引数に取ることでテストできる。
+func readProfileFrom(path string) ([]byte, bool, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read profile file: %w", err)
+	}
+	return data, true, nil
+}
+
+// writeProfile はプロフィールファイルへ書き込む。ディレクトリが無ければ作成する。
+func writeProfile(data []byte) error {
+	path, err := profilePath()
+	if err != nil {
+		return err
+	}
+	return writeProfileTo(path, data)
+}
+
+// writeProfileTo は指定パスへ書き込む。一時ファイルへ書いてから rename することで、
+// 書き込み途中のクラッシュによる破損を防ぐ。パスを引数に取ることでテストできる。
+func writeProfileTo(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create profile directory: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write profile file: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("failed to replace profile file: %w", err)
+	}
+	return nil
+}
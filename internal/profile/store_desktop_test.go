@@ -0,0 +1,87 @@
+//go:build !js || !wasm
+
+package profile
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteReadProfile_ラウンドトリップ(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "ruins", "profile.toml")
+	require.NoError(t, writeProfileTo(path, []byte("x")))
+	assert.FileExists(t, path)
+
+	data, ok, err := readProfileFrom(path)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "x", string(data))
+}
+
+func TestReadProfile_ファイルが無ければ_ok_false(t *testing.T) {
+	t.Parallel()
+
+	_, ok, err := readProfileFrom(filepath.Join(t.TempDir(), "profile.toml"))
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestProfileEncodeDecode_ラウンドトリップ(t *testing.T) {
+	t.Parallel()
+
+	src := &Profile{Runs: []RunRecord{{
+		Name:       "Ash",
+		Profession: "hunter",
+		Days:       3,
+		Cause:      gc.DeathCause{Killer: "Bat", Weapon: "Fangs", Element: gc.ElementTypeFire, Condition: "Starving"},
+		Score:      420,
+		EndedAt:    time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC),
+	}}}
+	data, err := src.encode()
+	require.NoError(t, err)
+
+	dst, err := decode(data)
+	require.NoError(t, err)
+	assert.Equal(t, src, dst)
+}
+
+// 以下は XDG_CONFIG_HOME を書き換えてパス解決を検証するため、
+// t.Setenv の制約上 t.Parallel は呼ばない。
+
+func TestProfilePath_XDG_CONFIG_HOME配下にruinsディレクトリを作る(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+
+	path, err := profilePath()
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "ruins", "profile.toml"), path)
+}
+
+func TestLoad_保存が無ければ空のプロフィール(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	p, err := Load()
+	require.NoError(t, err)
+	assert.Zero(t, p.RunCount())
+}
+
+func TestLoad_読んだプロフィールは書き足すたびに保存する(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	p, err := Load()
+	require.NoError(t, err)
+	require.NoError(t, p.Add(RunRecord{Name: "Ash", Score: 100}))
+	require.NoError(t, p.Add(RunRecord{Name: "Bea", Score: 200}))
+
+	reloaded, err := Load()
+	require.NoError(t, err)
+	require.Equal(t, 2, reloaded.RunCount())
+	assert.Equal(t, "Bea", reloaded.Runs[1].Name)
+}
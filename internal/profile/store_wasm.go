@@ -0,0 +1,34 @@
+//go:build js && wasm
+
+package profile
+
+import (
+	"fmt"
+	"syscall/js"
+)
+
+// profileStorageKey はローカルストレージ上のプロフィールのキー名
+const profileStorageKey = "ruins-profile"
+
+// readProfile はローカルストレージからプロフィールを読み込む。無ければ ok=false を返す。
+func readProfile() ([]byte, bool, error) {
+	localStorage := js.Global().Get("localStorage")
+	if localStorage.IsUndefined() {
+		return nil, false, fmt.Errorf("localStorage is not available")
+	}
+	item := localStorage.Call("getItem", profileStorageKey)
+	if item.IsNull() {
+		return nil, false, nil
+	}
+	return []byte(item.String()), true, nil
+}
+
+// writeProfile はローカルストレージへプロフィールを書き込む。
+func writeProfile(data []byte) error {
+	localStorage := js.Global().Get("localStorage")
+	if localStorage.IsUndefined() {
+		return fmt.Errorf("localStorage is not available")
+	}
+	localStorage.Call("setItem", profileStorageKey, string(data))
+	return nil
+}
@@ -0,0 +1,18 @@
+// Package quest はローデータのクエスト定義とプレイヤーの QuestLog を突き合わせ、進み具合と報酬を扱う。
+//
+// # 責務
+//
+// クエストの中身はローデータの quests に書く。1つのクエストは目標の並びと報酬を持つ。
+// 受けたクエストの進み具合は QuestLog シングルトンに目標ごとの達成量として残し、セーブに載る。
+// クエストは会話の startQuest 効果で受ける。
+//
+// # 目標の進み方
+//
+// 撃破と納品は起きたときに数える。撃破は DeadCleanupSystem が Record を、納品は話しかけたときに Deliver を呼ぶ。
+// 所持、到達、生存はターン終了の Evaluate が今の状態から測る。所持は手放せば戻るが、到達は一度着けば残る。
+// 目標がすべて揃ったクエストは Evaluate が達成済みにし、その場で報酬を渡す。
+//
+// # ステートとの境界
+//
+// このパッケージは states に依存しない。ジャーナル画面は Target と ObjectiveLabel で目標を表示する。
+package quest
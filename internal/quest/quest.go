@@ -0,0 +1,234 @@
+package quest
+
+import (
+	"fmt"
+
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/gamelog"
+	"github.com/kijimaD/ruins/internal/oapi"
+	"github.com/kijimaD/ruins/internal/raw"
+	w "github.com/kijimaD/ruins/internal/world"
+	"github.com/kijimaD/ruins/internal/world/lifecycle"
+	"github.com/kijimaD/ruins/internal/world/query"
+	"github.com/mlange-42/ark/ecs"
+)
+
+// Start は id のクエストを受ける。受けたことがあれば何もしない
+func Start(world w.World, id string) error {
+	def, err := raw.FindQuest(world.Resources.RawMaster, id)
+	if err != nil {
+		return err
+	}
+	ql := query.GetQuestLog(world)
+	if ql == nil {
+		return fmt.Errorf("quest log not found")
+	}
+	day := query.GetGameTime(world).GetDayNumber()
+	if !ql.Start(id, len(def.Objectives), day) {
+		return nil
+	}
+	gamelog.New(query.GetGameLog(world)).
+		Markup(query.T(world, "Quest started: %s", gamelog.Tag("system", query.T(world, def.Name)))).
+		Log()
+	return nil
+}
+
+// Record は倒れた entity を進行中のクエストの撃破目標に数える
+func Record(world w.World, entity ecs.Entity) {
+	id := query.GetEntityID(entity, world)
+	if id == "" {
+		return
+	}
+	eachActive(world, func(def oapi.Quest, q *gc.QuestProgress) {
+		for i, obj := range def.Objectives {
+			if obj.Kill != nil && *obj.Kill == id && q.Progress[i] < Target(obj) {
+				q.Progress[i]++
+			}
+		}
+	})
+}
+
+// Deliver は話し手 speaker が渡し先の納品目標に、手持ちの品を足りないぶんだけ渡す。
+// 手持ちが足りなければ持っているぶんだけ渡し、残りは次に話しかけたときに渡す
+func Deliver(world w.World, speaker ecs.Entity) error {
+	id := query.GetEntityID(speaker, world)
+	if id == "" {
+		return nil
+	}
+	var err error
+	eachActive(world, func(def oapi.Quest, q *gc.QuestProgress) {
+		for i, obj := range def.Objectives {
+			if err != nil || obj.Deliver == nil || obj.To == nil || *obj.To != id {
+				continue
+			}
+			n := min(heldCount(world, *obj.Deliver), Target(obj)-q.Progress[i])
+			if n <= 0 {
+				continue
+			}
+			if err = lifecycle.ChangeStackCount(world, *obj.Deliver, -n); err != nil {
+				err = fmt.Errorf("failed to deliver %q for quest %q: %w", *obj.Deliver, def.Id, err)
+				return
+			}
+			q.Progress[i] += n
+			gamelog.New(query.GetGameLog(world)).
+				Markup(query.T(world, "Handed over %s x%d.", gamelog.Tag("item", query.T(world, raw.ItemName(world.Resources.RawMaster, *obj.Deliver))), n)).
+				Log()
+		}
+	})
+	return err
+}
+
+// Evaluate は所持・到達・生存の目標を今の状態で測り直し、目標の揃ったクエストを達成して報酬を渡す
+func Evaluate(world w.World) error {
+	day := query.GetGameTime(world).GetDayNumber()
+	chunk, onOverworld := playerChunk(world)
+
+	var completed []oapi.Quest
+	eachActive(world, func(def oapi.Quest, q *gc.QuestProgress) {
+		for i, obj := range def.Objectives {
+			switch {
+			case obj.Collect != nil:
+				q.Progress[i] = min(heldCount(world, *obj.Collect), Target(obj))
+			case obj.Reach != nil:
+				if onOverworld && chunk.X == consts.Chunk(obj.Reach.X) && chunk.Y == consts.Chunk(obj.Reach.Y) {
+					q.Progress[i] = 1
+				}
+			case obj.SurviveDays != nil:
+				q.Progress[i] = min(day-q.StartDay, Target(obj))
+			}
+		}
+		if done(def, q) {
+			q.Status = gc.QuestStatusCompleted
+			completed = append(completed, def)
+		}
+	})
+
+	// 報酬の品はスタックを作り直すことがあるので、QuestLog の走査を終えてから渡す
+	for _, def := range completed {
+		if err := reward(world, def); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Target は目標を果たすのに要る達成量を返す
+func Target(obj oapi.QuestObjective) int {
+	switch {
+	case obj.SurviveDays != nil:
+		return *obj.SurviveDays
+	case obj.Reach != nil:
+		return 1
+	case obj.Count != nil:
+		return *obj.Count
+	}
+	return 1
+}
+
+// ObjectiveLabel は目標を1行の文にする
+func ObjectiveLabel(world w.World, obj oapi.QuestObjective) string {
+	rawMaster := world.Resources.RawMaster
+	switch {
+	case obj.Collect != nil:
+		return query.T(world, "Collect %s", query.T(world, raw.ItemName(rawMaster, *obj.Collect)))
+	case obj.Deliver != nil && obj.To != nil:
+		return query.T(world, "Deliver %s to %s", query.T(world, raw.ItemName(rawMaster, *obj.Deliver)), query.T(world, memberName(rawMaster, *obj.To)))
+	case obj.Kill != nil:
+		return query.T(world, "Defeat %s", query.T(world, memberName(rawMaster, *obj.Kill)))
+	case obj.Reach != nil:
+		return query.T(world, "Reach area (%d, %d)", obj.Reach.X, obj.Reach.Y)
+	case obj.SurviveDays != nil:
+		return query.T(world, "Survive %d days", *obj.SurviveDays)
+	}
+	return ""
+}
+
+// eachActive は進行中のクエストを定義と組にして fn へ渡す。定義の消えたクエストは飛ばす
+func eachActive(world w.World, fn func(def oapi.Quest, q *gc.QuestProgress)) {
+	ql := query.GetQuestLog(world)
+	if ql == nil {
+		return
+	}
+	for i := range ql.Quests {
+		q := &ql.Quests[i]
+		if q.Status != gc.QuestStatusActive {
+			continue
+		}
+		def, err := raw.FindQuest(world.Resources.RawMaster, q.ID)
+		if err != nil || len(q.Progress) != len(def.Objectives) {
+			continue
+		}
+		fn(def, q)
+	}
+}
+
+// done は目標がすべて揃ったかを返す
+func done(def oapi.Quest, q *gc.QuestProgress) bool {
+	for i, obj := range def.Objectives {
+		if q.Progress[i] < Target(obj) {
+			return false
+		}
+	}
+	return true
+}
+
+// reward は達成したクエストの報酬をプレイヤーに渡して記録する
+func reward(world w.World, def oapi.Quest) error {
+	gamelog.New(query.GetGameLog(world)).
+		Markup(query.T(world, "Quest completed: %s", gamelog.Tag("success", query.T(world, def.Name)))).
+		Log()
+	if def.Reward == nil {
+		return nil
+	}
+	if def.Reward.Currency != nil && *def.Reward.Currency > 0 {
+		player, err := query.GetPlayerEntity(world)
+		if err != nil {
+			return fmt.Errorf("failed to reward quest %q: %w", def.Id, err)
+		}
+		if err := query.AddCurrency(world, player, consts.Currency(*def.Reward.Currency)); err != nil {
+			return fmt.Errorf("failed to reward quest %q: %w", def.Id, err)
+		}
+	}
+	for _, it := range raw.PtrSlice(def.Reward.Items) {
+		if err := lifecycle.ChangeStackCount(world, it.Id, it.Count); err != nil {
+			return fmt.Errorf("failed to reward quest %q: %w", def.Id, err)
+		}
+	}
+	return nil
+}
+
+// heldCount はプレイヤーのバックパックにある id の品の個数を返す
+func heldCount(world w.World, id string) int {
+	entity, found := query.FindStackInInventory(world, id)
+	if !found {
+		return 0
+	}
+	return query.GetEntityCount(world, entity)
+}
+
+// memberName は id からメンバーの表示名を返す。定義が見つからなければ id をそのまま返す
+func memberName(raws raw.Master, id string) string {
+	member, err := raw.FindMember(raws, id)
+	if err != nil {
+		return id
+	}
+	return member.Name
+}
+
+// playerChunk はオーバーワールドでのプレイヤーの絶対チャンク座標を返す。オーバーワールドにいなければ ok=false
+func playerChunk(world w.World) (consts.Coord[consts.Chunk], bool) {
+	sb := query.GetSeamlessBand(world)
+	if sb == nil || !sb.Active || sb.ChunkW <= 0 || sb.ChunkH <= 0 {
+		return consts.Coord[consts.Chunk]{}, false
+	}
+	player, err := query.GetPlayerEntity(world)
+	if err != nil || !world.Components.GridElement.Has(player) {
+		return consts.Coord[consts.Chunk]{}, false
+	}
+	g := world.Components.GridElement.Get(player)
+	return consts.Coord[consts.Chunk]{
+		X: sb.EastIndex + consts.Chunk(int(g.X)/int(sb.ChunkW)),
+		Y: consts.Chunk(int(g.Y) / int(sb.ChunkH)),
+	}, true
+}
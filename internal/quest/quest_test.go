@@ -0,0 +1,186 @@
+package quest
+
+import (
+	"slices"
+	"testing"
+
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/oapi"
+	"github.com/kijimaD/ruins/internal/raw"
+	"github.com/kijimaD/ruins/internal/testutil"
+	w "github.com/kijimaD/ruins/internal/world"
+	"github.com/kijimaD/ruins/internal/world/lifecycle"
+	"github.com/kijimaD/ruins/internal/world/query"
+	"github.com/mlange-42/ark/ecs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupQuest はプレイヤーを置き、実データに defs のクエストを足した world を返す
+func setupQuest(t *testing.T, defs ...oapi.Quest) (w.World, ecs.Entity) {
+	t.Helper()
+	world := testutil.InitTestWorld(t)
+	raws := world.Resources.RawMaster.Raws
+	quests := slices.Concat(raw.PtrSlice(raws.Quests), defs)
+	raws.Quests = &quests
+	world.Resources.RawMaster = raw.NewMaster(raws)
+
+	player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 10, Y: 10}, "ash")
+	require.NoError(t, err)
+	return world, player
+}
+
+// recentLog は直近のゲームログを返す
+func recentLog(world w.World) []string {
+	return query.GetGameLog(world).GetRecent(10)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestStart_受けたクエストは受け直さない(t *testing.T) {
+	t.Parallel()
+
+	world, _ := setupQuest(t)
+	require.NoError(t, Start(world, "rat_hunt"))
+	query.GetQuestLog(world).Find("rat_hunt").Progress[0] = 2
+	require.NoError(t, Start(world, "rat_hunt"))
+
+	ql := query.GetQuestLog(world)
+	require.Len(t, ql.Quests, 1)
+	assert.Equal(t, 2, ql.Quests[0].Progress[0], "受け直して進み具合を消さない")
+	assert.Error(t, Start(world, "undefined"), "定義のないクエストは受けられない")
+}
+
+func TestStart_QuestLogが無ければエラー(t *testing.T) {
+	t.Parallel()
+
+	world, _ := setupQuest(t)
+	world.Components.QuestLog.Remove(world.Resources.SingletonEntity)
+	assert.Error(t, Start(world, "rat_hunt"))
+}
+
+func TestRecord_撃破目標を数えて達成で報酬を渡す(t *testing.T) {
+	t.Parallel()
+
+	world, player := setupQuest(t)
+	require.NoError(t, Start(world, "rat_hunt"))
+	before := query.GetCurrency(world, player)
+
+	other, err := lifecycle.SpawnEnemy(world, consts.Coord[consts.Tile]{X: 3, Y: 3}, "moss_turtle")
+	require.NoError(t, err)
+	Record(world, other)
+	assert.Equal(t, 0, query.GetQuestLog(world).Find("rat_hunt").Progress[0], "目標でない敵は数えない")
+
+	for i := range 4 {
+		rat, err := lifecycle.SpawnEnemy(world, consts.Coord[consts.Tile]{X: consts.Tile(4 + i), Y: 3}, "rat")
+		require.NoError(t, err)
+		Record(world, rat)
+	}
+	q := query.GetQuestLog(world).Find("rat_hunt")
+	assert.Equal(t, 3, q.Progress[0], "目標の数を超えて数えない")
+	assert.Equal(t, gc.QuestStatusActive, q.Status, "達成は Evaluate で決める")
+
+	require.NoError(t, Evaluate(world))
+	assert.Equal(t, gc.QuestStatusCompleted, q.Status)
+	assert.Equal(t, before+300, query.GetCurrency(world, player))
+	assert.Contains(t, recentLog(world), "Quest completed: Rat Hunt")
+
+	require.NoError(t, Evaluate(world))
+	assert.Equal(t, before+300, query.GetCurrency(world, player), "報酬は1度だけ渡す")
+}
+
+func TestEvaluate_所持と到達と生存を測る(t *testing.T) {
+	t.Parallel()
+
+	world, _ := setupQuest(t, oapi.Quest{
+		Id:   "scout",
+		Name: "Scout",
+		Objectives: []oapi.QuestObjective{
+			{Collect: ptr("iron"), Count: ptr(2)},
+			{Reach: &oapi.ChunkCoord{X: 2, Y: 0}},
+			{SurviveDays: ptr(1)},
+		},
+		Reward: &oapi.QuestReward{Items: &[]oapi.QuestRewardItem{{Id: "iron", Count: 5}}},
+	})
+	require.NoError(t, Start(world, "scout"))
+	q := query.GetQuestLog(world).Find("scout")
+
+	_, err := lifecycle.SpawnBackpackItem(world, "iron", 2)
+	require.NoError(t, err)
+	require.NoError(t, Evaluate(world))
+	assert.Equal(t, []int{2, 0, 0}, q.Progress, "オーバーワールドの外では到達を測らない")
+
+	sb := query.EnsureSeamlessBand(world)
+	sb.Active = true
+	sb.EastIndex = 2
+	sb.ChunkW = 40
+	sb.ChunkH = 40
+	require.NoError(t, Evaluate(world))
+	assert.Equal(t, []int{2, 1, 0}, q.Progress)
+
+	// 到達は離れても残るが、所持は手放せば戻る
+	sb.EastIndex = 5
+	require.NoError(t, lifecycle.ChangeStackCount(world, "iron", -1))
+	require.NoError(t, Evaluate(world))
+	assert.Equal(t, []int{1, 1, 0}, q.Progress)
+
+	query.GetGameTime(world).TotalTurns += 1500
+	require.NoError(t, lifecycle.ChangeStackCount(world, "iron", 1))
+	require.NoError(t, Evaluate(world))
+	assert.Equal(t, gc.QuestStatusCompleted, q.Status)
+
+	iron, found := query.FindStackInInventory(world, "iron")
+	require.True(t, found)
+	assert.Equal(t, 7, query.GetEntityCount(world, iron), "所持の目標は品を取り上げず、報酬の品が足される")
+}
+
+func TestDeliver_渡し先に話しかけると手持ちを渡す(t *testing.T) {
+	t.Parallel()
+
+	world, _ := setupQuest(t, oapi.Quest{
+		Id:         "supply",
+		Name:       "Supply",
+		Objectives: []oapi.QuestObjective{{Deliver: ptr("iron"), To: ptr("merchant"), Count: ptr(3)}},
+	})
+	require.NoError(t, Start(world, "supply"))
+	merchant, err := lifecycle.SpawnNeutralNPC(world, consts.Coord[consts.Tile]{X: 11, Y: 10}, "merchant")
+	require.NoError(t, err)
+	soldier, err := lifecycle.SpawnNeutralNPC(world, consts.Coord[consts.Tile]{X: 9, Y: 10}, "old_soldier")
+	require.NoError(t, err)
+	q := query.GetQuestLog(world).Find("supply")
+
+	_, err = lifecycle.SpawnBackpackItem(world, "iron", 2)
+	require.NoError(t, err)
+	require.NoError(t, Deliver(world, soldier))
+	assert.Equal(t, 0, q.Progress[0], "渡し先でない相手には渡さない")
+
+	require.NoError(t, Deliver(world, merchant))
+	assert.Equal(t, 2, q.Progress[0], "足りなければ持っているぶんだけ渡す")
+	_, found := query.FindStackInInventory(world, "iron")
+	assert.False(t, found)
+
+	_, err = lifecycle.SpawnBackpackItem(world, "iron", 5)
+	require.NoError(t, err)
+	require.NoError(t, Deliver(world, merchant))
+	assert.Equal(t, 3, q.Progress[0])
+	iron, found := query.FindStackInInventory(world, "iron")
+	require.True(t, found)
+	assert.Equal(t, 4, query.GetEntityCount(world, iron), "足りないぶんだけ渡す")
+
+	require.NoError(t, Evaluate(world))
+	assert.Equal(t, gc.QuestStatusCompleted, q.Status)
+}
+
+func TestObjectiveLabel(t *testing.T) {
+	t.Parallel()
+
+	world := testutil.InitTestWorld(t)
+	assert.Equal(t, "Defeat Rat", ObjectiveLabel(world, oapi.QuestObjective{Kill: ptr("rat")}))
+	assert.Equal(t, "Reach area (3, 1)", ObjectiveLabel(world, oapi.QuestObjective{Reach: &oapi.ChunkCoord{X: 3, Y: 1}}))
+	assert.Equal(t, "Survive 5 days", ObjectiveLabel(world, oapi.QuestObjective{SurviveDays: ptr(5)}))
+	assert.Equal(t, 5, Target(oapi.QuestObjective{SurviveDays: ptr(5)}))
+	assert.Equal(t, 1, Target(oapi.QuestObjective{Kill: ptr("rat")}), "数を省略すると1")
+}
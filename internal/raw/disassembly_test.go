@@ -21,7 +21,7 @@ func TestFindDisassembly(t *testing.T) {
 		BaseAP:       200,
 		Yields:       []oapi.DisassemblyYield{{Id: "ネジ", Count: "1d2"}},
 	}
-	raws := oapi.Raws{
+	raws := NewMaster(oapi.Raws{
 		Props: &[]oapi.Prop{
 			{Id: "棚", Name: "棚", Disassembly: propDisassembly},
 			{Id: "机", Name: "机"},
@@ -30,7 +30,7 @@ func TestFindDisassembly(t *testing.T) {
 			{Id: "廃品", Name: "廃品", Disassembly: itemDisassembly},
 			{Id: "回復薬", Name: "回復薬"},
 		},
-	}
+	})
 
 	t.Run("propの分解定義を返す", func(t *testing.T) {
 		t.Parallel()
@@ -75,12 +75,12 @@ func TestFindDisassemblyTool(t *testing.T) {
 		Categories: []oapi.ToolCategory{oapi.Prying, oapi.Cutting},
 		Grade:      2,
 	}
-	raws := oapi.Raws{
+	raws := NewMaster(oapi.Raws{
 		Items: &[]oapi.Item{
 			{Id: "バール", Name: "バール", DisassemblyTool: tool},
 			{Id: "回復薬", Name: "回復薬"},
 		},
-	}
+	})
 
 	t.Run("アイテムの分解工具定義を返す", func(t *testing.T) {
 		t.Parallel()
@@ -104,12 +104,43 @@ func TestFindDisassemblyTool(t *testing.T) {
 	})
 }
 
+func TestFindCraftStation(t *testing.T) {
+	t.Parallel()
+
+	station := oapi.CraftStationFire
+	raws := NewMaster(oapi.Raws{
+		Props: &[]oapi.Prop{
+			{Id: "焚き火", Name: "焚き火", CraftStation: &station},
+			{Id: "机", Name: "机"},
+		},
+	})
+
+	t.Run("propの作業場の種類を返す", func(t *testing.T) {
+		t.Parallel()
+		got, ok := FindCraftStation(raws, "焚き火")
+		require.True(t, ok)
+		assert.Equal(t, oapi.CraftStationFire, got)
+	})
+
+	t.Run("作業場でないpropはfalseを返す", func(t *testing.T) {
+		t.Parallel()
+		_, ok := FindCraftStation(raws, "机")
+		assert.False(t, ok)
+	})
+
+	t.Run("存在しないprop名はfalseを返す", func(t *testing.T) {
+		t.Parallel()
+		_, ok := FindCraftStation(raws, "存在しないprop")
+		assert.False(t, ok)
+	})
+}
+
 func TestValidateReferences(t *testing.T) {
 	t.Parallel()
 
 	t.Run("空のRawsは成功する", func(t *testing.T) {
 		t.Parallel()
-		assert.NoError(t, ValidateReferences(oapi.Raws{}))
+		assert.NoError(t, ValidateReferences(oapi.Raws{}, nil))
 	})
 
 	t.Run("全チェックを満たすRawsは成功する", func(t *testing.T) {
@@ -159,7 +190,7 @@ func TestValidateReferences(t *testing.T) {
 				CommandTableId: new(oapi.EntityName("剣術")),
 			}},
 		}
-		assert.NoError(t, ValidateReferences(raws))
+		assert.NoError(t, ValidateReferences(raws, nil))
 	})
 
 	t.Run("分解参照エラーが最初に検出される", func(t *testing.T) {
@@ -179,7 +210,7 @@ func TestValidateReferences(t *testing.T) {
 				Entries: []oapi.DropTableEntry{{Material: "別の存在しない素材", Weight: 1}},
 			}},
 		}
-		err := ValidateReferences(raws)
+		err := ValidateReferences(raws, nil)
 		require.ErrorIs(t, err, errDisassemblyYieldUndefined)
 	})
 
@@ -192,7 +223,7 @@ func TestValidateReferences(t *testing.T) {
 				Entries: []oapi.CommandTableEntry{{Weapon: "未定義武器"}},
 			}},
 		}
-		err := ValidateReferences(raws)
+		err := ValidateReferences(raws, nil)
 		require.ErrorIs(t, err, errCommandTableRefUndefinedWeapon)
 	})
 }
@@ -0,0 +1,296 @@
+package raw
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/kijimaD/ruins/internal/oapi"
+)
+
+// rawsSectionOrder はローデータのトップレベル配列の並び順。
+// 書き戻しでファイル全体の差分が暴れないよう、既存ファイルの並びに合わせて固定する
+var rawsSectionOrder = []string{
+	"items",
+	"recipes",
+	"members",
+	"commandTables",
+	"dropTables",
+	"itemGroups",
+	"itemTables",
+	"enemyTables",
+	"spriteSheets",
+	"tiles",
+	"props",
+	"professions",
+	"dungeons",
+	"dialogues",
+	"quests",
+}
+
+// EncodeRaws はoapi.RawsをDecodeRawsで読み戻せるTOML文字列にエンコードする。
+// キー名はJSONタグ(camelCase)に従い、各配列の要素順は入力のまま保つ。
+// トップレベル配列は rawsSectionOrder 順、テーブル内のキーは名前順に並べる。
+// ただし id は name の直後に置く
+func EncodeRaws(raws oapi.Raws) (string, error) {
+	// JSONタグと omitempty の扱いを生成コードと一致させるため、JSONを経由して汎用値にする
+	bs, err := json.Marshal(raws)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal raws: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(bs))
+	dec.UseNumber()
+	var root map[string]any
+	if err := dec.Decode(&root); err != nil {
+		return "", fmt.Errorf("failed to unmarshal raws: %w", err)
+	}
+
+	sections := slices.Clone(rawsSectionOrder)
+	for _, key := range sortedKeys(root) {
+		if !slices.Contains(sections, key) {
+			sections = append(sections, key)
+		}
+	}
+
+	var w tomlWriter
+	for _, section := range sections {
+		entries, ok := root[section].([]any)
+		if !ok {
+			continue
+		}
+		for _, entry := range entries {
+			table, ok := entry.(map[string]any)
+			if !ok {
+				return "", fmt.Errorf("section %q contains non-table entry", section)
+			}
+			if err := w.writeTable(section, table, true); err != nil {
+				return "", err
+			}
+		}
+	}
+	return w.buf.String(), nil
+}
+
+// SortRaws は各配列をローデータの正規順に並べ替える。
+// アイテムは種別の組み合わせ、それ以外は名前(職業はID)で並べる。
+// ダンジョンは定義順がそのまま選択画面の並びになるため並べ替えない。
+// エディタのインデックスはこの順序に対するものになる
+func SortRaws(raws *oapi.Raws) {
+	if raws.Items != nil {
+		slices.SortStableFunc(*raws.Items, func(a, b oapi.Item) int {
+			if c := strings.Compare(itemSortKey(a), itemSortKey(b)); c != 0 {
+				return c
+			}
+			return strings.Compare(a.Name, b.Name)
+		})
+	}
+	sortByName(raws.Members, func(v oapi.Member) string { return v.Name })
+	sortByName(raws.Recipes, func(v oapi.Recipe) string { return v.Name })
+	sortByName(raws.CommandTables, func(v oapi.CommandTable) string { return v.Name })
+	sortByName(raws.DropTables, func(v oapi.DropTable) string { return v.Name })
+	sortByName(raws.ItemGroups, func(v oapi.ItemGroup) string { return v.Name })
+	sortByName(raws.ItemTables, func(v oapi.ItemTable) string { return v.Name })
+	sortByName(raws.EnemyTables, func(v oapi.EnemyTable) string { return v.Name })
+	sortByName(raws.SpriteSheets, func(v oapi.SpriteSheet) string { return v.Name })
+	sortByName(raws.Tiles, func(v oapi.Tile) string { return v.Name })
+	sortByName(raws.Props, func(v oapi.Prop) string { return v.Name })
+	sortByName(raws.Professions, func(v oapi.Profession) string { return v.Id })
+	sortByName(raws.Dialogues, func(v oapi.Dialogue) string { return v.Id })
+	sortByName(raws.Quests, func(v oapi.Quest) string { return v.Id })
+}
+
+// itemSortKey はアイテムが持つ種別をコード化する。種別を持たないものは末尾に置く
+func itemSortKey(item oapi.Item) string {
+	flags := []struct {
+		present bool
+		code    string
+	}{
+		{item.Melee != nil, "B"},
+		{item.Fire != nil, "C"},
+		{item.Wearable != nil, "D"},
+		{item.Consumable != nil, "E"},
+		{item.Ammo != nil, "F"},
+		{item.Book != nil, "G"},
+	}
+	var b strings.Builder
+	for _, f := range flags {
+		if f.present {
+			b.WriteString(f.code)
+		}
+	}
+	if b.Len() == 0 {
+		return "Z"
+	}
+	return b.String()
+}
+
+func sortByName[T any](data *[]T, name func(T) string) {
+	if data == nil {
+		return
+	}
+	slices.SortStableFunc(*data, func(a, b T) int { return strings.Compare(name(a), name(b)) })
+}
+
+// tomlWriter はEncodeRaws用の最小限のTOMLライター
+type tomlWriter struct {
+	buf bytes.Buffer
+}
+
+// writeTable はテーブルヘッダと中身を書き出す。
+// 値を先に、サブテーブルと配列テーブルを後に書くことで TOML の構文制約を満たす
+func (w *tomlWriter) writeTable(path string, table map[string]any, isArrayElem bool) error {
+	if w.buf.Len() > 0 {
+		w.buf.WriteString("\n")
+	}
+	if isArrayElem {
+		fmt.Fprintf(&w.buf, "[[%s]]\n", path)
+	} else {
+		fmt.Fprintf(&w.buf, "[%s]\n", path)
+	}
+
+	var children []string
+	for _, key := range tableKeyOrder(table) {
+		value := table[key]
+		if isTableValue(value) {
+			children = append(children, key)
+			continue
+		}
+		literal, err := tomlLiteral(value)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %w", path, key, err)
+		}
+		fmt.Fprintf(&w.buf, "%s = %s\n", tomlKey(key), literal)
+	}
+
+	for _, key := range children {
+		childPath := path + "." + tomlKey(key)
+		switch v := table[key].(type) {
+		case map[string]any:
+			if err := w.writeTable(childPath, v, false); err != nil {
+				return err
+			}
+		case []any:
+			for _, elem := range v {
+				child, ok := elem.(map[string]any)
+				if !ok {
+					return fmt.Errorf("%s: mixed array of tables", childPath)
+				}
+				if err := w.writeTable(childPath, child, true); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// tableKeyOrder はテーブル内のキーを書き出し順に並べる
+func tableKeyOrder(table map[string]any) []string {
+	keys := sortedKeys(table)
+	_, hasName := table["name"]
+	_, hasID := table["id"]
+	if !hasName || !hasID {
+		return keys
+	}
+	keys = slices.DeleteFunc(keys, func(k string) bool { return k == "id" })
+	nameIdx := slices.Index(keys, "name")
+	return slices.Insert(keys, nameIdx+1, "id")
+}
+
+// isTableValue はテーブルまたは空でないテーブル配列として書き出す値かを判定する
+func isTableValue(value any) bool {
+	switch v := value.(type) {
+	case map[string]any:
+		return true
+	case []any:
+		if len(v) == 0 {
+			return false
+		}
+		for _, elem := range v {
+			if _, ok := elem.(map[string]any); !ok {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// tomlLiteral はスカラー値またはスカラー配列をTOMLのリテラル表記にする
+func tomlLiteral(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return tomlString(v), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case json.Number:
+		return v.String(), nil
+	case []any:
+		parts := make([]string, 0, len(v))
+		for _, elem := range v {
+			s, err := tomlLiteral(elem)
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, s)
+		}
+		return "[" + strings.Join(parts, ", ") + "]", nil
+	case nil:
+		return "", fmt.Errorf("null cannot be represented in TOML")
+	}
+	return "", fmt.Errorf("unsupported value type %T", value)
+}
+
+// tomlString はTOMLの基本文字列表記にする
+func tomlString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if r < 0x20 || r == 0x7f {
+				fmt.Fprintf(&b, `\u%04X`, r)
+				continue
+			}
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// tomlKey は裸のキーとして書けない場合だけ引用符で囲む
+func tomlKey(key string) string {
+	if key == "" {
+		return `""`
+	}
+	for _, r := range key {
+		isBare := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' || r == '-'
+		if !isBare {
+			return tomlString(key)
+		}
+	}
+	return key
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,118 @@
+package raw
+
+import (
+	"path"
+	"testing"
+
+	"github.com/kijimaD/ruins/assets"
+	"github.com/kijimaD/ruins/internal/oapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// decodeLayout は本体の配置どおりの断片を検証せずに読んで結合する。エディタの読み込みと同じ手順
+func decodeLayout(t *testing.T) oapi.Raws {
+	t.Helper()
+	frags := map[string]oapi.Raws{}
+	for _, file := range LayoutFiles() {
+		bs, err := assets.FS.ReadFile(path.Join(DefaultDir, file))
+		require.NoError(t, err)
+		frag, err := DecodeRaws(string(bs))
+		require.NoError(t, err, file)
+		frags[file] = frag
+	}
+	return JoinRaws(frags)
+}
+
+func TestEncodeRaws_実データを書き戻しても内容が変わらない(t *testing.T) {
+	t.Parallel()
+	raws := decodeLayout(t)
+
+	encoded, err := EncodeRaws(raws)
+	require.NoError(t, err)
+	decoded, err := DecodeRaws(encoded)
+	require.NoError(t, err)
+
+	assert.Equal(t, raws, decoded)
+	require.NoError(t, ValidateRaws(decoded))
+	require.NoError(t, ValidateReferences(decoded, nil))
+}
+
+func TestEncodeRaws_再エンコードで同じ文字列になる(t *testing.T) {
+	t.Parallel()
+	raws := decodeLayout(t)
+	SortRaws(&raws)
+
+	first, err := EncodeRaws(raws)
+	require.NoError(t, err)
+	decoded, err := DecodeRaws(first)
+	require.NoError(t, err)
+	second, err := EncodeRaws(decoded)
+	require.NoError(t, err)
+
+	assert.Equal(t, first, second)
+}
+
+func TestEncodeRaws_セクション順とキー順が固定される(t *testing.T) {
+	t.Parallel()
+	raws := oapi.Raws{
+		SpriteSheets: &[]oapi.SpriteSheet{{Name: "field", Path: "file/textures/field.png"}},
+		Items: &[]oapi.Item{{
+			Name:            "回復薬",
+			Id:              "potion",
+			Description:     "体力を回復する",
+			SpriteSheetName: "field",
+			SpriteKey:       "potion",
+			Value:           10,
+		}},
+	}
+
+	encoded, err := EncodeRaws(raws)
+	require.NoError(t, err)
+
+	expect := `[[items]]
+description = "体力を回復する"
+name = "回復薬"
+id = "potion"
+spriteKey = "potion"
+spriteSheetName = "field"
+value = 10
+
+[[spriteSheets]]
+name = "field"
+path = "file/textures/field.png"
+`
+	assert.Equal(t, expect, encoded)
+}
+
+func TestEncodeRaws_文字列をエスケープする(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, `"a\"b\\c\nd"`, tomlString("a\"b\\c\nd"))
+	assert.Equal(t, `"\u0001"`, tomlString("\x01"))
+	assert.Equal(t, "snake_case", tomlKey("snake_case"))
+	assert.Equal(t, `"日本語"`, tomlKey("日本語"))
+}
+
+func TestSortRaws_アイテムは種別順のあと名前順に並ぶ(t *testing.T) {
+	t.Parallel()
+	raws := oapi.Raws{
+		Items: &[]oapi.Item{
+			{Name: "石"},
+			{Name: "薬B", Consumable: &oapi.Consumable{}},
+			{Name: "剣", Melee: &oapi.Melee{}},
+			{Name: "薬A", Consumable: &oapi.Consumable{}},
+		},
+		Professions: &[]oapi.Profession{{Id: "b"}, {Id: "a"}},
+		Dungeons:    &[]oapi.Dungeon{{Name: "Dead forest"}, {Name: "Ash cave"}},
+	}
+
+	SortRaws(&raws)
+
+	names := []string{}
+	for _, item := range *raws.Items {
+		names = append(names, item.Name)
+	}
+	assert.Equal(t, []string{"剣", "薬A", "薬B", "石"}, names)
+	assert.Equal(t, "a", (*raws.Professions)[0].Id)
+	assert.Equal(t, "Dead forest", (*raws.Dungeons)[0].Name, "ダンジョンは選択画面の並びを保つ")
+}
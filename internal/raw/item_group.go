@@ -27,7 +27,7 @@ type DrawnItem struct {
 // collection は各エントリを独立に確率判定する。個数は entry の pack ダイスで振る。stackable は個数を1エントリ
 // にまとめ、非 stackable は1個ずつのエントリに展開する。危険度を扱わず group を直接引くので、地上の床 loot と
 // 家具の収納 loot が同じ抽選を共有できる。
-func SelectFromItemGroup(raws oapi.Raws, groupID string, rng *rand.Rand) ([]DrawnItem, error) {
+func SelectFromItemGroup(raws Master, groupID string, rng *rand.Rand) ([]DrawnItem, error) {
 	group, err := GetItemGroup(raws, groupID)
 	if err != nil {
 		return nil, err
@@ -10,11 +10,11 @@ import (
 )
 
 // newTestRawsForItemGroup はアイテムとアイテムグループだけを持つテスト用 Raws を作る。
-func newTestRawsForItemGroup(items []oapi.Item, groups []oapi.ItemGroup) oapi.Raws {
-	return oapi.Raws{
+func newTestRawsForItemGroup(items []oapi.Item, groups []oapi.ItemGroup) Master {
+	return NewMaster(oapi.Raws{
 		Items:      &items,
 		ItemGroups: &groups,
-	}
+	})
 }
 
 // testGroupItems は抽選対象のアイテム。
@@ -9,12 +9,12 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-// テスト用のoapi.Rawsを作成する。アイテムグループとアイテムテーブルを含む
-func newTestRawsForItemTable(groups []oapi.ItemGroup, table oapi.ItemTable) oapi.Raws {
-	return oapi.Raws{
+// テスト用のMasterを作成する。アイテムグループとアイテムテーブルを含む
+func newTestRawsForItemTable(groups []oapi.ItemGroup, table oapi.ItemTable) Master {
+	return NewMaster(oapi.Raws{
 		ItemGroups: &groups,
 		ItemTables: &[]oapi.ItemTable{table},
-	}
+	})
 }
 
 var testGroups = []oapi.ItemGroup{
@@ -0,0 +1,68 @@
+package raw
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/kijimaD/ruins/internal/oapi"
+)
+
+// 本体のローデータは DefaultDir 以下に、コレクションごとの断片として置く。
+// ローダーは配置を問わず全断片を結合するが、エディタの読み書きはこの配置に従う。
+
+// fragmentLayout はコレクション名から、そのコレクションを置く断片の DefaultDir からの相対パスへの対応
+var fragmentLayout = map[string]string{
+	"items":         "items/items.toml",
+	"recipes":       "items/recipes.toml",
+	"members":       "members/members.toml",
+	"professions":   "members/professions.toml",
+	"dialogues":     "members/dialogues.toml",
+	"props":         "props/props.toml",
+	"tiles":         "props/tiles.toml",
+	"commandTables": "tables/command_tables.toml",
+	"dropTables":    "tables/drop_tables.toml",
+	"itemGroups":    "tables/item_groups.toml",
+	"itemTables":    "tables/item_tables.toml",
+	"enemyTables":   "tables/enemy_tables.toml",
+	"spriteSheets":  "sprite_sheets.toml",
+	"dungeons":      "dungeons.toml",
+	"quests":        "quests.toml",
+}
+
+// LayoutFiles は本体の配置に現れる断片のパスを辞書順に返す。パスは DefaultDir からの相対で区切りは /
+func LayoutFiles() []string {
+	files := slices.Collect(maps.Values(fragmentLayout))
+	slices.Sort(files)
+	return slices.Compact(files)
+}
+
+// SplitRaws は raws を本体の配置どおりの断片に分ける。キーは LayoutFiles のパス。
+// 定義の無いコレクションの断片も空で返し、書き戻しで消えた定義がファイルに残らないようにする
+func SplitRaws(raws oapi.Raws) map[string]oapi.Raws {
+	frags := map[string]oapi.Raws{}
+	for _, file := range LayoutFiles() {
+		frags[file] = oapi.Raws{}
+	}
+	for _, c := range rawCollections {
+		file := fragmentLayout[c.collectionName()]
+		frag := frags[file]
+		c.extract(raws, &frag)
+		frags[file] = frag
+	}
+	return frags
+}
+
+// JoinRaws は断片をパスの辞書順に1つのローデータへ結合する。検証はしない
+func JoinRaws(frags map[string]oapi.Raws) oapi.Raws {
+	files := slices.Sorted(maps.Keys(frags))
+	list := make([]fragment, len(files))
+	for i, file := range files {
+		list[i] = fragment{path: file, raws: frags[file]}
+	}
+	var raws oapi.Raws
+	sources := Sources{}
+	for _, c := range rawCollections {
+		c.mergeLayer(&raws, sources, list, false)
+	}
+	return raws
+}
@@ -0,0 +1,106 @@
+package raw
+
+import (
+	"maps"
+	"path"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/kijimaD/ruins/assets"
+	"github.com/kijimaD/ruins/internal/oapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readDefaultFragments は同梱の断片をすべて読む。断片ごとのパスは DefaultDir から始まる
+func readDefaultFragments(t *testing.T) []fragment {
+	t.Helper()
+	frags, err := readFragments(assets.FS, DefaultDir, func(p string) string { return p })
+	require.NoError(t, err)
+	return frags
+}
+
+// fragmentAt は frags から DefaultDir からの相対パスが file の断片を返す
+func fragmentAt(t *testing.T, frags []fragment, file string) *fragment {
+	t.Helper()
+	for i := range frags {
+		if frags[i].path == path.Join(DefaultDir, file) {
+			return &frags[i]
+		}
+	}
+	require.FailNow(t, "断片が無い", file)
+	return nil
+}
+
+func TestDefaultDir_同梱データは配置どおりの断片に分かれている(t *testing.T) {
+	t.Parallel()
+
+	frags := readDefaultFragments(t)
+	files := make([]string, len(frags))
+	for i, f := range frags {
+		files[i] = strings.TrimPrefix(f.path, DefaultDir+"/")
+	}
+	assert.Equal(t, LayoutFiles(), files, "配置に無い断片を置かず、配置の断片を欠かさない")
+
+	for _, f := range frags {
+		file := strings.TrimPrefix(f.path, DefaultDir+"/")
+		assert.Equal(t, f.raws, SplitRaws(f.raws)[file], "%s は配置で割り当てたコレクションだけを持つ", file)
+	}
+}
+
+func TestDefaultDir_断片を結合すると全コレクションがそろう(t *testing.T) {
+	t.Parallel()
+
+	master, err := LoadFromDir(DefaultDir, "")
+	require.NoError(t, err)
+	assert.NotEmpty(t, PtrSlice(master.Items))
+	assert.NotEmpty(t, PtrSlice(master.Members))
+	assert.NotEmpty(t, PtrSlice(master.Props))
+	assert.NotEmpty(t, PtrSlice(master.ItemTables))
+	assert.NotEmpty(t, PtrSlice(master.Dungeons))
+
+	dungeons := PtrSlice(master.Dungeons)
+	assert.Equal(t, "Dead forest", dungeons[0].Name, "ダンジョンは定義順のまま結合する")
+}
+
+func TestDefaultDir_参照切れは同梱の断片のファイル名を示す(t *testing.T) {
+	t.Parallel()
+
+	frags := readDefaultFragments(t)
+	tables := fragmentAt(t, frags, "tables/item_tables.toml")
+	broken := PtrSlice(tables.raws.ItemTables)
+	broken[0].Entries = append([]oapi.ItemTableEntry{}, broken[0].Entries...)
+	broken[0].Entries[0].Id = "undefined_group"
+	tables.raws.ItemTables = &broken
+
+	_, err := mergeFragments(frags, nil)
+	require.ErrorIs(t, err, errItemTableRefUndefinedGroup)
+	assert.Contains(t, err.Error(), path.Join(DefaultDir, "tables/item_tables.toml"))
+}
+
+func TestDefaultDir_断片をまたいだidの衝突は両方のファイル名を示す(t *testing.T) {
+	t.Parallel()
+
+	frags := readDefaultFragments(t)
+	items := fragmentAt(t, frags, "items/items.toml")
+	props := fragmentAt(t, frags, "props/props.toml")
+	dup := []oapi.Item{PtrSlice(items.raws.Items)[0]}
+	props.raws.Items = &dup
+
+	_, err := mergeFragments(frags, nil)
+	require.ErrorIs(t, err, errDuplicateID)
+	assert.Contains(t, err.Error(), path.Join(DefaultDir, "items/items.toml"))
+	assert.Contains(t, err.Error(), path.Join(DefaultDir, "props/props.toml"))
+}
+
+func TestSplitRaws_JoinRawsで元に戻る(t *testing.T) {
+	t.Parallel()
+
+	master, err := LoadFromDir(DefaultDir, "")
+	require.NoError(t, err)
+
+	frags := SplitRaws(master.Raws)
+	assert.Equal(t, LayoutFiles(), slices.Sorted(maps.Keys(frags)))
+	assert.Equal(t, master.Raws, JoinRaws(frags))
+}
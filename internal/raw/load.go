@@ -0,0 +1,207 @@
+package raw
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/kijimaD/ruins/assets"
+	"github.com/kijimaD/ruins/internal/oapi"
+)
+
+// DefaultDir は埋め込みアセット内で本体のローデータを置くディレクトリ
+const DefaultDir = "metadata/entities/raw"
+
+// Sources は結合したローデータの各定義がどのファイルから来たかを持つ。
+// コレクション名ごとに、oapi.Raws の配列と同じ添字でファイルパスを並べる
+type Sources map[string][]string
+
+// File は collection の index 番目の定義の出どころを返す。分からなければ空文字
+func (s Sources) File(collection string, index int) string {
+	files := s[collection]
+	if index < 0 || index >= len(files) {
+		return ""
+	}
+	return files[index]
+}
+
+// fragment は1ファイルぶんのローデータ
+type fragment struct {
+	path string
+	raws oapi.Raws
+}
+
+// LoadFromFile はファイルからローデータを読み込み、OpenAPIスキーマで検証して索引付きの Master にする
+func LoadFromFile(path string) (Master, error) {
+	frag, err := readFragment(assets.FS, path, path)
+	if err != nil {
+		return Master{}, err
+	}
+	return mergeFragments([]fragment{frag}, nil)
+}
+
+// LoadFromDir は埋め込みアセットの dir 以下にある TOML 断片をすべて読み込み、1つのローデータに結合する。
+// 断片はパスの辞書順に足し合わせるので、items/ や props/ のように分けて置ける。
+// modDir が空でなければ、OS 上のそのディレクトリの断片を上に重ねる。MOD の定義は同じ id の定義を置き換え、
+// 新しい id の定義は足される。本体どうし、MOD どうしで id がぶつかれば、両方のファイル名を添えてエラーにする
+func LoadFromDir(dir string, modDir string) (Master, error) {
+	base, err := readFragments(assets.FS, dir, func(p string) string { return p })
+	if err != nil {
+		return Master{}, err
+	}
+	var mod []fragment
+	if modDir != "" {
+		mod, err = readFragments(os.DirFS(modDir), ".", func(p string) string { return filepath.Join(modDir, filepath.FromSlash(p)) })
+		if err != nil {
+			return Master{}, err
+		}
+	}
+	return mergeFragments(base, mod)
+}
+
+// readFragments は root 以下の .toml を辞書順にすべて読み込む。label はエラーと Sources に載せるパスを作る
+func readFragments(fsys fs.FS, root string, label func(string) string) ([]fragment, error) {
+	var frags []fragment
+	err := fs.WalkDir(fsys, root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || path.Ext(p) != ".toml" {
+			return nil
+		}
+		frag, err := readFragment(fsys, p, label(p))
+		if err != nil {
+			return err
+		}
+		frags = append(frags, frag)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return frags, nil
+}
+
+// readFragment は1ファイルを読み込み、スキーマで検証する。断片ごとに検証してエラーにファイル名を添える
+func readFragment(fsys fs.FS, p string, label string) (fragment, error) {
+	bs, err := fs.ReadFile(fsys, p)
+	if err != nil {
+		return fragment{}, err
+	}
+	raws, err := DecodeRaws(string(bs))
+	if err != nil {
+		return fragment{}, fmt.Errorf("%s: %w", label, err)
+	}
+	if err := ValidateRaws(raws); err != nil {
+		return fragment{}, fmt.Errorf("failed to validate raw data for %s: %w", label, err)
+	}
+	return fragment{path: label, raws: raws}, nil
+}
+
+// mergeFragments は本体の断片を結合し、その上に MOD の断片を重ねて参照整合を検証する
+func mergeFragments(base, mod []fragment) (Master, error) {
+	var raws oapi.Raws
+	sources := Sources{}
+	for _, c := range rawCollections {
+		c.mergeLayer(&raws, sources, base, false)
+		c.mergeLayer(&raws, sources, mod, true)
+	}
+	if err := ValidateReferences(raws, sources); err != nil {
+		return Master{}, fmt.Errorf("failed to validate raw data: %w", err)
+	}
+	return NewMaster(raws), nil
+}
+
+// rawCollection は oapi.Raws の1つの配列に対する結合と重複検査。要素型を隠して一覧で回せるようにする
+type rawCollection interface {
+	// mergeLayer は frags の定義を raws へ足す。overlay なら、それより前の層にある同じ id の定義を置き換える
+	mergeLayer(raws *oapi.Raws, sources Sources, frags []fragment, overlay bool)
+	// duplicate は同じ id を持つ最初の2定義の添字を返す
+	duplicate(raws oapi.Raws) (id string, first, second int, found bool)
+	// extract は src のこのコレクションを dst へ写す
+	extract(src oapi.Raws, dst *oapi.Raws)
+	collectionName() string
+}
+
+// typedCollection は rawCollection の要素型ごとの実装
+type typedCollection[T any] struct {
+	name  string
+	field func(*oapi.Raws) **[]T
+	key   func(T) string
+}
+
+// rawCollections は結合と重複検査の対象の一覧。名前はローデータのトップレベルのキーと揃える
+var rawCollections = []rawCollection{
+	typedCollection[oapi.Item]{"items", func(r *oapi.Raws) **[]oapi.Item { return &r.Items }, func(v oapi.Item) string { return v.Id }},
+	typedCollection[oapi.Member]{"members", func(r *oapi.Raws) **[]oapi.Member { return &r.Members }, func(v oapi.Member) string { return v.Id }},
+	typedCollection[oapi.Recipe]{"recipes", func(r *oapi.Raws) **[]oapi.Recipe { return &r.Recipes }, func(v oapi.Recipe) string { return v.Id }},
+	typedCollection[oapi.Tile]{"tiles", func(r *oapi.Raws) **[]oapi.Tile { return &r.Tiles }, func(v oapi.Tile) string { return v.Id }},
+	typedCollection[oapi.Prop]{"props", func(r *oapi.Raws) **[]oapi.Prop { return &r.Props }, func(v oapi.Prop) string { return v.Id }},
+	typedCollection[oapi.Profession]{"professions", func(r *oapi.Raws) **[]oapi.Profession { return &r.Professions }, func(v oapi.Profession) string { return v.Id }},
+	typedCollection[oapi.CommandTable]{"commandTables", func(r *oapi.Raws) **[]oapi.CommandTable { return &r.CommandTables }, func(v oapi.CommandTable) string { return v.Id }},
+	typedCollection[oapi.DropTable]{"dropTables", func(r *oapi.Raws) **[]oapi.DropTable { return &r.DropTables }, func(v oapi.DropTable) string { return v.Id }},
+	typedCollection[oapi.ItemGroup]{"itemGroups", func(r *oapi.Raws) **[]oapi.ItemGroup { return &r.ItemGroups }, func(v oapi.ItemGroup) string { return v.Id }},
+	typedCollection[oapi.ItemTable]{"itemTables", func(r *oapi.Raws) **[]oapi.ItemTable { return &r.ItemTables }, func(v oapi.ItemTable) string { return v.Id }},
+	typedCollection[oapi.EnemyTable]{"enemyTables", func(r *oapi.Raws) **[]oapi.EnemyTable { return &r.EnemyTables }, func(v oapi.EnemyTable) string { return v.Id }},
+	typedCollection[oapi.SpriteSheet]{"spriteSheets", func(r *oapi.Raws) **[]oapi.SpriteSheet { return &r.SpriteSheets }, func(v oapi.SpriteSheet) string { return v.Name }},
+	typedCollection[oapi.Dungeon]{"dungeons", func(r *oapi.Raws) **[]oapi.Dungeon { return &r.Dungeons }, func(v oapi.Dungeon) string { return v.Name }},
+	typedCollection[oapi.Dialogue]{"dialogues", func(r *oapi.Raws) **[]oapi.Dialogue { return &r.Dialogues }, func(v oapi.Dialogue) string { return v.Id }},
+	typedCollection[oapi.Quest]{"quests", func(r *oapi.Raws) **[]oapi.Quest { return &r.Quests }, func(v oapi.Quest) string { return v.Id }},
+}
+
+func (c typedCollection[T]) collectionName() string {
+	return c.name
+}
+
+func (c typedCollection[T]) mergeLayer(raws *oapi.Raws, sources Sources, frags []fragment, overlay bool) {
+	slot := c.field(raws)
+	entries := PtrSlice(*slot)
+	files := sources[c.name]
+
+	// 置き換えの対象は前の層までの定義に限る。同じ層で2度現れた id は衝突として残し、重複検査に拾わせる
+	lower := map[string]int{}
+	if overlay {
+		for i, e := range entries {
+			if _, ok := lower[c.key(e)]; !ok {
+				lower[c.key(e)] = i
+			}
+		}
+	}
+	replaced := map[string]bool{}
+	for _, f := range frags {
+		for _, e := range PtrSlice(*c.field(&f.raws)) {
+			k := c.key(e)
+			if i, ok := lower[k]; ok && !replaced[k] {
+				entries[i] = e
+				files[i] = f.path
+				replaced[k] = true
+				continue
+			}
+			entries = append(entries, e)
+			files = append(files, f.path)
+		}
+	}
+
+	if len(entries) > 0 {
+		*slot = &entries
+	}
+	sources[c.name] = files
+}
+
+func (c typedCollection[T]) duplicate(raws oapi.Raws) (string, int, int, bool) {
+	seen := map[string]int{}
+	for i, e := range PtrSlice(*c.field(&raws)) {
+		k := c.key(e)
+		if first, ok := seen[k]; ok {
+			return k, first, i, true
+		}
+		seen[k] = i
+	}
+	return "", 0, 0, false
+}
+
+func (c typedCollection[T]) extract(src oapi.Raws, dst *oapi.Raws) {
+	*c.field(dst) = *c.field(&src)
+}
@@ -0,0 +1,137 @@
+package raw
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	fragmentTorch = `
+[[items]]
+name = "Modded Torch"
+id = "torch"
+description = "A torch from a mod."
+spriteKey = "torch"
+spriteSheetName = "field"
+value = 1
+weight = "500 g"
+`
+	fragmentLantern = `
+[[items]]
+name = "Lantern"
+id = "lantern"
+description = "A lantern from a mod."
+spriteKey = "torch"
+spriteSheetName = "field"
+value = 40
+weight = "1 kg"
+`
+	fragmentLight = `
+[[itemGroups]]
+name = "Light"
+id = "light"
+subtype = "distribution"
+
+[[itemGroups.entries]]
+id = "lantern"
+weight = 1
+pack = "1d1"
+`
+)
+
+// writeModFile は MOD ディレクトリに断片を置く
+func writeModFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	p := filepath.Join(dir, filepath.FromSlash(name))
+	require.NoError(t, os.MkdirAll(filepath.Dir(p), 0o755))
+	require.NoError(t, os.WriteFile(p, []byte(content), 0o644))
+}
+
+func TestMergeFragments_ディレクトリの断片を1つに結合する(t *testing.T) {
+	t.Parallel()
+
+	fsys := fstest.MapFS{
+		"raw/items/light.toml":  {Data: []byte(fragmentLantern)},
+		"raw/tables/light.toml": {Data: []byte(fragmentLight)},
+		"raw/README.md":         {Data: []byte("toml 以外は読まない")},
+	}
+	frags, err := readFragments(fsys, "raw", func(p string) string { return p })
+	require.NoError(t, err)
+	require.Len(t, frags, 2)
+
+	master, err := mergeFragments(frags, nil)
+	require.NoError(t, err)
+	item, err := FindItem(master, "lantern")
+	require.NoError(t, err)
+	assert.Equal(t, "Lantern", item.Name)
+	assert.Equal(t, []string{"light"}, master.ItemGroupsContaining("lantern"))
+}
+
+func TestLoadFromDir(t *testing.T) {
+	t.Parallel()
+
+	t.Run("MODなしなら本体の定義だけを読む", func(t *testing.T) {
+		t.Parallel()
+		master, err := LoadFromDir("metadata/entities/raw", "")
+		require.NoError(t, err)
+		item, err := FindItem(master, "torch")
+		require.NoError(t, err)
+		assert.Equal(t, "Torch", item.Name)
+	})
+
+	t.Run("MODは同じidの定義を置き換え新しいidを足す", func(t *testing.T) {
+		t.Parallel()
+		modDir := t.TempDir()
+		writeModFile(t, modDir, "items/torch.toml", fragmentTorch)
+		writeModFile(t, modDir, "items/lantern.toml", fragmentLantern)
+
+		base, err := LoadFromDir("metadata/entities/raw", "")
+		require.NoError(t, err)
+		master, err := LoadFromDir("metadata/entities/raw", modDir)
+		require.NoError(t, err)
+
+		item, err := FindItem(master, "torch")
+		require.NoError(t, err)
+		assert.Equal(t, "Modded Torch", item.Name)
+		_, err = FindItem(master, "lantern")
+		require.NoError(t, err)
+		assert.Len(t, PtrSlice(master.Items), len(PtrSlice(base.Items))+1, "置き換えた定義は数に入らないべき")
+	})
+
+	t.Run("MODどうしでidが衝突すると両方のファイルを示す", func(t *testing.T) {
+		t.Parallel()
+		modDir := t.TempDir()
+		writeModFile(t, modDir, "a.toml", fragmentLantern)
+		writeModFile(t, modDir, "b.toml", fragmentLantern)
+
+		_, err := LoadFromDir("metadata/entities/raw", modDir)
+		require.ErrorIs(t, err, errDuplicateID)
+		assert.Contains(t, err.Error(), filepath.Join(modDir, "a.toml"))
+		assert.Contains(t, err.Error(), filepath.Join(modDir, "b.toml"))
+	})
+
+	t.Run("未定義の参照は定義のあるファイルを示す", func(t *testing.T) {
+		t.Parallel()
+		modDir := t.TempDir()
+		writeModFile(t, modDir, "tables/light.toml", fragmentLight)
+
+		_, err := LoadFromDir("metadata/entities/raw", modDir)
+		require.ErrorIs(t, err, errItemGroupRefUndefinedItem)
+		assert.Contains(t, err.Error(), filepath.Join(modDir, "tables", "light.toml"))
+	})
+
+	t.Run("スキーマに合わない断片はファイル名を添えてエラー", func(t *testing.T) {
+		t.Parallel()
+		modDir := t.TempDir()
+		writeModFile(t, modDir, "broken.toml", "[[items]]\nid = 1\n")
+
+		_, err := LoadFromDir("metadata/entities/raw", modDir)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), filepath.Join(modDir, "broken.toml"))
+	})
+}
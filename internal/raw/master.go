@@ -0,0 +1,138 @@
+package raw
+
+import (
+	"slices"
+
+	"github.com/kijimaD/ruins/internal/oapi"
+)
+
+// Master は読み込み済みのローデータ。oapi.Raws を埋め込み、id から定義を O(1) で引く索引と、
+// アイテムがどこから参照されているかの逆引きを持つ。
+// 索引は NewMaster で一度だけ作る。埋め込んだ Raws の配列を後から書き換えると索引とずれるので、
+// 変えるときは NewMaster で作り直す。ゼロ値は空のローデータとして振る舞う
+type Master struct {
+	oapi.Raws
+	index *masterIndex
+}
+
+// masterIndex は id から各配列の添字を引く表と、アイテムの逆引き表。
+// 同じ id が重複するときは先に現れた定義を採る
+type masterIndex struct {
+	items         map[string]int
+	members       map[string]int
+	spriteSheets  map[string]int // SpriteSheet は Name で引く
+	recipes       map[string]int
+	commandTables map[string]int
+	dropTables    map[string]int
+	itemGroups    map[string]int
+	itemTables    map[string]int
+	enemyTables   map[string]int
+	tiles         map[string]int
+	props         map[string]int
+	professions   map[string]int
+	dialogues     map[string]int
+	quests        map[string]int
+
+	// dropTablesByMaterial はアイテム id からそれを落とすドロップテーブル id を引く
+	dropTablesByMaterial map[string][]string
+	// itemGroupsByItem はアイテム id からそれを含むアイテムグループ id を引く
+	itemGroupsByItem map[string][]string
+	// recipesByInput はアイテム id からそれを素材に使うレシピ id を引く
+	recipesByInput map[string][]string
+}
+
+// emptyIndex はゼロ値の Master が引く空の索引。nil map の読み出しは見つからない扱いになる
+var emptyIndex = &masterIndex{}
+
+// NewMaster は raws から索引を作り Master を返す
+func NewMaster(raws oapi.Raws) Master {
+	idx := &masterIndex{
+		items:         indexByKey(raws.Items, func(i oapi.Item) string { return i.Id }),
+		members:       indexByKey(raws.Members, func(m oapi.Member) string { return m.Id }),
+		spriteSheets:  indexByKey(raws.SpriteSheets, func(s oapi.SpriteSheet) string { return s.Name }),
+		recipes:       indexByKey(raws.Recipes, func(r oapi.Recipe) string { return r.Id }),
+		commandTables: indexByKey(raws.CommandTables, func(c oapi.CommandTable) string { return c.Id }),
+		dropTables:    indexByKey(raws.DropTables, func(d oapi.DropTable) string { return d.Id }),
+		itemGroups:    indexByKey(raws.ItemGroups, func(g oapi.ItemGroup) string { return g.Id }),
+		itemTables:    indexByKey(raws.ItemTables, func(t oapi.ItemTable) string { return t.Id }),
+		enemyTables:   indexByKey(raws.EnemyTables, func(t oapi.EnemyTable) string { return t.Id }),
+		tiles:         indexByKey(raws.Tiles, func(t oapi.Tile) string { return t.Id }),
+		props:         indexByKey(raws.Props, func(p oapi.Prop) string { return p.Id }),
+		professions:   indexByKey(raws.Professions, func(p oapi.Profession) string { return p.Id }),
+		dialogues:     indexByKey(raws.Dialogues, func(d oapi.Dialogue) string { return d.Id }),
+		quests:        indexByKey(raws.Quests, func(q oapi.Quest) string { return q.Id }),
+
+		dropTablesByMaterial: map[string][]string{},
+		itemGroupsByItem:     map[string][]string{},
+		recipesByInput:       map[string][]string{},
+	}
+	for _, dt := range PtrSlice(raws.DropTables) {
+		for _, e := range dt.Entries {
+			appendUnique(idx.dropTablesByMaterial, e.Material, dt.Id)
+		}
+	}
+	for _, g := range PtrSlice(raws.ItemGroups) {
+		for _, e := range g.Entries {
+			appendUnique(idx.itemGroupsByItem, e.Id, g.Id)
+		}
+	}
+	for _, r := range PtrSlice(raws.Recipes) {
+		for _, in := range r.Inputs {
+			appendUnique(idx.recipesByInput, in.Id, r.Id)
+		}
+	}
+	return Master{Raws: raws, index: idx}
+}
+
+// DropTablesDropping は itemID を落とすドロップテーブルの id を定義順に返す
+func (m Master) DropTablesDropping(itemID string) []string {
+	return m.idx().dropTablesByMaterial[itemID]
+}
+
+// ItemGroupsContaining は itemID を含むアイテムグループの id を定義順に返す
+func (m Master) ItemGroupsContaining(itemID string) []string {
+	return m.idx().itemGroupsByItem[itemID]
+}
+
+// RecipesUsing は itemID を素材に使うレシピの id を定義順に返す
+func (m Master) RecipesUsing(itemID string) []string {
+	return m.idx().recipesByInput[itemID]
+}
+
+// idx は索引を返す。NewMaster を通していないゼロ値では空の索引を返す
+func (m Master) idx() *masterIndex {
+	if m.index == nil {
+		return emptyIndex
+	}
+	return m.index
+}
+
+// indexByKey は配列の各要素のキーから添字を引く表を作る。重複キーは先の要素を残す
+func indexByKey[T any](slice *[]T, keyFn func(T) string) map[string]int {
+	elems := PtrSlice(slice)
+	index := make(map[string]int, len(elems))
+	for i, elem := range elems {
+		key := keyFn(elem)
+		if _, dup := index[key]; !dup {
+			index[key] = i
+		}
+	}
+	return index
+}
+
+// lookup は索引で配列の要素を引く
+func lookup[T any](slice *[]T, index map[string]int, key string) (T, bool) {
+	i, ok := index[key]
+	if !ok || i >= len(PtrSlice(slice)) {
+		var zero T
+		return zero, false
+	}
+	return (*slice)[i], true
+}
+
+// appendUnique は m[key] に value を重複なく足す
+func appendUnique(m map[string][]string, key, value string) {
+	if !slices.Contains(m[key], value) {
+		m[key] = append(m[key], value)
+	}
+}
@@ -0,0 +1,94 @@
+package raw
+
+import (
+	"testing"
+
+	"github.com/kijimaD/ruins/internal/oapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMaster(t *testing.T) {
+	t.Parallel()
+
+	master := NewMaster(oapi.Raws{
+		Items: &[]oapi.Item{
+			{Id: "iron", Name: "Iron"},
+			{Id: "herb", Name: "Herb"},
+			{Id: "iron", Name: "Iron Duplicate"},
+		},
+		DropTables: &[]oapi.DropTable{
+			{Id: "ruins", Entries: []oapi.DropTableEntry{{Material: "iron", Weight: 1}, {Material: "iron", Weight: 2}}},
+			{Id: "forest", Entries: []oapi.DropTableEntry{{Material: "herb", Weight: 1}}},
+		},
+		ItemGroups: &[]oapi.ItemGroup{
+			{Id: "metals", Entries: []oapi.ItemGroupEntry{{Id: "iron", Weight: 1}}},
+			{Id: "mixed", Entries: []oapi.ItemGroupEntry{{Id: "herb", Weight: 1}, {Id: "iron", Weight: 1}}},
+		},
+		Recipes: &[]oapi.Recipe{
+			{Id: "iron_knife", Inputs: []oapi.RecipeInput{{Id: "iron", Amount: 2}}},
+			{Id: "antidote", Inputs: []oapi.RecipeInput{{Id: "herb", Amount: 1}}},
+		},
+	})
+
+	t.Run("idで定義を引ける", func(t *testing.T) {
+		t.Parallel()
+		item, err := FindItem(master, "herb")
+		require.NoError(t, err)
+		assert.Equal(t, "Herb", item.Name)
+	})
+
+	t.Run("重複したidは先に現れた定義を引く", func(t *testing.T) {
+		t.Parallel()
+		item, err := FindItem(master, "iron")
+		require.NoError(t, err)
+		assert.Equal(t, "Iron", item.Name)
+	})
+
+	t.Run("埋め込んだRawsの配列をそのまま走査できる", func(t *testing.T) {
+		t.Parallel()
+		assert.Len(t, PtrSlice(master.Items), 3)
+	})
+
+	t.Run("アイテムを落とすドロップテーブルを逆引きできる", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, []string{"ruins"}, master.DropTablesDropping("iron"))
+		assert.Equal(t, []string{"forest"}, master.DropTablesDropping("herb"))
+	})
+
+	t.Run("アイテムを含むアイテムグループを逆引きできる", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, []string{"metals", "mixed"}, master.ItemGroupsContaining("iron"))
+	})
+
+	t.Run("アイテムを素材に使うレシピを逆引きできる", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, []string{"iron_knife"}, master.RecipesUsing("iron"))
+		assert.Empty(t, master.RecipesUsing("iron_knife"))
+	})
+}
+
+func TestMaster_ゼロ値は空のローデータとして振る舞う(t *testing.T) {
+	t.Parallel()
+
+	var master Master
+	_, err := FindItem(master, "iron")
+	require.Error(t, err)
+	_, ok := FindDisassemblyTool(master, "iron")
+	assert.False(t, ok)
+	assert.Empty(t, master.RecipesUsing("iron"))
+}
+
+func BenchmarkFindItem(b *testing.B) {
+	master, err := LoadFromDir(DefaultDir, "")
+	require.NoError(b, err)
+	items := PtrSlice(master.Items)
+	// 線形走査なら最も遅くなる末尾の定義を引く
+	last := items[len(items)-1].Id
+
+	for b.Loop() {
+		if _, err := FindItem(master, last); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
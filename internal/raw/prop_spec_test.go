@@ -24,8 +24,9 @@ SpriteSheetName = "field"
 SpriteKey = "wall"
 Depth = 1
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	entitySpec, err := NewPropSpec(raws, "壁")
 	require.NoError(t, err)
@@ -49,8 +50,9 @@ SpriteSheetName = "field"
 SpriteKey = "mud"
 Depth = 1
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	entitySpec, err := NewPropSpec(raws, "泥濘")
 	require.NoError(t, err)
@@ -76,8 +78,9 @@ Depth = 1
 
 [Props.Door]
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	entitySpec, err := NewPropSpec(raws, "木の扉")
 	require.NoError(t, err)
@@ -106,8 +109,9 @@ Depth = 1
 
 [Props.WarpNextTrigger]
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	entitySpec, err := NewPropSpec(raws, "下り階段")
 	require.NoError(t, err)
@@ -133,8 +137,9 @@ Depth = 1
 
 [Props.WarpCubeExitTrigger]
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	entitySpec, err := NewPropSpec(raws, "キューブ出口")
 	require.NoError(t, err)
@@ -160,8 +165,9 @@ Depth = 1
 
 [Props.CubePanelTrigger]
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	entitySpec, err := NewPropSpec(raws, "操作パネル")
 	require.NoError(t, err)
@@ -187,8 +193,9 @@ Depth = 1
 
 [Props.ShippingStation]
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	entitySpec, err := NewPropSpec(raws, "出荷場所")
 	require.NoError(t, err)
@@ -216,8 +223,9 @@ Depth = 1
 [Props.Storage]
 MaxWeight = "abc"
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	_, err = NewPropSpec(raws, "壊れた収納")
 	require.Error(t, err)
@@ -226,7 +234,7 @@ MaxWeight = "abc"
 
 func TestNewPropSpec_分解定義があるとInteractionDisassembleが設定される(t *testing.T) {
 	t.Parallel()
-	raws := oapi.Raws{
+	raws := NewMaster(oapi.Raws{
 		Props: &[]oapi.Prop{
 			{
 				Id:   "解体台",
@@ -242,7 +250,7 @@ func TestNewPropSpec_分解定義があるとInteractionDisassembleが設定さ
 				},
 			},
 		},
-	}
+	})
 
 	entitySpec, err := NewPropSpec(raws, "解体台")
 	require.NoError(t, err)
@@ -253,8 +261,9 @@ func TestNewPropSpec_分解定義があるとInteractionDisassembleが設定さ
 
 func TestNewPropSpec_未存在のPropはKeyNotFoundErrorになる(t *testing.T) {
 	t.Parallel()
-	raws, err := DecodeRaws("")
+	decoded, err := DecodeRaws("")
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	_, err = NewPropSpec(raws, "存在しないProp")
 	require.Error(t, err)
@@ -7,7 +7,6 @@ import (
 	"math/rand/v2"
 
 	"github.com/BurntSushi/toml"
-	"github.com/kijimaD/ruins/assets"
 	gc "github.com/kijimaD/ruins/internal/components"
 	"github.com/kijimaD/ruins/internal/consts"
 	"github.com/kijimaD/ruins/internal/oapi"
@@ -22,36 +21,6 @@ func PtrSlice[T any](p *[]T) []T {
 	return *p
 }
 
-// findByKey はスライスからキー関数でマッチする要素を線形検索する
-func findByKey[T any](slice *[]T, keyFn func(T) string, target string) (T, bool) {
-	for _, item := range PtrSlice(slice) {
-		if keyFn(item) == target {
-			return item, true
-		}
-	}
-	var zero T
-	return zero, false
-}
-
-// LoadFromFile はファイルからローデータを読み込み、OpenAPIスキーマで検証する
-func LoadFromFile(path string) (oapi.Raws, error) {
-	bs, err := assets.FS.ReadFile(path)
-	if err != nil {
-		return oapi.Raws{}, err
-	}
-	raws, err := DecodeRaws(string(bs))
-	if err != nil {
-		return oapi.Raws{}, err
-	}
-	if err := ValidateRaws(raws); err != nil {
-		return oapi.Raws{}, fmt.Errorf("failed to validate raw data for %s: %w", path, err)
-	}
-	if err := ValidateReferences(raws); err != nil {
-		return oapi.Raws{}, fmt.Errorf("failed to validate raw data for %s: %w", path, err)
-	}
-	return raws, nil
-}
-
 // DecodeRaws はTOML文字列をoapi.Raws構造体にデコードする
 // 未知のキーが含まれる場合はエラーを返す
 func DecodeRaws(content string) (oapi.Raws, error) {
@@ -67,8 +36,8 @@ func DecodeRaws(content string) (oapi.Raws, error) {
 }
 
 // FindItem は指定された名前のアイテム定義を検索する
-func FindItem(raws oapi.Raws, name string) (oapi.Item, error) {
-	item, ok := findByKey(raws.Items, func(i oapi.Item) string { return i.Id }, name)
+func FindItem(raws Master, name string) (oapi.Item, error) {
+	item, ok := lookup(raws.Items, raws.idx().items, name)
 	if !ok {
 		return oapi.Item{}, NewKeyNotFoundError(name, "Items")
 	}
@@ -76,7 +45,7 @@ func FindItem(raws oapi.Raws, name string) (oapi.Item, error) {
 }
 
 // ItemName は id からアイテムの表示名を返す。定義が見つからなければ id をそのまま返す
-func ItemName(raws oapi.Raws, id string) string {
+func ItemName(raws Master, id string) string {
 	item, err := FindItem(raws, id)
 	if err != nil {
 		return id
@@ -85,8 +54,8 @@ func ItemName(raws oapi.Raws, id string) string {
 }
 
 // FindMember は指定された名前のメンバー定義を検索する
-func FindMember(raws oapi.Raws, name string) (oapi.Member, error) {
-	member, ok := findByKey(raws.Members, func(m oapi.Member) string { return m.Id }, name)
+func FindMember(raws Master, name string) (oapi.Member, error) {
+	member, ok := lookup(raws.Members, raws.idx().members, name)
 	if !ok {
 		return oapi.Member{}, NewKeyNotFoundError(name, "Members")
 	}
@@ -94,8 +63,8 @@ func FindMember(raws oapi.Raws, name string) (oapi.Member, error) {
 }
 
 // FindSpriteSheet は指定された名前のスプライトシートを検索する
-func FindSpriteSheet(raws oapi.Raws, name string) (oapi.SpriteSheet, error) {
-	sheet, ok := findByKey(raws.SpriteSheets, func(s oapi.SpriteSheet) string { return s.Name }, name)
+func FindSpriteSheet(raws Master, name string) (oapi.SpriteSheet, error) {
+	sheet, ok := lookup(raws.SpriteSheets, raws.idx().spriteSheets, name)
 	if !ok {
 		return oapi.SpriteSheet{}, NewKeyNotFoundError(name, "SpriteSheets")
 	}
@@ -193,6 +162,19 @@ func newProvidesHealingFromAPI(h *oapi.ProvidesHealing) *gc.ProvidesHealing {
 	}
 }
 
+// newThrowableFromAPI はoapi.Throwableからgc.Throwableを生成する。splash 省略時は着弾点だけに当たる
+func newThrowableFromAPI(t *oapi.Throwable) *gc.Throwable {
+	throwable := &gc.Throwable{
+		Range:          consts.Tile(t.Range),
+		Damage:         t.Damage,
+		BreaksOnImpact: t.BreaksOnImpact,
+	}
+	if t.Splash != nil {
+		throwable.Splash = consts.Tile(*t.Splash)
+	}
+	return throwable
+}
+
 // newBookFromAPI はoapi.Bookからgc.Bookを生成する
 func newBookFromAPI(b *oapi.Book) (*gc.Book, error) {
 	if b.Skill == nil {
@@ -215,7 +197,7 @@ func newBookFromAPI(b *oapi.Book) (*gc.Book, error) {
 }
 
 // NewItemSpec は指定された名前のアイテムのEntitySpecを生成する
-func NewItemSpec(raws oapi.Raws, name string) (gc.EntitySpec, error) {
+func NewItemSpec(raws Master, name string) (gc.EntitySpec, error) {
 	item, err := FindItem(raws, name)
 	if err != nil {
 		return gc.EntitySpec{}, err
@@ -266,6 +248,12 @@ func NewItemSpec(raws oapi.Raws, name string) (gc.EntitySpec, error) {
 	if item.InflictsDamage != nil {
 		entitySpec.InflictsDamage = &gc.InflictsDamage{Amount: *item.InflictsDamage}
 	}
+	if item.Throwable != nil {
+		entitySpec.Throwable = newThrowableFromAPI(item.Throwable)
+	}
+	if item.ProvidesTreatment != nil {
+		entitySpec.ProvidesTreatment = &gc.ProvidesTreatment{Amount: *item.ProvidesTreatment}
+	}
 
 	if item.Ammo != nil {
 		var ammoAmmoTag oapi.AmmoTag
@@ -343,8 +331,8 @@ func NewItemSpec(raws oapi.Raws, name string) (gc.EntitySpec, error) {
 }
 
 // NewRecipeSpec は指定された名前のレシピのEntitySpecを生成する
-func NewRecipeSpec(raws oapi.Raws, name string) (gc.EntitySpec, error) {
-	recipe, ok := findByKey(raws.Recipes, func(r oapi.Recipe) string { return r.Id }, name)
+func NewRecipeSpec(raws Master, name string) (gc.EntitySpec, error) {
+	recipe, ok := lookup(raws.Recipes, raws.idx().recipes, name)
 	if !ok {
 		return gc.EntitySpec{}, NewKeyNotFoundError(name, "Recipes")
 	}
@@ -355,6 +343,13 @@ func NewRecipeSpec(raws oapi.Raws, name string) (gc.EntitySpec, error) {
 	for _, input := range recipe.Inputs {
 		entitySpec.Recipe.Inputs = append(entitySpec.Recipe.Inputs, gc.RecipeInput{ID: input.Id, Amount: input.Amount})
 	}
+	entitySpec.Recipe.Station = recipe.Station
+	if recipe.Tool != nil {
+		entitySpec.Recipe.Tool = &gc.RecipeTool{Category: recipe.Tool.Category, Grade: recipe.Tool.Grade}
+	}
+	if recipe.WorkAP != nil {
+		entitySpec.Recipe.WorkAP = *recipe.WorkAP
+	}
 
 	// 説明文や分類のため、マッチしたitemの定義から持ってくる
 	itemSpec, err := NewItemSpec(raws, recipe.Id)
@@ -385,7 +380,7 @@ func NewRecipeSpec(raws oapi.Raws, name string) (gc.EntitySpec, error) {
 }
 
 // NewWeaponSpec は指定された名前の武器のEntitySpecを生成する
-func NewWeaponSpec(raws oapi.Raws, name string) (gc.EntitySpec, error) {
+func NewWeaponSpec(raws Master, name string) (gc.EntitySpec, error) {
 	// 武器はアイテムの一種なので、Itemsから検索して存在確認
 	if _, err := FindItem(raws, name); err != nil {
 		return gc.EntitySpec{}, err
@@ -405,8 +400,8 @@ func NewWeaponSpec(raws oapi.Raws, name string) (gc.EntitySpec, error) {
 }
 
 // NewMemberSpec は指定された名前のメンバーのEntitySpecを生成する
-func NewMemberSpec(raws oapi.Raws, name string) (gc.EntitySpec, error) {
-	member, ok := findByKey(raws.Members, func(m oapi.Member) string { return m.Id }, name)
+func NewMemberSpec(raws Master, name string) (gc.EntitySpec, error) {
+	member, ok := lookup(raws.Members, raws.idx().members, name)
 	if !ok {
 		return gc.EntitySpec{}, fmt.Errorf("key does not exist: %s", name)
 	}
@@ -444,6 +439,18 @@ func NewMemberSpec(raws oapi.Raws, name string) (gc.EntitySpec, error) {
 			return gc.EntitySpec{}, fmt.Errorf("failed to get command table for member '%s': %w", name, err)
 		}
 		entitySpec.CommandTable = &gc.CommandTable{Name: ct.Id}
+		// 射撃武器は最初に見つかった1つを個体に持たせる。武器名はロード時に参照検証済み
+		for _, entry := range ct.Entries {
+			weapon, err := NewWeaponSpec(raws, entry.Weapon)
+			if err != nil {
+				return gc.EntitySpec{}, fmt.Errorf("failed to get weapon '%s' for member '%s': %w", entry.Weapon, name, err)
+			}
+			if weapon.Fire != nil {
+				entitySpec.CommandTable.Fire = weapon.Fire
+				entitySpec.CommandTable.FireWeapon = weapon.Name.Name
+				break
+			}
+		}
 	}
 
 	if member.DropTableId != nil {
@@ -508,7 +515,7 @@ func NewMemberSpec(raws oapi.Raws, name string) (gc.EntitySpec, error) {
 }
 
 // NewPlayerSpec は指定された名前のプレイヤーのEntitySpecを生成する
-func NewPlayerSpec(raws oapi.Raws, name string) (gc.EntitySpec, error) {
+func NewPlayerSpec(raws Master, name string) (gc.EntitySpec, error) {
 	entitySpec, err := NewMemberSpec(raws, name)
 	if err != nil {
 		return gc.EntitySpec{}, err
@@ -521,7 +528,7 @@ func NewPlayerSpec(raws oapi.Raws, name string) (gc.EntitySpec, error) {
 }
 
 // NewEnemySpec は指定された名前の敵のEntitySpecを生成する
-func NewEnemySpec(raws oapi.Raws, name string) (gc.EntitySpec, error) {
+func NewEnemySpec(raws Master, name string) (gc.EntitySpec, error) {
 	entitySpec, err := NewMemberSpec(raws, name)
 	if err != nil {
 		return gc.EntitySpec{}, err
@@ -532,8 +539,8 @@ func NewEnemySpec(raws oapi.Raws, name string) (gc.EntitySpec, error) {
 }
 
 // GetCommandTable は指定された名前のコマンドテーブルを取得する
-func GetCommandTable(raws oapi.Raws, name string) (oapi.CommandTable, error) {
-	ct, ok := findByKey(raws.CommandTables, func(c oapi.CommandTable) string { return c.Id }, name)
+func GetCommandTable(raws Master, name string) (oapi.CommandTable, error) {
+	ct, ok := lookup(raws.CommandTables, raws.idx().commandTables, name)
 	if !ok {
 		return oapi.CommandTable{}, fmt.Errorf("key does not exist: %s", name)
 	}
@@ -541,8 +548,8 @@ func GetCommandTable(raws oapi.Raws, name string) (oapi.CommandTable, error) {
 }
 
 // GetDropTable は指定された名前のドロップテーブルを取得する
-func GetDropTable(raws oapi.Raws, name string) (oapi.DropTable, error) {
-	dt, ok := findByKey(raws.DropTables, func(d oapi.DropTable) string { return d.Id }, name)
+func GetDropTable(raws Master, name string) (oapi.DropTable, error) {
+	dt, ok := lookup(raws.DropTables, raws.idx().dropTables, name)
 	if !ok {
 		return oapi.DropTable{}, fmt.Errorf("key does not exist: %s", name)
 	}
@@ -553,8 +560,8 @@ func GetDropTable(raws oapi.Raws, name string) (oapi.DropTable, error) {
 var errItemGroupNotExist = errors.New("item group does not exist")
 
 // GetItemGroup は指定された名前のアイテムグループを取得する
-func GetItemGroup(raws oapi.Raws, name string) (oapi.ItemGroup, error) {
-	ig, ok := findByKey(raws.ItemGroups, func(g oapi.ItemGroup) string { return g.Id }, name)
+func GetItemGroup(raws Master, name string) (oapi.ItemGroup, error) {
+	ig, ok := lookup(raws.ItemGroups, raws.idx().itemGroups, name)
 	if !ok {
 		return oapi.ItemGroup{}, fmt.Errorf("%w: %s", errItemGroupNotExist, name)
 	}
@@ -562,8 +569,8 @@ func GetItemGroup(raws oapi.Raws, name string) (oapi.ItemGroup, error) {
 }
 
 // GetItemTable は指定された名前のアイテムテーブルを取得する
-func GetItemTable(raws oapi.Raws, name string) (oapi.ItemTable, error) {
-	it, ok := findByKey(raws.ItemTables, func(t oapi.ItemTable) string { return t.Id }, name)
+func GetItemTable(raws Master, name string) (oapi.ItemTable, error) {
+	it, ok := lookup(raws.ItemTables, raws.idx().itemTables, name)
 	if !ok {
 		return oapi.ItemTable{}, fmt.Errorf("key does not exist: %s", name)
 	}
@@ -571,8 +578,8 @@ func GetItemTable(raws oapi.Raws, name string) (oapi.ItemTable, error) {
 }
 
 // GetEnemyTable は指定された名前の敵テーブルを取得する
-func GetEnemyTable(raws oapi.Raws, name string) (oapi.EnemyTable, error) {
-	et, ok := findByKey(raws.EnemyTables, func(t oapi.EnemyTable) string { return t.Id }, name)
+func GetEnemyTable(raws Master, name string) (oapi.EnemyTable, error) {
+	et, ok := lookup(raws.EnemyTables, raws.idx().enemyTables, name)
 	if !ok {
 		return oapi.EnemyTable{}, fmt.Errorf("key does not exist: %s", name)
 	}
@@ -581,8 +588,8 @@ func GetEnemyTable(raws oapi.Raws, name string) (oapi.EnemyTable, error) {
 
 // GetTile は指定された名前のタイルを取得する
 // 計画段階でタイルの性質（Walkableなど）を参照する場合に使用する
-func GetTile(raws oapi.Raws, id string) (oapi.Tile, error) {
-	tile, ok := findByKey(raws.Tiles, func(t oapi.Tile) string { return t.Id }, id)
+func GetTile(raws Master, id string) (oapi.Tile, error) {
+	tile, ok := lookup(raws.Tiles, raws.idx().tiles, id)
 	if !ok {
 		return oapi.Tile{}, NewKeyNotFoundError(id, "Tiles")
 	}
@@ -591,7 +598,7 @@ func GetTile(raws oapi.Raws, id string) (oapi.Tile, error) {
 
 // NewTileSpec は指定された名前のタイルのEntitySpecを生成する
 // 実際にエンティティを生成する際に使用する
-func NewTileSpec(raws oapi.Raws, name string, x, y consts.Tile, autoTileIndex *int) (gc.EntitySpec, error) {
+func NewTileSpec(raws Master, name string, x, y consts.Tile, autoTileIndex *int) (gc.EntitySpec, error) {
 	tileRaw, err := GetTile(raws, name)
 	if err != nil {
 		return gc.EntitySpec{}, err
@@ -630,8 +637,8 @@ func NewTileSpec(raws oapi.Raws, name string, x, y consts.Tile, autoTileIndex *i
 }
 
 // GetProp は指定された名前の置物の設定を取得する
-func GetProp(raws oapi.Raws, name string) (oapi.Prop, error) {
-	prop, ok := findByKey(raws.Props, func(p oapi.Prop) string { return p.Id }, name)
+func GetProp(raws Master, name string) (oapi.Prop, error) {
+	prop, ok := lookup(raws.Props, raws.idx().props, name)
 	if !ok {
 		return oapi.Prop{}, NewKeyNotFoundError(name, "Props")
 	}
@@ -639,7 +646,7 @@ func GetProp(raws oapi.Raws, name string) (oapi.Prop, error) {
 }
 
 // NewPropSpec は指定された名前の置物のEntitySpecを生成する
-func NewPropSpec(raws oapi.Raws, name string) (gc.EntitySpec, error) {
+func NewPropSpec(raws Master, name string) (gc.EntitySpec, error) {
 	propRaw, err := GetProp(raws, name)
 	if err != nil {
 		return gc.EntitySpec{}, err
@@ -712,6 +719,9 @@ func NewPropSpec(raws oapi.Raws, name string) (gc.EntitySpec, error) {
 			return gc.EntitySpec{}, fmt.Errorf("container '%s' max weight: %w", name, err)
 		}
 		entitySpec.WeightCapacity = &gc.WeightCapacity{Max: mg}
+		if propRaw.Storage.Temperature != nil {
+			entitySpec.StorageTemperature = &gc.StorageTemperature{Celsius: *propRaw.Storage.Temperature}
+		}
 		interactions = append(interactions, gc.InteractionStorage)
 	}
 
@@ -724,6 +734,14 @@ func NewPropSpec(raws oapi.Raws, name string) (gc.EntitySpec, error) {
 		interactions = append(interactions, gc.InteractionDisassemble)
 	}
 
+	if propRaw.Lock != nil {
+		entitySpec.Lock = &gc.Lock{Difficulty: propRaw.Lock.Difficulty}
+		if propRaw.Lock.KeyId != nil {
+			entitySpec.Lock.KeyID = *propRaw.Lock.KeyId
+		}
+		interactions = append(interactions, gc.InteractionPickLock, gc.InteractionForceLock)
+	}
+
 	if len(interactions) > 0 {
 		entitySpec.Interactable = &gc.Interactable{Interactions: interactions}
 	}
@@ -732,34 +750,85 @@ func NewPropSpec(raws oapi.Raws, name string) (gc.EntitySpec, error) {
 }
 
 // FindDisassembly は指定された名前の分解定義を返す。propとitemの両方を探す
-func FindDisassembly(raws oapi.Raws, name string) (*oapi.Disassembly, bool) {
-	if prop, ok := findByKey(raws.Props, func(p oapi.Prop) string { return p.Id }, name); ok && prop.Disassembly != nil {
+func FindDisassembly(raws Master, name string) (*oapi.Disassembly, bool) {
+	if prop, ok := lookup(raws.Props, raws.idx().props, name); ok && prop.Disassembly != nil {
 		return prop.Disassembly, true
 	}
-	if item, ok := findByKey(raws.Items, func(i oapi.Item) string { return i.Id }, name); ok && item.Disassembly != nil {
+	if item, ok := lookup(raws.Items, raws.idx().items, name); ok && item.Disassembly != nil {
 		return item.Disassembly, true
 	}
 	return nil, false
 }
 
+// FindCraftStation は指定された名前の prop が合成の作業場なら、その種類を返す
+func FindCraftStation(raws Master, name string) (oapi.CraftStation, bool) {
+	prop, ok := lookup(raws.Props, raws.idx().props, name)
+	if !ok || prop.CraftStation == nil {
+		return "", false
+	}
+	return *prop.CraftStation, true
+}
+
 // FindDisassemblyTool は指定された名前のアイテムの分解工具定義を返す
-func FindDisassemblyTool(raws oapi.Raws, name string) (*oapi.DisassemblyTool, bool) {
-	item, ok := findByKey(raws.Items, func(i oapi.Item) string { return i.Id }, name)
+func FindDisassemblyTool(raws Master, name string) (*oapi.DisassemblyTool, bool) {
+	item, ok := lookup(raws.Items, raws.idx().items, name)
 	if !ok || item.DisassemblyTool == nil {
 		return nil, false
 	}
 	return item.DisassemblyTool, true
 }
 
+// FindLockKey は指定された名前のアイテムが鍵なら、合う錠の ID を返す
+func FindLockKey(raws Master, name string) (string, bool) {
+	item, ok := lookup(raws.Items, raws.idx().items, name)
+	if !ok || item.Key == nil {
+		return "", false
+	}
+	return *item.Key, true
+}
+
+// FindSounds は指定された id の item・prop・member が持つ効果音定義を返す。
+// 同じ id が複数の種類にあれば item、prop、member の順に先に見つかったものを採る
+func FindSounds(raws Master, id string) (oapi.SoundSet, bool) {
+	if item, ok := lookup(raws.Items, raws.idx().items, id); ok && item.Sounds != nil {
+		return *item.Sounds, true
+	}
+	if prop, ok := lookup(raws.Props, raws.idx().props, id); ok && prop.Sounds != nil {
+		return *prop.Sounds, true
+	}
+	if member, ok := lookup(raws.Members, raws.idx().members, id); ok && member.Sounds != nil {
+		return *member.Sounds, true
+	}
+	return oapi.SoundSet{}, false
+}
+
 // GetProfession は指定されたIDの職業データを返す
-func GetProfession(raws oapi.Raws, id string) (oapi.Profession, error) {
-	prof, ok := findByKey(raws.Professions, func(p oapi.Profession) string { return p.Id }, id)
+func GetProfession(raws Master, id string) (oapi.Profession, error) {
+	prof, ok := lookup(raws.Professions, raws.idx().professions, id)
 	if !ok {
 		return oapi.Profession{}, NewKeyNotFoundError(id, "Professions")
 	}
 	return prof, nil
 }
 
+// FindDialogue は指定されたIDの会話グラフを検索する。メンバーの dialog.messageKey で引く
+func FindDialogue(raws Master, id string) (oapi.Dialogue, error) {
+	d, ok := lookup(raws.Dialogues, raws.idx().dialogues, id)
+	if !ok {
+		return oapi.Dialogue{}, NewKeyNotFoundError(id, "Dialogues")
+	}
+	return d, nil
+}
+
+// FindQuest は指定されたIDのクエスト定義を検索する
+func FindQuest(raws Master, id string) (oapi.Quest, error) {
+	q, ok := lookup(raws.Quests, raws.idx().quests, id)
+	if !ok {
+		return oapi.Quest{}, NewKeyNotFoundError(id, "Quests")
+	}
+	return q, nil
+}
+
 // SelectCommandByWeight はコマンドテーブルから重み付きランダム選択する
 func SelectCommandByWeight(ct oapi.CommandTable, rng *rand.Rand) (string, error) {
 	return SelectByWeightFunc(
@@ -786,7 +855,7 @@ const MinDanger = 1
 
 // SelectItemByWeight はアイテムテーブルから危険度を考慮してグループ経由で重み付きランダム選択する
 // テーブルエントリからグループを選び、グループ内からアイテムを選択して返す
-func SelectItemByWeight(raws oapi.Raws, it oapi.ItemTable, rng *rand.Rand, danger int) (string, error) {
+func SelectItemByWeight(raws Master, it oapi.ItemTable, rng *rand.Rand, danger int) (string, error) {
 	if danger < MinDanger {
 		return "", fmt.Errorf("danger %d is below the minimum %d; the caller must specify a valid danger", danger, MinDanger)
 	}
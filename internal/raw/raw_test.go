@@ -22,8 +22,9 @@ Name = "回復薬"
 id = "回復薬"
 Description = "半分程度回復する"
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	items := PtrSlice(raws.Items)
 	assert.Len(t, items, 2)
@@ -40,8 +41,9 @@ id = "リペア"
 SpriteSheetName = "field"
 SpriteKey = "repair_item"
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 	entitySpec, err := NewItemSpec(raws, "リペア")
 	require.NoError(t, err)
 	assert.NotNil(t, entitySpec.Name)
@@ -57,8 +59,9 @@ Name = "テストアイテム"
 id = "テストアイテム"
 Description = "スプライトなしアイテム"
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	// 現在の実装ではスプライト情報なしでも生成される（デフォルト値が設定される）
 	entitySpec, err := NewItemSpec(raws, "テストアイテム")
@@ -86,8 +89,9 @@ Dexterity = 6
 Agility = 5
 Defense = 0
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 	entitySpec, err := NewPlayerSpec(raws, "テストプレイヤー")
 	require.NoError(t, err)
 
@@ -117,8 +121,9 @@ Dexterity = 6
 Agility = 5
 Defense = 0
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	// 現在の実装ではスプライト情報なしでも生成される（空文字列が設定される）
 	entitySpec, err := NewPlayerSpec(raws, "スプライトなしキャラ")
@@ -138,8 +143,9 @@ Description = "スプライト付き素材"
 SpriteSheetName = "field"
 SpriteKey = "field_item"
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 	entitySpec, err := NewItemSpec(raws, "テスト素材")
 	require.NoError(t, err)
 
@@ -162,8 +168,9 @@ Name = "スプライトなし素材"
 id = "スプライトなし素材"
 Description = "スプライトなし素材"
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	// 現在の実装ではスプライト情報なしでも生成される（デフォルト値が設定される）
 	entitySpec, err := NewItemSpec(raws, "スプライトなし素材")
@@ -195,8 +202,9 @@ id = "テストアイテム"
 Description = "テスト用"
 `
 
-	raws, err := DecodeRaws(tomlData)
+	decoded, err := DecodeRaws(tomlData)
 	require.NoError(t, err, "raw.goからの読み込みに失敗")
+	raws := NewMaster(decoded)
 
 	// 基本的なタイルが定義されていることを確認
 	expectedTiles := []string{"TestFloor", "TestWall"}
@@ -222,8 +230,9 @@ Description = "生成テスト用壁タイル"
 BlockPass = true
 `
 
-	raws, err := DecodeRaws(tomlData)
+	decoded, err := DecodeRaws(tomlData)
 	require.NoError(t, err, "テストTOMLの読み込みに失敗")
+	raws := NewMaster(decoded)
 
 	// 床タイルの取得をテスト
 	floorTile, err := GetTile(raws, "GenerateTestFloor")
@@ -258,8 +267,9 @@ Description = "ヘルパー関数テスト2"
 BlockPass = true
 `
 
-	raws, err := DecodeRaws(tomlData)
+	decoded, err := DecodeRaws(tomlData)
 	require.NoError(t, err, "テストTOMLの読み込みに失敗")
+	raws := NewMaster(decoded)
 
 	// GetTile のテスト（存在するタイル）
 	tileRaw, err := GetTile(raws, "Helper1")
@@ -294,8 +304,9 @@ Description = "壁のような性質"
 BlockPass = true
 `
 
-	raws, err := DecodeRaws(tomlData)
+	decoded, err := DecodeRaws(tomlData)
 	require.NoError(t, err, "テストTOMLの読み込みに失敗")
+	raws := NewMaster(decoded)
 
 	testCases := []struct {
 		name         string
@@ -317,9 +328,9 @@ BlockPass = true
 func TestLoadFromRealTileFile(t *testing.T) {
 	t.Parallel()
 
-	// 実際のraw.tomlファイルからタイル定義を読み込み
-	raws, err := LoadFromFile("metadata/entities/raw/raw.toml")
-	require.NoError(t, err, "実際のraw.tomlファイルの読み込みに失敗")
+	// 実際のローデータからタイル定義を読み込み
+	raws, err := LoadFromDir(DefaultDir, "")
+	require.NoError(t, err, "実際のローデータの読み込みに失敗")
 
 	// 基本的なタイルが定義されていることを確認
 	expectedTiles := []string{"floor", "wall", "dirt"}
@@ -377,8 +388,9 @@ id = "テストタイル"
 Description = "正常なタイル"
 `
 
-	raws, err := DecodeRaws(validToml)
+	decoded, err := DecodeRaws(validToml)
 	require.NoError(t, err, "正常なTOMLでエラーが発生してはいけない")
+	raws := NewMaster(decoded)
 	assert.Len(t, PtrSlice(raws.Items), 1, "アイテムが1つ読み込まれるべき")
 	assert.Len(t, PtrSlice(raws.Tiles), 1, "タイルが1つ読み込まれるべき")
 }
@@ -394,8 +406,9 @@ SpriteSheetName = "field"
 SpriteKey = "item_0"
 AnimKeys = ["item_0", "item_1"]
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	// AnimKeysが正しく読み込まれていることを確認
 	items := PtrSlice(raws.Items)
@@ -420,8 +433,9 @@ Description = "アニメーションしないアイテム"
 SpriteSheetName = "field"
 SpriteKey = "static_item"
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	// AnimKeysが指定されていない場合はnil
 	item := PtrSlice(raws.Items)[0]
@@ -452,8 +466,9 @@ Dexterity = 6
 Agility = 5
 Defense = 0
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	// AnimKeysが正しく読み込まれていることを確認
 	members := PtrSlice(raws.Members)
@@ -485,8 +500,9 @@ Dexterity = 6
 Agility = 5
 Defense = 0
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	// AnimKeysが指定されていない場合はnil
 	member := PtrSlice(raws.Members)[0]
@@ -515,8 +531,9 @@ SpriteSheetName = "field"
 SpriteKey = "fire_0_"
 Depth = 1
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	// AnimKeysが正しく読み込まれていることを確認
 	props := PtrSlice(raws.Props)
@@ -546,8 +563,9 @@ SpriteSheetName = "field"
 SpriteKey = "prop_table"
 Depth = 1
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	// AnimKeysが指定されていない場合はnil
 	prop := PtrSlice(raws.Props)[0]
@@ -576,8 +594,9 @@ SpriteSheetName = "field"
 SpriteKey = "prop_table"
 Depth = 1
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	_, err = NewPropSpec(raws, "矛盾Prop")
 	require.Error(t, err)
@@ -600,8 +619,9 @@ SpriteSheetName = "field"
 SpriteKey = "prop_table"
 Depth = 1
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	entitySpec, err := NewPropSpec(raws, "壊れるProp")
 	require.NoError(t, err)
@@ -629,8 +649,9 @@ SpriteSheetName = "field"
 SpriteKey = "prop_table"
 Depth = 1
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	entitySpec, err := NewPropSpec(raws, "壊れないProp")
 	require.NoError(t, err)
@@ -656,8 +677,9 @@ Depth = 2
 [Props.Storage]
 MaxWeight = "20 kg"
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	entitySpec, err := NewPropSpec(raws, "木箱")
 	require.NoError(t, err)
@@ -671,6 +693,36 @@ MaxWeight = "20 kg"
 	assert.True(t, ok, "Storage付きPropにはStorageInteractionが設定されるべき")
 }
 
+func TestPropWithStorageTemperature(t *testing.T) {
+	t.Parallel()
+	str := `
+[[Props]]
+Name = "保冷箱"
+id = "保冷箱"
+Description = "断熱材を張った箱"
+BlockPass = true
+BlockView = false
+
+[Props.SpriteRender]
+SpriteSheetName = "field"
+SpriteKey = "display_cooler"
+Depth = 2
+
+[Props.Storage]
+MaxWeight = "15 kg"
+Temperature = 3
+`
+	decoded, err := DecodeRaws(str)
+	require.NoError(t, err)
+	raws := NewMaster(decoded)
+
+	entitySpec, err := NewPropSpec(raws, "保冷箱")
+	require.NoError(t, err)
+
+	require.NotNil(t, entitySpec.StorageTemperature, "気温付きの収納にはStorageTemperatureが設定されるべき")
+	assert.Equal(t, 3, entitySpec.StorageTemperature.Celsius)
+}
+
 func TestPropWithWarpPrev(t *testing.T) {
 	t.Parallel()
 	str := `
@@ -688,8 +740,9 @@ Depth = 1
 
 [Props.WarpPrevTrigger]
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	entitySpec, err := NewPropSpec(raws, "上り階段")
 	require.NoError(t, err)
@@ -714,13 +767,15 @@ SpriteSheetName = "field"
 SpriteKey = "table"
 Depth = 1
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	entitySpec, err := NewPropSpec(raws, "テーブル")
 	require.NoError(t, err)
 
 	assert.Nil(t, entitySpec.WeightCapacity, "Storage定義のないPropにはWeightCapacityコンポーネントが設定されないべき")
+	assert.Nil(t, entitySpec.StorageTemperature, "Storage定義のないPropは気温を持たない")
 }
 
 func TestMemberCombatPolicy(t *testing.T) {
@@ -756,8 +811,9 @@ Dexterity = 3
 Agility = 3
 Defense = 2
 `
-			raws, err := DecodeRaws(toml)
+			decoded, err := DecodeRaws(toml)
 			require.NoError(t, err)
+			raws := NewMaster(decoded)
 
 			entitySpec, err := NewMemberSpec(raws, "テスト敵")
 			require.NoError(t, err)
@@ -787,8 +843,9 @@ Dexterity = 3
 Agility = 3
 Defense = 2
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	entitySpec, err := NewMemberSpec(raws, "態度なし")
 	require.NoError(t, err)
@@ -829,8 +886,9 @@ Dexterity = 3
 Agility = 3
 Defense = 2
 `
-			raws, err := DecodeRaws(toml)
+			decoded, err := DecodeRaws(toml)
 			require.NoError(t, err)
+			raws := NewMaster(decoded)
 
 			entitySpec, err := NewMemberSpec(raws, "テスト敵")
 			require.NoError(t, err)
@@ -858,8 +916,9 @@ AttackCount = 1
 Element = "none"
 AttackCategory = "SWORD"
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	spec, err := NewWeaponSpec(raws, "テスト剣")
 	require.NoError(t, err)
@@ -876,8 +935,9 @@ Name = "回復薬"
 id = "回復薬"
 Description = "回復するアイテム"
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	_, err = NewWeaponSpec(raws, "回復薬")
 	assert.Error(t, err, "Melee/Fireを持たないアイテムは武器ではない")
@@ -891,8 +951,9 @@ func TestNewWeaponSpec_NotFound(t *testing.T) {
 Name = "何か"
 id = "何か"
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	_, err = NewWeaponSpec(raws, "存在しない")
 	assert.Error(t, err)
@@ -915,8 +976,9 @@ Dexterity = 3
 Agility = 3
 Defense = 2
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	spec, err := NewEnemySpec(raws, "テスト敵")
 	require.NoError(t, err)
@@ -935,8 +997,9 @@ Description = "テスト床"
 BlockPass = false
 BlockView = false
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	spec, err := NewTileSpec(raws, "test_floor", 5, 10, nil)
 	require.NoError(t, err)
@@ -957,8 +1020,9 @@ Description = "テスト壁"
 BlockPass = true
 BlockView = true
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	spec, err := NewTileSpec(raws, "test_wall", 3, 7, nil)
 	require.NoError(t, err)
@@ -979,8 +1043,9 @@ Description = "オートタイル"
 SpriteSheetName = "field"
 SpriteKey = "wall"
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	spec, err := NewTileSpec(raws, "auto_tile", 0, 0, new(5))
 	require.NoError(t, err)
@@ -990,7 +1055,7 @@ SpriteKey = "wall"
 func TestGetItemTable(t *testing.T) {
 	t.Parallel()
 
-	raws, err := LoadFromFile("metadata/entities/raw/raw.toml")
+	raws, err := LoadFromDir(DefaultDir, "")
 	require.NoError(t, err)
 
 	// ItemTablesが存在する場合のテスト
@@ -1009,7 +1074,7 @@ func TestGetItemTable(t *testing.T) {
 func TestGetEnemyTable(t *testing.T) {
 	t.Parallel()
 
-	raws, err := LoadFromFile("metadata/entities/raw/raw.toml")
+	raws, err := LoadFromDir(DefaultDir, "")
 	require.NoError(t, err)
 
 	// EnemyTablesが存在する場合のテスト
@@ -1034,8 +1099,9 @@ Name = "回復薬"
 id = "item_heal"
 Description = "半分程度回復する"
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	assert.Equal(t, "回復薬", ItemName(raws, "item_heal"))
 }
@@ -1043,8 +1109,9 @@ Description = "半分程度回復する"
 func TestItemName_存在しないIDはIDをそのまま返す(t *testing.T) {
 	t.Parallel()
 
-	raws, err := DecodeRaws("")
+	decoded, err := DecodeRaws("")
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	assert.Equal(t, "存在しないID", ItemName(raws, "存在しないID"))
 }
@@ -1067,11 +1134,79 @@ Dexterity = 3
 Agility = 3
 Defense = 2
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	entitySpec, err := NewMemberSpec(raws, "パターンなし")
 	require.NoError(t, err)
 	require.NotNil(t, entitySpec.SoloAI)
 	assert.Empty(t, entitySpec.SoloAI.Movement)
 }
+
+func TestFindSounds(t *testing.T) {
+	t.Parallel()
+
+	str := `
+[[Items]]
+Name = "拳銃"
+id = "pistol"
+Description = "拳銃"
+SpriteSheetName = "field"
+SpriteKey = "pistol"
+Value = 100
+[Items.Sounds]
+Hit = "gunshot"
+Reload = "pistol_reload"
+
+[[Props]]
+Name = "扉"
+id = "iron_door"
+Description = "扉"
+BlockPass = true
+BlockView = true
+[Props.SpriteRender]
+SpriteSheetName = "field"
+SpriteKey = "door"
+[Props.Sounds]
+Open = "iron_door_open"
+
+[[Props]]
+Name = "机"
+id = "desk"
+Description = "机"
+BlockPass = true
+BlockView = false
+[Props.SpriteRender]
+SpriteSheetName = "field"
+SpriteKey = "desk"
+`
+	decoded, err := DecodeRaws(str)
+	require.NoError(t, err)
+	raws := NewMaster(decoded)
+
+	t.Run("itemの効果音を返す", func(t *testing.T) {
+		t.Parallel()
+		got, ok := FindSounds(raws, "pistol")
+		require.True(t, ok)
+		require.NotNil(t, got.Hit)
+		assert.Equal(t, "gunshot", *got.Hit)
+		assert.Nil(t, got.Miss, "書いていない出来事は nil のまま")
+	})
+
+	t.Run("propの効果音を返す", func(t *testing.T) {
+		t.Parallel()
+		got, ok := FindSounds(raws, "iron_door")
+		require.True(t, ok)
+		require.NotNil(t, got.Open)
+		assert.Equal(t, "iron_door_open", *got.Open)
+	})
+
+	t.Run("効果音を持たない定義と存在しない id はfalseを返す", func(t *testing.T) {
+		t.Parallel()
+		_, ok := FindSounds(raws, "desk")
+		assert.False(t, ok)
+		_, ok = FindSounds(raws, "存在しないid")
+		assert.False(t, ok)
+	})
+}
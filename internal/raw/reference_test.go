@@ -7,16 +7,15 @@ import (
 	"testing"
 
 	"github.com/kijimaD/ruins/assets"
-	"github.com/kijimaD/ruins/internal/oapi"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-// loadTestRaws はテスト用にraw.tomlを読み込む
-func loadTestRaws(t *testing.T) oapi.Raws {
+// loadTestRaws はテスト用に本体のローデータを読み込む
+func loadTestRaws(t *testing.T) Master {
 	t.Helper()
-	raws, err := LoadFromFile("metadata/entities/raw/raw.toml")
-	require.NoError(t, err, "raw.tomlの読み込みに失敗")
+	raws, err := LoadFromDir(DefaultDir, "")
+	require.NoError(t, err, "ローデータの読み込みに失敗")
 	return raws
 }
 
@@ -339,7 +338,7 @@ func TestRawDuplicateNames(t *testing.T) {
 }
 
 // buildSpriteSheetSprites はSpriteSheetからスプライトキー一覧を構築する
-func buildSpriteSheetSprites(t *testing.T, raws oapi.Raws) map[string]map[string]bool {
+func buildSpriteSheetSprites(t *testing.T, raws Master) map[string]map[string]bool {
 	t.Helper()
 	spriteSheetSprites := make(map[string]map[string]bool)
 
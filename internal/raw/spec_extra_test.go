@@ -34,8 +34,9 @@ Amount = 0
 Ratio = 0.5
 ValueType = "PERCENTAGE"
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	spec, err := NewItemSpec(raws, "回復薬")
 	require.NoError(t, err)
@@ -72,8 +73,9 @@ Amount = 15
 Ratio = 0
 ValueType = "NUMERAL"
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	spec, err := NewItemSpec(raws, "包帯")
 	require.NoError(t, err)
@@ -97,8 +99,9 @@ AccuracyBonus = 5
 AmmoTag = "9mm"
 DamageBonus = -2
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	spec, err := NewItemSpec(raws, "9mm弾")
 	require.NoError(t, err)
@@ -128,8 +131,9 @@ InsulationHeat = 2
 Agility = 1
 Dexterity = 1
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	spec, err := NewItemSpec(raws, "革靴")
 	require.NoError(t, err)
@@ -158,8 +162,9 @@ MaxLevel = 2
 RequiredLevel = 0
 TargetSkill = "exploration"
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	spec, err := NewItemSpec(raws, "探索の本")
 	require.NoError(t, err)
@@ -183,8 +188,9 @@ Description = "何も書かれていない"
 [Items.Book]
 TotalEffort = 10
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	_, err = NewItemSpec(raws, "白紙の本")
 	require.Error(t, err)
@@ -208,8 +214,9 @@ MaxLevel = 1
 RequiredLevel = 0
 TargetSkill = "unknown_skill"
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	_, err = NewItemSpec(raws, "謎の本")
 	require.Error(t, err)
@@ -226,8 +233,9 @@ id = "不正な重量アイテム"
 Description = "重量表記がおかしい"
 Weight = "abc"
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	_, err = NewItemSpec(raws, "不正な重量アイテム")
 	require.Error(t, err)
@@ -263,8 +271,9 @@ Amount = 4
 Id = "フェライトコア"
 Amount = 2
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	spec, err := NewRecipeSpec(raws, "レイガン")
 	require.NoError(t, err)
@@ -279,11 +288,50 @@ Amount = 2
 	assert.Equal(t, 20, spec.Melee.Damage)
 }
 
+func TestNewRecipeSpec_作業場と工具と作業量が設定される(t *testing.T) {
+	t.Parallel()
+
+	str := `
+[[Items]]
+Name = "木刀"
+id = "木刀"
+
+[[Recipes]]
+Name = "木刀"
+id = "木刀"
+station = "workbench"
+workAP = 2000
+
+[Recipes.tool]
+category = "cutting"
+grade = 2
+
+[[Recipes.Inputs]]
+Id = "木の棒"
+Amount = 2
+`
+	decoded, err := DecodeRaws(str)
+	require.NoError(t, err)
+	raws := NewMaster(decoded)
+
+	spec, err := NewRecipeSpec(raws, "木刀")
+	require.NoError(t, err)
+
+	require.NotNil(t, spec.Recipe)
+	require.NotNil(t, spec.Recipe.Station)
+	assert.Equal(t, oapi.CraftStationWorkbench, *spec.Recipe.Station)
+	require.NotNil(t, spec.Recipe.Tool)
+	assert.Equal(t, oapi.Cutting, spec.Recipe.Tool.Category)
+	assert.Equal(t, 2, spec.Recipe.Tool.Grade)
+	assert.Equal(t, 2000, spec.Recipe.WorkAP)
+}
+
 func TestNewRecipeSpec_レシピ未存在はエラー(t *testing.T) {
 	t.Parallel()
 
-	raws, err := DecodeRaws("")
+	decoded, err := DecodeRaws("")
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	_, err = NewRecipeSpec(raws, "存在しないレシピ")
 	require.Error(t, err)
@@ -301,8 +349,9 @@ id = "対応アイテムなしレシピ"
 Id = "鉄"
 Amount = 1
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	_, err = NewRecipeSpec(raws, "対応アイテムなしレシピ")
 	require.Error(t, err)
@@ -325,8 +374,9 @@ Dexterity = 3
 Agility = 3
 Defense = 2
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	_, err = NewMemberSpec(raws, "不正派閥")
 	require.Error(t, err)
@@ -349,8 +399,9 @@ Dexterity = 3
 Agility = 3
 Defense = 2
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	_, err = NewMemberSpec(raws, "不正戦闘方針")
 	require.Error(t, err)
@@ -382,8 +433,9 @@ G = 200
 B = 50
 A = 255
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	spec, err := NewMemberSpec(raws, "光る敵")
 	require.NoError(t, err)
@@ -411,8 +463,9 @@ Defense = 2
 [Members.Dialog]
 MessageKey = "villager_greeting"
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	spec, err := NewMemberSpec(raws, "話す村人")
 	require.NoError(t, err)
@@ -432,8 +485,9 @@ Id = "hunter"
 Name = "猟師"
 Description = "野外活動に長けた生存者"
 `
-	raws, err := DecodeRaws(str)
+	decoded, err := DecodeRaws(str)
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	prof, err := GetProfession(raws, "hunter")
 	require.NoError(t, err)
@@ -445,8 +499,9 @@ Description = "野外活動に長けた生存者"
 func TestGetProfession_未存在はエラー(t *testing.T) {
 	t.Parallel()
 
-	raws, err := DecodeRaws("")
+	decoded, err := DecodeRaws("")
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	_, err = GetProfession(raws, "存在しない職業")
 	require.Error(t, err)
@@ -455,8 +510,9 @@ func TestGetProfession_未存在はエラー(t *testing.T) {
 func TestGetItemGroup_未存在はエラー(t *testing.T) {
 	t.Parallel()
 
-	raws, err := DecodeRaws("")
+	decoded, err := DecodeRaws("")
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	_, err = GetItemGroup(raws, "存在しないグループ")
 	require.Error(t, err)
@@ -466,8 +522,9 @@ func TestGetItemGroup_未存在はエラー(t *testing.T) {
 func TestGetProp_未存在はエラー(t *testing.T) {
 	t.Parallel()
 
-	raws, err := DecodeRaws("")
+	decoded, err := DecodeRaws("")
 	require.NoError(t, err)
+	raws := NewMaster(decoded)
 
 	_, err = GetProp(raws, "存在しないProp")
 	require.Error(t, err)
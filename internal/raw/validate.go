@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	gc "github.com/kijimaD/ruins/internal/components"
 	"github.com/kijimaD/ruins/internal/consts"
 	"github.com/kijimaD/ruins/internal/oapi"
 )
@@ -18,12 +19,49 @@ var (
 	errDropTableMaterialUndefined     = errors.New("drop table references undefined material")
 	errMemberDropTableUndefined       = errors.New("member references undefined drop table")
 	errMemberCommandTableUndefined    = errors.New("member references undefined command table")
+	errDungeonEnemyTableUndefined     = errors.New("dungeon references undefined enemy table")
+	errDungeonItemTableUndefined      = errors.New("dungeon references undefined item table")
+	errDialogueNoNodes                = errors.New("dialogue has no nodes")
+	errDialogueDuplicateNode          = errors.New("dialogue has duplicate node id")
+	errDialogueNodeUndefined          = errors.New("dialogue references undefined node")
+	errDialogueItemUndefined          = errors.New("dialogue references undefined item")
+	errDialogueSkillUndefined         = errors.New("dialogue references undefined skill")
+	errDialogueQuestUndefined         = errors.New("dialogue references undefined quest")
+	errDialogueConditionKind          = errors.New("dialogue condition must set exactly one of item, event, skill, relation, quest")
+	errDialogueEffectKind             = errors.New("dialogue effect must set exactly one of giveItem, takeItem, setEvent, currency, openShop, startQuest")
+	errQuestNoObjectives              = errors.New("quest has no objectives")
+	errQuestObjectiveKind             = errors.New("quest objective must set exactly one of collect, deliver, kill, reach, surviveDays")
+	errQuestDeliverRecipient          = errors.New("quest objective must set to with deliver and only with deliver")
+	errQuestItemUndefined             = errors.New("quest references undefined item")
+	errQuestMemberUndefined           = errors.New("quest references undefined member")
+	errLockKeyUndefined               = errors.New("lock references key that no item opens")
 	errDisassemblyYieldUndefined      = errors.New("disassembly yield references undefined item")
 	errDisassemblyBonusUndefined      = errors.New("disassembly bonus references undefined item")
 	errInvalidPackNotation            = errors.New("invalid pack notation")
 	errInvalidLootCountNotation       = errors.New("invalid lootCount notation")
+	errDuplicateID                    = errors.New("duplicate id")
 )
 
+// entryError は検証エラーを起こした定義の位置を持つ。ValidateReferences が Sources で出どころのファイルへ引き直す
+type entryError struct {
+	collection string
+	index      int
+	err        error
+}
+
+func (e *entryError) Error() string {
+	return e.err.Error()
+}
+
+func (e *entryError) Unwrap() error {
+	return e.err
+}
+
+// atEntry は err に collection の index 番目の定義が起こしたという位置を付ける
+func atEntry(collection string, index int, err error) error {
+	return &entryError{collection: collection, index: index, err: err}
+}
+
 // ValidateRaws はoapi.RawsをOpenAPIスキーマの VisitJSON で一括検証する
 func ValidateRaws(raws oapi.Raws) error {
 	spec, err := oapi.GetSpec()
@@ -57,10 +95,43 @@ func ValidateRaws(raws oapi.Raws) error {
 	return nil
 }
 
-// ValidateReferences は定義間の名前参照の整合を検証する。
+// ValidateReferences は id の重複と定義間の名前参照の整合を検証する。
 // スキーマ検証は名前の参照整合を見ないため、ここで補う。実行時の解決失敗を
-// ロード時エラーに前倒しする
-func ValidateReferences(raws oapi.Raws) error {
+// ロード時エラーに前倒しする。sources を渡すと、エラーに問題の定義があるファイル名を添える。nil でもよい
+func ValidateReferences(raws oapi.Raws, sources Sources) error {
+	if err := validateUniqueIDs(raws, sources); err != nil {
+		return err
+	}
+	err := validateReferences(raws)
+	var ee *entryError
+	if errors.As(err, &ee) {
+		if file := sources.File(ee.collection, ee.index); file != "" {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+	}
+	return err
+}
+
+// validateUniqueIDs は同じコレクションに同じ id の定義が2つ無いことを検証する。
+// 断片や MOD をまたいだ衝突では、両方のファイル名を添える
+func validateUniqueIDs(raws oapi.Raws, sources Sources) error {
+	for _, c := range rawCollections {
+		id, first, second, found := c.duplicate(raws)
+		if !found {
+			continue
+		}
+		name := c.collectionName()
+		firstFile, secondFile := sources.File(name, first), sources.File(name, second)
+		if firstFile == "" && secondFile == "" {
+			return fmt.Errorf("%s %q: %w", name, id, errDuplicateID)
+		}
+		return fmt.Errorf("%s %q defined in both %s and %s: %w", name, id, firstFile, secondFile, errDuplicateID)
+	}
+	return nil
+}
+
+// validateReferences は定義間の名前参照の整合を順に検証する
+func validateReferences(raws oapi.Raws) error {
 	if err := validateDisassemblyReferences(raws); err != nil {
 		return err
 	}
@@ -82,9 +153,255 @@ func ValidateReferences(raws oapi.Raws) error {
 	if err := validateEnemyTableReferences(raws); err != nil {
 		return err
 	}
+	if err := validateDungeonReferences(raws); err != nil {
+		return err
+	}
+	if err := validateDialogueReferences(raws); err != nil {
+		return err
+	}
+	if err := validateQuestReferences(raws); err != nil {
+		return err
+	}
+	if err := validateLockReferences(raws); err != nil {
+		return err
+	}
 	return validateCommandTableWeaponReferences(raws)
 }
 
+// validateLockReferences は錠の keyId に合う鍵がアイテムに1つはあることを検証する。
+// 鍵の無い錠は解錠か破錠でしか開かないので、keyId を省略して表す
+func validateLockReferences(raws oapi.Raws) error {
+	keys := make(map[string]struct{})
+	for _, item := range PtrSlice(raws.Items) {
+		if item.Key != nil {
+			keys[*item.Key] = struct{}{}
+		}
+	}
+	props := PtrSlice(raws.Props)
+	for i := range props {
+		lock := props[i].Lock
+		if lock == nil || lock.KeyId == nil {
+			continue
+		}
+		if _, ok := keys[*lock.KeyId]; !ok {
+			return atEntry("props", i, fmt.Errorf("prop %q lock key %q: %w", props[i].Id, *lock.KeyId, errLockKeyUndefined))
+		}
+	}
+	return nil
+}
+
+// validateDungeonReferences はダンジョンの敵テーブルとアイテムテーブルの id が定義に存在することを検証する。
+// 省略と空文字は湧かせない指定として扱う。プランナー名は mapplanner に依存するので dungeon パッケージで検証する
+func validateDungeonReferences(raws oapi.Raws) error {
+	enemyTables := make(map[string]struct{})
+	for _, t := range PtrSlice(raws.EnemyTables) {
+		enemyTables[t.Id] = struct{}{}
+	}
+	itemTables := make(map[string]struct{})
+	for _, t := range PtrSlice(raws.ItemTables) {
+		itemTables[t.Id] = struct{}{}
+	}
+
+	dungeons := PtrSlice(raws.Dungeons)
+	for i := range dungeons {
+		if id := dungeons[i].EnemyTableId; id != nil && *id != "" {
+			if _, ok := enemyTables[*id]; !ok {
+				return atEntry("dungeons", i, fmt.Errorf("dungeon %q enemy table %q: %w", dungeons[i].Name, *id, errDungeonEnemyTableUndefined))
+			}
+		}
+		if id := dungeons[i].ItemTableId; id != nil && *id != "" {
+			if _, ok := itemTables[*id]; !ok {
+				return atEntry("dungeons", i, fmt.Errorf("dungeon %q item table %q: %w", dungeons[i].Name, *id, errDungeonItemTableUndefined))
+			}
+		}
+	}
+	return nil
+}
+
+// dialogueRefs は会話の条件と効果が指せる定義の id 集合
+type dialogueRefs struct {
+	items  map[string]struct{}
+	quests map[string]struct{}
+}
+
+// validateDialogueReferences は会話グラフの遷移先ノードと、条件・効果が指すアイテム、スキル、クエストが定義に存在することを検証する。
+// 条件と効果はスキーマ上どれも省略できるので、種類をちょうど1つ指定していることもここで確かめる
+func validateDialogueReferences(raws oapi.Raws) error {
+	refs := dialogueRefs{items: make(map[string]struct{}), quests: make(map[string]struct{})}
+	for _, item := range PtrSlice(raws.Items) {
+		refs.items[item.Id] = struct{}{}
+	}
+	for _, q := range PtrSlice(raws.Quests) {
+		refs.quests[q.Id] = struct{}{}
+	}
+
+	dialogues := PtrSlice(raws.Dialogues)
+	for i, d := range dialogues {
+		if err := validateDialogue(d, refs); err != nil {
+			return atEntry("dialogues", i, fmt.Errorf("dialogue %q: %w", d.Id, err))
+		}
+	}
+	return nil
+}
+
+// validateDialogue は1つの会話グラフを検証する
+func validateDialogue(d oapi.Dialogue, refs dialogueRefs) error {
+	if len(d.Nodes) == 0 {
+		return errDialogueNoNodes
+	}
+	nodes := make(map[string]struct{}, len(d.Nodes))
+	for _, node := range d.Nodes {
+		if _, dup := nodes[node.Id]; dup {
+			return fmt.Errorf("node %q: %w", node.Id, errDialogueDuplicateNode)
+		}
+		nodes[node.Id] = struct{}{}
+	}
+	checkNext := func(next *string) error {
+		if next == nil {
+			return nil
+		}
+		if _, ok := nodes[*next]; !ok {
+			return fmt.Errorf("next %q: %w", *next, errDialogueNodeUndefined)
+		}
+		return nil
+	}
+
+	for _, node := range d.Nodes {
+		if err := checkNext(node.Next); err != nil {
+			return fmt.Errorf("node %q: %w", node.Id, err)
+		}
+		for j, choice := range PtrSlice(node.Choices) {
+			if err := checkNext(choice.Next); err != nil {
+				return fmt.Errorf("node %q choice %d: %w", node.Id, j, err)
+			}
+			for _, cond := range PtrSlice(choice.Conditions) {
+				if err := validateDialogueCondition(cond, refs); err != nil {
+					return fmt.Errorf("node %q choice %d: %w", node.Id, j, err)
+				}
+			}
+			for _, eff := range PtrSlice(choice.Effects) {
+				if err := validateDialogueEffect(eff, refs); err != nil {
+					return fmt.Errorf("node %q choice %d: %w", node.Id, j, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// validateDialogueCondition は条件の種類が1つだけで、参照先が定義に存在することを検証する
+func validateDialogueCondition(cond oapi.DialogueCondition, refs dialogueRefs) error {
+	if countSet(cond.Item != nil, cond.Event != nil, cond.Skill != nil, cond.Relation != nil, cond.Quest != nil) != 1 {
+		return errDialogueConditionKind
+	}
+	if cond.Item != nil {
+		if _, ok := refs.items[*cond.Item]; !ok {
+			return fmt.Errorf("condition item %q: %w", *cond.Item, errDialogueItemUndefined)
+		}
+	}
+	if cond.Skill != nil && !gc.HasSkillName(gc.SkillID(*cond.Skill)) {
+		return fmt.Errorf("condition skill %q: %w", *cond.Skill, errDialogueSkillUndefined)
+	}
+	if cond.Quest != nil {
+		if _, ok := refs.quests[*cond.Quest]; !ok {
+			return fmt.Errorf("condition quest %q: %w", *cond.Quest, errDialogueQuestUndefined)
+		}
+	}
+	return nil
+}
+
+// validateDialogueEffect は効果の種類が1つだけで、参照先が定義に存在することを検証する
+func validateDialogueEffect(eff oapi.DialogueEffect, refs dialogueRefs) error {
+	if countSet(eff.GiveItem != nil, eff.TakeItem != nil, eff.SetEvent != nil, eff.Currency != nil, eff.OpenShop != nil, eff.StartQuest != nil) != 1 {
+		return errDialogueEffectKind
+	}
+	for _, id := range []*string{eff.GiveItem, eff.TakeItem} {
+		if id == nil {
+			continue
+		}
+		if _, ok := refs.items[*id]; !ok {
+			return fmt.Errorf("effect item %q: %w", *id, errDialogueItemUndefined)
+		}
+	}
+	if eff.StartQuest != nil {
+		if _, ok := refs.quests[*eff.StartQuest]; !ok {
+			return fmt.Errorf("effect quest %q: %w", *eff.StartQuest, errDialogueQuestUndefined)
+		}
+	}
+	return nil
+}
+
+// validateQuestReferences はクエストの目標と報酬が指すアイテムとメンバーが定義に存在することを検証する。
+// 目標はスキーマ上どれも省略できるので、種類をちょうど1つ指定していることもここで確かめる
+func validateQuestReferences(raws oapi.Raws) error {
+	items := make(map[string]struct{})
+	for _, item := range PtrSlice(raws.Items) {
+		items[item.Id] = struct{}{}
+	}
+	members := make(map[string]struct{})
+	for _, m := range PtrSlice(raws.Members) {
+		members[m.Id] = struct{}{}
+	}
+
+	quests := PtrSlice(raws.Quests)
+	for i, q := range quests {
+		if err := validateQuest(q, items, members); err != nil {
+			return atEntry("quests", i, fmt.Errorf("quest %q: %w", q.Id, err))
+		}
+	}
+	return nil
+}
+
+// validateQuest は1つのクエスト定義を検証する
+func validateQuest(q oapi.Quest, items, members map[string]struct{}) error {
+	if len(q.Objectives) == 0 {
+		return errQuestNoObjectives
+	}
+	for j, obj := range q.Objectives {
+		if countSet(obj.Collect != nil, obj.Deliver != nil, obj.Kill != nil, obj.Reach != nil, obj.SurviveDays != nil) != 1 {
+			return fmt.Errorf("objective %d: %w", j, errQuestObjectiveKind)
+		}
+		if (obj.Deliver != nil) != (obj.To != nil) {
+			return fmt.Errorf("objective %d: %w", j, errQuestDeliverRecipient)
+		}
+		for _, id := range []*string{obj.Collect, obj.Deliver} {
+			if id == nil {
+				continue
+			}
+			if _, ok := items[*id]; !ok {
+				return fmt.Errorf("objective %d item %q: %w", j, *id, errQuestItemUndefined)
+			}
+		}
+		for _, id := range []*string{obj.Kill, obj.To} {
+			if id == nil {
+				continue
+			}
+			if _, ok := members[*id]; !ok {
+				return fmt.Errorf("objective %d member %q: %w", j, *id, errQuestMemberUndefined)
+			}
+		}
+	}
+	if q.Reward != nil {
+		for _, it := range PtrSlice(q.Reward.Items) {
+			if _, ok := items[it.Id]; !ok {
+				return fmt.Errorf("reward item %q: %w", it.Id, errQuestItemUndefined)
+			}
+		}
+	}
+	return nil
+}
+
+// countSet は真の個数を返す
+func countSet(flags ...bool) int {
+	n := 0
+	for _, f := range flags {
+		if f {
+			n++
+		}
+	}
+	return n
+}
+
 // validateItemTableReferences はアイテムテーブルの参照グループ id がアイテムグループ定義に存在することを検証する。
 // 空文字は参照なしとして扱い、非空の参照だけを検証する
 func validateItemTableReferences(raws oapi.Raws) error {
@@ -101,7 +418,7 @@ func validateItemTableReferences(raws oapi.Raws) error {
 				continue
 			}
 			if _, ok := groupNames[entry.Id]; !ok {
-				return fmt.Errorf("item table %q references group %q: %w", itemTables[i].Name, entry.Id, errItemTableRefUndefinedGroup)
+				return atEntry("itemTables", i, fmt.Errorf("item table %q references group %q: %w", itemTables[i].Name, entry.Id, errItemTableRefUndefinedGroup))
 			}
 		}
 	}
@@ -123,7 +440,7 @@ func validateItemGroupReferences(raws oapi.Raws) error {
 				continue
 			}
 			if _, ok := itemNames[entry.Id]; !ok {
-				return fmt.Errorf("item group %q references item %q: %w", groups[i].Name, entry.Id, errItemGroupRefUndefinedItem)
+				return atEntry("itemGroups", i, fmt.Errorf("item group %q references item %q: %w", groups[i].Name, entry.Id, errItemGroupRefUndefinedItem))
 			}
 		}
 	}
@@ -145,7 +462,7 @@ func validateEnemyTableReferences(raws oapi.Raws) error {
 				continue
 			}
 			if _, ok := memberNames[entry.Id]; !ok {
-				return fmt.Errorf("enemy table %q references enemy %q: %w", enemyTables[i].Name, entry.Id, errEnemyTableRefUndefinedEnemy)
+				return atEntry("enemyTables", i, fmt.Errorf("enemy table %q references enemy %q: %w", enemyTables[i].Name, entry.Id, errEnemyTableRefUndefinedEnemy))
 			}
 		}
 	}
@@ -168,7 +485,7 @@ func validateCommandTableWeaponReferences(raws oapi.Raws) error {
 				continue
 			}
 			if _, ok := itemNames[entry.Weapon]; !ok {
-				return fmt.Errorf("command table %q references weapon %q: %w", commandTables[i].Name, entry.Weapon, errCommandTableRefUndefinedWeapon)
+				return atEntry("commandTables", i, fmt.Errorf("command table %q references weapon %q: %w", commandTables[i].Name, entry.Weapon, errCommandTableRefUndefinedWeapon))
 			}
 		}
 	}
@@ -183,7 +500,7 @@ func validateSpawnDice(raws oapi.Raws) error {
 	for i := range enemyTables {
 		for _, e := range enemyTables[i].Entries {
 			if _, err := consts.ParseDice(e.Pack); err != nil {
-				return fmt.Errorf("enemy table %q entry %q has %w: %w", enemyTables[i].Name, e.Id, errInvalidPackNotation, err)
+				return atEntry("enemyTables", i, fmt.Errorf("enemy table %q entry %q has %w: %w", enemyTables[i].Name, e.Id, errInvalidPackNotation, err))
 			}
 		}
 	}
@@ -191,7 +508,7 @@ func validateSpawnDice(raws oapi.Raws) error {
 	for i := range itemGroups {
 		for _, e := range itemGroups[i].Entries {
 			if _, err := consts.ParseDice(e.Pack); err != nil {
-				return fmt.Errorf("item group %q entry %q has %w: %w", itemGroups[i].Name, e.Id, errInvalidPackNotation, err)
+				return atEntry("itemGroups", i, fmt.Errorf("item group %q entry %q has %w: %w", itemGroups[i].Name, e.Id, errInvalidPackNotation, err))
 			}
 		}
 	}
@@ -201,7 +518,7 @@ func validateSpawnDice(raws oapi.Raws) error {
 			continue
 		}
 		if _, err := consts.ParseDice(*props[i].Storage.LootCount); err != nil {
-			return fmt.Errorf("container %q has %w: %w", props[i].Name, errInvalidLootCountNotation, err)
+			return atEntry("props", i, fmt.Errorf("container %q has %w: %w", props[i].Name, errInvalidLootCountNotation, err))
 		}
 	}
 	return nil
@@ -244,12 +561,12 @@ func validateDisassemblyReferences(raws oapi.Raws) error {
 	props := PtrSlice(raws.Props)
 	for i := range props {
 		if err := check("prop", props[i].Name, props[i].Disassembly); err != nil {
-			return err
+			return atEntry("props", i, err)
 		}
 	}
 	for i := range items {
 		if err := check("item", items[i].Name, items[i].Disassembly); err != nil {
-			return err
+			return atEntry("items", i, err)
 		}
 	}
 	return nil
@@ -274,7 +591,7 @@ func validateDropTableReferences(raws oapi.Raws) error {
 				continue
 			}
 			if _, ok := itemNames[entry.Material]; !ok {
-				return fmt.Errorf("drop table %q material %q: %w", dropTables[i].Name, entry.Material, errDropTableMaterialUndefined)
+				return atEntry("dropTables", i, fmt.Errorf("drop table %q material %q: %w", dropTables[i].Name, entry.Material, errDropTableMaterialUndefined))
 			}
 		}
 	}
@@ -286,7 +603,7 @@ func validateDropTableReferences(raws oapi.Raws) error {
 			continue
 		}
 		if _, ok := tableNames[*members[i].DropTableId]; !ok {
-			return fmt.Errorf("member %q drop table %q: %w", members[i].Name, *members[i].DropTableId, errMemberDropTableUndefined)
+			return atEntry("members", i, fmt.Errorf("member %q drop table %q: %w", members[i].Name, *members[i].DropTableId, errMemberDropTableUndefined))
 		}
 	}
 	return nil
@@ -307,7 +624,7 @@ func validateCommandTableReferences(raws oapi.Raws) error {
 			continue
 		}
 		if _, ok := tableNames[*members[i].CommandTableId]; !ok {
-			return fmt.Errorf("member %q command table %q: %w", members[i].Name, *members[i].CommandTableId, errMemberCommandTableUndefined)
+			return atEntry("members", i, fmt.Errorf("member %q command table %q: %w", members[i].Name, *members[i].CommandTableId, errMemberCommandTableUndefined))
 		}
 	}
 	return nil
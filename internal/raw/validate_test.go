@@ -11,10 +11,10 @@ import (
 func TestValidateRaws_RealData(t *testing.T) {
 	t.Parallel()
 
-	master, err := LoadFromFile("metadata/entities/raw/raw.toml")
+	master, err := LoadFromDir(DefaultDir, "")
 	require.NoError(t, err)
 
-	err = ValidateRaws(master)
+	err = ValidateRaws(master.Raws)
 	assert.NoError(t, err)
 }
 
@@ -382,6 +382,237 @@ func TestValidateEnemyTableReferences(t *testing.T) {
 	})
 }
 
+func TestValidateDungeonReferences(t *testing.T) {
+	t.Parallel()
+
+	forest := "forest"
+	undefined := "未定義テーブル"
+	enemyTables := &[]oapi.EnemyTable{{Id: forest, Name: "森"}}
+	itemTables := &[]oapi.ItemTable{{Id: forest, Name: "森"}}
+
+	t.Run("実在するテーブルと省略は通る", func(t *testing.T) {
+		t.Parallel()
+		raws := oapi.Raws{
+			EnemyTables: enemyTables,
+			ItemTables:  itemTables,
+			Dungeons: &[]oapi.Dungeon{
+				{Name: "森", EnemyTableId: &forest, ItemTableId: &forest},
+				{Name: "空き家"},
+			},
+		}
+		require.NoError(t, validateDungeonReferences(raws))
+	})
+
+	t.Run("敵テーブルが存在しないとエラー", func(t *testing.T) {
+		t.Parallel()
+		raws := oapi.Raws{
+			EnemyTables: enemyTables,
+			ItemTables:  itemTables,
+			Dungeons:    &[]oapi.Dungeon{{Name: "森", EnemyTableId: &undefined}},
+		}
+		require.ErrorIs(t, validateDungeonReferences(raws), errDungeonEnemyTableUndefined)
+	})
+
+	t.Run("アイテムテーブルが存在しないとエラー", func(t *testing.T) {
+		t.Parallel()
+		raws := oapi.Raws{
+			EnemyTables: enemyTables,
+			ItemTables:  itemTables,
+			Dungeons:    &[]oapi.Dungeon{{Name: "森", ItemTableId: &undefined}},
+		}
+		require.ErrorIs(t, validateDungeonReferences(raws), errDungeonItemTableUndefined)
+	})
+}
+
+func TestValidateDialogueReferences(t *testing.T) {
+	t.Parallel()
+
+	items := &[]oapi.Item{{Id: "herb", Name: "Herb"}}
+	herb := "herb"
+	undefined := "undefined"
+	swordSkill := "sword"
+	seen := "met_soldier"
+	yes := true
+	// dialogue は1つの選択肢を持つ会話を作る
+	dialogue := func(choice oapi.DialogueChoice) oapi.Raws {
+		return oapi.Raws{
+			Items: items,
+			Dialogues: &[]oapi.Dialogue{{Id: "greeting", Nodes: []oapi.DialogueNode{
+				{Id: "start", Text: "Hello.", Choices: &[]oapi.DialogueChoice{choice}},
+				{Id: "end", Text: "Bye."},
+			}}},
+		}
+	}
+
+	t.Run("実在するノード・アイテム・スキルを指す会話は通る", func(t *testing.T) {
+		t.Parallel()
+		end := "end"
+		raws := dialogue(oapi.DialogueChoice{
+			Text: "Trade",
+			Next: &end,
+			Conditions: &[]oapi.DialogueCondition{
+				{Item: &herb},
+				{Skill: &swordSkill},
+				{Event: &seen, Not: &yes},
+			},
+			Effects: &[]oapi.DialogueEffect{{TakeItem: &herb}, {SetEvent: &seen}},
+		})
+		require.NoError(t, validateDialogueReferences(raws))
+	})
+
+	t.Run("存在しないノードへ進むとエラー", func(t *testing.T) {
+		t.Parallel()
+		raws := dialogue(oapi.DialogueChoice{Text: "Go", Next: &undefined})
+		require.ErrorIs(t, validateDialogueReferences(raws), errDialogueNodeUndefined)
+	})
+
+	t.Run("ノードのidが重複するとエラー", func(t *testing.T) {
+		t.Parallel()
+		raws := oapi.Raws{Dialogues: &[]oapi.Dialogue{{Id: "greeting", Nodes: []oapi.DialogueNode{
+			{Id: "start", Text: "Hello."},
+			{Id: "start", Text: "Hello again."},
+		}}}}
+		require.ErrorIs(t, validateDialogueReferences(raws), errDialogueDuplicateNode)
+	})
+
+	t.Run("ノードがないとエラー", func(t *testing.T) {
+		t.Parallel()
+		raws := oapi.Raws{Dialogues: &[]oapi.Dialogue{{Id: "greeting"}}}
+		require.ErrorIs(t, validateDialogueReferences(raws), errDialogueNoNodes)
+	})
+
+	t.Run("存在しないアイテムを受け渡すとエラー", func(t *testing.T) {
+		t.Parallel()
+		raws := dialogue(oapi.DialogueChoice{Text: "Take", Effects: &[]oapi.DialogueEffect{{GiveItem: &undefined}}})
+		require.ErrorIs(t, validateDialogueReferences(raws), errDialogueItemUndefined)
+	})
+
+	t.Run("存在しないスキルを条件にするとエラー", func(t *testing.T) {
+		t.Parallel()
+		raws := dialogue(oapi.DialogueChoice{Text: "Fight", Conditions: &[]oapi.DialogueCondition{{Skill: &undefined}}})
+		require.ErrorIs(t, validateDialogueReferences(raws), errDialogueSkillUndefined)
+	})
+
+	t.Run("条件の種類は1つだけ指定する", func(t *testing.T) {
+		t.Parallel()
+		both := dialogue(oapi.DialogueChoice{Text: "Ask", Conditions: &[]oapi.DialogueCondition{{Item: &herb, Event: &seen}}})
+		require.ErrorIs(t, validateDialogueReferences(both), errDialogueConditionKind)
+		none := dialogue(oapi.DialogueChoice{Text: "Ask", Conditions: &[]oapi.DialogueCondition{{Not: &yes}}})
+		require.ErrorIs(t, validateDialogueReferences(none), errDialogueConditionKind)
+	})
+
+	t.Run("効果の種類は1つだけ指定する", func(t *testing.T) {
+		t.Parallel()
+		raws := dialogue(oapi.DialogueChoice{Text: "Pay", Effects: &[]oapi.DialogueEffect{{GiveItem: &herb, OpenShop: &yes}}})
+		require.ErrorIs(t, validateDialogueReferences(raws), errDialogueEffectKind)
+	})
+
+	t.Run("存在しないクエストを受けるとエラー", func(t *testing.T) {
+		t.Parallel()
+		raws := dialogue(oapi.DialogueChoice{Text: "Accept", Effects: &[]oapi.DialogueEffect{{StartQuest: &undefined}}})
+		require.ErrorIs(t, validateDialogueReferences(raws), errDialogueQuestUndefined)
+
+		hunt := "hunt"
+		days := 3
+		raws = dialogue(oapi.DialogueChoice{
+			Text:       "Accept",
+			Conditions: &[]oapi.DialogueCondition{{Quest: &hunt, Not: &yes}},
+			Effects:    &[]oapi.DialogueEffect{{StartQuest: &hunt}},
+		})
+		raws.Quests = &[]oapi.Quest{{Id: "hunt", Name: "Hunt", Objectives: []oapi.QuestObjective{{SurviveDays: &days}}}}
+		require.NoError(t, validateDialogueReferences(raws))
+	})
+}
+
+func TestValidateQuestReferences(t *testing.T) {
+	t.Parallel()
+
+	herb := "herb"
+	rat := "rat"
+	undefined := "undefined"
+	three := 3
+	// quest は1つの目標を持つクエストを作る
+	quest := func(obj oapi.QuestObjective) oapi.Raws {
+		return oapi.Raws{
+			Items:   &[]oapi.Item{{Id: "herb", Name: "Herb"}},
+			Members: &[]oapi.Member{{Id: "rat", Name: "Rat"}},
+			Quests:  &[]oapi.Quest{{Id: "hunt", Name: "Hunt", Objectives: []oapi.QuestObjective{obj}}},
+		}
+	}
+
+	t.Run("実在するアイテム・メンバーを指すクエストは通る", func(t *testing.T) {
+		t.Parallel()
+		raws := quest(oapi.QuestObjective{Kill: &rat, Count: &three})
+		(*raws.Quests)[0].Objectives = append((*raws.Quests)[0].Objectives,
+			oapi.QuestObjective{Deliver: &herb, To: &rat},
+			oapi.QuestObjective{Reach: &oapi.ChunkCoord{X: 1, Y: 2}},
+		)
+		(*raws.Quests)[0].Reward = &oapi.QuestReward{Items: &[]oapi.QuestRewardItem{{Id: herb, Count: 2}}}
+		require.NoError(t, validateQuestReferences(raws))
+	})
+
+	t.Run("目標がないとエラー", func(t *testing.T) {
+		t.Parallel()
+		raws := oapi.Raws{Quests: &[]oapi.Quest{{Id: "hunt", Name: "Hunt"}}}
+		require.ErrorIs(t, validateQuestReferences(raws), errQuestNoObjectives)
+	})
+
+	t.Run("目標の種類は1つだけ指定する", func(t *testing.T) {
+		t.Parallel()
+		require.ErrorIs(t, validateQuestReferences(quest(oapi.QuestObjective{Kill: &rat, Collect: &herb})), errQuestObjectiveKind)
+		require.ErrorIs(t, validateQuestReferences(quest(oapi.QuestObjective{Count: &three})), errQuestObjectiveKind)
+	})
+
+	t.Run("届け先は deliver のときだけ指定する", func(t *testing.T) {
+		t.Parallel()
+		require.ErrorIs(t, validateQuestReferences(quest(oapi.QuestObjective{Deliver: &herb})), errQuestDeliverRecipient)
+		require.ErrorIs(t, validateQuestReferences(quest(oapi.QuestObjective{Collect: &herb, To: &rat})), errQuestDeliverRecipient)
+	})
+
+	t.Run("存在しないアイテムを指すとエラー", func(t *testing.T) {
+		t.Parallel()
+		require.ErrorIs(t, validateQuestReferences(quest(oapi.QuestObjective{Collect: &undefined})), errQuestItemUndefined)
+		raws := quest(oapi.QuestObjective{Kill: &rat})
+		(*raws.Quests)[0].Reward = &oapi.QuestReward{Items: &[]oapi.QuestRewardItem{{Id: undefined, Count: 1}}}
+		require.ErrorIs(t, validateQuestReferences(raws), errQuestItemUndefined)
+	})
+
+	t.Run("存在しないメンバーを指すとエラー", func(t *testing.T) {
+		t.Parallel()
+		require.ErrorIs(t, validateQuestReferences(quest(oapi.QuestObjective{Kill: &undefined})), errQuestMemberUndefined)
+		require.ErrorIs(t, validateQuestReferences(quest(oapi.QuestObjective{Deliver: &herb, To: &undefined})), errQuestMemberUndefined)
+	})
+}
+
+func TestValidateLockReferences(t *testing.T) {
+	t.Parallel()
+
+	storeroom := "storeroom"
+	undefined := "undefined"
+	// locked は keyId の錠が付いた金庫と、storeroom の鍵を持つローデータを作る
+	locked := func(keyID *string) oapi.Raws {
+		return oapi.Raws{
+			Items: &[]oapi.Item{{Id: "storeroom_key", Name: "Storeroom Key", Key: &storeroom}},
+			Props: &[]oapi.Prop{{Id: "safe", Name: "Safe", Lock: &oapi.LockRaw{Difficulty: 30, KeyId: keyID}}},
+		}
+	}
+
+	t.Run("合う鍵がある錠は通る", func(t *testing.T) {
+		t.Parallel()
+		require.NoError(t, validateLockReferences(locked(&storeroom)))
+	})
+
+	t.Run("keyId を省略した錠は通る", func(t *testing.T) {
+		t.Parallel()
+		require.NoError(t, validateLockReferences(locked(nil)))
+	})
+
+	t.Run("合う鍵が無い錠はエラー", func(t *testing.T) {
+		t.Parallel()
+		require.ErrorIs(t, validateLockReferences(locked(&undefined)), errLockKeyUndefined)
+	})
+}
+
 func TestValidateCommandTableWeaponReferences(t *testing.T) {
 	t.Parallel()
 
@@ -2,11 +2,13 @@ package resources
 
 import (
 	"github.com/hajimehoshi/ebiten/v2/text/v2"
+	"github.com/kijimaD/ruins/internal/audio"
 	"github.com/kijimaD/ruins/internal/components"
 	"github.com/kijimaD/ruins/internal/config"
 	"github.com/kijimaD/ruins/internal/i18n"
 	"github.com/kijimaD/ruins/internal/inputmapper"
-	"github.com/kijimaD/ruins/internal/oapi"
+	"github.com/kijimaD/ruins/internal/profile"
+	"github.com/kijimaD/ruins/internal/raw"
 	"github.com/mlange-42/ark/ecs"
 )
 
@@ -19,10 +21,13 @@ type Resources struct {
 	Fonts            map[string]Font
 	Faces            map[string]text.Face
 	UIResources      UIResources
-	RawMaster        oapi.Raws
-	I18N             i18n.Catalog   // 国際化のマスタ。全言語の訳を持つ読み取り専用データ。現在言語は UserSettings が持ち query.T が引く
-	Config           *config.Config // 実行設定。起動時に注入する
-	SingletonEntity  ecs.Entity     // シングルトンエンティティIDキャッシュ
+	RawMaster        raw.Master       // 索引付きのローデータ。定義の参照は raw パッケージの関数へ渡して引く
+	I18N             i18n.Catalog     // 国際化のマスタ。全言語の訳を持つ読み取り専用データ。現在言語は UserSettings が持ち query.T が引く
+	Config           *config.Config   // 実行設定。起動時に注入する
+	SingletonEntity  ecs.Entity       // シングルトンエンティティIDキャッシュ
+	Audio            *audio.Player    // 曲と効果音の再生窓口。既定は何も鳴らさず、ウィンドウ付きの起動時に実際の Backend へ差し替える
+	Profile          *profile.Profile // run をまたいで残る記録。既定は保存しないゼロ値で、ウィンドウ付きの起動時に保存済みのものへ差し替える
+	Morgue           MorgueWriter     // 死の記録の書き出し先。既定は何も書かず、ウィンドウ付きの起動時に実際の保存先へ差し替える
 
 	// InputSource は Action の入力供給源。nil なら本番どおりキーボードから変換する。
 	// 再生ドライバだけが Action 列を返す供給源を差し、キー入力を経由せず本番フローを駆動する。
@@ -30,6 +35,17 @@ type Resources struct {
 	InputSource inputmapper.Source
 }
 
+// MorgueWriter は死の記録を1件書き出す先。本文を組むのは morgue パッケージで、ここは書き出しだけを受け持つ
+type MorgueWriter interface {
+	WriteEntry(name string, data []byte) error
+}
+
+// NullMorgueWriter は何も書き出さない MorgueWriter
+type NullMorgueWriter struct{}
+
+// WriteEntry は何もしない
+func (NullMorgueWriter) WriteEntry(string, []byte) error { return nil }
+
 // ScreenDimensions contains current screen dimensions
 type ScreenDimensions struct {
 	Width  int
@@ -62,5 +78,11 @@ func InitGameResources() *Resources {
 		UIResources:  UIResources{},
 		// 全言語の訳を持つ不変マスタを常に持たせる。どのワールドでも res.I18N が非 nil になる。
 		I18N: i18n.NewCatalog(),
+		// テストやヘッドレス実行で音声デバイスを要求しないよう、既定は何も鳴らさない
+		Audio: audio.NewPlayer(audio.NullBackend{}, audio.Volume{}),
+		// 利用者の記録を書き換えないよう、既定は保存しないプロフィールにする
+		Profile: &profile.Profile{},
+		// 自動プレイやテストで利用者の設定ディレクトリへ書かないよう、既定は死の記録を書き出さない
+		Morgue: NullMorgueWriter{},
 	}
 }
@@ -30,6 +30,10 @@ func TestInitGameResources_マップフィールドが空で初期化される(t
 	assert.Empty(t, r.Fonts)
 	assert.Empty(t, r.Faces)
 	assert.Equal(t, ScreenDimensions{}, r.ScreenDimensions)
+	// 音声デバイスの無い環境でも鳴らす呼び出しが通るよう、再生窓口は常に持たせる
+	assert.NotNil(t, r.Audio)
+	// 自動プレイやテストで利用者の設定ディレクトリへ死の記録を書かない
+	assert.Equal(t, NullMorgueWriter{}, r.Morgue)
 }
 
 func TestInitializeResources_エラーなくフィールドを置き換える(t *testing.T) {
@@ -17,6 +17,7 @@ ark-serde でワールド全体をJSON化して保存し、ロード時にその
     キーを持たないため攻撃者による改ざん検知は目的とせず、あくまで破損検知に用いる
   - PlayerName: 一覧表示用にプレイヤー名をメタとして保持（ワールド全体を展開せず参照できる）
   - World:      ark-serde が出力するワールドJSON
+  - GameLog:    メッセージログの履歴。GameLog コンポーネントは serde できないため、エントリだけを持つ
 
 ## シリアライズの方針
 
@@ -33,13 +34,22 @@ ark-serde でワールド全体をJSON化して保存し、ロード時にその
 
 Deserialize はリセット済みワールドを要求するため、RestoreWorldFromJSON は先に
 world.ECS.Reset() を行う。復元後、reestablishSingleton がスキップした一時コンポーネント
-（GameLog・SpatialIndex）を再付与し、視界マップを初期化し、Resources.SingletonEntity の
+（GameLog・SpatialIndex）を再付与して GameLog に封筒のログを戻し、視界マップを初期化し、Resources.SingletonEntity の
 参照を張り直す。
 
+## バージョン移行
+
+封筒の Version が saveDataVersion と異なるセーブは、復元前に migrations に登録した移行を
+順に適用して現行の形式へ変換する。移行は ark-serde のワールドJSONを型なしのまま書き換え
+（コンポーネントの追加・改名・削除、フィールドの書き換え）、Goの型の変更に引きずられない。
+コンポーネントの変更で互換性が崩れるときは saveDataVersion を上げて移行を1件追加し、
+testdata/saves に旧バージョンのセーブを凍結して残す。テストは全フィクスチャの復元を検証する。
+
 ## パッケージ責務
 
   - serde.go:   ark-serde ラッパー、skipリスト、封筒、シングルトン再確立
   - manager.go: セーブ・ロード処理とスロット/オートセーブ管理
+  - migrate.go: 旧バージョンのワールドJSONを現行形式へ移行する連鎖
   - desktop.go / wasm.go: プラットフォーム別のファイルI/O
 */
 package save
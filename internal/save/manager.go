@@ -8,10 +8,11 @@ import (
 	"time"
 
 	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/gamelog"
 	w "github.com/kijimaD/ruins/internal/world"
 )
 
-const saveDataVersion = "2.0.0"
+const saveDataVersion = "2.2.0"
 
 const maxAutoSaves = 4
 
@@ -61,6 +62,7 @@ func (sm *SerializationManager) GenerateWorldJSON(world w.World) (string, error)
 		Timestamp:  time.Now(),
 		PlayerName: extractPlayerName(world),
 		World:      worldJSON,
+		GameLog:    extractLogEntries(world),
 	}
 	env.Checksum = checksumOf(&env)
 
@@ -120,8 +122,10 @@ func (sm *SerializationManager) RestoreWorldFromJSON(world w.World, jsonData str
 		return fmt.Errorf("save data validation failed: %w", err)
 	}
 
-	if env.Version != saveDataVersion {
-		return fmt.Errorf("unsupported save data version: %s", env.Version)
+	// 旧バージョンのセーブは復元前に現行の形式へ移行する。チェックサムは保存時の内容に対して検証済み
+	worldJSON, err := migrateWorld(migrations, env.Version, saveDataVersion, env.World)
+	if err != nil {
+		return err
 	}
 
 	// 本番ワールドを破壊する前に、使い捨ての probe ワールドで復元の全工程を検証する。
@@ -133,16 +137,16 @@ func (sm *SerializationManager) RestoreWorldFromJSON(world w.World, jsonData str
 	if err != nil {
 		return fmt.Errorf("failed to create probe world: %w", err)
 	}
-	if err := restoreInto(probe, env.World); err != nil {
+	if err := restoreInto(probe, worldJSON, env.GameLog); err != nil {
 		return err
 	}
 
-	return restoreInto(world, env.World)
+	return restoreInto(world, worldJSON, env.GameLog)
 }
 
 // restoreInto はリセット済みワールドへ復元の全工程を適用する。deserialize は事前の
 // world.ECS.Reset() を要求する。probe と本番ワールドの両方でこの手順を共有する。
-func restoreInto(world w.World, worldJSON []byte) error {
+func restoreInto(world w.World, worldJSON []byte, logEntries []gamelog.LogEntry) error {
 	// ark-serdeのDeserializeはリセット済みワールドを要求する
 	world.ECS.Reset()
 
@@ -151,7 +155,7 @@ func restoreInto(world w.World, worldJSON []byte) error {
 	}
 
 	// スキップした一時コンポーネントとシングルトン参照を再確立する
-	if err := reestablishSingleton(world); err != nil {
+	if err := reestablishSingleton(world, logEntries); err != nil {
 		return fmt.Errorf("failed to reestablish singleton: %w", err)
 	}
 
@@ -0,0 +1,195 @@
+package save
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// migration はあるバージョンのワールドJSONを1つ新しいバージョンへ変換する。
+// コンポーネントの追加・改名・削除やフィールドの書き換えをセーブ形式の変更ごとに1件登録する
+type migration struct {
+	From  string
+	To    string
+	Apply func(doc *worldDocument) error
+}
+
+// migrations は登録済みの移行の連鎖。From から順に辿って saveDataVersion に到達できなければならない。
+// コンポーネントを変更して saveDataVersion を上げるときは、ここに移行を追加し、
+// 旧バージョンのフィクスチャを testdata/saves に残す
+var migrations = []migration{
+	// RunStats.Cause を素の文字列から死因の内訳へ変えた。記録済みの文字列は倒した相手として残す
+	{From: "2.0.0", To: "2.1.0", Apply: func(doc *worldDocument) error {
+		return doc.RewriteComponent("components.RunStats", func(fields map[string]any) error {
+			cause := map[string]any{}
+			if s, ok := fields["Cause"].(string); ok && s != "" {
+				cause["Killer"] = s
+			}
+			fields["Cause"] = cause
+			return nil
+		})
+	}},
+	// クエストの記録 QuestLog をシングルトンに足した。導入前のセーブには無いので、受けたクエストの無い記録を付ける
+	{From: "2.1.0", To: "2.2.0", Apply: func(doc *worldDocument) error {
+		return doc.AddComponent("components.QuestLog", map[string]any{"quests": []any{}}, func(comps map[string]json.RawMessage) bool {
+			_, ok := comps["components.GameProgress"]
+			return ok
+		})
+	}},
+}
+
+// migrateWorld はワールドJSONを version から target まで順に移行する。
+// 同じバージョンなら何もしない。経路が見つからなければエラーを返す
+func migrateWorld(chain []migration, version, target string, worldJSON []byte) ([]byte, error) {
+	if version == target {
+		return worldJSON, nil
+	}
+
+	var steps []migration
+	visited := map[string]bool{}
+	current := version
+	for current != target {
+		if visited[current] {
+			return nil, fmt.Errorf("migration chain has a cycle at version %s", current)
+		}
+		visited[current] = true
+		idx := slices.IndexFunc(chain, func(m migration) bool { return m.From == current })
+		if idx < 0 {
+			return nil, fmt.Errorf("unsupported save data version: %s", version)
+		}
+		steps = append(steps, chain[idx])
+		current = chain[idx].To
+	}
+
+	doc, err := parseWorldDocument(worldJSON)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range steps {
+		if err := m.Apply(doc); err != nil {
+			return nil, fmt.Errorf("failed to migrate save data from %s to %s: %w", m.From, m.To, err)
+		}
+	}
+	return doc.marshal()
+}
+
+// worldDocument は ark-serde のワールドJSONを移行用に展開したもの。
+// コンポーネント値は型を持たない JSON のまま扱い、移行時点のGoの型に依存しない
+type worldDocument struct {
+	// Types はワールドに登録されたコンポーネント型名の一覧
+	Types []string
+	// Components はエンティティごとの「型名→値」。並びは World.Alive と対応する
+	Components []map[string]json.RawMessage
+	// rest は移行で触らない残りのフィールド（World・Resources など）
+	rest map[string]json.RawMessage
+}
+
+func parseWorldDocument(worldJSON []byte) (*worldDocument, error) {
+	var rest map[string]json.RawMessage
+	if err := json.Unmarshal(worldJSON, &rest); err != nil {
+		return nil, fmt.Errorf("failed to parse world data: %w", err)
+	}
+	doc := &worldDocument{rest: rest}
+	if raw, ok := rest["Types"]; ok {
+		if err := json.Unmarshal(raw, &doc.Types); err != nil {
+			return nil, fmt.Errorf("failed to parse component types: %w", err)
+		}
+	}
+	if raw, ok := rest["Components"]; ok {
+		if err := json.Unmarshal(raw, &doc.Components); err != nil {
+			return nil, fmt.Errorf("failed to parse components: %w", err)
+		}
+	}
+	for i := range doc.Components {
+		if doc.Components[i] == nil {
+			doc.Components[i] = map[string]json.RawMessage{}
+		}
+	}
+	return doc, nil
+}
+
+func (doc *worldDocument) marshal() ([]byte, error) {
+	types, err := json.Marshal(doc.Types)
+	if err != nil {
+		return nil, err
+	}
+	components, err := json.Marshal(doc.Components)
+	if err != nil {
+		return nil, err
+	}
+	doc.rest["Types"] = types
+	doc.rest["Components"] = components
+	return json.Marshal(doc.rest)
+}
+
+// RenameComponent はコンポーネント型名を付け替える。値はそのまま引き継ぐ
+func (doc *worldDocument) RenameComponent(from, to string) {
+	for i, tp := range doc.Types {
+		if tp == from {
+			doc.Types[i] = to
+		}
+	}
+	for _, comps := range doc.Components {
+		if v, ok := comps[from]; ok {
+			delete(comps, from)
+			comps[to] = v
+		}
+	}
+}
+
+// DropComponent はコンポーネントを全エンティティから取り除く。
+// ark-serde は未登録の型名を受け付けないため、型一覧からも消す
+func (doc *worldDocument) DropComponent(name string) {
+	doc.Types = slices.DeleteFunc(doc.Types, func(tp string) bool { return tp == name })
+	for _, comps := range doc.Components {
+		delete(comps, name)
+	}
+}
+
+// AddComponent は条件に合うエンティティへ既定値のコンポーネントを付ける。
+// match には各エンティティのコンポーネント（型名→値）を渡す
+func (doc *worldDocument) AddComponent(name string, value any, match func(comps map[string]json.RawMessage) bool) error {
+	bs, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", name, err)
+	}
+	added := false
+	for _, comps := range doc.Components {
+		if _, ok := comps[name]; ok || !match(comps) {
+			continue
+		}
+		comps[name] = bs
+		added = true
+	}
+	if added && !slices.Contains(doc.Types, name) {
+		doc.Types = append(doc.Types, name)
+	}
+	return nil
+}
+
+// RewriteComponent は指定コンポーネントの値をフィールド単位で書き換える
+func (doc *worldDocument) RewriteComponent(name string, fn func(fields map[string]any) error) error {
+	for _, comps := range doc.Components {
+		raw, ok := comps[name]
+		if !ok {
+			continue
+		}
+		// 数値を float64 に丸めないよう json.Number のまま扱う
+		dec := json.NewDecoder(bytes.NewReader(raw))
+		dec.UseNumber()
+		var fields map[string]any
+		if err := dec.Decode(&fields); err != nil {
+			return fmt.Errorf("failed to parse %s: %w", name, err)
+		}
+		if err := fn(fields); err != nil {
+			return fmt.Errorf("failed to rewrite %s: %w", name, err)
+		}
+		bs, err := json.Marshal(fields)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s: %w", name, err)
+		}
+		comps[name] = bs
+	}
+	return nil
+}
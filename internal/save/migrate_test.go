@@ -0,0 +1,188 @@
+package save
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/testutil"
+	"github.com/kijimaD/ruins/internal/world/query"
+	"github.com/mlange-42/ark/ecs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fixtureDir は過去バージョンのセーブを凍結して置く場所。ファイル名は v<バージョン>.json
+const fixtureDir = "testdata/saves"
+
+func TestMigrateWorld_同じバージョンなら何もしない(t *testing.T) {
+	t.Parallel()
+	in := []byte(`{"Types":[],"Components":[]}`)
+	out, err := migrateWorld(nil, "2.0.0", "2.0.0", in)
+	require.NoError(t, err)
+	assert.Equal(t, in, out)
+}
+
+func TestMigrateWorld_経路がなければエラー(t *testing.T) {
+	t.Parallel()
+	chain := []migration{{From: "1.0.0", To: "2.0.0", Apply: func(_ *worldDocument) error { return nil }}}
+	_, err := migrateWorld(chain, "0.9.0", "2.0.0", []byte(`{}`))
+	assert.ErrorContains(t, err, "unsupported save data version: 0.9.0")
+}
+
+func TestMigrateWorld_循環する連鎖はエラー(t *testing.T) {
+	t.Parallel()
+	noop := func(_ *worldDocument) error { return nil }
+	chain := []migration{
+		{From: "1.0.0", To: "1.1.0", Apply: noop},
+		{From: "1.1.0", To: "1.0.0", Apply: noop},
+	}
+	_, err := migrateWorld(chain, "1.0.0", "2.0.0", []byte(`{}`))
+	assert.ErrorContains(t, err, "cycle")
+}
+
+func TestMigrateWorld_連鎖を順に適用する(t *testing.T) {
+	t.Parallel()
+	in := []byte(`{
+		"World": {"Alive": [2, 3]},
+		"Types": ["components.Old", "components.Obsolete", "components.HP"],
+		"Components": [
+			{"components.Old": {"A": 1}, "components.Obsolete": {}, "components.HP": {"Current": 5, "Max": 10}},
+			null
+		],
+		"Resources": {}
+	}`)
+	chain := []migration{
+		{From: "1.1.0", To: "2.0.0", Apply: func(doc *worldDocument) error {
+			return doc.RewriteComponent("components.HP", func(fields map[string]any) error {
+				fields["Max"] = 20
+				return nil
+			})
+		}},
+		{From: "1.0.0", To: "1.1.0", Apply: func(doc *worldDocument) error {
+			doc.RenameComponent("components.Old", "components.New")
+			doc.DropComponent("components.Obsolete")
+			return doc.AddComponent("components.Added", map[string]int{"V": 1}, func(comps map[string]json.RawMessage) bool {
+				_, ok := comps["components.HP"]
+				return ok
+			})
+		}},
+	}
+
+	out, err := migrateWorld(chain, "1.0.0", "2.0.0", in)
+	require.NoError(t, err)
+
+	doc, err := parseWorldDocument(out)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"components.New", "components.HP", "components.Added"}, doc.Types)
+	require.Len(t, doc.Components, 2)
+	assert.JSONEq(t, `{"A": 1}`, string(doc.Components[0]["components.New"]))
+	assert.JSONEq(t, `{"V": 1}`, string(doc.Components[0]["components.Added"]))
+	assert.JSONEq(t, `{"Current": 5, "Max": 20}`, string(doc.Components[0]["components.HP"]))
+	assert.NotContains(t, doc.Components[0], "components.Obsolete")
+	assert.Empty(t, doc.Components[1])
+	// 移行で触らないフィールドは保たれる
+	assert.JSONEq(t, `{"Alive": [2, 3]}`, string(doc.rest["World"]))
+}
+
+func TestMigrations_全バージョンから現行へ到達できる(t *testing.T) {
+	t.Parallel()
+	for _, m := range migrations {
+		_, err := migrateWorld(migrations, m.From, saveDataVersion, []byte(`{"Types":[],"Components":[]}`))
+		require.NoError(t, err, "%s から %s へ移行できる", m.From, saveDataVersion)
+	}
+}
+
+func TestMigrations_死因の文字列を内訳へ移す(t *testing.T) {
+	t.Parallel()
+	in := []byte(`{
+		"Types": ["components.RunStats"],
+		"Components": [{"components.RunStats": {"EnemiesKilled": 3, "Cause": "debug"}}, {"components.RunStats": {"Cause": ""}}]
+	}`)
+	out, err := migrateWorld(migrations, "2.0.0", "2.1.0", in)
+	require.NoError(t, err)
+
+	doc, err := parseWorldDocument(out)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"EnemiesKilled": 3, "Cause": {"Killer": "debug"}}`, string(doc.Components[0]["components.RunStats"]))
+	assert.JSONEq(t, `{"Cause": {}}`, string(doc.Components[1]["components.RunStats"]))
+}
+
+func TestMigrations_シングルトンへ空のクエスト記録を足す(t *testing.T) {
+	t.Parallel()
+	in := []byte(`{
+		"Types": ["components.GameProgress", "components.Name"],
+		"Components": [{"components.Name": {"Name": "x"}}, {"components.GameProgress": {}}]
+	}`)
+	out, err := migrateWorld(migrations, "2.1.0", "2.2.0", in)
+	require.NoError(t, err)
+
+	doc, err := parseWorldDocument(out)
+	require.NoError(t, err)
+	assert.Contains(t, doc.Types, "components.QuestLog")
+	assert.NotContains(t, doc.Components[0], "components.QuestLog", "シングルトン以外には付けない")
+	assert.JSONEq(t, `{"quests": []}`, string(doc.Components[1]["components.QuestLog"]))
+
+	// 記録を持つセーブはそのまま
+	kept := []byte(`{
+		"Types": ["components.GameProgress", "components.QuestLog"],
+		"Components": [{"components.GameProgress": {}, "components.QuestLog": {"quests": [{"id": "q"}]}}]
+	}`)
+	out, err = migrateWorld(migrations, "2.1.0", "2.2.0", kept)
+	require.NoError(t, err)
+	doc, err = parseWorldDocument(out)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"quests": [{"id": "q"}]}`, string(doc.Components[0]["components.QuestLog"]))
+}
+
+// TestSaveFixtures_過去バージョンのセーブを読み込める は testdata/saves に凍結した全バージョンの
+// セーブが、移行を経て現行のワールドへ復元できることを検証する。
+// 現行バージョンのフィクスチャは GOLDIE_UPDATE=1 で生成する。一度コミットしたら書き換えない
+func TestSaveFixtures_過去バージョンのセーブを読み込める(t *testing.T) {
+	t.Parallel()
+
+	current := filepath.Join(fixtureDir, "v"+saveDataVersion+".json")
+	if os.Getenv("GOLDIE_UPDATE") != "" {
+		if _, err := os.Stat(current); errors.Is(err, fs.ErrNotExist) {
+			manager := createTestSerializationManager(t)
+			jsonData, err := manager.GenerateWorldJSON(createComplexDeterministicWorld(t))
+			require.NoError(t, err)
+			require.NoError(t, os.MkdirAll(fixtureDir, 0755))
+			require.NoError(t, os.WriteFile(current, []byte(jsonData+"\n"), 0644))
+		}
+	}
+	require.FileExists(t, current, "現行バージョンのフィクスチャがない。GOLDIE_UPDATE=1 で生成する")
+
+	paths, err := filepath.Glob(filepath.Join(fixtureDir, "v*.json"))
+	require.NoError(t, err)
+	for _, path := range paths {
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			t.Parallel()
+			data, err := os.ReadFile(path)
+			require.NoError(t, err)
+
+			var env saveEnvelope
+			require.NoError(t, json.Unmarshal(data, &env))
+			assert.Equal(t, strings.TrimSuffix(strings.TrimPrefix(filepath.Base(path), "v"), ".json"), env.Version,
+				"ファイル名と封筒のバージョンが一致する")
+
+			manager := createTestSerializationManager(t)
+			world := testutil.InitTestWorld(t)
+			require.NoError(t, manager.RestoreWorldFromJSON(world, string(data)))
+			assertComplexWorldRestored(t, world)
+
+			players := 0
+			q := ecs.NewFilter1[gc.Player](world.ECS).Query()
+			for q.Next() {
+				players++
+			}
+			assert.Equal(t, 1, players)
+			assert.NotNil(t, query.GetQuestLog(world), "QuestLog の無い古いセーブも移行で空の記録を持つ")
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	gc "github.com/kijimaD/ruins/internal/components"
 	"github.com/kijimaD/ruins/internal/gamelog"
 	w "github.com/kijimaD/ruins/internal/world"
+	"github.com/kijimaD/ruins/internal/world/query"
 	arkserde "github.com/mlange-42/ark-serde"
 	"github.com/mlange-42/ark/ecs"
 )
@@ -23,6 +24,9 @@ type saveEnvelope struct {
 	Checksum   string          `json:"checksum"`
 	PlayerName string          `json:"playerName"`
 	World      json.RawMessage `json:"world"`
+	// GameLog はメッセージログの履歴。GameLog コンポーネントは mutex を含み serde できないため、
+	// エントリだけをワールドの外に持つ。古いセーブには無く、その場合は空のログで始める
+	GameLog []gamelog.LogEntry `json:"gameLog,omitempty"`
 }
 
 // skipComponents はserde除外対象を返す。
@@ -32,7 +36,7 @@ func skipComponents() []ecs.Comp {
 	return []ecs.Comp{
 		ecs.C[gc.SpatialIndex](),       // struct-keyed map。ロード時に再構築
 		ecs.C[gc.VisionState](),        // struct-keyed map。視界更新で再構築
-		ecs.C[gc.GameLog](),            // sync.Mutex を含むため不可。毎ロード初期化
+		ecs.C[gc.GameLog](),            // sync.Mutex を含むため不可。エントリは封筒に別途保存する
 		ecs.C[gc.VisualEffects](),      // interfaceスライス・毎フレーム再生成
 		ecs.C[gc.Position](),           // GridElementから毎フレーム算出
 		ecs.C[gc.StateChangeRequest](), // イベント・毎ターン消費
@@ -72,7 +76,8 @@ func deserializeWorld(world w.World, worldJSON []byte) (err error) {
 // reestablishSingleton は復元後のシングルトンエンティティを再確立する。
 // スキップした一時コンポーネント（GameLog/SpatialIndex）を再付与し、
 // json:"-"で除外された視界マップを初期化し、Resourcesの参照を張り直す。
-func reestablishSingleton(world w.World) error {
+// GameLog には封筒に保存したログエントリを戻す。
+func reestablishSingleton(world w.World, logEntries []gamelog.LogEntry) error {
 	// GameProgressを持つ最初のエンティティをシングルトンとする。
 	// 途中returnはワールドをロックしたまま残すため、クエリは最後まで反復する
 	var singleton ecs.Entity
@@ -89,9 +94,9 @@ func reestablishSingleton(world w.World) error {
 	}
 	world.Resources.SingletonEntity = singleton
 
-	world.Components.GameLog.Add(singleton, &gc.GameLog{
-		Store: gamelog.NewSafeSlice(gamelog.GameLogMaxSize),
-	})
+	store := gamelog.NewSafeSlice(gamelog.GameLogMaxSize)
+	store.Restore(logEntries)
+	world.Components.GameLog.Add(singleton, &gc.GameLog{Store: store})
 	world.Components.SpatialIndex.Add(singleton, gc.NewSpatialIndex())
 	// 視界計算の一時状態は serde 除外なのでロード後に再構築する
 	world.Components.VisionState.Add(singleton, gc.NewVisionState())
@@ -168,6 +173,19 @@ func extractPlayerName(world w.World) string {
 	return name
 }
 
+// extractLogEntries はシングルトンのメッセージログを保存用に取り出す。ログが無ければnilを返す
+func extractLogEntries(world w.World) []gamelog.LogEntry {
+	store := query.GetGameLog(world)
+	if store == nil {
+		return nil
+	}
+	entries := store.GetHistoryEntries()
+	if len(entries) == 0 {
+		return nil
+	}
+	return entries
+}
+
 // checksumOf は破損検知用にチェックサムを除いた封筒のSHA-256を計算する。
 // json.Marshal は json.RawMessage を compact するため、保存ファイルが
 // MarshalIndent で整形されていても検証時に同一バイト列へ正規化され、値が一致する。
@@ -178,6 +196,7 @@ func checksumOf(env *saveEnvelope) string {
 		Timestamp:  env.Timestamp,
 		PlayerName: env.PlayerName,
 		World:      env.World,
+		GameLog:    env.GameLog,
 	}
 	jsonBytes, err := json.Marshal(target)
 	if err != nil {
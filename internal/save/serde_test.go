@@ -5,6 +5,7 @@ import (
 
 	gc "github.com/kijimaD/ruins/internal/components"
 	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/gamelog"
 	"github.com/kijimaD/ruins/internal/testutil"
 	"github.com/kijimaD/ruins/internal/world/lifecycle"
 	"github.com/kijimaD/ruins/internal/world/query"
@@ -247,6 +248,33 @@ func TestSerde_GameTimePersists(t *testing.T) {
 	assert.Equal(t, consts.Turn(1234), query.GetGameTime(newWorld).TotalTurns, "総ターン数が復元される")
 }
 
+// TestSerde_GameLogが往復する はメッセージログの履歴が色付きの断片ごとセーブ・ロードで復元されることを検証する。
+// GameLog コンポーネント自体は serde 除外で、エントリは封筒に保存される。
+func TestSerde_GameLogが往復する(t *testing.T) {
+	t.Parallel()
+	testDir := t.TempDir()
+	manager, err := NewSerializationManager(WithSaveDir(testDir))
+	require.NoError(t, err)
+
+	world := testutil.InitTestWorld(t)
+	_, err = lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 5, Y: 5}, "ash")
+	require.NoError(t, err)
+	store := query.GetGameLog(world)
+	store.Push("通常のログ")
+	gamelog.New(store).Markup(gamelog.Tag("error", "ダメージ") + "を受けた").Log()
+	want := store.GetHistoryEntries()
+
+	require.NoError(t, manager.SaveWorld(world, "gamelog"))
+
+	newWorld := testutil.InitTestWorld(t)
+	require.NoError(t, manager.LoadWorld(newWorld, "gamelog"))
+
+	restored := query.GetGameLog(newWorld)
+	require.NotNil(t, restored)
+	assert.Equal(t, want, restored.GetHistoryEntries(), "色付きの断片を含めてログが復元される")
+	assert.Equal(t, len(want), restored.Version(), "表示側が更新を検知できる")
+}
+
 // TestSerde_Perishableが往復する は腐敗食の鮮度がセーブ・ロードで保たれることを検証する。
 // RotAccrued と RotUpdatedTurn が失われると復元後に鮮度が狂うため、往復で保たれる必要がある。
 func TestSerde_Perishableが往復する(t *testing.T) {
@@ -19,5 +19,6 @@
 //   - Filter: 画面エフェクトを表すインターフェース
 //   - Pipeline: Filter を適用順に並べたポスト処理チェーン。src へ順にかけて dst へ出す
 //   - RetroFilter: 樽型歪み、色収差、ビネット、フリッカー、グロー効果を提供
+//   - PrecipitationFilter: 天候の雨や雪を画面へ重ねる。粒の位置は添字とフレーム数から決まる
 //   - 多段適用の中間バッファを内部で管理し、画面サイズ変更に自動対応
 package screeneffect
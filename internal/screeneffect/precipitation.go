@@ -0,0 +1,121 @@
+package screeneffect
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// Precipitation は降水の種類を表す
+type Precipitation int
+
+// 降水の種類
+const (
+	PrecipitationNone Precipitation = iota // 降っていない
+	PrecipitationRain                      // 雨
+	PrecipitationSnow                      // 雪
+)
+
+// 降水の描画の調整値
+const (
+	// precipitationDensity は画面 10000 平方ピクセルあたりの粒数。強さ 1.0 のときの値
+	precipitationDensity = 6.0
+	// rainSpeed と snowSpeed は1フレームで粒が落ちるピクセル数
+	rainSpeed = 14.0
+	snowSpeed = 1.5
+	// rainLength は雨筋の長さ
+	rainLength = 10.0
+	// rainDrift は雨筋の横流れ。落下1ピクセルあたりの横移動
+	rainDrift = 0.25
+)
+
+var (
+	rainColor = color.RGBA{R: 170, G: 190, B: 220, A: 150}
+	snowColor = color.RGBA{R: 240, G: 245, B: 255, A: 210}
+)
+
+// PrecipitationFilter は雨や雪を画面に重ねるフィルタ。
+// 粒の位置は添字とフレーム数から決まり、乱数も粒ごとの状態も持たない
+type PrecipitationFilter struct {
+	kind      Precipitation
+	intensity float64
+	frame     int
+}
+
+// NewPrecipitationFilter は降っていない状態の降水フィルタを作成する
+func NewPrecipitationFilter() *PrecipitationFilter {
+	return &PrecipitationFilter{}
+}
+
+// SetPrecipitation は描く降水の種類と強さを設定する。強さは 0.0 から 1.0 で、粒の数に比例する
+func (f *PrecipitationFilter) SetPrecipitation(kind Precipitation, intensity float64) {
+	f.kind = kind
+	f.intensity = max(0, min(1, intensity))
+}
+
+// Apply はFilterインターフェースの実装
+// ソース画像を写してから降水を重ねる
+func (f *PrecipitationFilter) Apply(dst, src *ebiten.Image) {
+	dst.DrawImage(src, nil)
+	f.Draw(dst)
+}
+
+// Draw は dst へ降水だけを重ね、アニメーションを1フレーム進める。降っていなければ何もしない
+func (f *PrecipitationFilter) Draw(dst *ebiten.Image) {
+	if f.kind == PrecipitationNone || f.intensity <= 0 {
+		return
+	}
+	f.frame++
+
+	bounds := dst.Bounds()
+	width := float64(bounds.Dx())
+	height := float64(bounds.Dy())
+	count := int(width * height / 10000 * precipitationDensity * f.intensity)
+	for i := range count {
+		h := particleHash(uint64(i))
+		baseX := float64(h % uint64(bounds.Dx()+1))
+		baseY := float64((h >> 32) % uint64(bounds.Dy()+1))
+		// 粒ごとに落ちる速さを 0.75 倍から 1.25 倍の範囲でばらし、幕のように揃って動くのを避ける
+		speedScale := 0.75 + float64(h>>56)/512
+		switch f.kind {
+		case PrecipitationRain:
+			fall := float64(f.frame) * rainSpeed * speedScale
+			y := wrap(baseY+fall, height)
+			x := wrap(baseX+fall*rainDrift, width)
+			vector.StrokeLine(dst, float32(x), float32(y), float32(x+rainLength*rainDrift), float32(y+rainLength), 1, rainColor, false)
+		case PrecipitationSnow:
+			fall := float64(f.frame) * snowSpeed * speedScale
+			// 雪は粒ごとに周期をずらして左右へ揺らす
+			sway := 3 * math.Sin(float64(f.frame)/20+float64(h>>48))
+			y := wrap(baseY+fall, height)
+			x := wrap(baseX+sway, width)
+			vector.FillRect(dst, float32(x), float32(y), 2, 2, snowColor, false)
+		case PrecipitationNone:
+		}
+	}
+}
+
+// particleHash は粒の添字から位置の元になる 64bit を撹拌する。splitmix64 の finalizer を使う
+func particleHash(i uint64) uint64 {
+	x := i * 0x9E3779B97F4A7C15
+	x ^= x >> 30
+	x *= 0xBF58476D1CE4E5B9
+	x ^= x >> 27
+	x *= 0x94D049BB133111EB
+	x ^= x >> 31
+	return x
+}
+
+// wrap は v を 0 以上 size 未満へ折り返す
+func wrap(v, size float64) float64 {
+	if size <= 0 {
+		return 0
+	}
+	v = math.Mod(v, size)
+	if v < 0 {
+		v += size
+	}
+	return v
+}
@@ -0,0 +1,57 @@
+package screeneffect
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrecipitationFilter_Apply(t *testing.T) {
+	t.Parallel()
+
+	for _, kind := range []Precipitation{PrecipitationNone, PrecipitationRain, PrecipitationSnow} {
+		filter := NewPrecipitationFilter()
+		filter.SetPrecipitation(kind, 1.0)
+
+		src := ebiten.NewImage(100, 100)
+		dst := ebiten.NewImage(100, 100)
+
+		// パニックしないことを確認
+		assert.NotPanics(t, func() {
+			filter.Apply(dst, src)
+			filter.Apply(dst, src)
+		}, "Applyでパニックが発生しないこと")
+	}
+}
+
+func TestPrecipitationFilter_SetPrecipitation(t *testing.T) {
+	t.Parallel()
+
+	filter := NewPrecipitationFilter()
+	filter.SetPrecipitation(PrecipitationRain, 2.0)
+	assert.InDelta(t, 1.0, filter.intensity, 1e-9, "強さは1.0で頭打ち")
+	filter.SetPrecipitation(PrecipitationRain, -1.0)
+	assert.InDelta(t, 0.0, filter.intensity, 1e-9, "強さは0.0で底打ち")
+}
+
+func TestPrecipitationFilter_降っていなければ進まない(t *testing.T) {
+	t.Parallel()
+
+	filter := NewPrecipitationFilter()
+	dst := ebiten.NewImage(100, 100)
+	filter.Draw(dst)
+	assert.Equal(t, 0, filter.frame, "降っていないフレームはアニメーションを進めない")
+
+	filter.SetPrecipitation(PrecipitationSnow, 0.5)
+	filter.Draw(dst)
+	assert.Equal(t, 1, filter.frame)
+}
+
+func TestWrap(t *testing.T) {
+	t.Parallel()
+
+	assert.InDelta(t, 5.0, wrap(105, 100), 1e-9)
+	assert.InDelta(t, 95.0, wrap(-5, 100), 1e-9)
+	assert.InDelta(t, 0.0, wrap(5, 0), 1e-9)
+}
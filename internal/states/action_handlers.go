@@ -220,6 +220,18 @@ func getInteractionActions(world w.World, interactable *gc.Interactable, interac
 					Interaction: interaction,
 				})
 			}
+		case gc.InteractionPickLock:
+			result = append(result, InteractionAction{
+				Label:       query.T(world, "Pick lock (%s)", query.GetEntityName(interactableEntity, world)),
+				Target:      interactableEntity,
+				Interaction: interaction,
+			})
+		case gc.InteractionForceLock:
+			result = append(result, InteractionAction{
+				Label:       query.T(world, "Force open (%s)", query.GetEntityName(interactableEntity, world)),
+				Target:      interactableEntity,
+				Interaction: interaction,
+			})
 		case gc.InteractionEnterCube:
 			result = append(result, InteractionAction{
 				Label:       query.T(world, "Enter (%s)", dirLabel),
@@ -99,9 +99,28 @@ func (st *CharacterState) createBasicItems(world w.World, playerEntity ecs.Entit
 		statusItemData{Label: query.T(world, "Ambient temperature"), Value: fmt.Sprintf("%d%s", envTemp, consts.IconDegree), Description: query.T(world, "Temperature at current location")},
 		statusItemData{Label: query.T(world, "Time of day"), Value: query.T(world, query.GetGameTime(world).GetTimeOfDay().String()), Description: query.T(world, "Current time of day. Affects temperature outdoors")},
 	)
+	// 天候は地上だけのもの。予報は現在を除く先の期間を並べる
+	if forecast := query.WeatherForecast(world, weatherForecastPeriods+1); len(forecast) > 0 {
+		items = append(items,
+			statusItemData{Label: query.T(world, "Weather"), Value: query.T(world, forecast[0].Weather.String()), Description: query.T(world, "Current weather. Affects temperature, visibility and brightness outdoors")},
+			statusItemData{Label: query.T(world, "Forecast"), Value: formatWeatherForecast(world, forecast[1:]), Description: query.T(world, "Weather for the coming periods, changing every %d turns", int(gc.WeatherPeriodTurns))},
+		)
+	}
 	return items
 }
 
+// weatherForecastPeriods は予報に並べる先の期間の数
+const weatherForecastPeriods = 3
+
+// formatWeatherForecast は予報の天候を矢印でつないだ文字列にする
+func formatWeatherForecast(world w.World, periods []gc.WeatherPeriod) string {
+	names := make([]string, 0, len(periods))
+	for _, p := range periods {
+		names = append(names, query.T(world, p.Weather.String()))
+	}
+	return strings.Join(names, " > ")
+}
+
 func (st *CharacterState) createAbilityItems(world w.World, playerEntity ecs.Entity) []statusItemData {
 	items := []statusItemData{}
 	if query.AliveHas(world, world.Components.Abilities, playerEntity) {
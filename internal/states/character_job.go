@@ -84,17 +84,20 @@ type JobMenuProps struct {
 	Items []jobMenuItem
 }
 
-// jobMenuItem は職業メニューの項目
+// jobMenuItem は職業メニューの項目。Profession は解放済みの初期所持だけを持つ
 type jobMenuItem struct {
 	Profession oapi.Profession
+	Locked     bool // 節目に届いておらず選べない
 }
 
-// Fetch は世界から表示 props を構築する。menuloop.Model の Model 部にあたる
+// Fetch は世界から表示 props を構築する。menuloop.Model の Model 部にあたる。
+// 解放の判定はプロフィールの記録から行い、未解放の職業も条件を見せるため一覧に残す
 func (st *CharacterJobState) Fetch(world w.World) (JobMenuProps, error) {
+	p := world.Resources.Profile
 	professions := raw.PtrSlice(world.Resources.RawMaster.Professions)
 	items := make([]jobMenuItem, len(professions))
 	for i := range professions {
-		items[i] = jobMenuItem{Profession: professions[i]}
+		items[i] = jobMenuItem{Profession: p.Available(professions[i]), Locked: !p.Unlocked(professions[i])}
 	}
 	return JobMenuProps{Items: items}, nil
 }
@@ -107,7 +110,7 @@ func (st *CharacterJobState) Menu(props JobMenuProps) menuloop.MenuConfig {
 func (st *CharacterJobState) handleSelection(world w.World) (es.Transition[w.World], error) {
 	props := st.screen.Props()
 	itemIndex := st.screen.Selection().ItemIndex
-	if itemIndex >= len(props.Items) {
+	if itemIndex >= len(props.Items) || props.Items[itemIndex].Locked {
 		return es.Transition[w.World]{Type: es.TransNone}, nil
 	}
 
@@ -184,7 +187,11 @@ func (st *CharacterJobState) View(world w.World, props JobMenuProps, cursor menu
 	leftContainer := styled.NewVerticalContainer()
 	rows := make([]menuRow, len(props.Items))
 	for i := range props.Items {
-		rows[i] = menuRow{Cells: styled.TextCells(query.T(world, props.Items[i].Profession.Name))}
+		label := query.T(world, props.Items[i].Profession.Name)
+		if props.Items[i].Locked {
+			label = fmt.Sprintf("%s (%s)", label, query.T(world, "Locked"))
+		}
+		rows[i] = menuRow{Cells: styled.TextCells(label)}
 	}
 	leftContainer.AddChild(renderMenuList(itemIndex, rows, []int{160}, []styled.TextAlign{styled.AlignLeft}, menuListOpts{Spaced: true}, res))
 	rightContainer := st.buildDetailPanel(world, props, itemIndex, res)
@@ -239,6 +246,12 @@ func (st *CharacterJobState) buildDetailPanel(world w.World, props JobMenuProps,
 
 	prof := props.Items[itemIndex].Profession
 
+	// 解放条件
+	if props.Items[itemIndex].Locked {
+		container.AddChild(styled.NewDescriptionText(query.T(world, "Unlock condition"), res))
+		container.AddChild(styled.NewMenuText(" "+milestoneText(world, prof.Unlock), res))
+	}
+
 	// 装備
 	if len(prof.Equips) > 0 {
 		container.AddChild(styled.NewDescriptionText(query.T(world, "Equipment"), res))
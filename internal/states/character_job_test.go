@@ -5,6 +5,7 @@ import (
 
 	es "github.com/kijimaD/ruins/internal/engine/states"
 	"github.com/kijimaD/ruins/internal/inputmapper"
+	"github.com/kijimaD/ruins/internal/profile"
 	"github.com/kijimaD/ruins/internal/raw"
 	"github.com/kijimaD/ruins/internal/testutil"
 	"github.com/stretchr/testify/assert"
@@ -40,7 +41,7 @@ func TestProfessionItems(t *testing.T) {
 		professionID string
 		itemCount    int
 	}{
-		{professionID: "evacuee", itemCount: 4},
+		{professionID: "evacuee", itemCount: 5},
 		{professionID: "soldier", itemCount: 1},
 		{professionID: "sniper", itemCount: 3},
 		{professionID: "mechanic", itemCount: 2},
@@ -126,6 +127,29 @@ func TestCharacterJobState_FetchProps(t *testing.T) {
 	assert.Equal(t, "Soldier", props.Items[5].Profession.Name)
 }
 
+func TestCharacterJobState_Fetch_節目に届くまで解放しない(t *testing.T) {
+	t.Parallel()
+
+	state := &CharacterJobState{playerName: "TestPlayer"}
+	world := testutil.InitTestWorld(t)
+	require.NoError(t, state.OnStart(world))
+
+	// 記録が無いうちは節目を持つ職業が選べず、節目つきの初期所持も付かない
+	props, err := state.Fetch(world)
+	require.NoError(t, err)
+	assert.False(t, props.Items[0].Locked, "Refugee は最初から選べる")
+	assert.Len(t, props.Items[0].Profession.Items, 4, "節目つきの初期所持は除く")
+	assert.True(t, props.Items[4].Locked, "Sniper は生存日数で解放")
+	assert.True(t, props.Items[5].Locked, "Soldier は run 数で解放")
+
+	world.Resources.Profile = &profile.Profile{Runs: []profile.RunRecord{{Days: 7, Score: 1000}, {}, {}}}
+	props, err = state.Fetch(world)
+	require.NoError(t, err)
+	assert.Len(t, props.Items[0].Profession.Items, 5)
+	assert.False(t, props.Items[4].Locked)
+	assert.False(t, props.Items[5].Locked)
+}
+
 func TestCharacterJobState_DoAction_Cancel(t *testing.T) {
 	t.Parallel()
 
@@ -2,11 +2,14 @@ package states
 
 import (
 	"fmt"
+	"image/color"
 	"slices"
 
 	"github.com/ebitenui/ebitenui"
 	"github.com/ebitenui/ebitenui/widget"
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/kijimaD/ruins/internal/activity"
+	gc "github.com/kijimaD/ruins/internal/components"
 	"github.com/kijimaD/ruins/internal/consts"
 	es "github.com/kijimaD/ruins/internal/engine/states"
 	"github.com/kijimaD/ruins/internal/inputmapper"
@@ -21,7 +24,6 @@ import (
 	"github.com/kijimaD/ruins/internal/widgets/theme"
 	w "github.com/kijimaD/ruins/internal/world"
 
-	"github.com/kijimaD/ruins/internal/world/gameaction"
 	"github.com/kijimaD/ruins/internal/world/query"
 	"github.com/mlange-42/ark/ecs"
 )
@@ -75,9 +77,7 @@ func (st *CraftMenuState) DoAction(world w.World, action inputmapper.ActionID) (
 	case inputmapper.ActionOpenItemDetail:
 		st.detail.Open(world)
 	case inputmapper.ActionMenuSelect:
-		if err := st.craftSelected(world); err != nil {
-			return es.Transition[w.World]{}, err
-		}
+		return st.craftSelected(world)
 	default:
 		return es.Transition[w.World]{}, fmt.Errorf("craftMenu: unsupported action: %s", action)
 	}
@@ -102,7 +102,7 @@ type craftTabData struct {
 type craftItemData struct {
 	RecipeID   string // 合成の同定キー。NewRecipeSpec/CanCraft/Craft はこれで引く
 	RecipeName string // 表示名
-	CanCraft   bool
+	CanCraft   bool   // 素材・作業場・工具が揃っている
 }
 
 // Fetch は世界から表示 props を構築する。menuloop.Model の Model 部にあたる
@@ -127,9 +127,10 @@ func (st *CraftMenuState) Menu(props CraftProps) menuloop.MenuConfig {
 
 func (st *CraftMenuState) createMenuItems(world w.World, recipeIDs []string) []craftItemData {
 	items := make([]craftItemData, len(recipeIDs))
+	player, playerErr := query.GetPlayerEntity(world)
 
 	for i, recipeID := range recipeIDs {
-		canCraft, _ := gameaction.CanCraft(world, recipeID)
+		canCraft := playerErr == nil && activity.CheckCraftRequirements(world, player, recipeID) == nil
 		// レシピ id は生成アイテム id と一致する。表示名はアイテムの英語名を翻訳して出し、材料表示と経路を揃える
 		items[i] = craftItemData{
 			RecipeID:   recipeID,
@@ -197,20 +198,34 @@ func (st *CraftMenuState) queryMenuWearable(world w.World) []string {
 // 合成
 // ================
 
-// craftSelected は現在カーソルが当たっているレシピを合成し、結果モーダルを開く。
+// craftSelected は現在カーソルが当たっているレシピの合成アクティビティを始める。
+// その場で仕上がれば結果モーダルを開く。作業量のあるレシピは画面を閉じ、ターンの進行に合成を任せる。
 // 合成不可のレシピは何もしない。決定で即実行し、途中のアクション選択は挟まない
-func (st *CraftMenuState) craftSelected(world w.World) error {
+func (st *CraftMenuState) craftSelected(world w.World) (es.Transition[w.World], error) {
 	item, ok := st.selectedRecipe()
 	if !ok || !item.CanCraft {
-		return nil
+		return es.Transition[w.World]{Type: es.TransNone}, nil
 	}
-	resultEntity, err := gameaction.Craft(world, item.RecipeID)
+	player, err := query.GetPlayerEntity(world)
 	if err != nil {
-		return fmt.Errorf("failed to craft: %w", err)
+		return es.Transition[w.World]{}, err
 	}
-	st.resultEntity = resultEntity
-	st.result.Open(world)
-	return nil
+	act := activity.NewCraftActivity(item.RecipeID, player, world)
+	result, err := activity.Execute(act, player, world)
+	if err != nil {
+		return es.Transition[w.World]{}, fmt.Errorf("failed to craft: %w", err)
+	}
+	switch result.State {
+	case gc.ActivityStateRunning:
+		return es.Transition[w.World]{Type: es.TransPop}, nil
+	case gc.ActivityStateCompleted:
+		// 完了時に Finish が合成したアイテムを Params へ書き戻している
+		if p, ok := act.Params.(*gc.CraftParams); ok && p.Result != gc.InvalidEntity {
+			st.resultEntity = p.Result
+			st.result.Open(world)
+		}
+	}
+	return es.Transition[w.World]{Type: es.TransNone}, nil
 }
 
 // selectedRecipe は現在カーソルが当たっているレシピを返す
@@ -294,6 +309,7 @@ func (st *CraftMenuState) detailContent(world w.World) (overlay.DetailContent, b
 			label := query.T(world, raw.ItemName(world.Resources.RawMaster, in.ID))
 			rows = append(rows, entityspec.SpecRow{Label: label, Value: fmt.Sprintf("%d / %d", in.Amount, owned), Color: &rowColor})
 		}
+		rows = append(rows, st.requirementRows(world, spec.Recipe)...)
 	}
 	rows = append(rows, entityspec.SpecRowsFromSpec(world, spec)...)
 
@@ -303,3 +319,40 @@ func (st *CraftMenuState) detailContent(world w.World) (overlay.DetailContent, b
 	}
 	return overlay.DetailContent{Name: item.RecipeName, Desc: desc, Rows: rows}, true
 }
+
+// requirementRows はレシピの作業場・工具・作業量の行を返す。
+// 作業場と工具は満たしていれば成功色、欠けていれば警告色で示す
+func (st *CraftMenuState) requirementRows(world w.World, recipe *gc.Recipe) []entityspec.SpecRow {
+	player, err := query.GetPlayerEntity(world)
+	if err != nil {
+		return nil
+	}
+	statusColor := func(ok bool) *color.RGBA {
+		c := theme.StatusDanger
+		if ok {
+			c = theme.StatusSuccess
+		}
+		return &c
+	}
+
+	var rows []entityspec.SpecRow
+	if recipe.Station != nil {
+		rows = append(rows, entityspec.SpecRow{
+			Label: query.T(world, "Station"),
+			Value: query.T(world, activity.CraftStationName(*recipe.Station)),
+			Color: statusColor(activity.HasCraftStationNearby(world, player, *recipe.Station)),
+		})
+	}
+	if recipe.Tool != nil {
+		grade, _, found := activity.FindBestDisassemblyTool(world, player, recipe.Tool.Category)
+		rows = append(rows, entityspec.SpecRow{
+			Label: query.T(world, "Tool"),
+			Value: fmt.Sprintf("%s %d", query.T(world, activity.ToolCategoryName(recipe.Tool.Category)), recipe.Tool.Grade),
+			Color: statusColor(found && grade >= recipe.Tool.Grade),
+		})
+	}
+	if recipe.WorkAP > 0 {
+		rows = append(rows, entityspec.SpecRow{Label: query.T(world, "Work"), Value: fmt.Sprintf("%d", recipe.WorkAP)})
+	}
+	return rows
+}
@@ -45,7 +45,7 @@ func debugMenuChoices(_ w.World) (string, []Choice) {
 		{Label: "Run result (death screen)", Run: func(world w.World) (es.Transition[w.World], error) {
 			// 死因に目印の debug を入れて結果画面を確認する
 			if s := query.GetRunStats(world); s != nil {
-				s.Cause = "debug"
+				s.Cause = gc.DeathCause{Killer: "debug"}
 			}
 			return es.Transition[w.World]{Type: es.TransPush, NewStateFuncs: []es.StateFactory[w.World]{NewRunResultState}}, nil
 		}},
@@ -127,6 +127,7 @@ func debugMenuChoices(_ w.World) (string, []Choice) {
 		Choice{Label: "Spawn prop: barrel (destructible)", Run: stayAfter(func(world w.World) error { return spawnPropNearPlayer(world, "barrel") })},
 		Choice{Label: "Spawn prop: construction_sign (impassable)", Run: stayAfter(func(world w.World) error { return spawnPropNearPlayer(world, "construction_sign") })},
 		Choice{Label: "Spawn prop: wooden crate (storage, with items)", Run: stayAfter(spawnStorageWithItems)},
+		Choice{Label: "Spawn prop: cold box (cold storage)", Run: stayAfter(func(world w.World) error { return spawnPropNearPlayer(world, "cold_box") })},
 		Choice{Label: "Component list", Run: pushChoice(NewComponentDebugState)},
 		Choice{Label: "Close", Run: func(_ w.World) (es.Transition[w.World], error) {
 			return es.Transition[w.World]{Type: es.TransPop}, nil
@@ -12,6 +12,7 @@ import (
 
 	"github.com/kijimaD/ruins/internal/world/gameaction"
 	"github.com/kijimaD/ruins/internal/world/lifecycle"
+	"github.com/kijimaD/ruins/internal/world/query"
 )
 
 // DemoStartState はデモ開始時のプレイヤー初期化を行うステート。
@@ -34,6 +35,11 @@ func (st *DemoStartState) OnStart(world w.World) error {
 		}
 	}
 
+	// 解放の条件を飛ばしてデバッグ用の持ち物で始めるので、この run は記録に残さない
+	if stats := query.GetRunStats(world); stats != nil {
+		stats.Demo = true
+	}
+
 	if err := spawnDebugBiscuits(world); err != nil {
 		return err
 	}
@@ -24,6 +24,7 @@ func TestDemoStartState_OnStart(t *testing.T) {
 	// プレイヤーが生成されていることを確認
 	_, err = query.GetPlayerEntity(world)
 	assert.NoError(t, err, "プレイヤーが生成されている")
+	assert.True(t, query.GetRunStats(world).Demo, "デモの run は記録に残さない印を持つ")
 }
 
 func TestDemoStartState_Update(t *testing.T) {
@@ -0,0 +1,55 @@
+package states
+
+import (
+	"fmt"
+
+	"github.com/kijimaD/ruins/internal/dialogue"
+	es "github.com/kijimaD/ruins/internal/engine/states"
+	"github.com/kijimaD/ruins/internal/messagedata"
+	"github.com/kijimaD/ruins/internal/oapi"
+	"github.com/kijimaD/ruins/internal/widgets/messagewindow"
+	w "github.com/kijimaD/ruins/internal/world"
+	"github.com/kijimaD/ruins/internal/world/lifecycle"
+	"github.com/mlange-42/ark/ecs"
+)
+
+// DialogueState はローデータの会話グラフを dialogue.Runner で進めるステート。
+// 会話を終える選択肢でウィンドウが閉じればポップする。店などを積んでから戻ったときは、
+// 会話を先頭から組み直し、条件を今の状態で評価し直す
+type DialogueState struct {
+	MessageState
+}
+
+var _ es.State[w.World] = &DialogueState{}
+
+// OnResume はステートが再開される際に呼ばれる
+func (st *DialogueState) OnResume(world w.World) error {
+	st.messageData = st.build(world)
+	st.messageWindow = messagewindow.NewWindow(world, st.messageData)
+	return nil
+}
+
+// NewDialogueState は speaker との会話 def を進めるステートを作成する。
+// openShop の効果は、前回から日が変わっていれば話し手の店に入荷させてから店を積む
+func NewDialogueState(world w.World, def oapi.Dialogue, speaker ecs.Entity) (es.State[w.World], error) {
+	st := &DialogueState{}
+	runner, err := dialogue.NewRunner(world, def, speaker, dialogue.Hooks{
+		OpenShop: func(world w.World) error {
+			if err := lifecycle.RestockMerchant(world, speaker, world.Resources.Config.RNG); err != nil {
+				return fmt.Errorf("failed to restock merchant: %w", err)
+			}
+			st.SetTransition(es.Transition[w.World]{
+				Type: es.TransPush,
+				NewStateFuncs: []es.StateFactory[w.World]{
+					func() (es.State[w.World], error) { return NewShopMenuState(speaker) },
+				},
+			})
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	st.build = func(_ w.World) *messagedata.MessageData { return runner.Start() }
+	return st, nil
+}
@@ -0,0 +1,42 @@
+package states
+
+import (
+	"testing"
+
+	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/raw"
+	"github.com/kijimaD/ruins/internal/testutil"
+	"github.com/kijimaD/ruins/internal/world/lifecycle"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDialogueState(t *testing.T) {
+	t.Parallel()
+
+	world := testutil.InitTestWorld(t)
+	_, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 10, Y: 10}, "ash")
+	require.NoError(t, err)
+	merchant, err := lifecycle.SpawnNeutralNPC(world, consts.Coord[consts.Tile]{X: 11, Y: 10}, "merchant")
+	require.NoError(t, err)
+	def, err := raw.FindDialogue(world.Resources.RawMaster, "merchant_greeting")
+	require.NoError(t, err)
+
+	state, err := NewDialogueState(world, def, merchant)
+	require.NoError(t, err)
+	ds, ok := state.(*DialogueState)
+	require.True(t, ok, "DialogueState型である")
+
+	require.NotNil(t, ds.build, "build が設定されている")
+	md := ds.build(world)
+
+	require.NotNil(t, md)
+	assert.NotEmpty(t, md.TextSegmentLines, "本文がある")
+	require.Len(t, md.Choices, 3, "見る・仕事を尋ねる・取引しないの3択がある")
+
+	// 見るを選ぶと店のステートへの遷移が積まれる
+	require.NoError(t, md.Choices[0].Action(world))
+	trans := ds.GetTransition()
+	require.NotNil(t, trans)
+	assert.Len(t, trans.NewStateFuncs, 1)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"image/color"
 	"sync"
+	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	gc "github.com/kijimaD/ruins/internal/components"
@@ -11,6 +12,7 @@ import (
 	es "github.com/kijimaD/ruins/internal/engine/states"
 	mapplanner "github.com/kijimaD/ruins/internal/mapplanner"
 	"github.com/kijimaD/ruins/internal/overworld"
+	"github.com/kijimaD/ruins/internal/screeneffect"
 	gs "github.com/kijimaD/ruins/internal/systems"
 	"github.com/kijimaD/ruins/internal/widgets/theme"
 	w "github.com/kijimaD/ruins/internal/world"
@@ -49,6 +51,8 @@ type DungeonState struct {
 
 	// three は3D表示の状態と操作。3D固有のものは dungeon3D に隔離する
 	three dungeon3D
+	// precipitation は地上の雨や雪を世界レイヤへ重ねるフィルタ。初回の Draw で作る
+	precipitation *screeneffect.PrecipitationFilter
 }
 
 // isSeamless はこの State がオーバーワールド帯モードかを返す。オーバーワールドとダンジョンの
@@ -181,9 +185,13 @@ func (st *DungeonState) completeSwap(world w.World) (es.Transition[w.World], err
 // OnStop はステートが停止される際に呼ばれる。
 //
 // 共存方式ではオーバーワールドと遺跡が同一 world に共存し、退避中ステージも保持するため、
-// ここでは何もしない。world を捨てるのは新しいゲームを始める・ロードのときで、
+// world には手を付けない。world を捨てるのは新しいゲームを始める・ロードのときで、
 // world.ResetForNewGame と save の ECS.Reset が担う。ステージ単位の破棄が要る場合は stage.Purge を呼ぶ。
-func (st *DungeonState) OnStop(_ w.World) error { return nil }
+// 曲は MusicSystem がこのステートの中でだけ流すので、死亡やメインメニューへ戻るときに止める
+func (st *DungeonState) OnStop(world w.World) error {
+	world.Resources.Audio.StopMusic()
+	return nil
+}
 
 // checkPlayerDeath はプレイヤーの死亡状態をチェックする。Update フローの述語
 func (st *DungeonState) checkPlayerDeath(world w.World) bool {
@@ -239,12 +247,14 @@ func (st *DungeonState) Update(world w.World) (es.Transition[w.World], error) {
 		&gs.WeightDirtySystem{},
 		&gs.VisualEffectSystem{},
 		&gs.AuctionSystem{},
+		&gs.MusicSystem{},
 	); err != nil {
 		return es.Transition[w.World]{}, err
 	}
 
-	// プレイヤー死亡チェック。死亡で run は終わり結果画面へ移る
+	// プレイヤー死亡チェック。死亡で run は終わり、死の記録とプロフィールへの記録を書き出して結果画面へ移る
 	if st.checkPlayerDeath(world) {
+		recordRunEnd(world, time.Now())
 		return es.Transition[w.World]{Type: es.TransPush, NewStateFuncs: []es.StateFactory[w.World]{NewRunResultState}}, nil
 	}
 
@@ -282,7 +292,7 @@ func (st *DungeonState) Update(world w.World) (es.Transition[w.World], error) {
 
 // Draw はゲームステートの描画処理を行う。世界と HUD を screen へ描く。
 // フィールドのライティングは vision が壁遮蔽込みで計算した per-tile の暗さを
-// Render3DSystem が描く。地上は時間帯の色フィルタを世界へ一様に掛ける。
+// Render3DSystem が描く。地上は時間帯の色フィルタを世界へ一様に掛け、天候の降水を重ねる。
 func (st *DungeonState) Draw(world w.World, screen *ebiten.Image) error {
 	if st.baseImage != nil {
 		screen.DrawImage(st.baseImage, nil)
@@ -296,6 +306,12 @@ func (st *DungeonState) Draw(world w.World, screen *ebiten.Image) error {
 	if query.IsOnOverworld(world) {
 		applyTimeOfDayTint(screen, query.GetGameTime(world).GetTimeOfDay())
 	}
+	// 降水は色フィルタの後に重ね、HUD には掛けない。遺跡の中は晴れ扱いなので降らない
+	if st.precipitation == nil {
+		st.precipitation = screeneffect.NewPrecipitationFilter()
+	}
+	st.precipitation.SetPrecipitation(weatherPrecipitation(query.CurrentWeather(world)))
+	st.precipitation.Draw(screen)
 	// HUD レイヤは screen へ等倍で描く。色フィルタを避けて文字やバーの読みやすさを保つ
 	return drawRenderers(world, screen,
 		&gs.HUDRenderingSystem{}, &gs.VisualEffectSystem{})
@@ -361,6 +377,22 @@ func timeOfDayTint(t gc.TimeOfDay) (r, g, b float32) {
 	panic(fmt.Sprintf("unknown TimeOfDay: %d", t))
 }
 
+// weatherPrecipitation は天候を降水の種類と強さへ写す。降らない天候は強さ0を返す。
+// default を置かず全 case を列挙する。天候を足したら exhaustive linter がここの漏れを検知する。
+func weatherPrecipitation(wt gc.Weather) (screeneffect.Precipitation, float64) {
+	switch wt {
+	case gc.WeatherClear, gc.WeatherOvercast, gc.WeatherFog:
+		return screeneffect.PrecipitationNone, 0
+	case gc.WeatherRain:
+		return screeneffect.PrecipitationRain, 0.6
+	case gc.WeatherThunderstorm:
+		return screeneffect.PrecipitationRain, 1.0
+	case gc.WeatherSnow:
+		return screeneffect.PrecipitationSnow, 0.7
+	}
+	panic(fmt.Sprintf("unknown Weather: %d", wt))
+}
+
 // drawRenderers は登録済みのレンダラを順に target へ描く。未登録のものは飛ばす。
 func drawRenderers(world w.World, target *ebiten.Image, renderers ...w.Renderer) error {
 	for _, renderer := range renderers {
@@ -13,6 +13,7 @@ import (
 	mapplanner "github.com/kijimaD/ruins/internal/mapplanner"
 	"github.com/kijimaD/ruins/internal/menuloop"
 	"github.com/kijimaD/ruins/internal/messagedata"
+	"github.com/kijimaD/ruins/internal/raw"
 	w "github.com/kijimaD/ruins/internal/world"
 
 	"github.com/kijimaD/ruins/internal/world/lifecycle"
@@ -39,6 +40,7 @@ var dungeonBindings = []keybind.Binding{
 	{Key: ebiten.KeyE, Action: inputmapper.ActionVerbConsume, Label: "Eat"},
 	{Key: ebiten.KeyR, Action: inputmapper.ActionVerbRead, Label: "Read"},
 	{Key: ebiten.KeyT, Action: inputmapper.ActionVerbUse, Label: "Use"},
+	{Key: ebiten.KeyV, Action: inputmapper.ActionVerbThrow, Label: "Throw"},
 	{Key: ebiten.KeyS, Action: inputmapper.ActionVerbList, Label: "List"},
 	// 移動。WASD は動詞へ空けるため矢印キーのみを使う。斜めへは視点を回してから直進する
 	{Key: ebiten.KeyUp, Press: keybind.PressRepeat, Action: inputmapper.ActionMoveNorth, Label: "Move"},
@@ -257,22 +259,24 @@ func (st *DungeonState) handleStateChangeRequest(world w.World) (es.Transition[w
 		}
 		speakerName := query.GetEntityName(p.SpeakerEntity, world)
 
-		// NPCの種類に応じて専用ステートを返す
+		// 専用の画面を持つ NPC はステートを直接返し、それ以外はローデータの会話グラフで進める
 		switch p.MessageKey {
-		case "merchant_greeting":
-			merchant := p.SpeakerEntity
-			return es.Transition[w.World]{Type: es.TransPush, NewStateFuncs: []es.StateFactory[w.World]{
-				func() (es.State[w.World], error) { return NewMerchantDialogState(speakerName, merchant) },
-			}}, nil
 		case "doctor_greeting":
 			return es.Transition[w.World]{Type: es.TransPush, NewStateFuncs: []es.StateFactory[w.World]{
 				func() (es.State[w.World], error) { return NewDoctorDialogState(speakerName) },
 			}}, nil
 		default:
-			// 通常の会話はdialoguesから取得
-			dialogMessage := messagedata.GetDialogue(world, p.MessageKey, speakerName)
+			def, err := raw.FindDialogue(world.Resources.RawMaster, p.MessageKey)
+			if err != nil {
+				// 会話の定義がない NPC は黙っている
+				dialogMessage := messagedata.NewDialogMessage("...", speakerName)
+				return es.Transition[w.World]{Type: es.TransPush, NewStateFuncs: []es.StateFactory[w.World]{
+					func() (es.State[w.World], error) { return NewMessageState(dialogMessage) },
+				}}, nil
+			}
+			speaker := p.SpeakerEntity
 			return es.Transition[w.World]{Type: es.TransPush, NewStateFuncs: []es.StateFactory[w.World]{
-				func() (es.State[w.World], error) { return NewMessageState(dialogMessage) },
+				func() (es.State[w.World], error) { return NewDialogueState(world, def, speaker) },
 			}}, nil
 		}
 	case gc.WarpDescend:
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/kijimaD/ruins/internal/audio"
 	gc "github.com/kijimaD/ruins/internal/components"
 	"github.com/kijimaD/ruins/internal/consts"
 	"github.com/kijimaD/ruins/internal/dungeon"
@@ -449,6 +450,15 @@ func TestDungeonState_OnStartResume_PreservesWorld(t *testing.T) {
 	assert.True(t, world.ECS.Alive(prop), "復元済みエンティティが保持される")
 }
 
+func TestDungeonState_OnStop_曲を止める(t *testing.T) {
+	t.Parallel()
+	world := testutil.InitTestWorld(t)
+	world.Resources.Audio.PlayMusic(audio.MusicDungeon)
+
+	require.NoError(t, (&DungeonState{}).OnStop(world))
+	assert.Empty(t, world.Resources.Audio.Music(), "死亡やメインメニューへ戻った先でダンジョンの曲を流し続けない")
+}
+
 // TestDungeonBindings_ヘルプとデバッグのSlash共有 は、デバッグ表を重ねても Shift+Slash の
 // ヘルプが影で食われないことを固定する。デバッグ表は先に評価されるため、Shift 条件を
 // 誤ると Shift+Slash がデバッグメニューに一致してヘルプへ届かなくなる
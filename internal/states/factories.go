@@ -9,6 +9,7 @@ import (
 	"github.com/kijimaD/ruins/internal/logger"
 	mapplanner "github.com/kijimaD/ruins/internal/mapplanner"
 	"github.com/kijimaD/ruins/internal/messagedata"
+	"github.com/kijimaD/ruins/internal/morgue"
 	"github.com/kijimaD/ruins/internal/overworld"
 	"github.com/kijimaD/ruins/internal/save"
 	w "github.com/kijimaD/ruins/internal/world"
@@ -28,6 +29,11 @@ func dungeonMenuChoices(world w.World) (string, []Choice) {
 	return "", []Choice{
 		{Label: query.T(world, "Inventory"), Run: pushChoice(NewItemActionState(verbExamine))},
 		{Label: query.T(world, "Character"), Run: pushChoice(NewCharacterState)},
+		// メニューは閉じて合成画面へ移る。作業量のある合成を始めたらそのままダンジョンへ戻る
+		{Label: query.T(world, "Crafting"), Run: func(_ w.World) (es.Transition[w.World], error) {
+			return es.Transition[w.World]{Type: es.TransSwitch, NewStateFuncs: []es.StateFactory[w.World]{NewCraftMenuState}}, nil
+		}},
+		{Label: query.T(world, "Journal"), Run: pushChoice(NewJournalState)},
 		{Label: query.T(world, "Statistics"), Run: pushChoice(NewRunStatsState)},
 		{Label: query.T(world, "Save game"), Run: pushChoice(NewSaveMenuState)},
 		{Label: query.T(world, "Quit"), Run: func(_ w.World) (es.Transition[w.World], error) {
@@ -204,6 +210,29 @@ func NewLoadMenuState() (es.State[w.World], error) {
 	}), nil
 }
 
+// NewMorgueMenuState は保存済みの死の記録を新しい順に並べる画面を作成するファクトリー関数。
+// 選ぶとその記録の本文を開く
+func NewMorgueMenuState() (es.State[w.World], error) {
+	// 一覧はファイル IO を伴うので初回 Fetch で一度だけ組んでキャッシュする
+	var choices []Choice
+	return NewChoiceMenu(func(world w.World) (string, []Choice) {
+		if choices == nil {
+			names, err := morgue.List()
+			if err != nil {
+				logger.New(logger.CategorySave).Error("failed to list morgue", "error", err.Error())
+			}
+			for _, name := range names {
+				choices = append(choices, Choice{Label: morgue.Label(name), Run: pushChoice(NewMorgueState(name))})
+			}
+			if len(names) == 0 {
+				choices = append(choices, Choice{Label: query.T(world, "No entries"), Header: true})
+			}
+			choices = append(choices, backChoice(world))
+		}
+		return query.T(world, "Morgue"), choices
+	}), nil
+}
+
 // backChoice は戻る選択肢を返す。選択メニューで共通に使う
 func backChoice(world w.World) Choice {
 	return Choice{Label: query.T(world, "Back"), Run: func(_ w.World) (es.Transition[w.World], error) {
@@ -322,31 +351,6 @@ func sameTileActionChoices(world w.World) (string, []Choice) {
 	return "", interactionActionChoices(GetSameTileManualActions(world))
 }
 
-// NewMerchantDialogState は商人との会話ステートを作成。merchant はこの商人の実体で、店を開くとき在庫の持ち主として渡す
-func NewMerchantDialogState(speakerName string, merchant ecs.Entity) (es.State[w.World], error) {
-	persistentState := &PersistentMessageState{}
-
-	persistentState.build = func(world w.World) *messagedata.MessageData {
-		return messagedata.NewDialogMessage("", speakerName).
-			AddText(query.T(world, "Want to make a deal?\n\nI've got good stuff.")).
-			WithChoice(query.T(world, "Look"), func(_ w.World) error {
-				persistentState.SetTransition(es.Transition[w.World]{
-					Type: es.TransPush,
-					NewStateFuncs: []es.StateFactory[w.World]{
-						func() (es.State[w.World], error) { return NewShopMenuState(merchant) },
-					},
-				})
-				return nil
-			}).
-			WithChoice(query.T(world, "No business"), func(_ w.World) error {
-				persistentState.SetTransition(es.Transition[w.World]{Type: es.TransPop})
-				return nil
-			})
-	}
-
-	return persistentState, nil
-}
-
 // NewDoctorDialogState は怪しい科学者との会話ステートを作成
 func NewDoctorDialogState(speakerName string) (es.State[w.World], error) {
 	persistentState := &PersistentMessageState{}
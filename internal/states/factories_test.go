@@ -49,22 +49,3 @@ func TestNewGameOverMessageState(t *testing.T) {
 	assert.NotEmpty(t, md.TextSegmentLines, "本文がある")
 	assert.NotEmpty(t, md.Choices, "メインメニューへ戻る選択肢がある")
 }
-
-func TestNewMerchantDialogState(t *testing.T) {
-	t.Parallel()
-
-	world := testutil.InitTestWorld(t)
-	merchant := world.ECS.NewEntity()
-
-	state, err := NewMerchantDialogState("商人", merchant)
-	require.NoError(t, err)
-	ps, ok := state.(*PersistentMessageState)
-	require.True(t, ok, "PersistentMessageState型である")
-
-	require.NotNil(t, ps.build, "build が設定されている")
-	md := ps.build(world)
-
-	require.NotNil(t, md)
-	assert.NotEmpty(t, md.TextSegmentLines, "本文がある")
-	assert.Len(t, md.Choices, 2, "見る・取引しないの2択がある")
-}
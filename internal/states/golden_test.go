@@ -12,6 +12,7 @@ import (
 
 	"github.com/kijimaD/ruins/internal/consts"
 	"github.com/kijimaD/ruins/internal/dungeon"
+	"github.com/kijimaD/ruins/internal/loader"
 	"github.com/kijimaD/ruins/internal/mapplanner"
 	gs "github.com/kijimaD/ruins/internal/states"
 	"github.com/kijimaD/ruins/internal/vrt"
@@ -20,7 +21,17 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// TestMain は本番の起動と同じくローデータのダンジョンを登録し、ebiten の実行状態の中で全テストを実行する
 func TestMain(m *testing.M) {
+	master, err := loader.LoadRaws("")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to load raw data: %v\n", err)
+		os.Exit(1)
+	}
+	if err := dungeon.Register(master); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to register dungeons: %v\n", err)
+		os.Exit(1)
+	}
 	os.Exit(vrt.RunTestMain(m))
 }
 
@@ -0,0 +1,215 @@
+package states
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ebitenui/ebitenui"
+	"github.com/hajimehoshi/ebiten/v2"
+	es "github.com/kijimaD/ruins/internal/engine/states"
+	"github.com/kijimaD/ruins/internal/inputmapper"
+	"github.com/kijimaD/ruins/internal/keybind"
+	"github.com/kijimaD/ruins/internal/menuloop"
+	"github.com/kijimaD/ruins/internal/morgue"
+	"github.com/kijimaD/ruins/internal/oapi"
+	"github.com/kijimaD/ruins/internal/raw"
+	"github.com/kijimaD/ruins/internal/resources"
+	"github.com/kijimaD/ruins/internal/widgets/menuframe"
+	"github.com/kijimaD/ruins/internal/widgets/styled"
+	w "github.com/kijimaD/ruins/internal/world"
+	"github.com/kijimaD/ruins/internal/world/query"
+)
+
+// 殿堂画面。プロフィールに積んだ run の記録をスコア順に並べるタブと、節目で解放される
+// 職業・初期所持の条件と到達状況を並べるタブを持つ。読み取り専用で、プロフィールを書き換えない。
+
+const hallOfFameMenuKey = "hall_of_fame"
+
+// hallOfFameSize は記録タブに並べる上位の件数
+const hallOfFameSize = 50
+
+// HallOfFameState は終えた run の記録と解放状況を見る読み取り専用画面
+type HallOfFameState struct {
+	es.BaseState[w.World]
+	screen *menuloop.Screen[HallOfFameProps]
+}
+
+// HallOfFameProps は殿堂画面の表示 props。タブごとに列が違うので行を分けて持つ
+type HallOfFameProps struct {
+	Records []hallOfFameRecord
+	Unlocks []hallOfFameUnlock
+}
+
+// hallOfFameRecord は記録タブの1行。値はどれも表示用に整えた文字列
+type hallOfFameRecord struct {
+	Rank       string
+	Name       string
+	Profession string
+	Days       string
+	Score      string
+	Cause      string
+}
+
+// hallOfFameUnlock は解放タブの1行。解放される対象と条件、到達状況
+type hallOfFameUnlock struct {
+	Label     string
+	Condition string
+	Status    string
+}
+
+var _ es.State[w.World] = &HallOfFameState{}
+
+// NewHallOfFameState は殿堂画面を作る。メインメニューから開き、閉じると元へ戻る
+func NewHallOfFameState() (es.State[w.World], error) {
+	return &HallOfFameState{}, nil
+}
+
+// OnStart は Screen を組み立てる。overlay は持たない読み取り専用画面
+func (st *HallOfFameState) OnStart(_ w.World) error {
+	st.screen = menuloop.NewScreen[HallOfFameProps](st)
+	return nil
+}
+
+// Update はステートの更新処理を Screen へ委譲する
+func (st *HallOfFameState) Update(world w.World) (es.Transition[w.World], error) {
+	return st.screen.Update(world)
+}
+
+// Draw はステートの描画を Screen へ委譲する
+func (st *HallOfFameState) Draw(_ w.World, screen *ebiten.Image) error {
+	st.screen.Draw(screen)
+	return nil
+}
+
+// DoAction は閲覧中の Action を処理する。読み取り専用なので閉じる操作だけ扱う
+func (st *HallOfFameState) DoAction(_ w.World, action inputmapper.ActionID) (es.Transition[w.World], error) {
+	switch action {
+	case inputmapper.ActionMenuCancel, inputmapper.ActionCloseMenu:
+		return es.Transition[w.World]{Type: es.TransPop}, nil
+	case inputmapper.ActionMenuSelect:
+		return es.Transition[w.World]{Type: es.TransNone}, nil
+	default:
+		return es.Transition[w.World]{}, fmt.Errorf("unknown action: %s", action)
+	}
+}
+
+// Fetch はプロフィールの記録を上位から、解放対象を職業の定義順に組む
+func (st *HallOfFameState) Fetch(world w.World) (HallOfFameProps, error) {
+	return HallOfFameProps{
+		Records: hallOfFameRecords(world),
+		Unlocks: hallOfFameUnlocks(world),
+	}, nil
+}
+
+// Menu は記録と解放の2タブ構成を返す
+func (st *HallOfFameState) Menu(props HallOfFameProps) menuloop.MenuConfig {
+	return menuloop.MenuConfig{Key: hallOfFameMenuKey, TabCount: 2, ItemCounts: []int{len(props.Records), len(props.Unlocks)}}
+}
+
+// View は見出しとタブ帯、選択中タブの表を menuframe のタブ画面枠へ組む
+func (st *HallOfFameState) View(world w.World, props HallOfFameProps, cursor menuloop.Selection, res resources.UIResources) *ebitenui.UI {
+	var rows []menuRow
+	var colWidths []int
+	var aligns []styled.TextAlign
+	var headerRow []string
+	var emptyText string
+	switch cursor.TabIndex {
+	case 0:
+		colWidths = []int{30, 120, 100, 60, 70, 260}
+		aligns = []styled.TextAlign{styled.AlignRight, styled.AlignLeft, styled.AlignLeft, styled.AlignRight, styled.AlignRight, styled.AlignLeft}
+		headerRow = []string{"#", query.T(world, "Name"), query.T(world, "Profession"), query.T(world, "Days"), query.T(world, "Score"), query.T(world, "Cause of death")}
+		emptyText = query.T(world, "No records")
+		rows = make([]menuRow, len(props.Records))
+		for i, r := range props.Records {
+			rows[i] = menuRow{Cells: styled.TextCells(r.Rank, r.Name, r.Profession, r.Days, r.Score, r.Cause)}
+		}
+	default:
+		colWidths = []int{260, 260, 120}
+		aligns = []styled.TextAlign{styled.AlignLeft, styled.AlignLeft, styled.AlignRight}
+		headerRow = []string{"", query.T(world, "Unlock condition"), ""}
+		emptyText = query.T(world, "No entries")
+		rows = make([]menuRow, len(props.Unlocks))
+		for i, u := range props.Unlocks {
+			rows[i] = menuRow{Cells: styled.TextCells(u.Label, u.Condition, u.Status)}
+		}
+	}
+	content := renderMenuList(cursor.ItemIndex, rows, colWidths, aligns, menuListOpts{
+		AlwaysIndicator: true,
+		HeaderRow:       headerRow,
+		EmptyText:       emptyText,
+		// 列見出しの1行ぶん容量から引く
+		ItemsPerPage: menuframe.ListCapacity(res, true, true) - 1,
+	}, res)
+
+	return menuframe.NewTabScreen(res, menuframe.TabScreen{
+		Header:    query.T(world, "Hall of fame"),
+		TabLabels: []string{query.T(world, "Records"), query.T(world, "Unlocks")},
+		TabIndex:  cursor.TabIndex,
+		Content:   content,
+		Footer:    keybind.HelpHint(world),
+	})
+}
+
+// hallOfFameRecords はスコア上位の記録を表の行に組む。定義の消えた職業はIDのまま出す
+func hallOfFameRecords(world w.World) []hallOfFameRecord {
+	runs := world.Resources.Profile.Ranking(hallOfFameSize)
+	records := make([]hallOfFameRecord, len(runs))
+	for i, r := range runs {
+		profession := r.Profession
+		if prof, err := raw.GetProfession(world.Resources.RawMaster, r.Profession); err == nil {
+			profession = query.T(world, prof.Name)
+		}
+		records[i] = hallOfFameRecord{
+			Rank:       fmt.Sprintf("%d", i+1),
+			Name:       r.Name,
+			Profession: profession,
+			Days:       fmt.Sprintf("%d", r.Days),
+			Score:      fmt.Sprintf("%d", r.Score),
+			Cause:      morgue.CauseText(world, r.Cause),
+		}
+	}
+	return records
+}
+
+// hallOfFameUnlocks は節目を持つ職業と初期所持を職業の定義順に並べる。節目の無いものは出さない
+func hallOfFameUnlocks(world w.World) []hallOfFameUnlock {
+	p := world.Resources.Profile
+	var unlocks []hallOfFameUnlock
+	add := func(label string, m *oapi.Milestone) {
+		status := query.T(world, "Locked")
+		if p.Reached(m) {
+			status = query.T(world, "Unlocked")
+		}
+		unlocks = append(unlocks, hallOfFameUnlock{Label: label, Condition: milestoneText(world, m), Status: status})
+	}
+	for _, prof := range raw.PtrSlice(world.Resources.RawMaster.Professions) {
+		name := query.T(world, prof.Name)
+		if prof.Unlock != nil {
+			add(name, prof.Unlock)
+		}
+		for _, it := range prof.Items {
+			if it.Unlock != nil {
+				add(fmt.Sprintf("%s: %s x%d", name, query.T(world, raw.ItemName(world.Resources.RawMaster, it.Name)), it.Count), it.Unlock)
+			}
+		}
+	}
+	return unlocks
+}
+
+// milestoneText は節目の条件を現在言語の文にする。複数の項目はカンマでつなぐ
+func milestoneText(world w.World, m *oapi.Milestone) string {
+	if m == nil {
+		return ""
+	}
+	var parts []string
+	if m.Runs != nil {
+		parts = append(parts, query.T(world, "Finish %d runs", *m.Runs))
+	}
+	if m.Days != nil {
+		parts = append(parts, query.T(world, "Survive %d days", *m.Days))
+	}
+	if m.Score != nil {
+		parts = append(parts, query.T(world, "Score %d points", *m.Score))
+	}
+	return strings.Join(parts, ", ")
+}
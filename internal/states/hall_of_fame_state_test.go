@@ -0,0 +1,76 @@
+package states
+
+import (
+	"testing"
+
+	gc "github.com/kijimaD/ruins/internal/components"
+	es "github.com/kijimaD/ruins/internal/engine/states"
+	"github.com/kijimaD/ruins/internal/inputmapper"
+	"github.com/kijimaD/ruins/internal/oapi"
+	"github.com/kijimaD/ruins/internal/profile"
+	"github.com/kijimaD/ruins/internal/testutil"
+	"github.com/kijimaD/ruins/internal/world/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHallOfFameFetch_記録をスコア順に並べる(t *testing.T) {
+	t.Parallel()
+
+	world := testutil.InitTestWorld(t)
+	world.Resources.Profile = &profile.Profile{Runs: []profile.RunRecord{
+		{Name: "Ash", Profession: "hunter", Days: 2, Score: 200},
+		{Name: "Bea", Profession: "gone", Days: 5, Score: 500, Cause: gc.DeathCause{Killer: "Bat"}},
+	}}
+	state := &HallOfFameState{}
+	require.NoError(t, state.OnStart(world))
+
+	props, err := state.Fetch(world)
+	require.NoError(t, err)
+
+	require.Len(t, props.Records, 2)
+	assert.Equal(t, hallOfFameRecord{Rank: "1", Name: "Bea", Profession: "gone", Days: "5", Score: "500", Cause: query.T(world, "Killed by %s", "Bat")}, props.Records[0], "定義の消えた職業はIDのまま")
+	assert.Equal(t, "Ash", props.Records[1].Name)
+	assert.Equal(t, "Hunter", props.Records[1].Profession)
+}
+
+func TestHallOfFameFetch_節目の到達状況を並べる(t *testing.T) {
+	t.Parallel()
+
+	world := testutil.InitTestWorld(t)
+	world.Resources.Profile = &profile.Profile{Runs: []profile.RunRecord{{Days: 7}}}
+	state := &HallOfFameState{}
+	require.NoError(t, state.OnStart(world))
+
+	props, err := state.Fetch(world)
+	require.NoError(t, err)
+
+	status := map[string]string{}
+	for _, u := range props.Unlocks {
+		status[u.Label] = u.Status
+	}
+	assert.Equal(t, "Unlocked", status["Sniper"], "生存日数の節目に届いた")
+	assert.Equal(t, "Locked", status["Soldier"], "run 数の節目に届いていない")
+	assert.Len(t, props.Unlocks, 3, "節目を持つ職業2つと初期所持1つ")
+}
+
+func TestHallOfFameState_DoAction_Cancel(t *testing.T) {
+	t.Parallel()
+
+	state := &HallOfFameState{}
+	world := testutil.InitTestWorld(t)
+	require.NoError(t, state.OnStart(world))
+
+	transition, err := state.DoAction(world, inputmapper.ActionMenuCancel)
+	require.NoError(t, err)
+	assert.Equal(t, es.TransPop, transition.Type)
+}
+
+func TestMilestoneText(t *testing.T) {
+	t.Parallel()
+
+	world := testutil.InitTestWorld(t)
+	runs, days := 3, 7
+	assert.Empty(t, milestoneText(world, nil))
+	assert.Equal(t, "Finish 3 runs, Survive 7 days", milestoneText(world, &oapi.Milestone{Runs: &runs, Days: &days}))
+}
@@ -34,6 +34,7 @@ const (
 	verbConsume verbID = "consume" // 食べる。飲み物も含む
 	verbRead    verbID = "read"    // 読む
 	verbUse     verbID = "use"     // 使う
+	verbThrow   verbID = "throw"   // 投げる
 	verbTag     verbID = "tag"     // 出品する。タグを貼って競売にかける
 )
 
@@ -57,7 +58,6 @@ type itemVerb struct {
 }
 
 // verbList は表示順に並べた動詞タブの一覧。タブ順を兼ねる。内容は定数なのでパッケージ変数で1度だけ構築する。
-// 投げるは Throwable と ThrowActivity の実装後に足す。
 var verbList = []itemVerb{
 	{
 		ID:      verbExamine,
@@ -105,6 +105,15 @@ var verbList = []itemVerb{
 		Accept:  acceptUseTool,
 		Exec:    execUseItem,
 	},
+	{
+		ID:      verbThrow,
+		Label:   "Throw",
+		KeyHint: "v",
+		Key:     ebiten.KeyV,
+		Action:  inputmapper.ActionVerbThrow,
+		Accept:  func(world w.World, entity ecs.Entity) bool { return world.Components.Throwable.Has(entity) },
+		Exec:    execThrow,
+	},
 	{
 		ID:      verbTag,
 		Label:   "List",
@@ -163,6 +172,11 @@ func execUseItem(world w.World, entity ecs.Entity) (es.Transition[w.World], erro
 	return es.Transition[w.World]{Type: es.TransPop}, nil
 }
 
+// execThrow は選択アイテムを投げる対象選びへ移る。動詞画面は閉じ、投擲モードを閉じればダンジョンへ戻る
+func execThrow(_ w.World, entity ecs.Entity) (es.Transition[w.World], error) {
+	return es.Transition[w.World]{Type: es.TransSwitch, NewStateFuncs: []es.StateFactory[w.World]{NewThrowingState(entity)}}, nil
+}
+
 // execRead は選択した本の読書を開始しダンジョンへ戻る。読了は複数ターンにわたりダンジョンの進行が駆動する。
 func execRead(world w.World, entity ecs.Entity) (es.Transition[w.World], error) {
 	player, err := query.GetPlayerEntity(world)
@@ -46,8 +46,8 @@ func TestVerbByAction_直達アクションを動詞へ対応づける(t *testin
 		{"食べる", inputmapper.ActionVerbConsume, verbConsume, true},
 		{"読む", inputmapper.ActionVerbRead, verbRead, true},
 		{"使う", inputmapper.ActionVerbUse, verbUse, true},
+		{"投げる", inputmapper.ActionVerbThrow, verbThrow, true},
 		{"出品", inputmapper.ActionVerbList, verbTag, true},
-		{"投げるは未実装なので対応なし", inputmapper.ActionVerbThrow, verbID(""), false},
 		{"動詞でないアクションは対応なし", inputmapper.ActionMenuSelect, verbID(""), false},
 	}
 	for _, tt := range cases {
@@ -96,7 +96,8 @@ func TestVerbTabIndex_動詞の表示順を返す(t *testing.T) {
 	assert.Equal(t, 2, verbTabIndex(verbConsume))
 	assert.Equal(t, 3, verbTabIndex(verbRead))
 	assert.Equal(t, 4, verbTabIndex(verbUse))
-	assert.Equal(t, 5, verbTabIndex(verbTag))
+	assert.Equal(t, 5, verbTabIndex(verbThrow))
+	assert.Equal(t, 6, verbTabIndex(verbTag))
 	// 未知の動詞は先頭タブへ寄せる
 	assert.Equal(t, 0, verbTabIndex(verbID("unknown")))
 }
@@ -139,3 +140,21 @@ func TestAcceptConsumeFood_食べる対象と使う対象は排他になる(t *t
 		})
 	}
 }
+
+func TestExecThrow_投擲モードへ切り替える(t *testing.T) {
+	t.Parallel()
+	world := testutil.InitTestWorld(t)
+	item := world.ECS.NewEntity()
+
+	trans, err := execThrow(world, item)
+	require.NoError(t, err)
+	assert.Equal(t, es.TransSwitch, trans.Type, "動詞画面を閉じて投擲モードに置き換える")
+	require.Len(t, trans.NewStateFuncs, 1)
+
+	state, err := trans.NewStateFuncs[0]()
+	require.NoError(t, err)
+	shooting, ok := state.(*ShootingState)
+	require.True(t, ok)
+	require.NotNil(t, shooting.throwItem)
+	assert.Equal(t, item, *shooting.throwItem)
+}
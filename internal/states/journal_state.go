@@ -0,0 +1,162 @@
+package states
+
+import (
+	"fmt"
+
+	"github.com/ebitenui/ebitenui"
+	"github.com/hajimehoshi/ebiten/v2"
+	gc "github.com/kijimaD/ruins/internal/components"
+	es "github.com/kijimaD/ruins/internal/engine/states"
+	"github.com/kijimaD/ruins/internal/inputmapper"
+	"github.com/kijimaD/ruins/internal/keybind"
+	"github.com/kijimaD/ruins/internal/menuloop"
+	"github.com/kijimaD/ruins/internal/quest"
+	"github.com/kijimaD/ruins/internal/raw"
+	"github.com/kijimaD/ruins/internal/resources"
+	"github.com/kijimaD/ruins/internal/widgets/menuframe"
+	"github.com/kijimaD/ruins/internal/widgets/styled"
+	w "github.com/kijimaD/ruins/internal/world"
+	"github.com/kijimaD/ruins/internal/world/query"
+)
+
+// クエストのジャーナル画面。進行中と達成済みをタブに分け、クエスト名の見出し行の下に
+// 目標と達成量を2列で並べる。読み取り専用で、QuestLog を書き換えない。
+
+const journalMenuKey = "journal"
+
+// JournalState は受けたクエストの目標と進み具合を見る読み取り専用画面
+type JournalState struct {
+	es.BaseState[w.World]
+	screen *menuloop.Screen[JournalProps]
+}
+
+// JournalProps はジャーナル画面の表示 props
+type JournalProps struct {
+	Tabs []journalTabData
+}
+
+type journalTabData struct {
+	Label string
+	Items []statusItemData
+}
+
+var _ es.State[w.World] = &JournalState{}
+
+// NewJournalState はジャーナル画面を作る。常時メニューから開き、閉じると元へ戻る
+func NewJournalState() (es.State[w.World], error) {
+	return &JournalState{}, nil
+}
+
+// OnStart は Screen を組み立てる。overlay は持たない読み取り専用画面
+func (st *JournalState) OnStart(_ w.World) error {
+	st.screen = menuloop.NewScreen[JournalProps](st)
+	return nil
+}
+
+// Update はステートの更新処理を Screen へ委譲する
+func (st *JournalState) Update(world w.World) (es.Transition[w.World], error) {
+	return st.screen.Update(world)
+}
+
+// Draw はステートの描画を Screen へ委譲する
+func (st *JournalState) Draw(_ w.World, screen *ebiten.Image) error {
+	st.screen.Draw(screen)
+	return nil
+}
+
+// DoAction は閲覧中の Action を処理する。読み取り専用なので閉じる操作だけ扱う
+func (st *JournalState) DoAction(_ w.World, action inputmapper.ActionID) (es.Transition[w.World], error) {
+	switch action {
+	case inputmapper.ActionMenuCancel, inputmapper.ActionCloseMenu:
+		return es.Transition[w.World]{Type: es.TransPop}, nil
+	case inputmapper.ActionMenuSelect:
+		return es.Transition[w.World]{Type: es.TransNone}, nil
+	default:
+		return es.Transition[w.World]{}, fmt.Errorf("unknown action: %s", action)
+	}
+}
+
+// Fetch は進行中と達成済みのクエストをタブごとの行に組む
+func (st *JournalState) Fetch(world w.World) (JournalProps, error) {
+	return JournalProps{Tabs: []journalTabData{
+		{Label: query.T(world, "Active"), Items: journalItems(world, gc.QuestStatusActive)},
+		{Label: query.T(world, "Completed"), Items: journalItems(world, gc.QuestStatusCompleted)},
+	}}, nil
+}
+
+// Menu はタブごとの行数を返す。クエスト名の見出し行はカーソルを飛ばす
+func (st *JournalState) Menu(props JournalProps) menuloop.MenuConfig {
+	itemCounts := make([]int, len(props.Tabs))
+	skips := make([][]bool, len(props.Tabs))
+	for i, tab := range props.Tabs {
+		itemCounts[i] = len(tab.Items)
+		skips[i] = make([]bool, len(tab.Items))
+		for j, it := range tab.Items {
+			skips[i][j] = it.IsHeader
+		}
+	}
+	return menuloop.MenuConfig{Key: journalMenuKey, TabCount: len(props.Tabs), ItemCounts: itemCounts, ItemsPerPage: menuloop.ItemsPerPageAuto, Skips: skips}
+}
+
+// View は見出しとタブ帯、選択中タブの目標テーブルを menuframe のタブ画面枠へ組む
+func (st *JournalState) View(world w.World, props JournalProps, cursor menuloop.Selection, res resources.UIResources) *ebitenui.UI {
+	labels := make([]string, len(props.Tabs))
+	for i, tab := range props.Tabs {
+		labels[i] = tab.Label
+	}
+	var items []statusItemData
+	if cursor.TabIndex < len(props.Tabs) {
+		items = props.Tabs[cursor.TabIndex].Items
+	}
+
+	columnWidths := []int{260, 70}
+	aligns := []styled.TextAlign{styled.AlignLeft, styled.AlignRight}
+	rows := make([]menuRow, len(items))
+	for i, it := range items {
+		rows[i] = menuRow{Cells: styled.TextCells(it.Label, it.Value), Header: it.IsHeader}
+	}
+	content := renderMenuList(cursor.ItemIndex, rows, columnWidths, aligns, menuListOpts{
+		AlwaysIndicator: true,
+		EmptyText:       query.T(world, "No quests"),
+		ItemsPerPage:    menuframe.ListCapacity(res, true, true),
+	}, res)
+
+	return menuframe.NewTabScreen(res, menuframe.TabScreen{
+		Header:    query.T(world, "Journal"),
+		TabLabels: labels,
+		TabIndex:  cursor.TabIndex,
+		Content:   content,
+		Footer:    keybind.HelpHint(world),
+	})
+}
+
+// journalItems は status のクエストを受けた順に、名前の見出し行と目標の行に組む。
+// 目標の行は目標の文と「達成量/必要量」の2列。定義の消えたクエストは飛ばす
+func journalItems(world w.World, status gc.QuestStatus) []statusItemData {
+	ql := query.GetQuestLog(world)
+	if ql == nil {
+		return nil
+	}
+	var items []statusItemData
+	for _, q := range ql.Quests {
+		if q.Status != status {
+			continue
+		}
+		def, err := raw.FindQuest(world.Resources.RawMaster, q.ID)
+		if err != nil {
+			continue
+		}
+		items = append(items, statusItemData{Label: query.T(world, def.Name), IsHeader: true})
+		for i, obj := range def.Objectives {
+			progress := 0
+			if i < len(q.Progress) {
+				progress = q.Progress[i]
+			}
+			items = append(items, statusItemData{
+				Label: quest.ObjectiveLabel(world, obj),
+				Value: fmt.Sprintf("%d/%d", progress, quest.Target(obj)),
+			})
+		}
+	}
+	return items
+}
@@ -0,0 +1,43 @@
+package states
+
+import (
+	"testing"
+
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/testutil"
+	"github.com/kijimaD/ruins/internal/world/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestJournalItems は受けたクエストが状態ごとのタブに、名前の見出しと目標の行で並ぶことを確認する
+func TestJournalItems(t *testing.T) {
+	t.Parallel()
+
+	world := testutil.InitTestWorld(t)
+	assert.Empty(t, journalItems(world, gc.QuestStatusActive), "受けていなければ空")
+
+	ql := query.GetQuestLog(world)
+	require.True(t, ql.Start("rat_hunt", 1, 1))
+	ql.Find("rat_hunt").Progress[0] = 2
+
+	items := journalItems(world, gc.QuestStatusActive)
+	require.Len(t, items, 2)
+	assert.True(t, items[0].IsHeader)
+	assert.Equal(t, "Rat Hunt", items[0].Label)
+	assert.False(t, items[1].IsHeader)
+	assert.Equal(t, "Defeat Rat", items[1].Label)
+	assert.Equal(t, "2/3", items[1].Value)
+	assert.Empty(t, journalItems(world, gc.QuestStatusCompleted))
+
+	ql.Find("rat_hunt").Status = gc.QuestStatusCompleted
+	assert.Empty(t, journalItems(world, gc.QuestStatusActive))
+	assert.Len(t, journalItems(world, gc.QuestStatusCompleted), 2)
+
+	st := &JournalState{}
+	props, err := st.Fetch(world)
+	require.NoError(t, err)
+	cfg := st.Menu(props)
+	assert.Equal(t, []int{0, 2}, cfg.ItemCounts)
+	assert.Equal(t, []bool{true, false}, cfg.Skips[1], "クエスト名の見出しはカーソルを飛ばす")
+}
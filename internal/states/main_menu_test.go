@@ -30,17 +30,21 @@ func TestMainMenuState_項目と遷移の対応(t *testing.T) {
 	props, err := state.Fetch(world)
 	require.NoError(t, err)
 
-	require.Len(t, props.Items, 5, "メニュー項目は5つ")
+	require.Len(t, props.Items, 7, "メニュー項目は7つ")
 	assert.Equal(t, "Start", props.Items[0].Label)
 	assert.Equal(t, es.TransReplace, props.Items[0].Transition.Type, "開始は Replace")
 	assert.Equal(t, "Demo", props.Items[1].Label)
 	assert.Equal(t, es.TransReplace, props.Items[1].Transition.Type, "デモは Replace")
 	assert.Equal(t, "Load", props.Items[2].Label)
 	assert.Equal(t, es.TransPush, props.Items[2].Transition.Type, "読込は Push")
-	assert.Equal(t, "Settings", props.Items[3].Label)
-	assert.Equal(t, es.TransPush, props.Items[3].Transition.Type, "設定は Push")
-	assert.Equal(t, "Quit", props.Items[4].Label)
-	assert.Equal(t, es.TransQuit, props.Items[4].Transition.Type, "終了は Quit")
+	assert.Equal(t, "Morgue", props.Items[3].Label)
+	assert.Equal(t, es.TransPush, props.Items[3].Transition.Type, "死の記録は Push")
+	assert.Equal(t, "Hall of fame", props.Items[4].Label)
+	assert.Equal(t, es.TransPush, props.Items[4].Transition.Type, "殿堂は Push")
+	assert.Equal(t, "Settings", props.Items[5].Label)
+	assert.Equal(t, es.TransPush, props.Items[5].Transition.Type, "設定は Push")
+	assert.Equal(t, "Quit", props.Items[6].Label)
+	assert.Equal(t, es.TransQuit, props.Items[6].Transition.Type, "終了は Quit")
 }
 
 func TestMainMenuState_言語切替でラベルが変わる(t *testing.T) {
@@ -60,7 +64,7 @@ func TestMainMenuState_言語切替でラベルが変わる(t *testing.T) {
 	ja, err := state.Fetch(world)
 	require.NoError(t, err)
 	assert.Equal(t, "開始", ja.Items[0].Label, "ja は日本語")
-	assert.Equal(t, "設定", ja.Items[3].Label, "ja は日本語")
+	assert.Equal(t, "設定", ja.Items[5].Label, "ja は日本語")
 }
 
 func TestMainMenuState_DoAction_Cancel(t *testing.T) {
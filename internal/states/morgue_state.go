@@ -0,0 +1,115 @@
+package states
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ebitenui/ebitenui"
+	"github.com/hajimehoshi/ebiten/v2"
+	es "github.com/kijimaD/ruins/internal/engine/states"
+	"github.com/kijimaD/ruins/internal/inputmapper"
+	"github.com/kijimaD/ruins/internal/keybind"
+	"github.com/kijimaD/ruins/internal/logger"
+	"github.com/kijimaD/ruins/internal/menuloop"
+	"github.com/kijimaD/ruins/internal/morgue"
+	"github.com/kijimaD/ruins/internal/resources"
+	"github.com/kijimaD/ruins/internal/widgets/menuframe"
+	"github.com/kijimaD/ruins/internal/widgets/styled"
+	w "github.com/kijimaD/ruins/internal/world"
+	"github.com/kijimaD/ruins/internal/world/query"
+)
+
+// 死の記録の閲覧画面。メインメニューの一覧から選んだ1件の本文を、1行1項目のページ送りで見せる。
+// 本文は書き出した平文そのままで、読み取り専用。
+
+const morgueMenuKey = "morgue"
+
+// morgueLineWidth は本文1行の列幅。ログの行がそのまま入るよう他の一覧より広く取る
+const morgueLineWidth = 640
+
+// MorgueState は死の記録1件を読む読み取り専用画面
+type MorgueState struct {
+	es.BaseState[w.World]
+	name   string
+	lines  []string
+	screen *menuloop.Screen[MorgueProps]
+}
+
+// MorgueProps は死の記録画面の表示 props
+type MorgueProps struct {
+	Lines []string
+}
+
+var _ es.State[w.World] = &MorgueState{}
+
+// NewMorgueState は name の死の記録を読む画面のファクトリーを返す
+func NewMorgueState(name string) es.StateFactory[w.World] {
+	return func() (es.State[w.World], error) {
+		return &MorgueState{name: name}, nil
+	}
+}
+
+// OnStart は本文を1度だけ読み込んで Screen を組み立てる。読めなければ空の画面で開く
+func (st *MorgueState) OnStart(_ w.World) error {
+	text, err := morgue.Read(st.name)
+	if err != nil {
+		logger.New(logger.CategorySave).Warn("failed to read morgue", "name", st.name, "error", err)
+	}
+	st.lines = strings.Split(strings.TrimRight(text, "\n"), "\n")
+	if text == "" {
+		st.lines = nil
+	}
+	st.screen = menuloop.NewScreen[MorgueProps](st)
+	return nil
+}
+
+// Update はステートの更新処理を Screen へ委譲する
+func (st *MorgueState) Update(world w.World) (es.Transition[w.World], error) {
+	return st.screen.Update(world)
+}
+
+// Draw はステートの描画を Screen へ委譲する
+func (st *MorgueState) Draw(_ w.World, screen *ebiten.Image) error {
+	st.screen.Draw(screen)
+	return nil
+}
+
+// DoAction は閲覧中の Action を処理する。読み取り専用なので閉じる操作だけ扱う
+func (st *MorgueState) DoAction(_ w.World, action inputmapper.ActionID) (es.Transition[w.World], error) {
+	switch action {
+	case inputmapper.ActionMenuCancel, inputmapper.ActionCloseMenu:
+		return es.Transition[w.World]{Type: es.TransPop}, nil
+	case inputmapper.ActionMenuSelect:
+		return es.Transition[w.World]{Type: es.TransNone}, nil
+	default:
+		return es.Transition[w.World]{}, fmt.Errorf("unknown action: %s", action)
+	}
+}
+
+// Fetch は読み込み済みの本文の行を返す
+func (st *MorgueState) Fetch(_ w.World) (MorgueProps, error) {
+	return MorgueProps{Lines: st.lines}, nil
+}
+
+// Menu は単一タブの読み取り専用構成を返す。ページ送りは一覧の描画がカーソル位置から行う
+func (st *MorgueState) Menu(props MorgueProps) menuloop.MenuConfig {
+	return menuloop.MenuConfig{Key: morgueMenuKey, TabCount: 1, ItemCounts: []int{len(props.Lines)}}
+}
+
+// View は本文の行を menuframe のタブ画面枠へ組む
+func (st *MorgueState) View(world w.World, props MorgueProps, cursor menuloop.Selection, res resources.UIResources) *ebitenui.UI {
+	rows := make([]menuRow, len(props.Lines))
+	for i, line := range props.Lines {
+		rows[i] = menuRow{Cells: styled.TextCells(line)}
+	}
+	content := renderMenuList(cursor.ItemIndex, rows, []int{morgueLineWidth}, []styled.TextAlign{styled.AlignLeft}, menuListOpts{
+		AlwaysIndicator: true,
+		EmptyText:       query.T(world, "No entries"),
+		ItemsPerPage:    menuframe.ListCapacity(res, true, false),
+	}, res)
+	return menuframe.NewTabScreen(res, menuframe.TabScreen{
+		Header:  query.T(world, "Morgue"),
+		Content: content,
+		Footer:  keybind.HelpHint(world),
+	})
+}
@@ -2,6 +2,7 @@ package states
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/ebitenui/ebitenui"
 	"github.com/ebitenui/ebitenui/widget"
@@ -10,7 +11,10 @@ import (
 	es "github.com/kijimaD/ruins/internal/engine/states"
 	"github.com/kijimaD/ruins/internal/inputmapper"
 	"github.com/kijimaD/ruins/internal/keybind"
+	"github.com/kijimaD/ruins/internal/logger"
 	"github.com/kijimaD/ruins/internal/menuloop"
+	"github.com/kijimaD/ruins/internal/morgue"
+	"github.com/kijimaD/ruins/internal/profile"
 	"github.com/kijimaD/ruins/internal/resources"
 	"github.com/kijimaD/ruins/internal/widgets/menuframe"
 	"github.com/kijimaD/ruins/internal/widgets/styled"
@@ -30,12 +34,14 @@ type RunStatsState struct {
 	headerMsgid  string                 // 見出しの msgid。統計は "Statistics"、結果は "You died."
 	exit         es.Transition[w.World] // Cancel で抜ける先。統計は Pop、結果はメインメニューへ Replace
 	exitOnSelect bool                   // Select でも exit へ抜けるか。結果画面は任意キーで戻せるよう真にする
+	showCause    bool                   // 見出しに死因を添えるか。結果画面だけ真にする
 	screen       *menuloop.Screen[RunStatsProps]
 }
 
 // RunStatsProps は統計画面の表示 props
 type RunStatsProps struct {
 	Items []statusItemData
+	Cause string // 見出しに添える死因の文。死因を見せない画面や未記録なら空
 }
 
 var _ es.State[w.World] = &RunStatsState{}
@@ -54,6 +60,7 @@ func NewRunResultState() (es.State[w.World], error) {
 		headerMsgid:  "You died.",
 		exit:         es.Transition[w.World]{Type: es.TransReplace, NewStateFuncs: []es.StateFactory[w.World]{NewMainMenuState}},
 		exitOnSelect: true,
+		showCause:    true,
 	}, nil
 }
 
@@ -89,9 +96,13 @@ func (st *RunStatsState) DoAction(_ w.World, action inputmapper.ActionID) (es.Tr
 	}
 }
 
-// Fetch は表示する統計行を組む
+// Fetch は表示する統計行と、結果画面なら死因の文を組む
 func (st *RunStatsState) Fetch(world w.World) (RunStatsProps, error) {
-	return RunStatsProps{Items: runStatsItems(world)}, nil
+	props := RunStatsProps{Items: runStatsItems(world)}
+	if s := query.GetRunStats(world); st.showCause && s != nil && !s.Cause.IsZero() {
+		props.Cause = morgue.CauseText(world, s.Cause)
+	}
+	return props, nil
 }
 
 // Menu は単一タブの読み取り専用構成を返す。見出し行が無いのでスキップは不要
@@ -102,8 +113,12 @@ func (st *RunStatsState) Menu(props RunStatsProps) menuloop.MenuConfig {
 // View は見出しと統計テーブルを menuframe のタブ画面枠へ組む。ラベルの訳のみ world から引く
 func (st *RunStatsState) View(world w.World, props RunStatsProps, cursor menuloop.Selection, res resources.UIResources) *ebitenui.UI {
 	content := buildStatsTable(world, props.Items, cursor.ItemIndex, res)
+	header := query.T(world, st.headerMsgid)
+	if props.Cause != "" {
+		header = fmt.Sprintf("%s %s", header, props.Cause)
+	}
 	return menuframe.NewTabScreen(res, menuframe.TabScreen{
-		Header:  query.T(world, st.headerMsgid),
+		Header:  header,
 		Content: content,
 		Footer:  keybind.HelpHint(world),
 	})
@@ -151,3 +166,37 @@ func runStatsFields(world w.World) (days, turns, kills, items int, sales consts.
 	}
 	return
 }
+
+// recordRunEnd は終えた run の死の記録を書き出し、プロフィールへ書き足す。
+// デモの run は記録に残さない。書き出せなくても結果画面へは進めるため、警告のみで続ける
+func recordRunEnd(world w.World, now time.Time) {
+	if stats := query.GetRunStats(world); stats != nil && stats.Demo {
+		return
+	}
+	if _, err := morgue.Write(world, now); err != nil {
+		logger.New(logger.CategorySave).Warn("failed to write morgue", "error", err)
+	}
+	if err := world.Resources.Profile.Add(newRunRecord(world, now)); err != nil {
+		logger.New(logger.CategorySave).Warn("failed to record run to profile", "error", err)
+	}
+}
+
+// newRunRecord は現在の run をプロフィールに書き足す記録に組む。名前は入力されたまま、職業はIDで残す
+func newRunRecord(world w.World, now time.Time) profile.RunRecord {
+	rec := profile.RunRecord{EndedAt: now}
+	if player, err := query.GetPlayerEntity(world); err == nil {
+		if world.Components.Name.Has(player) {
+			rec.Name = world.Components.Name.Get(player).Name
+		}
+		if world.Components.Profession.Has(player) {
+			rec.Profession = world.Components.Profession.Get(player).ID
+		}
+	}
+	rec.Days, _, _, _, _ = runStatsFields(world)
+	stats := query.GetRunStats(world)
+	if stats != nil {
+		rec.Cause = stats.Cause
+	}
+	rec.Score = profile.Score(rec.Days, stats)
+	return rec
+}
@@ -3,8 +3,13 @@ package states
 import (
 	"strings"
 	"testing"
+	"time"
 
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/profile"
 	"github.com/kijimaD/ruins/internal/testutil"
+	"github.com/kijimaD/ruins/internal/world/lifecycle"
 	"github.com/kijimaD/ruins/internal/world/query"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -36,3 +41,84 @@ func TestRunStatsItems(t *testing.T) {
 		assert.Contains(t, joined, want, "統計行に値 %s が含まれる", want)
 	}
 }
+
+// TestRunStatsFetch_結果画面だけ死因を添える は記録した死因が結果画面の props にだけ載ることを確認する
+func TestRunStatsFetch_結果画面だけ死因を添える(t *testing.T) {
+	t.Parallel()
+
+	world := testutil.InitTestWorld(t)
+	query.GetRunStats(world).Cause = gc.DeathCause{Killer: "Bat"}
+
+	result, err := NewRunResultState()
+	require.NoError(t, err)
+	props, err := result.(*RunStatsState).Fetch(world)
+	require.NoError(t, err)
+	assert.Contains(t, props.Cause, query.T(world, "Bat"))
+
+	stats, err := NewRunStatsState()
+	require.NoError(t, err)
+	props, err = stats.(*RunStatsState).Fetch(world)
+	require.NoError(t, err)
+	assert.Empty(t, props.Cause)
+}
+
+// TestNewRunRecord は終えた run の記録へ名前・職業・日数・死因・スコアが載ることを確認する
+func TestNewRunRecord(t *testing.T) {
+	t.Parallel()
+
+	world := testutil.InitTestWorld(t)
+	player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 5, Y: 5}, "ash")
+	require.NoError(t, err)
+	require.NoError(t, gc.Upsert(world.ECS, world.Components.Profession, player, &gc.Profession{ID: "hunter"}))
+	stats := query.GetRunStats(world)
+	stats.EnemiesKilled = 3
+	stats.Cause = gc.DeathCause{Killer: "Bat"}
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	rec := newRunRecord(world, now)
+
+	assert.Equal(t, world.Components.Name.Get(player).Name, rec.Name)
+	assert.Equal(t, "hunter", rec.Profession)
+	assert.Equal(t, query.GetGameTime(world).GetDayNumber(), rec.Days)
+	assert.Equal(t, gc.DeathCause{Killer: "Bat"}, rec.Cause)
+	assert.Equal(t, profile.Score(rec.Days, stats), rec.Score)
+	assert.Equal(t, now, rec.EndedAt)
+}
+
+// countingMorgue は書き出された死の記録を数える resources.MorgueWriter
+type countingMorgue struct{ n int }
+
+func (m *countingMorgue) WriteEntry(string, []byte) error {
+	m.n++
+	return nil
+}
+
+// TestRecordRunEnd はふつうの run は死の記録とプロフィールに残り、デモの run はどちらにも残らないことを確認する
+func TestRecordRunEnd(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name    string
+		demo    bool
+		runs    int
+		morgues int
+	}{
+		{"ふつうの run は記録する", false, 1, 1},
+		{"デモの run は記録しない", true, 0, 0},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			world := testutil.InitTestWorld(t)
+			written := &countingMorgue{}
+			world.Resources.Morgue = written
+			world.Resources.Profile = &profile.Profile{}
+			query.GetRunStats(world).Demo = tt.demo
+
+			recordRunEnd(world, time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC))
+
+			assert.Equal(t, tt.runs, world.Resources.Profile.RunCount())
+			assert.Equal(t, tt.morgues, written.n)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/ebitenui/ebitenui"
 	"github.com/ebitenui/ebitenui/widget"
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/kijimaD/ruins/internal/audio"
 	es "github.com/kijimaD/ruins/internal/engine/states"
 	"github.com/kijimaD/ruins/internal/i18n"
 	"github.com/kijimaD/ruins/internal/inputmapper"
@@ -20,7 +21,7 @@ import (
 )
 
 // SettingsMenuState はグローバル設定を変更するゲームステート。
-// メインメニューから push される。現状は設定項目が無く、将来の設定（音量など）を追加する土台。
+// メインメニューから push される。表示言語と音量を変更できる。
 type SettingsMenuState struct {
 	es.BaseState[w.World]
 	screen *menuloop.Screen[SettingsMenuProps]
@@ -71,8 +72,13 @@ func (st *SettingsMenuState) cycleFocused(world w.World) {
 	if !ok {
 		return
 	}
-	if item.Kind == settingsItemLanguage {
+	switch item.Kind {
+	case settingsItemLanguage:
 		cycleLanguage(world)
+	case settingsItemMasterVolume, settingsItemMusicVolume, settingsItemSEVolume:
+		cycleVolume(world, item.Kind)
+	case settingsItemBack:
+		// 値を持たない
 	}
 }
 
@@ -91,6 +97,12 @@ type settingsItemKind string
 const (
 	// settingsItemLanguage は言語を設定する項目を表す
 	settingsItemLanguage settingsItemKind = "language"
+	// settingsItemMasterVolume は全体の音量を設定する項目を表す
+	settingsItemMasterVolume settingsItemKind = "master_volume"
+	// settingsItemMusicVolume は曲の音量を設定する項目を表す
+	settingsItemMusicVolume settingsItemKind = "music_volume"
+	// settingsItemSEVolume は効果音の音量を設定する項目を表す
+	settingsItemSEVolume settingsItemKind = "se_volume"
 	// settingsItemBack は前の画面へ戻る項目を表す
 	settingsItemBack settingsItemKind = "back"
 )
@@ -104,9 +116,13 @@ type settingsMenuItem struct {
 
 // Fetch は世界から表示 props を構築する。menuloop.Model の Model 部にあたる
 func (st *SettingsMenuState) Fetch(world w.World) (SettingsMenuProps, error) {
+	user := world.Resources.Config.User
 	return SettingsMenuProps{
 		Items: []settingsMenuItem{
 			{Kind: settingsItemLanguage, Label: query.T(world, "Language"), Value: query.T(world, currentLanguageLabel(query.GetUserSettings(world).Language))},
+			{Kind: settingsItemMasterVolume, Label: query.T(world, "Master volume"), Value: volumeLabel(user.MasterVolume)},
+			{Kind: settingsItemMusicVolume, Label: query.T(world, "Music volume"), Value: volumeLabel(user.MusicVolume)},
+			{Kind: settingsItemSEVolume, Label: query.T(world, "Sound effects volume"), Value: volumeLabel(user.SEVolume)},
 			{Kind: settingsItemBack, Label: query.T(world, "Back")},
 		},
 	}, nil
@@ -183,6 +199,47 @@ func applyLanguage(world w.World, code string) {
 	}
 }
 
+// ================
+// 音量
+// ================
+
+// volumeStep は音量項目を1回送るときの増分（%）
+const volumeStep = 10
+
+// volumeLabel は音量の表示を返す
+func volumeLabel(volume int) string {
+	return fmt.Sprintf("%d%%", volume)
+}
+
+// nextVolume は volumeStep だけ上げた音量を返す。最大から送ると 0 へ戻る
+func nextVolume(volume int) int {
+	if volume >= 100 {
+		return 0
+	}
+	return min(volume+volumeStep, 100)
+}
+
+// cycleVolume は kind の音量を次へ循環させ、再生中の音へ即時反映してユーザー設定へ保存する。
+func cycleVolume(world w.World, kind settingsItemKind) {
+	user := &world.Resources.Config.User
+	var volume *int
+	switch kind {
+	case settingsItemMasterVolume:
+		volume = &user.MasterVolume
+	case settingsItemMusicVolume:
+		volume = &user.MusicVolume
+	case settingsItemSEVolume:
+		volume = &user.SEVolume
+	case settingsItemLanguage, settingsItemBack:
+		return
+	}
+	*volume = nextVolume(*volume)
+	world.Resources.Audio.SetVolume(audio.UserVolume(*user))
+	if err := world.Resources.Config.SaveUserConfig(); err != nil {
+		logger.New(logger.CategorySave).Warn("failed to save volume setting", "error", err)
+	}
+}
+
 // ================
 // View
 // ================
@@ -21,13 +21,19 @@ func TestSettingsMenuState_FetchProps(t *testing.T) {
 	props, err := state.Fetch(world)
 	require.NoError(t, err)
 
-	require.Len(t, props.Items, 2)
+	require.Len(t, props.Items, 5)
 	assert.Equal(t, "Language", props.Items[0].Label)
 	assert.Equal(t, settingsItemLanguage, props.Items[0].Kind)
 	// 現在言語の表示は UserSettings 由来にする。既定 en では英語表示
 	assert.Equal(t, "English", props.Items[0].Value)
-	assert.Equal(t, "Back", props.Items[1].Label)
-	assert.Equal(t, settingsItemBack, props.Items[1].Kind)
+	// 音量はユーザー設定の既定値を表示する
+	assert.Equal(t, settingsItemMasterVolume, props.Items[1].Kind)
+	assert.Equal(t, "80%", props.Items[1].Value)
+	assert.Equal(t, settingsItemMusicVolume, props.Items[2].Kind)
+	assert.Equal(t, "70%", props.Items[2].Value)
+	assert.Equal(t, settingsItemSEVolume, props.Items[3].Kind)
+	assert.Equal(t, "Back", props.Items[4].Label)
+	assert.Equal(t, settingsItemBack, props.Items[4].Kind)
 
 	// UserSettings を ja へ切り替えると表示も追従する。
 	// 期待値は ja 訳を i18n から導出し、ja.po の訳文更新でこのテストが drift しないようにする。
@@ -55,6 +61,24 @@ func TestCycleLanguage_次の言語へ循環しシングルトンと設定を更
 	assert.Equal(t, "en", query.GetUserSettings(world).Language, "循環して en へ戻る")
 }
 
+func TestCycleVolume_音量を送り再生中の音と設定へ反映する(t *testing.T) {
+	// SaveUserConfig の書き込み先を一時ディレクトリへ隔離する。t.Setenv があるので Parallel にしない
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	world := testutil.InitTestWorld(t)
+	world.Resources.Config.User.MusicVolume = 90
+
+	cycleVolume(world, settingsItemMusicVolume)
+	assert.Equal(t, 100, world.Resources.Config.User.MusicVolume)
+	assert.Equal(t, 100, world.Resources.Audio.Volume().Music, "再生中の音量へ即時反映する")
+
+	cycleVolume(world, settingsItemMusicVolume)
+	assert.Equal(t, 0, world.Resources.Config.User.MusicVolume, "最大から送ると 0 へ戻る")
+
+	// 他の項目の音量は変えない
+	assert.Equal(t, 80, world.Resources.Config.User.SEVolume)
+}
+
 func TestCurrentLanguageLabel(t *testing.T) {
 	t.Parallel()
 
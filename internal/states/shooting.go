@@ -23,9 +23,11 @@ import (
 )
 
 // ShootingState は射撃ターゲット選択モードのステート
-// 視界内の敵をTabで巡回し、Enterで射撃、Rでリロード、Escapeでキャンセルする
+// 視界内の敵をTabで巡回し、Enterで射撃、Rでリロード、Escapeでキャンセルする。
+// throwItem があれば投擲モードになり、射撃の代わりにそのアイテムを投げる
 type ShootingState struct {
 	es.BaseState[w.World]
+	throwItem      *ecs.Entity  // 投げるアイテム。nil なら射撃モード
 	enemies        []ecs.Entity // 視界内の敵一覧
 	targetIndex    int          // 現在選択中の敵インデックス
 	blinkCounter   int          // カーソル点滅用カウンタ
@@ -35,6 +37,13 @@ type ShootingState struct {
 
 var _ es.State[w.World] = &ShootingState{}
 
+// NewThrowingState は item を投げる対象を選ぶ投擲モードのファクトリを返す
+func NewThrowingState(item ecs.Entity) es.StateFactory[w.World] {
+	return func() (es.State[w.World], error) {
+		return &ShootingState{throwItem: &item}, nil
+	}
+}
+
 // OnPause はステートが一時停止される際に呼ばれる
 func (st *ShootingState) OnPause(_ w.World) error { return nil }
 
@@ -99,13 +108,21 @@ func (st *ShootingState) doAction(world w.World, action inputmapper.ActionID) (e
 				return es.Transition[w.World]{}, err
 			}
 			target := st.enemies[st.targetIndex]
-			if _, err := activity.Execute(activity.NewShootActivity(target), playerEntity, world); err != nil {
+			act := activity.NewShootActivity(target)
+			if st.throwItem != nil {
+				act = activity.NewThrowActivity(*st.throwItem, target)
+			}
+			if _, err := activity.Execute(act, playerEntity, world); err != nil {
 				return es.Transition[w.World]{}, err
 			}
 			return es.Transition[w.World]{Type: es.TransPop}, nil
 		}
 
 	case inputmapper.ActionReload:
+		// 投擲モードに装填は無い
+		if st.throwItem != nil {
+			return st.ConsumeTransition(), nil
+		}
 		playerEntity, err := query.GetPlayerEntity(world)
 		if err != nil {
 			return es.Transition[w.World]{}, err
@@ -146,7 +163,7 @@ func (st *ShootingState) checkFireWeaponStatus(world w.World) string {
 }
 
 // refreshEnemies は射撃可能な敵一覧を距離順で更新する。
-// 視界内の敵から死亡済み・射程外・射線遮断の敵を除外する
+// 視界内の敵から死亡済み・射程外・射線遮断の敵を除外する。投擲モードでは投げられる敵に絞る
 func (st *ShootingState) refreshEnemies(world w.World) error {
 	enemies, err := query.GetVisibleEnemies(world)
 	if err != nil {
@@ -161,7 +178,7 @@ func (st *ShootingState) refreshEnemies(world w.World) error {
 	// 射撃可能な敵のみ残す
 	var shootable []ecs.Entity
 	for _, e := range enemies {
-		if activity.CanShootTarget(playerEntity, e, world) {
+		if st.canTarget(world, playerEntity, e) {
 			shootable = append(shootable, e)
 		}
 	}
@@ -180,6 +197,14 @@ func (st *ShootingState) refreshEnemies(world w.World) error {
 	return nil
 }
 
+// canTarget はモードに応じて target を狙えるかを返す
+func (st *ShootingState) canTarget(world w.World, playerEntity, target ecs.Entity) bool {
+	if st.throwItem != nil {
+		return activity.CanThrowAt(playerEntity, *st.throwItem, target, world)
+	}
+	return activity.CanShootTarget(playerEntity, target, world)
+}
+
 // updateTargetCache はターゲット変更時に命中率と距離をキャッシュする
 func (st *ShootingState) updateTargetCache(world w.World) {
 	if len(st.enemies) == 0 {
@@ -190,7 +215,9 @@ func (st *ShootingState) updateTargetCache(world w.World) {
 		return
 	}
 	target := st.enemies[st.targetIndex]
-	st.cachedHitRate = activity.CalculateShootHitRate(playerEntity, target, world)
+	if st.throwItem == nil {
+		st.cachedHitRate = activity.CalculateShootHitRate(playerEntity, target, world)
+	}
 	st.cachedDistance = activity.EntityDistance(playerEntity, target, world)
 }
 
@@ -256,6 +283,23 @@ func (st *ShootingState) drawShootingPanel(world w.World, screen *ebiten.Image)
 		y += lineHeight
 	}
 
+	if st.throwItem != nil {
+		drawText(query.T(world, "== Throwing Mode =="))
+		y += 5
+		st.drawThrowItemInfo(world, *st.throwItem, drawText)
+		y += 5
+
+		if len(st.enemies) == 0 {
+			drawText(query.T(world, "No throwing target"))
+		} else {
+			st.drawTargetInfo(world, st.enemies[st.targetIndex], drawText)
+		}
+
+		y = panelY + panelHeight - 30
+		drawText(query.T(world, "Tab: Switch  Enter: Throw  Esc: Back"))
+		return nil
+	}
+
 	drawText(query.T(world, "== Shooting Mode =="))
 	y += 5
 
@@ -286,6 +330,17 @@ func (st *ShootingState) drawShootingPanel(world w.World, screen *ebiten.Image)
 	return nil
 }
 
+// drawThrowItemInfo は投げるアイテムの情報を描画する
+func (st *ShootingState) drawThrowItemInfo(world w.World, item ecs.Entity, drawText func(string)) {
+	drawText(fmt.Sprintf("%s: %s", query.T(world, "Item"), query.GetEntityName(item, world)))
+	throwable := world.Components.Throwable.Get(item)
+	if throwable == nil {
+		return
+	}
+	drawText(fmt.Sprintf("%s: %d", query.T(world, "Range"), throwable.Range))
+	drawText(fmt.Sprintf("%s: %d", query.T(world, "Damage"), throwable.Damage))
+}
+
 // drawWeaponInfo は武器情報を描画する
 func (st *ShootingState) drawWeaponInfo(world w.World, playerEntity ecs.Entity, drawText func(string)) {
 	selectedSlot := query.GetWeaponSelection(world).Slot
@@ -327,6 +382,9 @@ func (st *ShootingState) drawTargetInfo(world w.World, target ecs.Entity, drawTe
 		drawText(fmt.Sprintf("HP: %d/%d", hp.Current, hp.Max))
 	}
 
-	drawText(fmt.Sprintf("%s: %d%%", query.T(world, "Hit rate"), st.cachedHitRate))
+	// 投擲は命中判定を持たないので命中率は出さない
+	if st.throwItem == nil {
+		drawText(fmt.Sprintf("%s: %d%%", query.T(world, "Hit rate"), st.cachedHitRate))
+	}
 	drawText(fmt.Sprintf("%s: %.1f", query.T(world, "Distance"), st.cachedDistance))
 }
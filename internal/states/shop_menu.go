@@ -102,11 +102,12 @@ type shopTabData struct {
 // shopItemData は一覧1行分。1行は1スタックで、売買はスタック丸ごと行う。
 // 名前・重量・個数は実体から都度出せるので持たず、プレイヤーの所持金や倍率が要る値だけを持つ
 type shopItemData struct {
-	Entity   ecs.Entity      // スタック代表の実体。表示も操作もこれから解決する
-	Count    int             // スタックの個数。束ねた結果を持ち回り、行ごとに数え直さない
-	Price    consts.Currency // 行の合計額。単価は価値と交渉スキルの倍率から出し、スタック個数を掛ける
-	IsBuy    bool            // 買いタブの行なら真
-	Disabled bool            // 所持金が足りず選べない
+	Entity   ecs.Entity       // スタック代表の実体。表示も操作もこれから解決する
+	Count    int              // スタックの個数。束ねた結果を持ち回り、行ごとに数え直さない
+	Price    consts.Currency  // 行の合計額。単価は価値と集落・供給量・交渉スキルの倍率から出し、スタック個数を掛ける
+	Trend    query.PriceTrend // 供給量による値動きの向き。価格の横に矢印で添える
+	IsBuy    bool             // 買いタブの行なら真
+	Disabled bool             // 所持金が足りず選べない
 }
 
 // Fetch は世界から表示 props を構築する。menuloop.Model の Model 部にあたる。
@@ -144,11 +145,12 @@ func (st *ShopMenuState) createBuyItems(world w.World, player ecs.Entity, curren
 
 	for _, stack := range stacks {
 		// BuyPrice は価値×スタック個数で既に全量の額を返す
-		total := query.BuyPrice(world, player, stack.Rep)
+		total := query.BuyPrice(world, player, st.merchant, stack.Rep)
 		items = append(items, shopItemData{
 			Entity:   stack.Rep,
 			Count:    stack.Count,
 			Price:    total,
+			Trend:    query.GetPriceTrend(world, st.merchant, stack.Rep),
 			IsBuy:    true,
 			Disabled: currency < total,
 		})
@@ -167,7 +169,8 @@ func (st *ShopMenuState) createSellItems(world w.World, player ecs.Entity) []sho
 		items = append(items, shopItemData{
 			Entity: stack.Rep,
 			Count:  stack.Count,
-			Price:  query.SellPrice(world, player, stack.Rep),
+			Price:  query.SellPrice(world, player, st.merchant, stack.Rep),
+			Trend:  query.GetPriceTrend(world, st.merchant, stack.Rep),
 			IsBuy:  false,
 		})
 	}
@@ -263,7 +266,7 @@ func (st *ShopMenuState) buildItemContainer(world w.World, tabs []shopTabData, t
 		// 名前・重量・アイコンは実体から都度出す。一覧の実体は毎フレーム集め直すので描画時も生存している。
 		// 1行は1スタックなので、重量は額と同じく個数分の合計にし、行内の値の粒度を揃える
 		total := query.GetEntityWeight(world, it.Entity) * consts.Milligram(it.Count)
-		rows[i] = itemMenuRow(world, it.Entity, it.Count, it.Price.String(), total.KgString())
+		rows[i] = itemMenuRow(world, it.Entity, it.Count, shopPriceLabel(it), total.KgString())
 	}
 	emptyText := query.T(world, "No goods")
 	if currentTab.ID == "sell" {
@@ -271,3 +274,15 @@ func (st *ShopMenuState) buildItemContainer(world w.World, tabs []shopTabData, t
 	}
 	return renderMenuList(itemIndex, rows, columnWidths, aligns, menuListOpts{AlwaysIndicator: true, EmptyText: emptyText}, res)
 }
+
+// shopPriceLabel は行の額に値動きの矢印を添える。品薄で値上がりしていれば ↑、品余りで値下がりしていれば ↓ を前に置く
+func shopPriceLabel(it shopItemData) string {
+	switch it.Trend {
+	case query.PriceTrendUp:
+		return "↑" + it.Price.String()
+	case query.PriceTrendDown:
+		return "↓" + it.Price.String()
+	case query.PriceTrendSteady:
+	}
+	return it.Price.String()
+}
@@ -62,7 +62,7 @@ func TestShopMenuState_FetchProps_同一スタックは1行で額と個数は全
 	require.NoError(t, err)
 
 	// 全量の額に1だけ足りない所持金にし、購入不可判定が全量基準であることを見る
-	total := query.BuyPrice(world, player, rep)
+	total := query.BuyPrice(world, player, merchant, rep)
 	world.Components.Wallet.Get(player).Currency = total - 1
 
 	props, err := state.Fetch(world)
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/consts"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -42,3 +43,13 @@ func TestOverworldDaylight_昼夜の大小関係(t *testing.T) {
 	assert.Greater(t, overworldDaylight(gc.TimeNight), overworldDaylight(gc.TimeMidnight),
 		"夜は深夜より明るい")
 }
+
+// TestWeatherVisionRadius は天候が視界半径を縮めることを検証する。
+func TestWeatherVisionRadius(t *testing.T) {
+	t.Parallel()
+
+	full := consts.WorldPixel(consts.VisionRadiusTiles) * consts.TileSize
+	assert.Equal(t, full, weatherVisionRadius(gc.WeatherClear), "晴れは視界半径そのまま")
+	assert.Less(t, weatherVisionRadius(gc.WeatherRain), full, "雨は視界を縮める")
+	assert.Less(t, weatherVisionRadius(gc.WeatherFog), weatherVisionRadius(gc.WeatherRain), "霧は雨より見通せない")
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/kijimaD/ruins/internal/activity"
 	gc "github.com/kijimaD/ruins/internal/components"
 	"github.com/kijimaD/ruins/internal/logger"
+	"github.com/kijimaD/ruins/internal/quest"
 	"github.com/kijimaD/ruins/internal/raw"
 	w "github.com/kijimaD/ruins/internal/world"
 
@@ -124,6 +125,11 @@ func (sys *DeadCleanupSystem) Update(world w.World) error {
 		}
 	}
 
+	// クエストの撃破目標: 除去するエンティティを進行中のクエストに数える
+	for _, entity := range toDelete {
+		quest.Record(world, entity)
+	}
+
 	// 死亡エンティティのバックパック内アイテムをフィールドにドロップする。
 	// クエリ走査中の構造変更を避けるため先に集め、フィールドへの一括移動は移動口に委ねる
 	for _, entity := range toDelete {
@@ -0,0 +1,129 @@
+package systems
+
+import (
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/gamelog"
+	w "github.com/kijimaD/ruins/internal/world"
+
+	"github.com/kijimaD/ruins/internal/world/gameaction"
+	"github.com/kijimaD/ruins/internal/world/lifecycle"
+	"github.com/kijimaD/ruins/internal/world/query"
+	"github.com/mlange-42/ark/ecs"
+)
+
+// ElementConditionDecay は元素状態のタイマーが1ターンで戻る量
+const ElementConditionDecay = 2.5
+
+// ElementConditionSystem は元素攻撃で付いた状態を1ターン進めるシステム。
+// 状態は攻撃の命中で付き、ここで時間とともに引いていく。炎上は重症度に応じて毎ターン焼ける
+type ElementConditionSystem struct{}
+
+// String はシステム名を返す
+func (sys *ElementConditionSystem) String() string {
+	return "ElementConditionSystem"
+}
+
+// Update は元素状態のタイマーを戻し、効果と炎上ダメージを反映する
+func (sys *ElementConditionSystem) Update(world w.World) error {
+	var toMark []ecs.Entity
+	type burn struct {
+		entity ecs.Entity
+		damage int
+	}
+	var burns []burn
+	healthQuery := query.ActiveFilter1[gc.HealthStatus](world).Query()
+	for healthQuery.Next() {
+		entity := healthQuery.Entity()
+		if world.Components.Dead.Has(entity) {
+			continue
+		}
+		partHealth := &world.Components.HealthStatus.Get(entity).Parts[gc.BodyPartWholeBody]
+		isPlayer := world.Components.Player.Has(entity)
+
+		burnDamage := 0
+		if cond := partHealth.GetCondition(gc.ConditionBurning); cond != nil {
+			burnDamage = int(cond.Severity)
+		}
+
+		if updateElementConditions(world, partHealth, isPlayer) {
+			toMark = append(toMark, entity)
+		}
+
+		if burnDamage > 0 && world.Components.HP.Has(entity) {
+			burns = append(burns, burn{entity: entity, damage: burnDamage})
+		}
+	}
+
+	// ダメージ表示のエンティティ生成と死亡付与はクエリを抜けてから行う
+	for _, b := range burns {
+		applyBurnDamage(world, b.entity, b.damage, world.Components.Player.Has(b.entity))
+	}
+
+	for _, entity := range toMark {
+		if !world.Components.StatsChanged.Has(entity) {
+			world.Components.StatsChanged.Add(entity, &gc.StatsChanged{})
+		}
+	}
+
+	return nil
+}
+
+// updateElementConditions は全身の元素状態のタイマーを1ターン分戻し、効果を更新する。
+// - isPlayerがtrueの場合、回復時にログを出力する。
+// - 戻り値: 状態のSeverityが変化した場合trueを返す
+func updateElementConditions(world w.World, partHealth *gc.BodyPartHealth, isPlayer bool) bool {
+	var condTypes []gc.ConditionType
+	for _, cond := range partHealth.Conditions {
+		if gc.IsElementCondition(cond.Type) {
+			condTypes = append(condTypes, cond.Type)
+		}
+	}
+
+	hasChange := false
+	for _, condType := range condTypes {
+		change := partHealth.UpdateConditionTimer(condType, -ElementConditionDecay)
+		if cond := partHealth.GetCondition(condType); cond != nil {
+			cond.Effects = gc.ElementConditionEffects(condType, cond.Severity)
+		}
+		if change.Prev == change.Current {
+			continue
+		}
+		hasChange = true
+		if isPlayer && change.Current == gc.SeverityNone {
+			if msg := getElementRecoveryMessage(condType); msg != "" {
+				gamelog.New(query.GetGameLog(world)).
+					Markup(query.T(world, msg)).
+					Log()
+			}
+		}
+	}
+
+	return hasChange
+}
+
+// applyBurnDamage は炎上によるダメージを与える
+func applyBurnDamage(world w.World, entity ecs.Entity, damage int, isPlayer bool) {
+	if isPlayer {
+		gamelog.New(query.GetGameLog(world)).
+			Markup(gamelog.Tag("warning", query.T(world, "The flames burn you for %d damage.", damage))).
+			Log()
+	}
+	lifecycle.SpawnVisualEffect(entity, gc.NewDamageEffect(damage), world)
+	gameaction.ApplyDamageWith(world, entity, damage, entity, gameaction.Hit{Element: gc.ElementTypeFire})
+}
+
+// getElementRecoveryMessage は元素状態が治まったときのメッセージを返す
+func getElementRecoveryMessage(condType gc.ConditionType) string {
+	switch condType {
+	case gc.ConditionBurning:
+		return "The flames have died down"
+	case gc.ConditionShocked:
+		return "The numbness has faded"
+	case gc.ConditionFrozen:
+		return "Your body has thawed"
+	case gc.ConditionBlinded:
+		return "Your vision has cleared"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,68 @@
+package systems
+
+import (
+	"testing"
+
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/testutil"
+
+	"github.com/kijimaD/ruins/internal/world/lifecycle"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestElementConditionSystem_タイマーが戻り治まると状態が消える(t *testing.T) {
+	t.Parallel()
+	world := testutil.InitTestWorld(t)
+	player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 5, Y: 5}, "ash")
+	require.NoError(t, err)
+
+	wb := &world.Components.HealthStatus.Get(player).Parts[gc.BodyPartWholeBody]
+	wb.SetCondition(gc.HealthCondition{Type: gc.ConditionShocked, Severity: gc.SeverityMinor, Timer: 26})
+	world.Components.StatsChanged.Remove(player)
+
+	sys := &ElementConditionSystem{}
+	require.NoError(t, sys.Update(world))
+
+	cond := wb.GetCondition(gc.ConditionShocked)
+	require.NotNil(t, cond)
+	assert.Equal(t, gc.SeverityNone, cond.Severity)
+	assert.Empty(t, cond.Effects, "治まると効果も消える")
+	assert.True(t, world.Components.StatsChanged.Has(player))
+
+	for range 20 {
+		require.NoError(t, sys.Update(world))
+	}
+	assert.Nil(t, wb.GetCondition(gc.ConditionShocked), "タイマーが0になると状態を削除する")
+}
+
+func TestElementConditionSystem_炎上は重症度に応じて焼ける(t *testing.T) {
+	t.Parallel()
+	world := testutil.InitTestWorld(t)
+	player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 5, Y: 5}, "ash")
+	require.NoError(t, err)
+
+	wb := &world.Components.HealthStatus.Get(player).Parts[gc.BodyPartWholeBody]
+	wb.SetCondition(gc.HealthCondition{Type: gc.ConditionBurning, Severity: gc.SeverityMedium, Timer: 60})
+	hp := world.Components.HP.Get(player)
+	before := hp.Current
+
+	require.NoError(t, (&ElementConditionSystem{}).Update(world))
+
+	assert.Equal(t, before-2, hp.Current)
+}
+
+func TestElementConditionSystem_体温の状態には触れない(t *testing.T) {
+	t.Parallel()
+	world := testutil.InitTestWorld(t)
+	player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 5, Y: 5}, "ash")
+	require.NoError(t, err)
+
+	wb := &world.Components.HealthStatus.Get(player).Parts[gc.BodyPartWholeBody]
+	wb.SetCondition(gc.HealthCondition{Type: gc.ConditionHypothermia, Severity: gc.SeverityMinor, Timer: 30})
+
+	require.NoError(t, (&ElementConditionSystem{}).Update(world))
+
+	assert.InDelta(t, 30.0, wb.GetCondition(gc.ConditionHypothermia).Timer, 0.001)
+}
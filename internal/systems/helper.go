@@ -34,12 +34,27 @@ func InitializeSystems(world w.World) (map[string]w.Updater, map[string]w.Render
 	temperatureSystem := &TemperatureSystem{}
 	updaters[temperatureSystem.String()] = temperatureSystem
 
+	elementConditionSystem := &ElementConditionSystem{}
+	updaters[elementConditionSystem.String()] = elementConditionSystem
+
+	injurySystem := &InjurySystem{}
+	updaters[injurySystem.String()] = injurySystem
+
+	perishableSystem := &PerishableSystem{}
+	updaters[perishableSystem.String()] = perishableSystem
+
+	questSystem := &QuestSystem{}
+	updaters[questSystem.String()] = questSystem
+
 	visionSystem := NewVisionSystem()
 	updaters[visionSystem.String()] = visionSystem
 
 	auctionSystem := &AuctionSystem{}
 	updaters[auctionSystem.String()] = auctionSystem
 
+	musicSystem := &MusicSystem{}
+	updaters[musicSystem.String()] = musicSystem
+
 	// Renderers（描画システム） ================
 	renderSpriteSystem := NewRenderSpriteSystem()
 	renderers[renderSpriteSystem.String()] = renderSpriteSystem
@@ -160,6 +160,8 @@ func extractDebugOverlay(world w.World) hud.DebugOverlayData {
 			stateText = "CHASING"
 		case gc.AIStateFleeing:
 			stateText = "FLEEING"
+		case gc.AIStateInvestigating:
+			stateText = "INVESTIGATING"
 		default:
 			stateText = "UNKNOWN"
 		}
@@ -378,6 +380,21 @@ func extractStatusBadgesData(world w.World) hud.StatusBadgesData {
 		}
 	}
 
+	// プレイヤーに付いている元素状態
+	conditionQuery := ecs.NewFilter2[gc.Player, gc.HealthStatus](world.ECS).Query()
+	for conditionQuery.Next() {
+		hs := world.Components.HealthStatus.Get(conditionQuery.Entity())
+		for _, cond := range hs.Parts[gc.BodyPartWholeBody].Conditions {
+			if !gc.IsElementCondition(cond.Type) || !cond.IsActive() {
+				continue
+			}
+			badges = append(badges, hud.StatusBadge{
+				Text:  query.T(world, gc.ConditionTypeDisplayName(cond.Type)),
+				Color: getElementConditionBadgeColor(cond.Type),
+			})
+		}
+	}
+
 	// 画面サイズを取得
 	screenWidth, screenHeight := world.Resources.GetScreenDimensions()
 
@@ -408,3 +425,19 @@ func getHungerBadgeColor(level gc.HungerLevel) color.RGBA {
 		return color.RGBA{255, 255, 255, 255}
 	}
 }
+
+// getElementConditionBadgeColor は元素状態に応じたバッジ色を返す
+func getElementConditionBadgeColor(condType gc.ConditionType) color.RGBA {
+	switch condType {
+	case gc.ConditionBurning:
+		return color.RGBA{255, 120, 40, 255} // 橙（炎上）
+	case gc.ConditionShocked:
+		return color.RGBA{255, 240, 80, 255} // 黄（感電）
+	case gc.ConditionFrozen:
+		return color.RGBA{120, 200, 255, 255} // 水色（凍結）
+	case gc.ConditionBlinded:
+		return color.RGBA{230, 230, 255, 255} // 白（眩惑）
+	default:
+		return color.RGBA{255, 255, 255, 255}
+	}
+}
@@ -605,6 +605,24 @@ func TestExtractStatusBadgesData(t *testing.T) {
 	}
 }
 
+func TestExtractStatusBadgesData_発症中の元素状態にバッジが付く(t *testing.T) {
+	t.Parallel()
+	world := testutil.InitTestWorld(t)
+
+	player := world.ECS.NewEntity()
+	world.Components.Player.Add(player, &gc.Player{})
+	hs := &gc.HealthStatus{}
+	hs.Parts[gc.BodyPartWholeBody].SetCondition(gc.HealthCondition{Type: gc.ConditionFrozen, Severity: gc.SeverityMinor, Timer: 30})
+	hs.Parts[gc.BodyPartWholeBody].SetCondition(gc.HealthCondition{Type: gc.ConditionBurning, Timer: 10})
+	hs.Parts[gc.BodyPartWholeBody].SetCondition(gc.HealthCondition{Type: gc.ConditionHypothermia, Severity: gc.SeverityMinor, Timer: 30})
+	world.Components.HealthStatus.Add(player, hs)
+
+	data := extractStatusBadgesData(world)
+
+	require.Len(t, data.Badges, 1, "治まりかけの状態と体温の状態はバッジにしない")
+	assert.Equal(t, getElementConditionBadgeColor(gc.ConditionFrozen), data.Badges[0].Color)
+}
+
 func TestExtractMessageData_メッセージ履歴と画面情報を反映する(t *testing.T) {
 	t.Parallel()
 	world := testutil.InitTestWorld(t)
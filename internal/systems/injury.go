@@ -0,0 +1,61 @@
+package systems
+
+import (
+	gc "github.com/kijimaD/ruins/internal/components"
+	w "github.com/kijimaD/ruins/internal/world"
+
+	"github.com/kijimaD/ruins/internal/world/query"
+	"github.com/mlange-42/ark/ecs"
+)
+
+// InjuryNaturalRecovery は軽い負傷が1ターンで自然に癒える深さ
+const InjuryNaturalRecovery = 0.25
+
+// InjurySystem は部位の負傷を1ターン進めるシステム。
+// 軽い負傷は代謝で自然に癒える。中度以上の深手は手当てするまで残る
+type InjurySystem struct{}
+
+// String はシステム名を返す
+func (sys *InjurySystem) String() string {
+	return "InjurySystem"
+}
+
+// Update は軽い負傷を癒やし、重症度が変わった者の能力値を再計算させる
+func (sys *InjurySystem) Update(world w.World) error {
+	var toMark []ecs.Entity
+	healthQuery := query.ActiveFilter1[gc.HealthStatus](world).Query()
+	for healthQuery.Next() {
+		entity := healthQuery.Entity()
+		if world.Components.Dead.Has(entity) {
+			continue
+		}
+		if recoverMinorInjuries(world.Components.HealthStatus.Get(entity)) {
+			toMark = append(toMark, entity)
+		}
+	}
+
+	for _, entity := range toMark {
+		if !world.Components.StatsChanged.Has(entity) {
+			world.Components.StatsChanged.Add(entity, &gc.StatsChanged{})
+		}
+	}
+
+	return nil
+}
+
+// recoverMinorInjuries は軽傷以下の負傷を自然治癒させる。
+// 戻り値: 状態のSeverityが変化した場合trueを返す
+func recoverMinorInjuries(hs *gc.HealthStatus) bool {
+	hasChange := false
+	for _, ip := range gc.InjuryParts {
+		cond := hs.Parts[ip.Part].GetCondition(gc.ConditionInjury)
+		if cond == nil || cond.Severity > gc.SeverityMinor {
+			continue
+		}
+		change := hs.UpdateInjury(ip.Part, -InjuryNaturalRecovery)
+		if change.Prev != change.Current {
+			hasChange = true
+		}
+	}
+	return hasChange
+}
@@ -0,0 +1,38 @@
+package systems
+
+import (
+	"testing"
+
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/testutil"
+
+	"github.com/kijimaD/ruins/internal/world/lifecycle"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInjurySystem_軽傷は自然に癒え深手は残る(t *testing.T) {
+	t.Parallel()
+	world := testutil.InitTestWorld(t)
+	player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 5, Y: 5}, "ash")
+	require.NoError(t, err)
+
+	hs := world.Components.HealthStatus.Get(player)
+	hs.UpdateInjury(gc.BodyPartArms, 25)
+	hs.UpdateInjury(gc.BodyPartLegs, 60)
+	world.Components.StatsChanged.Remove(player)
+
+	sys := &InjurySystem{}
+	require.NoError(t, sys.Update(world))
+
+	arms := hs.Parts[gc.BodyPartArms].GetCondition(gc.ConditionInjury)
+	require.NotNil(t, arms)
+	assert.Equal(t, gc.SeverityNone, arms.Severity, "軽傷は代謝で浅くなる")
+	assert.Empty(t, arms.Effects)
+	assert.True(t, world.Components.StatsChanged.Has(player))
+
+	legs := hs.Parts[gc.BodyPartLegs].GetCondition(gc.ConditionInjury)
+	require.NotNil(t, legs)
+	assert.InDelta(t, 60.0, legs.Timer, 0.001, "深手は手当てするまで残る")
+}
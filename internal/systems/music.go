@@ -0,0 +1,52 @@
+package systems
+
+import (
+	"github.com/kijimaD/ruins/internal/audio"
+	"github.com/kijimaD/ruins/internal/dungeon"
+	w "github.com/kijimaD/ruins/internal/world"
+	"github.com/kijimaD/ruins/internal/world/query"
+)
+
+// MusicSystem は今いるステージに合った曲を流す。
+// 曲が変わるのはステージを移ったときだけで、同じ曲は流し直さない
+type MusicSystem struct{}
+
+// String はシステム名を返す
+func (sys MusicSystem) String() string {
+	return "MusicSystem"
+}
+
+// Update は現ステージの曲を流す
+func (sys *MusicSystem) Update(world w.World) error {
+	world.Resources.Audio.PlayMusic(StageMusic(world))
+	return nil
+}
+
+// StageMusic は現ステージで流す曲の ID を返す。
+// 地上は地上の曲、ダンジョンは定義が指定した曲を流す。定義が指定していなければ
+// ボスフロアはボス曲、それ以外は既定のダンジョン曲にする
+func StageMusic(world w.World) string {
+	if query.IsOnOverworld(world) {
+		return audio.MusicOverworld
+	}
+	dungeonRes := query.GetDungeon(world)
+	if dungeonRes == nil {
+		return ""
+	}
+	def, ok := dungeon.GetStageDefinition(dungeonRes.CurrentStage.Name)
+	if !ok {
+		return audio.MusicDungeon
+	}
+	dd, ok := def.(*dungeon.DungeonDefinition)
+	if !ok {
+		return audio.MusicDungeon
+	}
+	depth := dungeonRes.CurrentStage.Depth
+	if id := dd.Music(depth); id != "" {
+		return id
+	}
+	if _, isBoss := dd.BossPlanner(depth); isBoss {
+		return audio.MusicBoss
+	}
+	return audio.MusicDungeon
+}
@@ -0,0 +1,53 @@
+package systems
+
+import (
+	"testing"
+
+	"github.com/kijimaD/ruins/internal/audio"
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/testutil"
+	"github.com/kijimaD/ruins/internal/world/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStageMusic(t *testing.T) {
+	t.Parallel()
+
+	t.Run("地上は地上の曲", func(t *testing.T) {
+		t.Parallel()
+		world := testutil.InitTestWorld(t)
+		query.EnsureSeamlessBand(world)
+
+		assert.Equal(t, audio.MusicOverworld, StageMusic(world))
+	})
+
+	cases := []struct {
+		name  string
+		stage gc.StageKey
+		want  string
+	}{
+		{"定義が指定した曲", gc.NewDungeonStage("Dead forest", 1), "dead_forest"},
+		{"ボス曲の指定が無いボスフロアは既定のボス曲", gc.NewDungeonStage("Dead forest", 20), audio.MusicBoss},
+		{"定義が指定したボス曲", gc.NewDungeonStage("Forgotten ruins", 20), "forgotten_ruins_boss"},
+		{"曲の指定が無い定義は既定のダンジョン曲", gc.NewCubeInteriorStage(), audio.MusicDungeon},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			world := testutil.InitTestWorld(t, testutil.WithCurrentStage(tc.stage))
+
+			assert.Equal(t, tc.want, StageMusic(world))
+		})
+	}
+}
+
+func TestMusicSystem_Update(t *testing.T) {
+	t.Parallel()
+	world := testutil.InitTestWorld(t, testutil.WithCurrentStage(gc.NewDungeonStage("Ash cave", 3)))
+
+	sys := &MusicSystem{}
+	require.NoError(t, sys.Update(world))
+
+	assert.Equal(t, "ash_cave", world.Resources.Audio.Music())
+}
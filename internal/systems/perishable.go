@@ -0,0 +1,79 @@
+package systems
+
+import (
+	gc "github.com/kijimaD/ruins/internal/components"
+	w "github.com/kijimaD/ruins/internal/world"
+
+	"github.com/kijimaD/ruins/internal/world/query"
+	"github.com/mlange-42/ark/ecs"
+)
+
+// CarriedWarmth は身に着けて運ぶ食料が受ける体温ぶんの気温上乗せ
+const CarriedWarmth = 8
+
+// PerishableSystem は腐敗する食料の劣化速度を置き場所の気温から更新するシステム。
+// 床の食料はそのタイルの気温、収納の中身は収納の気温、持ち運ぶ食料は運び手の体温込みの気温で劣化する。
+// 速度が変わったときだけそれまでの劣化を畳み込むので、毎ターンの端数の切り捨ては積もらない
+type PerishableSystem struct{}
+
+// String はシステム名を返す
+func (sys *PerishableSystem) String() string {
+	return "PerishableSystem"
+}
+
+// Update は各食料の劣化速度を現在の置き場所に合わせる
+func (sys *PerishableSystem) Update(world w.World) error {
+	now := query.GetGameTime(world).TotalTurns
+	perishQuery := query.ActiveFilter1[gc.Perishable](world).Query()
+	for perishQuery.Next() {
+		entity := perishQuery.Entity()
+		celsius, ok := perishableTemperature(world, entity)
+		if !ok {
+			continue
+		}
+		query.SetPerishRate(world, entity, gc.PerishRateAt(celsius), now)
+	}
+	return nil
+}
+
+// perishableTemperature は食料 entity が置かれている場所の気温を返す。
+// 置き場所が定まらないときは ok=false を返し、速度を据え置く
+func perishableTemperature(world w.World, entity ecs.Entity) (int, bool) {
+	switch {
+	case world.Components.LocationOnField.Has(entity):
+		return tileTemperatureOf(world, entity)
+	case world.Components.LocationInStorage.Has(entity):
+		storage := world.Components.LocationInStorage.Get(entity).Owner
+		if world.Components.StorageTemperature.Has(storage) {
+			return world.Components.StorageTemperature.Get(storage).Celsius, true
+		}
+		return tileTemperatureOf(world, storage)
+	case world.Components.LocationInBackpack.Has(entity):
+		return carriedTemperature(world, world.Components.LocationInBackpack.Get(entity).Owner)
+	case world.Components.LocationEquipped.Has(entity):
+		return carriedTemperature(world, world.Components.LocationEquipped.Get(entity).Owner)
+	}
+	return 0, false
+}
+
+// carriedTemperature は運び手の居場所の気温に体温ぶんを上乗せして返す
+func carriedTemperature(world w.World, carrier ecs.Entity) (int, bool) {
+	celsius, ok := tileTemperatureOf(world, carrier)
+	if !ok {
+		return 0, false
+	}
+	return celsius + CarriedWarmth, true
+}
+
+// tileTemperatureOf は entity が立つタイルの環境気温を返す
+func tileTemperatureOf(world w.World, entity ecs.Entity) (int, bool) {
+	if !world.ECS.Alive(entity) || !world.Components.GridElement.Has(entity) {
+		return 0, false
+	}
+	grid := world.Components.GridElement.Get(entity)
+	celsius, err := CalculateEnvTemperature(world, grid.X, grid.Y)
+	if err != nil {
+		return 0, false
+	}
+	return celsius, true
+}
@@ -0,0 +1,92 @@
+package systems
+
+import (
+	"testing"
+
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/testutil"
+	w "github.com/kijimaD/ruins/internal/world"
+
+	"github.com/kijimaD/ruins/internal/world/lifecycle"
+	"github.com/kijimaD/ruins/internal/world/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupPerishableWorld は基本気温0度の遺跡に立つテスト用Worldを構築する
+func setupPerishableWorld(t *testing.T) w.World {
+	t.Helper()
+	world := testutil.InitTestWorld(t)
+	query.GetDungeon(world).CurrentStage = gc.NewDungeonStage(coldDungeonName, 1)
+	return world
+}
+
+func TestPerishableSystem_Update(t *testing.T) {
+	t.Parallel()
+
+	t.Run("床の食料はタイルの気温で劣化する", func(t *testing.T) {
+		t.Parallel()
+		world := setupPerishableWorld(t)
+		bread, err := lifecycle.SpawnFieldItem(world, "bread", 5, 5, 1)
+		require.NoError(t, err)
+		envTemp, err := CalculateEnvTemperature(world, 5, 5)
+		require.NoError(t, err)
+
+		require.NoError(t, (&PerishableSystem{}).Update(world))
+		assert.Equal(t, gc.PerishRateAt(envTemp), world.Components.Perishable.Get(bread).Rate())
+	})
+
+	t.Run("保冷箱の中は収納の気温で劣化する", func(t *testing.T) {
+		t.Parallel()
+		world := setupPerishableWorld(t)
+		box, err := lifecycle.SpawnProp(world, "cold_box", 5, 5)
+		require.NoError(t, err)
+		require.True(t, world.Components.StorageTemperature.Has(box), "保冷箱は気温を持つ")
+		bread, err := lifecycle.SpawnFieldItem(world, "bread", 5, 5, 1)
+		require.NoError(t, err)
+		require.NoError(t, lifecycle.MoveToStorage(world, bread, box))
+
+		require.NoError(t, (&PerishableSystem{}).Update(world))
+		celsius := world.Components.StorageTemperature.Get(box).Celsius
+		assert.Equal(t, gc.PerishRateAt(celsius), world.Components.Perishable.Get(bread).Rate())
+	})
+
+	t.Run("持ち運ぶ食料は体温で傷みやすい", func(t *testing.T) {
+		t.Parallel()
+		world := setupPerishableWorld(t)
+		player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 5, Y: 5}, "ash")
+		require.NoError(t, err)
+		carried, err := lifecycle.SpawnFieldItem(world, "bread", 5, 5, 1)
+		require.NoError(t, err)
+		require.NoError(t, lifecycle.MoveToBackpack(world, carried, player))
+		floor, err := lifecycle.SpawnFieldItem(world, "bread", 5, 5, 1)
+		require.NoError(t, err)
+
+		require.NoError(t, (&PerishableSystem{}).Update(world))
+		envTemp, err := CalculateEnvTemperature(world, 5, 5)
+		require.NoError(t, err)
+		assert.Equal(t, gc.PerishRateAt(envTemp+CarriedWarmth), world.Components.Perishable.Get(carried).Rate())
+		assert.GreaterOrEqual(t, world.Components.Perishable.Get(carried).Rate(), world.Components.Perishable.Get(floor).Rate(),
+			"身に着けた食料は床の食料より速く傷む")
+	})
+
+	t.Run("置き場所を移すとそれまでの劣化は元の速度で残る", func(t *testing.T) {
+		t.Parallel()
+		world := setupPerishableWorld(t)
+		box, err := lifecycle.SpawnProp(world, "cold_box", 5, 5)
+		require.NoError(t, err)
+		bread, err := lifecycle.SpawnFieldItem(world, "bread", 5, 5, 1)
+		require.NoError(t, err)
+		require.NoError(t, (&PerishableSystem{}).Update(world))
+		floorRate := world.Components.Perishable.Get(bread).Rate()
+
+		query.GetGameTime(world).TotalTurns = 200
+		require.NoError(t, lifecycle.MoveToStorage(world, bread, box))
+		require.NoError(t, (&PerishableSystem{}).Update(world))
+
+		p := world.Components.Perishable.Get(bread)
+		assert.Equal(t, consts.Turn(floorRate.ApplyInt(200)), p.RotAccrued, "床にいた200ターンは床の速度で積まれる")
+		assert.Equal(t, consts.Turn(200), p.RotUpdatedTurn)
+	})
+}
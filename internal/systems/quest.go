@@ -0,0 +1,19 @@
+package systems
+
+import (
+	"github.com/kijimaD/ruins/internal/quest"
+	w "github.com/kijimaD/ruins/internal/world"
+)
+
+// QuestSystem はターン終了ごとに進行中のクエストの目標を測り直し、揃ったクエストを達成させるシステム
+type QuestSystem struct{}
+
+// String はシステム名を返す
+func (sys *QuestSystem) String() string {
+	return "QuestSystem"
+}
+
+// Update は所持・到達・生存の目標を更新し、目標の揃ったクエストの報酬を渡す
+func (sys *QuestSystem) Update(world w.World) error {
+	return quest.Evaluate(world)
+}
@@ -3,12 +3,14 @@ package systems
 import (
 	"errors"
 
+	"github.com/kijimaD/ruins/internal/audio"
 	gc "github.com/kijimaD/ruins/internal/components"
 	"github.com/kijimaD/ruins/internal/consts"
 	"github.com/kijimaD/ruins/internal/dungeon"
 	"github.com/kijimaD/ruins/internal/gamelog"
 	w "github.com/kijimaD/ruins/internal/world"
 
+	"github.com/kijimaD/ruins/internal/world/gameaction"
 	"github.com/kijimaD/ruins/internal/world/query"
 	"github.com/mlange-42/ark/ecs"
 )
@@ -42,7 +44,7 @@ func ComfortableRange(insulation Insulation) (lower, upper int) {
 }
 
 // CalculateEnvTemperature は指定位置の環境気温を計算する
-// 基本気温 + タイル修正 + 時間帯修正
+// 基本気温 + タイル修正 + 時間帯修正 + 天候修正
 func CalculateEnvTemperature(world w.World, x, y consts.Tile) (int, error) {
 	dungeonRes := query.GetDungeon(world)
 	if dungeonRes == nil {
@@ -62,7 +64,10 @@ func CalculateEnvTemperature(world w.World, x, y consts.Tile) (int, error) {
 
 	frostModifier := frostZoneModifier(world, x)
 
-	return baseTemp + timeModifier + tileModifier + frostModifier, nil
+	// 天候は地上だけに効く。遺跡の中では CurrentWeather が晴れを返すので修正は0になる
+	weatherModifier := query.CurrentWeather(world).TemperatureModifier()
+
+	return baseTemp + timeModifier + tileModifier + frostModifier + weatherModifier, nil
 }
 
 // FrostZoneTempModifier は寒波前線の極低温ゾーン内タイルの環境気温修正。生存不能な極寒を表す。
@@ -261,10 +266,15 @@ func logTemperatureChange(world w.World, condType gc.ConditionType, current, pre
 		msg = getRecoveryMessage(condType, current)
 	}
 
-	if msg != "" {
-		gamelog.New(query.GetGameLog(world)).
-			Markup(gamelog.Tag("warning", query.T(world, msg))).
-			Log()
+	if msg == "" {
+		return
+	}
+	gamelog.New(query.GetGameLog(world)).
+		Markup(gamelog.Tag("warning", query.T(world, msg))).
+		Log()
+	// 悪化したときだけ警告音で気付かせる。回復は鳴らさない
+	if current > prev {
+		gameaction.PlaySound(world, audio.EventTemperatureWarning)
 	}
 }
 
@@ -125,6 +125,36 @@ func TestCalculateEnvTemperature_極低温ゾーンで極寒になる(t *testing
 	assert.LessOrEqual(t, inZone, 0, "ゾーン内は最大寒冷（0度以下）になり低体温が急進する")
 }
 
+// seedWithWeatherAt は turn の天候が want になる runSeed を探す
+func seedWithWeatherAt(t *testing.T, want gc.Weather, turn consts.Turn) uint64 {
+	t.Helper()
+	for seed := uint64(1); seed < 10000; seed++ {
+		if gc.WeatherAt(seed, turn) == want {
+			return seed
+		}
+	}
+	t.Fatalf("no seed found for weather %s", want)
+	return 0
+}
+
+func TestCalculateEnvTemperature_天候で気温が変わる(t *testing.T) {
+	t.Parallel()
+	world := testutil.InitTestWorld(t)
+	query.GetDungeon(world).CurrentStage = gc.NewOverworldStage()
+	sb := query.EnsureSeamlessBand(world)
+	turn := query.GetGameTime(world).TotalTurns
+
+	sb.RunSeed = seedWithWeatherAt(t, gc.WeatherClear, turn)
+	clear, err := CalculateEnvTemperature(world, 5, 5)
+	require.NoError(t, err)
+
+	sb.RunSeed = seedWithWeatherAt(t, gc.WeatherSnow, turn)
+	snow, err := CalculateEnvTemperature(world, 5, 5)
+	require.NoError(t, err)
+
+	assert.Equal(t, gc.WeatherSnow.TemperatureModifier(), snow-clear, "雪の日は晴れより天候修正ぶん冷える")
+}
+
 func TestTemperatureSystem_極低温ゾーンで低体温が急進する(t *testing.T) {
 	t.Parallel()
 
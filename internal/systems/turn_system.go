@@ -181,6 +181,10 @@ func runTurnEndSystems(world w.World) error {
 	for _, updater := range []w.Updater{
 		&AutoInteractionSystem{},
 		&TemperatureSystem{},
+		&ElementConditionSystem{},
+		&InjurySystem{},
+		&PerishableSystem{},
+		&QuestSystem{},
 	} {
 		if sys, ok := world.Updaters[updater.String()]; ok {
 			if err := sys.Update(world); err != nil {
@@ -75,59 +75,57 @@ func (sys *VisionSystem) Update(world w.World) error {
 		return nil
 	}
 
-	// 視界遮断タイルのインデックスを構築する
-	blockViewIndex := buildBlockViewIndex(world)
-
-	// タイルの可視性マップを更新
-	visionRadius := consts.WorldPixel(consts.VisionRadiusTiles) * consts.TileSize
-	visibilityData := calculateTileVisibilityWithDistance(playerPos, visionRadius, blockViewIndex)
+	// 視線の遮蔽は空間インデックスのビット集合から引く。ドアの開閉や物の移動は増分で反映済み
+	si := query.GetSpatialIndex(world)
+	if si == nil {
+		return nil
+	}
 
-	// 光源情報を更新前にクリアする
-	vs.LightSourceCache = make(map[gc.GridElement]gc.LightInfo)
+	// 地上では天候が視界半径を縮める
+	weather := query.CurrentWeather(world)
+	radiusTiles := int(weatherVisionRadius(weather) / consts.TileSize)
+	playerTile := consts.Coord[int]{X: int(playerGridElement.X), Y: int(playerGridElement.Y)}
 
-	// 視界内タイルの光源情報を計算し、探索済みマークを行う。
-	// マップ外座標はデータに含めない。
 	// 環境光は屋内なら微小、地上なら時間帯の日照。昼の屋外は全体が明るく松明が要らず、
-	// 地下や深夜は松明の届く範囲だけが見える
+	// 地下や深夜は松明の届く範囲だけが見える。雲や霧は日照を遮って地上を暗くする
 	ambient := dungeonAmbient
 	if query.IsOnOverworld(world) {
-		ambient = overworldDaylight(query.GetGameTime(world).GetTimeOfDay())
+		ambient = overworldDaylight(query.GetGameTime(world).GetTimeOfDay()) * weather.DaylightFactor()
 	}
+	lights := newLightField(world, playerTile, radiusTiles, si.IsOpaque, ambient)
+
+	// 光源情報を更新前にクリアする
+	vs.LightSourceCache = make(map[gc.GridElement]gc.LightInfo)
+
+	// シャドウキャストで得た視界内タイルの光源情報を引き、探索済みマークを行う。
+	// マップ外座標はデータに含めない。
 	visibleTiles := make(map[gc.GridElement]bool)
-	for _, tileData := range visibilityData {
-		if !tileData.Visible {
-			continue
-		}
-		gridElement := gc.GridElement{Coord: consts.Coord[consts.Tile]{X: consts.Tile(tileData.Col), Y: consts.Tile(tileData.Row)}}
+	geometry.ShadowCast(playerTile, radiusTiles, si.IsOpaque, func(tile consts.Coord[int]) {
+		gridElement := gc.GridElement{Coord: consts.Coord[consts.Tile]{X: consts.Tile(tile.X), Y: consts.Tile(tile.Y)}}
 		if !isInMapBounds(gridElement, field.Level) {
-			continue
+			return
 		}
-
-		info := calculateLightSourceDarkness(world, consts.Coord[int]{X: tileData.Col, Y: tileData.Row}, blockViewIndex, ambient)
+		info := lights.at(tile)
 		// 明るさが閾値未満なら見えない。視界を光の届く範囲へ寄せる。
 		// 見えないタイルは記憶側へ回るので、暗所の敵やアイテムは自然に隠れる
 		if 1.0-info.Darkness < visibilityThreshold {
-			continue
+			return
 		}
 		vs.LightSourceCache[gridElement] = info
 		field.ExploredTiles[gridElement] = true
 		visibleTiles[gridElement] = true
-	}
+	})
 	vs.VisibleTiles = visibleTiles
 
+	// 足元の暗さは AI の発見距離に効く。見えないほど暗くても残すため、視界の閾値とは別に求める
+	vs.PlayerDarkness = lights.at(playerTile).Darkness
+
 	sys.lastPlayer = playerPos
 	sys.isInitialized = true
 
 	return nil
 }
 
-// TileVisibility はレイキャストによるタイルの可視性判定結果を表す
-type TileVisibility struct {
-	Row     int
-	Col     int
-	Visible bool
-}
-
 type (
 	// VisibleDarkness は視界内タイルの暗闇の強さを表す
 	VisibleDarkness float64
@@ -211,114 +209,6 @@ func isInMapBounds(grid gc.GridElement, level gc.Level) bool {
 	return grid.X >= 0 && grid.X < level.TileWidth && grid.Y >= 0 && grid.Y < level.TileHeight
 }
 
-// calculateTileVisibilityWithDistance はレイキャストでタイルごとの可視性と距離を計算する。
-// 結果はキーで引かず順に走査するだけなので、マップでなくスライスで返す。座標は各要素の
-// Row・Col が持つ。タイルごとの文字列キー生成を避け、視界内タイル数ぶんの alloc を無くす。
-func calculateTileVisibilityWithDistance(playerPos consts.Coord[consts.WorldPixel], radius consts.WorldPixel, blockIndex map[gc.GridElement]bool) []TileVisibility {
-	// プレイヤーの位置からタイル座標を計算
-	playerTileX := int(playerPos.X) / int(consts.TileSize)
-	playerTileY := int(playerPos.Y) / int(consts.TileSize)
-
-	// 視界範囲を分割して段階的処理（視界範囲最適化）
-	maxTileDistance := int(radius)/int(consts.TileSize) + 2
-
-	// 走査する正方形のタイル数を上限に事前確保し、追加時の再確保を避ける
-	side := 2*maxTileDistance + 1
-	visibility := make([]TileVisibility, 0, side*side)
-
-	// タイルベース視界判定（Dark Days Ahead風）
-
-	for dx := -maxTileDistance; dx <= maxTileDistance; dx++ {
-		for dy := -maxTileDistance; dy <= maxTileDistance; dy++ {
-			tileX := playerTileX + dx
-			tileY := playerTileY + dy
-
-			// タイルの中心座標を計算
-			tileCenter := consts.TileCenterToWorld(consts.Coord[consts.Tile]{X: consts.Tile(tileX), Y: consts.Tile(tileY)})
-
-			// プレイヤーからタイル中心への距離をチェック（平方根計算の最適化）
-			dxF := float64(tileCenter.X - playerPos.X)
-			dyF := float64(tileCenter.Y - playerPos.Y)
-			distanceSquared := dxF*dxF + dyF*dyF
-			radiusSquared := float64(radius) * float64(radius)
-
-			// 視界範囲内のタイルのみ処理
-			if distanceSquared <= radiusSquared {
-				visible := isTileVisibleByRaycast(playerPos, tileCenter, blockIndex)
-
-				visibility = append(visibility, TileVisibility{
-					Row:     tileY,
-					Col:     tileX,
-					Visible: visible,
-				})
-			}
-			// 視界外のタイルは処理しない（最適化）
-		}
-	}
-
-	return visibility
-}
-
-// isTileVisibleByRaycast はタイルベース視界判定
-func isTileVisibleByRaycast(player, target consts.Coord[consts.WorldPixel], blockIndex map[gc.GridElement]bool) bool {
-	// タイル座標に変換
-	playerTileX := int(player.X / consts.TileSize)
-	playerTileY := int(player.Y / consts.TileSize)
-	targetTileX := int(target.X / consts.TileSize)
-	targetTileY := int(target.Y / consts.TileSize)
-
-	// 同じタイルまたは隣接タイルは常に見える
-	if geometry.Abs(targetTileX-playerTileX) <= 1 && geometry.Abs(targetTileY-playerTileY) <= 1 {
-		return true
-	}
-
-	// ブレゼンハムのライン描画アルゴリズムでタイルベースの視線判定
-	return bresenhamLineOfSight(playerTileX, playerTileY, targetTileX, targetTileY, blockIndex)
-}
-
-// bresenhamLineOfSight はブレゼンハムアルゴリズムを使用したタイルベース視線判定
-func bresenhamLineOfSight(x0, y0, x1, y1 int, blockIndex map[gc.GridElement]bool) bool {
-	dx := geometry.Abs(x1 - x0)
-	dy := geometry.Abs(y1 - y0)
-
-	var sx, sy int
-	if x0 < x1 {
-		sx = 1
-	} else {
-		sx = -1
-	}
-	if y0 < y1 {
-		sy = 1
-	} else {
-		sy = -1
-	}
-
-	err := dx - dy
-	x, y := x0, y0
-
-	for {
-		// ターゲットに到達したら見える
-		if x == x1 && y == y1 {
-			return true
-		}
-
-		// 現在のタイルが視界を遮るかチェック
-		if blockIndex[gc.GridElement{Coord: consts.Coord[consts.Tile]{X: consts.Tile(x), Y: consts.Tile(y)}}] {
-			return false
-		}
-
-		e2 := 2 * err
-		if e2 > -dy {
-			err -= dy
-			x += sx
-		}
-		if e2 < dx {
-			err += dx
-			y += sy
-		}
-	}
-}
-
 // overworldDaylight は地上の時間帯ごとの日照の明るさを返す。昼が最も明るく深夜が最も暗い。
 // default を置かず全 case を列挙する。時間帯を足したら exhaustive linter がここの漏れを検知する。
 func overworldDaylight(t gc.TimeOfDay) float64 {
@@ -339,16 +229,42 @@ func overworldDaylight(t gc.TimeOfDay) float64 {
 	panic(fmt.Sprintf("unknown TimeOfDay: %d", t))
 }
 
-// calculateLightSourceDarkness はタイルの明るさを光源の加算合成で求め、暗さ=1-明るさで返す。
-// 各光源は逆二乗ベースで減衰し、半径の外縁で滑らかに0へ落ちる。複数光源は加算し、
-// 環境光 ambient を下駄として足す。壁で視線が遮られた光源は寄与しない。壁の裏へ光が漏れない。
-// 色は各光源の寄与で加重平均する。
-func calculateLightSourceDarkness(world w.World, tile consts.Coord[int], blockIndex map[gc.GridElement]bool, ambient float64) gc.LightInfo {
-	brightness := ambient
+// weatherVisionRadius は天候で縮めた視界半径を返す。晴天なら VisionRadiusTiles のまま
+func weatherVisionRadius(weather gc.Weather) consts.WorldPixel {
+	tiles := weather.VisionRadiusPercent().ApplyInt(int(consts.VisionRadiusTiles))
+	return consts.WorldPixel(tiles) * consts.TileSize
+}
+
+// lightField は視界窓の各タイルへ届く光を、光源ごとのシャドウキャストで積算したもの。
+// タイルごとに全光源との視線を引き直さず、光源1つにつき照射範囲を1回だけ走査する。
+// シャドウキャストは対称なので、光源から照らされるタイルと光源が見えるタイルは一致する。
+type lightField struct {
+	// 窓の左上のタイル座標
+	origin consts.Coord[int]
+	// 窓の一辺のタイル数
+	side    int
+	ambient float64
+	cells   []lightCell
+}
+
+// lightCell は1タイルに届いた光の積算値。色は寄与で加重した合計を持つ
+type lightCell struct {
+	brightness             float64
+	totalR, totalG, totalB float64
+	totalWeight            float64
+}
 
-	// 色は光源の寄与で加重平均する
-	var totalR, totalG, totalB float64
-	var totalWeight float64
+// newLightField は center から半径 radius タイルの窓に届く光を求める。
+// 各光源は逆二乗ベースで減衰し、半径の外縁で滑らかに0へ落ちる。複数光源は加算し、
+// 環境光 ambient を下駄として足す。壁で遮られたタイルへは光が届かない。壁の裏へ光が漏れない。
+func newLightField(world w.World, center consts.Coord[int], radius int, isOpaque geometry.OpaqueFunc, ambient float64) *lightField {
+	side := 2*radius + 1
+	lf := &lightField{
+		origin:  consts.Coord[int]{X: center.X - radius, Y: center.Y - radius},
+		side:    side,
+		ambient: ambient,
+		cells:   make([]lightCell, side*side),
+	}
 
 	// 全ての光源をチェック。退避中ステージの光源は現ステージを照らさない
 	lightQuery := query.ActiveFilter2[gc.LightSource, gc.GridElement](world).Query()
@@ -361,43 +277,62 @@ func calculateLightSourceDarkness(world w.World, tile consts.Coord[int], blockIn
 		}
 
 		lightGrid := world.Components.GridElement.Get(lightEntity)
-		distance := geometry.Distance(float64(tile.X), float64(tile.Y), float64(lightGrid.X), float64(lightGrid.Y))
-		if distance > float64(lightSource.Radius) {
-			continue
-		}
-		// 光源からタイルへの視線が壁で遮られているなら光は届かない。壁の裏へ漏らさない。
-		if !bresenhamLineOfSight(int(lightGrid.X), int(lightGrid.Y), tile.X, tile.Y, blockIndex) {
+		lightPos := consts.Coord[int]{X: int(lightGrid.X), Y: int(lightGrid.Y)}
+		lightRadius := int(lightSource.Radius)
+		// 照射範囲が窓に掛からない光源は走査しない
+		if geometry.ChebyshevDistance(lightPos, center) > radius+lightRadius {
 			continue
 		}
 
-		// 中心1タイルまでは最大の明るさにする
-		if distance < 1.0 {
-			distance = 1.0
-		}
-		nd := distance / float64(lightSource.Radius)
-
-		// 平坦な床を照らす見た目にする。半径の内側 lightPlateau までは一様に明るく、
-		// 外縁だけ滑らかに0へ落とす。中心だけ極端に明るい逆二乗だと、トップダウンでは
-		// 光った球のように見えてしまうのを避ける
-		atten := 1.0 - smoothstep(lightPlateau, 1.0, nd)
+		geometry.ShadowCast(lightPos, lightRadius, isOpaque, func(tile consts.Coord[int]) {
+			cell := lf.cell(tile)
+			if cell == nil {
+				return
+			}
+			// 中心1タイルまでは最大の明るさにする
+			distance := math.Max(1.0, geometry.Distance(float64(tile.X), float64(tile.Y), float64(lightPos.X), float64(lightPos.Y)))
+			nd := distance / float64(lightSource.Radius)
+
+			// 平坦な床を照らす見た目にする。半径の内側 lightPlateau までは一様に明るく、
+			// 外縁だけ滑らかに0へ落とす。中心だけ極端に明るい逆二乗だと、トップダウンでは
+			// 光った球のように見えてしまうのを避ける
+			atten := 1.0 - smoothstep(lightPlateau, 1.0, nd)
+
+			// 加算合成。重なるほど明るい。色は寄与 atten で加重する
+			cell.brightness += atten
+			cell.totalR += float64(lightSource.Color.R) * atten
+			cell.totalG += float64(lightSource.Color.G) * atten
+			cell.totalB += float64(lightSource.Color.B) * atten
+			cell.totalWeight += atten
+		})
+	}
 
-		// 加算合成。重なるほど明るい
-		brightness += atten
+	return lf
+}
 
-		// 色は寄与 atten で加重する
-		totalR += float64(lightSource.Color.R) * atten
-		totalG += float64(lightSource.Color.G) * atten
-		totalB += float64(lightSource.Color.B) * atten
-		totalWeight += atten
+// cell は窓内タイルの積算値を返す。窓外はnil
+func (lf *lightField) cell(tile consts.Coord[int]) *lightCell {
+	x, y := tile.X-lf.origin.X, tile.Y-lf.origin.Y
+	if x < 0 || y < 0 || x >= lf.side || y >= lf.side {
+		return nil
 	}
+	return &lf.cells[y*lf.side+x]
+}
 
-	brightness = math.Max(0, math.Min(1, brightness))
+// at はタイルの明るさを暗さ=1-明るさで返す。色は各光源の寄与で加重平均する。
+// 窓外のタイルは環境光だけで決まる
+func (lf *lightField) at(tile consts.Coord[int]) gc.LightInfo {
+	var c lightCell
+	if p := lf.cell(tile); p != nil {
+		c = *p
+	}
+	brightness := math.Max(0, math.Min(1, lf.ambient+c.brightness))
 
 	col := color.RGBA{A: 255}
-	if totalWeight > 0 {
-		col.R = uint8(math.Min(255, totalR/totalWeight))
-		col.G = uint8(math.Min(255, totalG/totalWeight))
-		col.B = uint8(math.Min(255, totalB/totalWeight))
+	if c.totalWeight > 0 {
+		col.R = uint8(math.Min(255, c.totalR/c.totalWeight))
+		col.G = uint8(math.Min(255, c.totalG/c.totalWeight))
+		col.B = uint8(math.Min(255, c.totalB/c.totalWeight))
 	}
 
 	return gc.LightInfo{
@@ -428,16 +363,3 @@ func smoothstep(edge0, edge1, x float64) float64 {
 	t = math.Max(0, math.Min(1, t))
 	return t * t * (3 - 2*t)
 }
-
-// buildBlockViewIndex は全BlockViewエンティティのタイル座標をインデックス化する
-func buildBlockViewIndex(world w.World) map[gc.GridElement]bool {
-	index := make(map[gc.GridElement]bool)
-	// 退避中ステージの遮蔽物は現ステージの視界を遮らない
-	blockViewQuery := query.ActiveFilter2[gc.GridElement, gc.BlockView](world).Query()
-	for blockViewQuery.Next() {
-		entity := blockViewQuery.Entity()
-		grid := world.Components.GridElement.Get(entity)
-		index[*grid] = true
-	}
-	return index
-}
@@ -1,30 +1,46 @@
 package systems
 
 import (
+	"image/color"
 	"testing"
 
 	gc "github.com/kijimaD/ruins/internal/components"
 	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/geometry"
+	"github.com/kijimaD/ruins/internal/testutil"
 )
 
-// 視界再計算 calculateTileVisibilityWithDistance の実寸を測るベンチ。半径は実ゲームと同じ
-// VisionRadiusTiles=24。荒れ地は壁が無くレイが最後まで伸びる最悪ケース、市街地は建物で
-// レイが早期終了しつつ blockIndex 参照が重いケース。視界更新は毎ターンここを丸ごと引き直すので、
-// 1回のコストが体感に直結する。回帰の番人として置く。
+// 視界再計算の実寸を測るベンチ。半径は実ゲームと同じ VisionRadiusTiles=24。荒れ地は壁が無く
+// 影が1つも落ちない最悪ケース、市街地は建物で影が細かく割れ再帰が増えるケース。視界更新は毎ターン
+// ここを丸ごと引き直すので、1回のコストが体感に直結する。回帰の番人として置く。
+// 遮蔽判定は実ゲームと同じく空間インデックスのビット集合を引く。
 
-// benchPlayerTile はベンチのプレイヤータイル座標。maxTileDistance=26 を足しても正になるよう離す。
+// benchPlayerTile はベンチのプレイヤータイル座標。視界半径を足し引きしても盤面に収まるよう離す。
 const benchPlayerTile = 60
 
-// benchPlayerPos はプレイヤーのワールドピクセル座標(タイル中心)を返す。
-func benchPlayerPos() consts.Coord[consts.WorldPixel] {
-	c := benchPlayerTile*int(consts.TileSize) + int(consts.TileSize)/2
-	return consts.Coord[consts.WorldPixel]{X: consts.WorldPixel(c), Y: consts.WorldPixel(c)}
+// benchMapSize はベンチ盤面の一辺のタイル数
+const benchMapSize = 2 * benchPlayerTile
+
+// benchPlayer はプレイヤーのタイル座標を返す。
+func benchPlayer() consts.Coord[int] {
+	return consts.Coord[int]{X: benchPlayerTile, Y: benchPlayerTile}
 }
 
-// cityBlockIndex は市街地を模した blockIndex を作る。5×5 の壁枠の建物を 8 タイル間隔で敷き、
-// レイが途中の壁でよく止まる状況を再現する。
-func cityBlockIndex() map[gc.GridElement]bool {
-	idx := map[gc.GridElement]bool{}
+// benchIndex は遮蔽のビット集合だけを持つ構築済みの空間インデックスを作る。
+func benchIndex() *gc.SpatialIndex {
+	return &gc.SpatialIndex{
+		MapWidth:  benchMapSize,
+		MapHeight: benchMapSize,
+		BlockPass: map[gc.GridElement]bool{},
+		BlockView: gc.NewBitGrid(benchMapSize, benchMapSize),
+		Built:     true,
+	}
+}
+
+// cityIndex は市街地を模した空間インデックスを作る。5×5 の壁枠の建物を 8 タイル間隔で敷き、
+// 視線が途中の壁でよく止まる状況を再現する。
+func cityIndex() *gc.SpatialIndex {
+	si := benchIndex()
 	lo, hi := benchPlayerTile-30, benchPlayerTile+30
 	for by := lo; by < hi; by += 8 {
 		for bx := lo; bx < hi; bx += 8 {
@@ -37,28 +53,59 @@ func cityBlockIndex() map[gc.GridElement]bool {
 					if x == benchPlayerTile && y == benchPlayerTile {
 						continue // プレイヤー位置は空ける
 					}
-					idx[gc.GridElement{Coord: consts.Coord[consts.Tile]{X: consts.Tile(x), Y: consts.Tile(y)}}] = true
+					si.SetBlocker(consts.Coord[consts.Tile]{X: consts.Tile(x), Y: consts.Tile(y)}, true, true)
 				}
 			}
 		}
 	}
-	return idx
+	return si
 }
 
-func benchVision(b *testing.B, block map[gc.GridElement]bool) {
+func benchVision(b *testing.B, si *gc.SpatialIndex) {
 	b.Helper()
-	pos := benchPlayerPos()
-	radius := consts.WorldPixel(consts.VisionRadiusTiles) * consts.TileSize
+	origin := benchPlayer()
 	b.ResetTimer()
 	for range b.N {
-		calculateTileVisibilityWithDistance(pos, radius, block)
+		geometry.ShadowCast(origin, int(consts.VisionRadiusTiles), si.IsOpaque, func(consts.Coord[int]) {})
 	}
 }
 
 func BenchmarkVisionRecompute_荒れ地(b *testing.B) {
-	benchVision(b, map[gc.GridElement]bool{})
+	benchVision(b, benchIndex())
 }
 
 func BenchmarkVisionRecompute_市街地(b *testing.B) {
-	benchVision(b, cityBlockIndex())
+	benchVision(b, cityIndex())
+}
+
+// BenchmarkLightField_市街地 は視界窓へ届く光の積算を測る。松明を 6 タイル間隔で敷き、
+// 窓に掛かる光源が多い夜の市街地を再現する。光源ごとに照射範囲を1回だけ走査するので、
+// コストは視界内タイル数でなく光源数×照射面積に比例する。
+func BenchmarkLightField_市街地(b *testing.B) {
+	world := testutil.InitTestWorld(b, testutil.WithStageLevel(gc.Level{TileWidth: benchMapSize, TileHeight: benchMapSize}))
+	lo, hi := benchPlayerTile-30, benchPlayerTile+30
+	for y := lo; y < hi; y += 6 {
+		for x := lo; x < hi; x += 6 {
+			e := world.ECS.NewEntity()
+			world.Components.GridElement.Add(e, &gc.GridElement{Coord: consts.Coord[consts.Tile]{X: consts.Tile(x), Y: consts.Tile(y)}})
+			world.Components.LightSource.Add(e, &gc.LightSource{Radius: 6, Color: color.RGBA{R: 255, G: 220, B: 150, A: 255}, Enabled: true})
+		}
+	}
+	si := cityIndex()
+	b.ResetTimer()
+	for range b.N {
+		newLightField(world, benchPlayer(), int(consts.VisionRadiusTiles), si.IsOpaque, dungeonAmbient)
+	}
+}
+
+// BenchmarkInFieldOfView_市街地 は AI の視線判定1回ぶんを測る。視界半径ぎわの斜め方向を狙い、
+// 走査する象限と深さが最大になる場合を見る。
+func BenchmarkInFieldOfView_市街地(b *testing.B) {
+	si := cityIndex()
+	origin := benchPlayer()
+	target := consts.Coord[int]{X: origin.X + 17, Y: origin.Y + 16}
+	b.ResetTimer()
+	for range b.N {
+		geometry.InFieldOfView(origin, target, si.IsOpaque)
+	}
 }
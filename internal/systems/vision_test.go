@@ -6,8 +6,10 @@ import (
 
 	gc "github.com/kijimaD/ruins/internal/components"
 	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/geometry"
 	"github.com/kijimaD/ruins/internal/testutil"
 	w "github.com/kijimaD/ruins/internal/world"
+	"github.com/kijimaD/ruins/internal/world/lifecycle"
 
 	"github.com/kijimaD/ruins/internal/world/query"
 	"github.com/stretchr/testify/assert"
@@ -232,38 +234,46 @@ func TestIsInMapBounds(t *testing.T) {
 	assert.False(t, isInMapBounds(gc.GridElement{Coord: consts.Coord[consts.Tile]{X: -1, Y: 0}}, level))
 }
 
-func TestBuildBlockViewIndex(t *testing.T) {
-	t.Parallel()
-
-	t.Run("BlockViewを持つエンティティがインデックスに含まれる", func(t *testing.T) {
-		t.Parallel()
-		world := testutil.InitTestWorld(t)
-
-		// BlockView付きの壁タイルを生成する
-		wallGrid := gc.GridElement{Coord: consts.Coord[consts.Tile]{X: 3, Y: 4}}
-		wallEntity := world.ECS.NewEntity()
-		world.Components.GridElement.Add(wallEntity, &wallGrid)
-		world.Components.BlockView.Add(wallEntity, &gc.BlockView{})
-
-		// BlockViewなしの床タイルを生成する
-		floorGrid := gc.GridElement{Coord: consts.Coord[consts.Tile]{X: 5, Y: 6}}
-		floorEntity := world.ECS.NewEntity()
-		world.Components.GridElement.Add(floorEntity, &floorGrid)
-
-		index := buildBlockViewIndex(world)
-
-		assert.True(t, index[wallGrid], "壁タイルがインデックスに含まれる")
-		assert.False(t, index[floorGrid], "床タイルはインデックスに含まれない")
-	})
+// opaqueTiles は指定座標だけが視線を遮る OpaqueFunc を返す
+func opaqueTiles(tiles ...consts.Coord[int]) geometry.OpaqueFunc {
+	set := map[consts.Coord[int]]bool{}
+	for _, tile := range tiles {
+		set[tile] = true
+	}
+	return func(c consts.Coord[int]) bool { return set[c] }
+}
 
-	t.Run("BlockViewエンティティがない場合は空マップを返す", func(t *testing.T) {
-		t.Parallel()
-		world := testutil.InitTestWorld(t)
+// lightAt は1タイルだけの窓で光を積算し、そのタイルの光源情報を返す
+func lightAt(world w.World, tile consts.Coord[int], isOpaque geometry.OpaqueFunc, ambient float64) gc.LightInfo {
+	return newLightField(world, tile, 0, isOpaque, ambient).at(tile)
+}
 
-		index := buildBlockViewIndex(world)
+func TestVisionSystem_空間インデックスの遮蔽で視界が決まる(t *testing.T) {
+	t.Parallel()
 
-		assert.Empty(t, index)
-	})
+	// プレイヤーの東2タイルに壁を立てる。明るい地上の昼にして、暗さでなく遮蔽だけで見え方が決まるようにする
+	world := testutil.InitTestWorld(t)
+	_, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 10, Y: 10}, "ash")
+	require.NoError(t, err)
+	wall := world.ECS.NewEntity()
+	world.Components.GridElement.Add(wall, &gc.GridElement{Coord: consts.Coord[consts.Tile]{X: 12, Y: 10}})
+	world.Components.BlockView.Add(wall, &gc.BlockView{})
+	lightEntity := world.ECS.NewEntity()
+	world.Components.GridElement.Add(lightEntity, &gc.GridElement{Coord: consts.Coord[consts.Tile]{X: 10, Y: 10}})
+	world.Components.LightSource.Add(lightEntity, &gc.LightSource{Radius: 10, Color: color.RGBA{R: 255, G: 255, B: 255, A: 255}, Enabled: true})
+	query.InvalidateSpatialIndex(world)
+
+	require.NoError(t, NewVisionSystem().Update(world))
+
+	vs := query.GetVisionState(world)
+	at := func(x, y consts.Tile) gc.GridElement {
+		return gc.GridElement{Coord: consts.Coord[consts.Tile]{X: x, Y: y}}
+	}
+	assert.True(t, vs.VisibleTiles[at(11, 10)], "壁の手前は見える")
+	assert.True(t, vs.VisibleTiles[at(12, 10)], "壁自体は見える")
+	assert.False(t, vs.VisibleTiles[at(14, 10)], "壁の裏は見えない")
+	assert.True(t, vs.VisibleTiles[at(10, 14)], "遮蔽の無い方向は見える")
+	assert.Less(t, vs.PlayerDarkness, 1.0, "足元の松明で暗さが和らぐ")
 }
 
 func TestCalculateTileVisibility_チャンク境界をまたいで視線が通り壁で遮られる(t *testing.T) {
@@ -272,81 +282,33 @@ func TestCalculateTileVisibility_チャンク境界をまたいで視線が通
 	// オーバーワールドは複数チャンクを1つのステージに束ねるため、視界はチャンク境界を透過する。
 	// 視界にはチャンク固有の境界ロジックが無く絶対座標で動くことを、境界座標 x=chunkW を跨ぐ
 	// 水平視線で固定する。遮蔽が無ければ向こう側が見え、境界上の壁で遮られる。
-	const chunkW consts.Tile = 30 // 東西チャンク境界の座標
-	playerTile := consts.Coord[consts.Tile]{X: chunkW - 2, Y: 10}
-	playerPos := consts.TileCenterToWorld(playerTile)
-	radius := consts.WorldPixel(8 * int(consts.TileSize))
-	target := gc.GridElement{Coord: consts.Coord[consts.Tile]{X: chunkW + 3, Y: 10}} // 隣チャンク側
-
-	lookup := func(vis []TileVisibility, g gc.GridElement) (visible bool, found bool) {
-		for _, v := range vis {
-			if v.Col == int(g.X) && v.Row == int(g.Y) {
-				return v.Visible, true
+	const chunkW = 30 // 東西チャンク境界の座標
+	playerTile := consts.Coord[int]{X: chunkW - 2, Y: 10}
+	const radius = 8
+	target := consts.Coord[int]{X: chunkW + 3, Y: 10} // 隣チャンク側
+
+	visible := func(isOpaque geometry.OpaqueFunc) bool {
+		found := false
+		geometry.ShadowCast(playerTile, radius, isOpaque, func(c consts.Coord[int]) {
+			if c == target {
+				found = true
 			}
-		}
-		return false, false
+		})
+		return found
 	}
 
 	t.Run("遮蔽が無ければ隣チャンク側が見える", func(t *testing.T) {
 		t.Parallel()
-		vis := calculateTileVisibilityWithDistance(playerPos, radius, map[gc.GridElement]bool{})
-		visible, found := lookup(vis, target)
-		require.True(t, found, "対象タイルが視界走査範囲に入る")
-		assert.True(t, visible, "境界の向こう側のタイルが見える")
+		assert.True(t, visible(opaqueTiles()), "境界の向こう側のタイルが見える")
 	})
 
 	t.Run("境界上の壁で視線が遮られる", func(t *testing.T) {
 		t.Parallel()
-		blockIndex := map[gc.GridElement]bool{
-			{Coord: consts.Coord[consts.Tile]{X: chunkW, Y: 10}}: true, // 境界 x=chunkW 上の壁
-		}
-		vis := calculateTileVisibilityWithDistance(playerPos, radius, blockIndex)
-		visible, found := lookup(vis, target)
-		require.True(t, found, "対象タイルが視界走査範囲に入る")
-		assert.False(t, visible, "境界上の壁の向こうは見えない")
+		assert.False(t, visible(opaqueTiles(consts.Coord[int]{X: chunkW, Y: 10})), "境界上の壁の向こうは見えない")
 	})
 }
 
-func TestBresenhamLineOfSight(t *testing.T) {
-	t.Parallel()
-
-	t.Run("遮蔽物がなければ見える", func(t *testing.T) {
-		t.Parallel()
-		blockIndex := map[gc.GridElement]bool{}
-
-		assert.True(t, bresenhamLineOfSight(0, 0, 5, 5, blockIndex))
-	})
-
-	t.Run("途中に壁があれば見えない", func(t *testing.T) {
-		t.Parallel()
-		blockIndex := map[gc.GridElement]bool{
-			{Coord: consts.Coord[consts.Tile]{X: 2, Y: 2}}: true,
-		}
-
-		assert.False(t, bresenhamLineOfSight(0, 0, 5, 5, blockIndex))
-	})
-
-	t.Run("ターゲット位置の壁は遮蔽しない", func(t *testing.T) {
-		t.Parallel()
-		// ターゲット自体が壁でも到達判定が先なので見える
-		blockIndex := map[gc.GridElement]bool{
-			{Coord: consts.Coord[consts.Tile]{X: 3, Y: 3}}: true,
-		}
-
-		assert.True(t, bresenhamLineOfSight(0, 0, 3, 3, blockIndex))
-	})
-
-	t.Run("隣接タイルは常に見える", func(t *testing.T) {
-		t.Parallel()
-		// 隣接はbresenhamの最初のステップでターゲット到達する
-		blockIndex := map[gc.GridElement]bool{}
-
-		assert.True(t, bresenhamLineOfSight(5, 5, 6, 5, blockIndex))
-		assert.True(t, bresenhamLineOfSight(5, 5, 5, 6, blockIndex))
-	})
-}
-
-func TestCalculateLightSourceDarkness_壁が光を遮る(t *testing.T) {
+func TestLightField_壁が光を遮る(t *testing.T) {
 	t.Parallel()
 
 	// 光源を (5,5) に置き Radius 10 とする。(7,5) に壁を立て、壁の手前 (6,5) は照らされ、
@@ -364,21 +326,19 @@ func TestCalculateLightSourceDarkness_壁が光を遮る(t *testing.T) {
 		})
 		return world
 	}
-	wall := map[gc.GridElement]bool{
-		{Coord: consts.Coord[consts.Tile]{X: 7, Y: 5}}: true,
-	}
+	wall := opaqueTiles(consts.Coord[int]{X: 7, Y: 5})
 
 	t.Run("壁の手前のタイルは照らされる", func(t *testing.T) {
 		t.Parallel()
 		world := setup(t)
-		info := calculateLightSourceDarkness(world, consts.Coord[int]{X: 6, Y: 5}, wall, 0.0)
+		info := lightAt(world, consts.Coord[int]{X: 6, Y: 5}, wall, 0.0)
 		assert.Less(t, info.Darkness, 1.0, "手前のタイルは光が届き暗闇が解消される")
 	})
 
 	t.Run("壁の裏のタイルは照らされない", func(t *testing.T) {
 		t.Parallel()
 		world := setup(t)
-		info := calculateLightSourceDarkness(world, consts.Coord[int]{X: 9, Y: 5}, wall, 0.0)
+		info := lightAt(world, consts.Coord[int]{X: 9, Y: 5}, wall, 0.0)
 		assert.Equal(t, 1.0, info.Darkness, "壁の裏は光が遮られ完全に暗いまま")
 		// 光が寄与しないと RGB は 0 のまま。A は常に 255 が入るので RGB だけを見る。
 		// Darkness=1.0 なら描画側が色を無視するため、この色は実効に影響しない
@@ -389,17 +349,17 @@ func TestCalculateLightSourceDarkness_壁が光を遮る(t *testing.T) {
 		t.Parallel()
 		world := setup(t)
 		// 壁を除いた同座標で照らされることを示し、暗いのは距離でなく遮蔽が原因だと確定する
-		info := calculateLightSourceDarkness(world, consts.Coord[int]{X: 9, Y: 5}, map[gc.GridElement]bool{}, 0.0)
+		info := lightAt(world, consts.Coord[int]{X: 9, Y: 5}, opaqueTiles(), 0.0)
 		assert.Less(t, info.Darkness, 1.0, "壁が無ければ壁の裏と同じ位置でも照らされる")
 	})
 }
 
-// TestCalculateLightSourceDarkness_明るさの合成 は明るさが距離減衰・加算・環境光で
+// TestLightField_明るさの合成 は明るさが距離減衰・加算・環境光で
 // 決まることを固定する。遮蔽テストは「届くか」しか見ないので、減衰形状と合成を別に押さえる。
-func TestCalculateLightSourceDarkness_明るさの合成(t *testing.T) {
+func TestLightField_明るさの合成(t *testing.T) {
 	t.Parallel()
 
-	noWall := map[gc.GridElement]bool{}
+	noWall := opaqueTiles()
 	addLight := func(world w.World, x, y int) {
 		grid := gc.GridElement{Coord: consts.Coord[consts.Tile]{X: consts.Tile(x), Y: consts.Tile(y)}}
 		e := world.ECS.NewEntity()
@@ -415,8 +375,8 @@ func TestCalculateLightSourceDarkness_明るさの合成(t *testing.T) {
 		t.Parallel()
 		world := testutil.InitTestWorld(t)
 		addLight(world, 5, 5)
-		near := calculateLightSourceDarkness(world, consts.Coord[int]{X: 6, Y: 5}, noWall, 0.0)
-		far := calculateLightSourceDarkness(world, consts.Coord[int]{X: 13, Y: 5}, noWall, 0.0)
+		near := lightAt(world, consts.Coord[int]{X: 6, Y: 5}, noWall, 0.0)
+		far := lightAt(world, consts.Coord[int]{X: 13, Y: 5}, noWall, 0.0)
 		assert.Less(t, near.Darkness, far.Darkness, "近いタイルの方が暗さが小さい")
 	})
 
@@ -425,12 +385,12 @@ func TestCalculateLightSourceDarkness_明るさの合成(t *testing.T) {
 		// プラトー外の距離8で、1灯と2灯を比べる。加算されるので2灯の方が暗さが小さい
 		one := testutil.InitTestWorld(t)
 		addLight(one, 5, 5)
-		darknessOne := calculateLightSourceDarkness(one, consts.Coord[int]{X: 13, Y: 5}, noWall, 0.0).Darkness
+		darknessOne := lightAt(one, consts.Coord[int]{X: 13, Y: 5}, noWall, 0.0).Darkness
 
 		two := testutil.InitTestWorld(t)
 		addLight(two, 5, 5)
 		addLight(two, 21, 5)
-		darknessTwo := calculateLightSourceDarkness(two, consts.Coord[int]{X: 13, Y: 5}, noWall, 0.0).Darkness
+		darknessTwo := lightAt(two, consts.Coord[int]{X: 13, Y: 5}, noWall, 0.0).Darkness
 
 		assert.Less(t, darknessTwo, darknessOne, "2灯に照らされると加算で明るくなる")
 	})
@@ -439,7 +399,7 @@ func TestCalculateLightSourceDarkness_明るさの合成(t *testing.T) {
 		t.Parallel()
 		world := testutil.InitTestWorld(t)
 		// 光源を置かない。暗さ = 1 - 環境光
-		info := calculateLightSourceDarkness(world, consts.Coord[int]{X: 5, Y: 5}, noWall, 0.3)
+		info := lightAt(world, consts.Coord[int]{X: 5, Y: 5}, noWall, 0.3)
 		assert.InDelta(t, 0.7, info.Darkness, 1e-9, "光が無ければ暗さは環境光で決まる")
 	})
 }
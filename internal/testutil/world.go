@@ -9,7 +9,7 @@ import (
 	gc "github.com/kijimaD/ruins/internal/components"
 	"github.com/kijimaD/ruins/internal/config"
 	"github.com/kijimaD/ruins/internal/loader"
-	"github.com/kijimaD/ruins/internal/oapi"
+	"github.com/kijimaD/ruins/internal/raw"
 	w "github.com/kijimaD/ruins/internal/world"
 	"github.com/stretchr/testify/require"
 )
@@ -17,7 +17,7 @@ import (
 // 共有リソースをキャッシュ（一度だけ読み込む）
 var (
 	rawMasterOnce sync.Once
-	rawMaster     oapi.Raws
+	rawMaster     raw.Master
 )
 
 // initConfig は InitTestWorld の初期化オプションを集約する。
@@ -71,7 +71,7 @@ func InitTestWorld(tb testing.TB, opts ...Option) w.World {
 
 	// RawMasterのみを共有リソースから取得（一度だけ読み込む）
 	rawMasterOnce.Do(func() {
-		rw, err := loader.LoadRaws()
+		rw, err := loader.LoadRaws("")
 		require.NoError(tb, err, "failed to load RawMaster")
 		rawMaster = rw
 	})
@@ -53,6 +53,9 @@ func SpecRows(world w.World, entity ecs.Entity) []SpecRow {
 	if world.Components.ProvidesNutrition.Has(entity) {
 		rows = append(rows, nutritionRows(world, world.Components.ProvidesNutrition.Get(entity))...)
 	}
+	if world.Components.ProvidesTreatment.Has(entity) {
+		rows = append(rows, treatmentRows(world, world.Components.ProvidesTreatment.Get(entity))...)
+	}
 	if world.Components.Perishable.Has(entity) {
 		rows = append(rows, freshnessRow(world, entity))
 	}
@@ -243,6 +246,11 @@ func nutritionRows(world w.World, nutrition *gc.ProvidesNutrition) []SpecRow {
 	return []SpecRow{{Label: query.T(world, "Nutrition"), Value: strconv.Itoa(nutrition.Amount)}}
 }
 
+// treatmentRows は手当ての治療量の行を返す
+func treatmentRows(world w.World, treatment *gc.ProvidesTreatment) []SpecRow {
+	return []SpecRow{{Label: query.T(world, "Treatment"), Value: strconv.Itoa(treatment.Amount)}}
+}
+
 // freshnessRow は鮮度の1行を返す。鮮度の算出は query.FreshnessStageOf に委ねる
 func freshnessRow(world w.World, entity ecs.Entity) SpecRow {
 	stage, _ := query.FreshnessStageOf(world, entity)
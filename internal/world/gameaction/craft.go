@@ -2,6 +2,7 @@ package gameaction
 
 import (
 	"fmt"
+	"math"
 	"math/rand/v2"
 
 	gc "github.com/kijimaD/ruins/internal/components"
@@ -13,7 +14,9 @@ import (
 	"github.com/mlange-42/ark/ecs"
 )
 
-// Craft はアイテムをクラフトする
+// Craft はアイテムをクラフトする。
+// 腐る素材から腐る品を作るときは、いちばん傷んだ素材の鮮度の進み具合を完成品へ引き継ぐ。
+// 保存食にしても傷んだ素材は傷んだまま仕上がり、その後の傷みが遅くなるだけ
 func Craft(world w.World, name string) (ecs.Entity, error) {
 	canCraft, err := CanCraft(world, name)
 	if err != nil {
@@ -31,11 +34,18 @@ func Craft(world w.World, name string) (ecs.Entity, error) {
 		smithQualityPct = mods.SmithQuality
 	}
 
+	// 素材は消費で消えるので、鮮度の進み具合は先に読んでおく
+	progress, perishable := ingredientRotProgress(world, name)
+
 	resultEntity, err := lifecycle.SpawnBackpackItem(world, name, 1)
 	if err != nil {
 		return gc.InvalidEntity, fmt.Errorf("failed to generate item: %w", err)
 	}
 	randomize(world, resultEntity, smithQualityPct)
+	if perishable && world.Components.Perishable.Has(resultEntity) {
+		p := world.Components.Perishable.Get(resultEntity)
+		p.RotAccrued = consts.Turn(math.Round(progress * float64(p.StageLength)))
+	}
 	if err := consumeMaterials(world, name, craftCostPct); err != nil {
 		return gc.InvalidEntity, fmt.Errorf("failed to consume materials: %w", err)
 	}
@@ -77,6 +87,32 @@ func consumeMaterials(world w.World, goal string, craftCostPct consts.Percent) e
 	return nil
 }
 
+// ingredientRotProgress はレシピ need の素材のうち、いちばん傷んだものの鮮度の進み具合を返す。
+// 進み具合は累積劣化量を1段階の長さで割ったもので、1 で劣化、2 で腐敗に入る。
+// 腐る素材が無ければ false を返す
+func ingredientRotProgress(world w.World, need string) (float64, bool) {
+	now := query.GetGameTime(world).TotalTurns
+	worst, found := 0.0, false
+	for _, recipeInput := range requiredMaterials(world, need) {
+		stack, ok := query.FindStackInInventory(world, recipeInput.ID)
+		if !ok {
+			continue
+		}
+		for _, member := range query.StackMembers(world, stack) {
+			if !world.Components.Perishable.Has(member) {
+				continue
+			}
+			p := world.Components.Perishable.Get(member)
+			if p.StageLength <= 0 {
+				continue
+			}
+			worst = max(worst, float64(query.EffectiveRot(world, member, now))/float64(p.StageLength))
+			found = true
+		}
+	}
+	return worst, found
+}
+
 // requiredMaterials は指定したレシピに必要な素材一覧
 func requiredMaterials(world w.World, need string) []gc.RecipeInput {
 	rawMaster := world.Resources.RawMaster
@@ -91,3 +91,45 @@ func TestCraft_StackTwice(t *testing.T) {
 	assert.True(t, world.ECS.Alive(second), "統合されても生存する結果エンティティが返るべき")
 	assert.Equal(t, 2, query.GetEntityCount(world, second), "回復薬が2個に統合されているべき")
 }
+
+// TestCraft_素材の傷みを保存食へ引き継ぐ は、傷んだ素材で作った保存食が新品でなく、
+// 素材と同じ鮮度の進み具合で仕上がることを検証する
+func TestCraft_素材の傷みを保存食へ引き継ぐ(t *testing.T) {
+	t.Parallel()
+	world := testutil.InitTestWorld(t)
+	_, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 0, Y: 0}, "ash")
+	require.NoError(t, err)
+
+	// 干し肉は生肉2個から作る。片方だけ劣化段階の半ばまで傷ませる
+	fresh, err := lifecycle.SpawnBackpackItem(world, "raw_meat", 1)
+	require.NoError(t, err)
+	stale, err := lifecycle.SpawnBackpackItem(world, "raw_meat", 1)
+	require.NoError(t, err)
+	world.Components.Perishable.Get(stale).RotAccrued = world.Components.Perishable.Get(fresh).StageLength * 3 / 2
+	// 同じ段階のスタックから素材を取るよう、新鮮な方も同じ段階へそろえる
+	world.Components.Perishable.Get(fresh).RotAccrued = world.Components.Perishable.Get(fresh).StageLength
+
+	result, err := Craft(world, "dried_meat")
+	require.NoError(t, err)
+
+	p := world.Components.Perishable.Get(result)
+	assert.Equal(t, p.StageLength*3/2, p.RotAccrued, "いちばん傷んだ素材の進み具合を完成品の1段階の長さで引き継ぐ")
+	stage, ok := query.FreshnessStageOf(world, result)
+	require.True(t, ok)
+	assert.Equal(t, gc.FreshnessStale, stage, "劣化した素材からは劣化した保存食ができる")
+}
+
+// TestCraft_新鮮な素材の保存食は新鮮 は、傷んでいない素材からは新品の保存食ができることを検証する
+func TestCraft_新鮮な素材の保存食は新鮮(t *testing.T) {
+	t.Parallel()
+	world := testutil.InitTestWorld(t)
+	_, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 0, Y: 0}, "ash")
+	require.NoError(t, err)
+	_, err = lifecycle.SpawnBackpackItem(world, "raw_meat", 2)
+	require.NoError(t, err)
+
+	result, err := Craft(world, "dried_meat")
+	require.NoError(t, err)
+
+	assert.Equal(t, consts.Turn(0), world.Components.Perishable.Get(result).RotAccrued)
+}
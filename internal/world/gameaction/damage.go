@@ -9,9 +9,28 @@ import (
 	"github.com/mlange-42/ark/ecs"
 )
 
+// Hit はダメージを与えた手段。プレイヤーが倒れたときの死因に使う。分からない項目は空のまま渡す
+type Hit struct {
+	Weapon  string         // 攻撃手段の名前。武器名や素手など訳す前の英語原文
+	Element gc.ElementType // 攻撃の元素
+}
+
+// ItemHit は item を攻撃手段とする Hit を返す。投げた物や使ったアイテムによるダメージに添える
+func ItemHit(world w.World, item ecs.Entity) Hit {
+	if !world.ECS.Alive(item) || !world.Components.Name.Has(item) {
+		return Hit{}
+	}
+	return Hit{Weapon: world.Components.Name.Get(item).Name}
+}
+
 // ApplyDamage は共通のダメージ処理を実行する
 // source から target へダメージを与え、死亡判定とログ出力を行う
 func ApplyDamage(world w.World, target ecs.Entity, damage int, source ecs.Entity) {
+	ApplyDamageWith(world, target, damage, source, Hit{})
+}
+
+// ApplyDamageWith は攻撃手段を添えて ApplyDamage を行う。手段はプレイヤーの死因に記録する
+func ApplyDamageWith(world w.World, target ecs.Entity, damage int, source ecs.Entity, hit Hit) {
 	hp := world.Components.HP.Get(target)
 
 	beforeHP := hp.Current
@@ -23,10 +42,48 @@ func ApplyDamage(world w.World, target ecs.Entity, damage int, source ecs.Entity
 	// 被ダメージによる態度変化
 	reactToHostileAction(world, target)
 
+	// 生き残った HealthStatus 持ちは部位に負傷を負う
+	if hp.Current > 0 && damage > 0 {
+		inflictInjury(world, target, damage, hp.Max)
+	}
+
 	// 死亡チェック
 	if hp.Current <= 0 && beforeHP > 0 {
 		world.Components.Dead.Add(target, &gc.Dead{})
 		logDeath(world, target, source)
+		if isPlayerEntity(target, world) {
+			recordDeathCause(world, target, source, hit)
+		}
+		// 置物が壊れる音は壊した者の足元から響く
+		if world.Components.Fixed.Has(target) {
+			EmitNoise(world, source, NoiseBreak)
+		}
+	}
+}
+
+// InjuryPerMaxHP は最大HPぶんのダメージで進む負傷の深さ。最大HPの1/6ほどの被弾で軽傷になる
+const InjuryPerMaxHP = 150.0
+
+// inflictInjury は被ダメージの大きさに応じて、重みで選んだ部位に負傷を負わせる。
+// HealthStatus を持たない対象は HP だけで受ける
+func inflictInjury(world w.World, target ecs.Entity, damage, maxHP int) {
+	if !world.Components.HealthStatus.Has(target) || maxHP <= 0 {
+		return
+	}
+	part := gc.PickInjuryPart(world.Resources.Config.RNG.IntN(gc.InjuryPartsTotalWeight()))
+	depth := float64(damage) / float64(maxHP) * InjuryPerMaxHP
+
+	change := world.Components.HealthStatus.Get(target).UpdateInjury(part, depth)
+	if change.Prev == change.Current {
+		return
+	}
+	if !world.Components.StatsChanged.Has(target) {
+		world.Components.StatsChanged.Add(target, &gc.StatsChanged{})
+	}
+	if isPlayerEntity(target, world) && change.Current > change.Prev {
+		gamelog.New(query.GetGameLog(world)).
+			Markup(gamelog.Tag("warning", query.T(world, "Your %s is injured (%s).", query.T(world, part.String()), query.T(world, change.Current.String())))).
+			Log()
 	}
 }
 
@@ -175,3 +175,47 @@ func TestApplyDamage_Prop(t *testing.T) {
 		assert.True(t, world.Components.Dead.Has(prop))
 	})
 }
+
+func TestApplyDamage_負傷(t *testing.T) {
+	t.Parallel()
+
+	t.Run("HealthStatus持ちは被ダメージに応じて部位に負傷を負う", func(t *testing.T) {
+		t.Parallel()
+		world := testutil.InitTestWorld(t)
+
+		player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 1, Y: 1}, "ash")
+		require.NoError(t, err)
+		world.Components.StatsChanged.Remove(player)
+		hp := world.Components.HP.Get(player)
+		hp.Current = hp.Max
+
+		ApplyDamage(world, player, hp.Max*2/5, player)
+
+		hs := world.Components.HealthStatus.Get(player)
+		injured := 0
+		for _, ip := range gc.InjuryParts {
+			if cond := hs.Parts[ip.Part].GetCondition(gc.ConditionInjury); cond != nil {
+				injured++
+				assert.Equal(t, gc.SeverityMedium, cond.Severity, "最大HPの4割の被弾は中度の負傷になる")
+				assert.NotEmpty(t, cond.Effects)
+			}
+		}
+		assert.Equal(t, 1, injured, "1回の被弾で負傷する部位は1つ")
+		assert.True(t, world.Components.StatsChanged.Has(player))
+	})
+
+	t.Run("HealthStatusを持たない対象はHPだけ減る", func(t *testing.T) {
+		t.Parallel()
+		world := testutil.InitTestWorld(t)
+
+		source, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 1, Y: 1}, "ash")
+		require.NoError(t, err)
+		prop, err := lifecycle.SpawnProp(world, "crate", 5, 5)
+		require.NoError(t, err)
+
+		ApplyDamage(world, prop, 10, source)
+
+		assert.False(t, world.Components.HealthStatus.Has(prop))
+		assert.False(t, world.Components.StatsChanged.Has(prop))
+	})
+}
@@ -0,0 +1,53 @@
+package gameaction
+
+import (
+	gc "github.com/kijimaD/ruins/internal/components"
+	w "github.com/kijimaD/ruins/internal/world"
+	"github.com/kijimaD/ruins/internal/world/query"
+	"github.com/mlange-42/ark/ecs"
+)
+
+// recordDeathCause はプレイヤーの死因を RunStats に記録する。倒した相手は source が自分以外のときだけ残す。
+// 炎上や自分で使ったアイテムによる死では相手が居ない
+func recordDeathCause(world w.World, target, source ecs.Entity, hit Hit) {
+	stats := query.GetRunStats(world)
+	if stats == nil {
+		return
+	}
+	cause := gc.DeathCause{
+		Weapon:    hit.Weapon,
+		Condition: deathCondition(world, target),
+	}
+	if hit.Element != gc.ElementTypeNone {
+		cause.Element = hit.Element
+	}
+	if source != target && world.ECS.Alive(source) && world.Components.Name.Has(source) {
+		cause.Killer = world.Components.Name.Get(source).Name
+	}
+	stats.Cause = cause
+}
+
+// deathCondition は entity が患っている状態のうち最も重いものの名前を返す。
+// 体温や元素で付く全身の状態を重さで比べ、どれも無ければ飢餓を見る。何も無ければ空を返す
+func deathCondition(world w.World, entity ecs.Entity) string {
+	if world.Components.HealthStatus.Has(entity) {
+		worst := gc.SeverityNone
+		name := ""
+		for _, cond := range world.Components.HealthStatus.Get(entity).Parts[gc.BodyPartWholeBody].Conditions {
+			if cond.Type == gc.ConditionInjury || cond.Severity <= worst {
+				continue
+			}
+			worst = cond.Severity
+			name = gc.ConditionTypeDisplayName(cond.Type)
+		}
+		if name != "" {
+			return name
+		}
+	}
+	if world.Components.Hunger.Has(entity) {
+		if level := world.Components.Hunger.Get(entity).GetLevel(); level == gc.HungerStarving {
+			return level.String()
+		}
+	}
+	return ""
+}
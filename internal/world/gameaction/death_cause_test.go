@@ -0,0 +1,76 @@
+package gameaction
+
+import (
+	"testing"
+
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/testutil"
+	"github.com/kijimaD/ruins/internal/world/lifecycle"
+	"github.com/kijimaD/ruins/internal/world/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyDamageWith_死因を記録する(t *testing.T) {
+	t.Parallel()
+
+	t.Run("敵に倒されると相手と手段を残す", func(t *testing.T) {
+		t.Parallel()
+		world := testutil.InitTestWorld(t)
+		player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 5, Y: 5}, "ash")
+		require.NoError(t, err)
+		bat, err := lifecycle.SpawnEnemy(world, consts.Coord[consts.Tile]{X: 6, Y: 5}, "bat")
+		require.NoError(t, err)
+
+		ApplyDamageWith(world, player, 9999, bat, Hit{Weapon: "Fangs", Element: gc.ElementTypeFire})
+
+		assert.Equal(t, gc.DeathCause{
+			Killer:  world.Components.Name.Get(bat).Name,
+			Weapon:  "Fangs",
+			Element: gc.ElementTypeFire,
+		}, query.GetRunStats(world).Cause)
+	})
+
+	t.Run("自傷では相手を残さず患っていた状態を残す", func(t *testing.T) {
+		t.Parallel()
+		world := testutil.InitTestWorld(t)
+		player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 5, Y: 5}, "ash")
+		require.NoError(t, err)
+		whole := &world.Components.HealthStatus.Get(player).Parts[gc.BodyPartWholeBody]
+		whole.SetCondition(gc.HealthCondition{Type: gc.ConditionHypothermia, Severity: gc.SeverityMinor})
+		whole.SetCondition(gc.HealthCondition{Type: gc.ConditionBurning, Severity: gc.SeveritySevere})
+
+		ApplyDamageWith(world, player, 9999, player, Hit{Element: gc.ElementTypeFire})
+
+		cause := query.GetRunStats(world).Cause
+		assert.Empty(t, cause.Killer)
+		assert.Equal(t, gc.ElementTypeFire, cause.Element)
+		assert.Equal(t, "Burning", cause.Condition, "重い方の状態を残す")
+	})
+
+	t.Run("状態が無ければ飢餓を残す", func(t *testing.T) {
+		t.Parallel()
+		world := testutil.InitTestWorld(t)
+		player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 5, Y: 5}, "ash")
+		require.NoError(t, err)
+		world.Components.Hunger.Get(player).Current = 0
+
+		ApplyDamage(world, player, 9999, player)
+
+		assert.Equal(t, gc.DeathCause{Condition: "Starving"}, query.GetRunStats(world).Cause)
+	})
+
+	t.Run("敵が倒れても死因は残さない", func(t *testing.T) {
+		t.Parallel()
+		world := testutil.InitTestWorld(t)
+		player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 5, Y: 5}, "ash")
+		require.NoError(t, err)
+		bat, err := lifecycle.SpawnEnemy(world, consts.Coord[consts.Tile]{X: 6, Y: 5}, "bat")
+		require.NoError(t, err)
+
+		ApplyDamageWith(world, bat, 9999, player, Hit{Weapon: "Fist"})
+
+		assert.True(t, query.GetRunStats(world).Cause.IsZero())
+	})
+}
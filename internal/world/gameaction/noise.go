@@ -0,0 +1,66 @@
+package gameaction
+
+import (
+	"math"
+
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/consts"
+	w "github.com/kijimaD/ruins/internal/world"
+	"github.com/kijimaD/ruins/internal/world/query"
+	"github.com/mlange-42/ark/ecs"
+)
+
+// 行動ごとの物音の届く半径。
+// 移動は歩きと走りを分けないので、移動の物音は NoiseFootstep の1段階だけ。
+// 走破スキルは移動の AP を減らすだけで、足音は大きくしない
+const (
+	// NoiseFootstep は1歩ごとの足音。隠密スキルで縮む
+	NoiseFootstep consts.Tile = 3
+	// NoiseShot は射撃音。遠くまで届く
+	NoiseShot consts.Tile = 12
+	// NoiseForceLock は錠をこじ開ける音
+	NoiseForceLock consts.Tile = 8
+	// NoiseBreak は置物が壊れる音
+	NoiseBreak consts.Tile = 6
+)
+
+// EmitNoise は source の足元で半径 radius の物音を立てる。物音は壁を越えて届く。
+// 範囲内で source に敵対する AI は出どころを覚え、次の行動計画で調べに向かう。
+// 追跡中と逃亡中の AI はすでに相手を意識しており、戦闘方針が無視の AI は関心を持たないので、どちらも覚えない
+func EmitNoise(world w.World, source ecs.Entity, radius consts.Tile) {
+	if radius <= 0 || !world.Components.GridElement.Has(source) {
+		return
+	}
+	origin := world.Components.GridElement.Get(source).Coord
+
+	q := query.ActiveFilter2[gc.SoloAI, gc.GridElement](world).Query()
+	for q.Next() {
+		entity := q.Entity()
+		solo, grid := q.Get()
+		if entity == source || world.Components.Dead.Has(entity) {
+			continue
+		}
+		if solo.CombatCurrent == gc.CombatIgnore || solo.SubState == gc.AIStateChasing || solo.SubState == gc.AIStateFleeing {
+			continue
+		}
+		dx, dy := int(grid.X-origin.X), int(grid.Y-origin.Y)
+		if dx*dx+dy*dy > int(radius)*int(radius) {
+			continue
+		}
+		if query.FactionRelation(world, entity, source) != query.RelationHostile {
+			continue
+		}
+		heard := origin
+		solo.NoiseOrigin = &heard
+	}
+}
+
+// FootstepNoise は actor が1歩進むときの足音の半径を返す。移動の速さによらず同じ大きさで、
+// 隠密スキルが敵の視界を縮めるのと同じ倍率で足音も縮む
+func FootstepNoise(world w.World, actor ecs.Entity) consts.Tile {
+	if !world.Components.CharModifiers.Has(actor) {
+		return NoiseFootstep
+	}
+	mods := world.Components.CharModifiers.Get(actor)
+	return consts.Tile(math.Round(mods.EnemyVision.ApplyFloat(float64(NoiseFootstep))))
+}
@@ -0,0 +1,55 @@
+package gameaction
+
+import (
+	"testing"
+
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/testutil"
+	"github.com/kijimaD/ruins/internal/world/lifecycle"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmitNoise_範囲内の敵対AIだけが出どころを覚える(t *testing.T) {
+	t.Parallel()
+	world := testutil.InitTestWorld(t)
+
+	player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 10, Y: 10}, "ash")
+	require.NoError(t, err)
+	near, err := lifecycle.SpawnEnemy(world, consts.Coord[consts.Tile]{X: 14, Y: 13}, "fireball")
+	require.NoError(t, err)
+	far, err := lifecycle.SpawnEnemy(world, consts.Coord[consts.Tile]{X: 16, Y: 10}, "fireball")
+	require.NoError(t, err)
+	chasing, err := lifecycle.SpawnEnemy(world, consts.Coord[consts.Tile]{X: 10, Y: 12}, "fireball")
+	require.NoError(t, err)
+	world.Components.SoloAI.Get(chasing).SubState = gc.AIStateChasing
+	for _, solo := range []*gc.SoloAI{world.Components.SoloAI.Get(near), world.Components.SoloAI.Get(far), world.Components.SoloAI.Get(chasing)} {
+		solo.CombatCurrent = gc.CombatAttack
+	}
+	world.Components.SoloAI.Get(near).SubState = gc.AIStateWaiting
+	world.Components.SoloAI.Get(far).SubState = gc.AIStateWaiting
+
+	EmitNoise(world, player, 5)
+
+	heard := world.Components.SoloAI.Get(near).NoiseOrigin
+	require.NotNil(t, heard, "距離5以内の敵は聞きつける")
+	assert.Equal(t, consts.Coord[consts.Tile]{X: 10, Y: 10}, *heard)
+	assert.Nil(t, world.Components.SoloAI.Get(far).NoiseOrigin, "範囲外には届かない")
+	assert.Nil(t, world.Components.SoloAI.Get(chasing).NoiseOrigin, "追跡中の敵は物音を気にしない")
+}
+
+func TestFootstepNoise_隠密スキルで足音が縮む(t *testing.T) {
+	t.Parallel()
+	world := testutil.InitTestWorld(t)
+
+	player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 10, Y: 10}, "ash")
+	require.NoError(t, err)
+	require.True(t, world.Components.CharModifiers.Has(player))
+
+	world.Components.CharModifiers.Get(player).EnemyVision = 100
+	assert.Equal(t, NoiseFootstep, FootstepNoise(world, player))
+
+	world.Components.CharModifiers.Get(player).EnemyVision = 40
+	assert.Equal(t, consts.Tile(1), FootstepNoise(world, player))
+}
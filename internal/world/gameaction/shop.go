@@ -3,6 +3,7 @@ package gameaction
 import (
 	"fmt"
 
+	gc "github.com/kijimaD/ruins/internal/components"
 	w "github.com/kijimaD/ruins/internal/world"
 	"github.com/kijimaD/ruins/internal/world/lifecycle"
 	"github.com/kijimaD/ruins/internal/world/query"
@@ -11,10 +12,17 @@ import (
 
 // BuyStock はプレイヤーが商人の在庫アイテムを買う。一覧の1行はスタック代表なので、
 // 同一スタックを丸ごと買い、代金は個数×単価にする。実体を商人の収納からバックパックへ移す。
+// 買った個数ぶん商人の供給量が減り、同じ品が値上がりする。
 // 通貨が足りなければ何もせずエラーを返す
 func BuyStock(world w.World, player ecs.Entity, item ecs.Entity) error {
+	// 売り手は在庫の持ち主。移動前に引いておく
+	merchant := gc.InvalidEntity
+	if world.Components.LocationInStorage.Has(item) {
+		merchant = world.Components.LocationInStorage.Get(item).Owner
+	}
 	// BuyPrice は価値×スタック個数で全量の額を返す。移動もスタック丸ごとで、額と個数が揃う
-	price := query.BuyPrice(world, player, item)
+	price := query.BuyPrice(world, player, merchant, item)
+	count := query.GetEntityCount(world, item)
 
 	if !query.HasCurrency(world, player, price) {
 		return fmt.Errorf("not enough currency: need %d, have %d", price, query.GetCurrency(world, player))
@@ -30,16 +38,19 @@ func BuyStock(world w.World, player ecs.Entity, item ecs.Entity) error {
 		}
 		return fmt.Errorf("failed to move items to backpack: %w", err)
 	}
+	addSupply(world, merchant, item, -count)
 
 	return nil
 }
 
 // SellStock はプレイヤーが持ち物を商人へ売る。一覧の1行はスタック代表なので、
-// 同一スタックを丸ごと売り、代金は個数×単価で受け取る。実体は商人の収納へ移り店頭に並ぶ
+// 同一スタックを丸ごと売り、代金は個数×単価で受け取る。実体は商人の収納へ移り店頭に並ぶ。
+// 売った個数ぶん商人の供給量が増え、同じ品が値下がりする
 func SellStock(world w.World, player ecs.Entity, merchant ecs.Entity, item ecs.Entity) error {
-	// SellPrice は価値×スタック個数で全量の額を返す。額は移動前に確定する。移動後は
+	// SellPrice は価値×スタック個数で全量の額を返す。額と個数は移動前に確定する。移動後は
 	// スタックの位置が変わり個数の数え上げ範囲がずれるため、先に出しておく
-	price := query.SellPrice(world, player, item)
+	price := query.SellPrice(world, player, merchant, item)
+	count := query.GetEntityCount(world, item)
 
 	if _, err := lifecycle.MoveStackToStorage(world, item, merchant); err != nil {
 		return fmt.Errorf("failed to move items to merchant storage: %w", err)
@@ -51,6 +62,19 @@ func SellStock(world w.World, player ecs.Entity, merchant ecs.Entity, item ecs.E
 		}
 		return fmt.Errorf("failed to add currency: %w", err)
 	}
+	addSupply(world, merchant, item, count)
 
 	return nil
 }
+
+// addSupply は取引した品の供給量を商人へ記録する。商人の状態を持たない相手には何もしない
+func addSupply(world w.World, merchant ecs.Entity, item ecs.Entity, delta int) {
+	if !world.ECS.Alive(merchant) || !world.Components.Merchant.Has(merchant) {
+		return
+	}
+	rawID := query.GetEntityID(item, world)
+	if rawID == "" {
+		return
+	}
+	world.Components.Merchant.Get(merchant).AddSupply(rawID, delta)
+}
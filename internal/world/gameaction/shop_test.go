@@ -3,6 +3,7 @@ package gameaction
 import (
 	"testing"
 
+	gc "github.com/kijimaD/ruins/internal/components"
 	"github.com/kijimaD/ruins/internal/consts"
 	"github.com/kijimaD/ruins/internal/testutil"
 	"github.com/kijimaD/ruins/internal/world/lifecycle"
@@ -216,3 +217,30 @@ func TestSellStock(t *testing.T) {
 		assert.False(t, found)
 	})
 }
+
+// TestShopSupply は売買で商人の供給量が動き、同じ品の値付けが変わることを固定する
+func TestShopSupply(t *testing.T) {
+	t.Parallel()
+
+	world := testutil.InitTestWorld(t)
+	player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 1, Y: 1}, "ash")
+	require.NoError(t, err)
+	world.Components.Wallet.Get(player).Currency = 100000
+
+	merchant := world.ECS.NewEntity()
+	world.Components.Merchant.Add(merchant, gc.NewMerchant(gc.SettlementTown, 1))
+
+	rep, err := lifecycle.SpawnBackpackItem(world, "wooden_sword", 3)
+	require.NoError(t, err)
+	before := query.SellPrice(world, player, merchant, rep) / 3
+
+	require.NoError(t, SellStock(world, player, merchant, rep))
+	assert.Equal(t, 3, world.Components.Merchant.Get(merchant).Supply["wooden_sword"], "売った個数ぶん品余りになる")
+
+	other, err := lifecycle.SpawnBackpackItem(world, "wooden_sword", 1)
+	require.NoError(t, err)
+	assert.Less(t, query.SellPrice(world, player, merchant, other), before, "売り込んだ品は値下がりする")
+
+	require.NoError(t, BuyStock(world, player, rep))
+	assert.NotContains(t, world.Components.Merchant.Get(merchant).Supply, "wooden_sword", "買い戻すと平常に戻る")
+}
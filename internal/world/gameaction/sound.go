@@ -0,0 +1,56 @@
+package gameaction
+
+import (
+	"github.com/kijimaD/ruins/internal/audio"
+	"github.com/kijimaD/ruins/internal/oapi"
+	"github.com/kijimaD/ruins/internal/raw"
+	w "github.com/kijimaD/ruins/internal/world"
+	"github.com/mlange-42/ark/ecs"
+)
+
+// PlaySound は event の効果音を鳴らす。鳴らす音は SoundFor で決める
+func PlaySound(world w.World, event audio.Event, sources ...ecs.Entity) {
+	world.Resources.Audio.PlaySE(SoundFor(world, event, sources...))
+}
+
+// SoundFor は event で鳴らす効果音 ID を返す。
+// sources を前から順に見て、ローデータの sounds が event の音を指定していれば最初のものを採る。
+// 武器、攻撃者の順のように、より具体的な出どころを前に渡す。どれも指定していなければ既定の音を返す
+func SoundFor(world w.World, event audio.Event, sources ...ecs.Entity) string {
+	for _, source := range sources {
+		if source.IsZero() || !world.ECS.Alive(source) || !world.Components.RawID.Has(source) {
+			continue
+		}
+		set, ok := raw.FindSounds(world.Resources.RawMaster, world.Components.RawID.Get(source).ID)
+		if !ok {
+			continue
+		}
+		if id := soundOf(set, event); id != "" {
+			return id
+		}
+	}
+	return event.DefaultSound()
+}
+
+// soundOf は効果音定義のうち event に対応する音を返す。定義が無ければ空文字を返す
+func soundOf(set oapi.SoundSet, event audio.Event) string {
+	var id *oapi.SoundId
+	switch event {
+	case audio.EventAttackHit:
+		id = set.Hit
+	case audio.EventAttackMiss:
+		id = set.Miss
+	case audio.EventDoorOpen:
+		id = set.Open
+	case audio.EventPickup:
+		id = set.Pickup
+	case audio.EventReload:
+		id = set.Reload
+	case audio.EventLevelUp, audio.EventTemperatureWarning:
+		// 個体に結び付かない出来事なので上書きを持たない
+	}
+	if id == nil {
+		return ""
+	}
+	return *id
+}
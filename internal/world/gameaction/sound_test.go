@@ -0,0 +1,72 @@
+package gameaction
+
+import (
+	"testing"
+
+	"github.com/kijimaD/ruins/internal/audio"
+	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/testutil"
+	"github.com/kijimaD/ruins/internal/world/lifecycle"
+	"github.com/mlange-42/ark/ecs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSoundFor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("定義の音を出来事ごとに引く", func(t *testing.T) {
+		t.Parallel()
+		world := testutil.InitTestWorld(t)
+		_, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 1, Y: 1}, "ash")
+		require.NoError(t, err)
+		gun, err := lifecycle.SpawnBackpackItem(world, "handgun", 1)
+		require.NoError(t, err)
+
+		assert.Equal(t, "pistol_shot", SoundFor(world, audio.EventAttackHit, gun))
+		assert.Equal(t, "pistol_reload", SoundFor(world, audio.EventReload, gun))
+		assert.Equal(t, audio.EventPickup.DefaultSound(), SoundFor(world, audio.EventPickup, gun), "指定の無い出来事は既定の音")
+	})
+
+	t.Run("前の出どころが指定していなければ後ろを見る", func(t *testing.T) {
+		t.Parallel()
+		world := testutil.InitTestWorld(t)
+		bat, err := lifecycle.SpawnEnemy(world, consts.Coord[consts.Tile]{X: 3, Y: 3}, "bat")
+		require.NoError(t, err)
+		crate, err := lifecycle.SpawnProp(world, "crate", 5, 5)
+		require.NoError(t, err)
+
+		assert.Equal(t, "bat_bite", SoundFor(world, audio.EventAttackHit, ecs.Entity{}, crate, bat))
+		assert.Equal(t, audio.EventAttackMiss.DefaultSound(), SoundFor(world, audio.EventAttackMiss, crate, bat))
+	})
+
+	t.Run("出どころが無ければ既定の音", func(t *testing.T) {
+		t.Parallel()
+		world := testutil.InitTestWorld(t)
+
+		assert.Equal(t, "level_up", SoundFor(world, audio.EventLevelUp))
+	})
+}
+
+func TestPlaySound(t *testing.T) {
+	t.Parallel()
+	world := testutil.InitTestWorld(t)
+	rec := &recordBackend{}
+	world.Resources.Audio.SetBackend(rec)
+	door, err := lifecycle.SpawnProp(world, "door", 5, 5)
+	require.NoError(t, err)
+
+	PlaySound(world, audio.EventDoorOpen, door)
+
+	assert.Equal(t, []string{"door_creak"}, rec.played)
+}
+
+// recordBackend は鳴らした効果音を記録する audio.Backend
+type recordBackend struct {
+	audio.NullBackend
+	played []string
+}
+
+func (b *recordBackend) PlaySE(id string, _ float64) {
+	b.played = append(b.played, id)
+}
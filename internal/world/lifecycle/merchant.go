@@ -4,7 +4,10 @@ import (
 	"fmt"
 	"math/rand/v2"
 
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/raw"
 	w "github.com/kijimaD/ruins/internal/world"
+	"github.com/kijimaD/ruins/internal/world/query"
 	"github.com/mlange-42/ark/ecs"
 )
 
@@ -24,6 +27,27 @@ var merchantStockItems = []struct {
 	{Name: "army_shooting_manual", Count: 1},
 }
 
+// merchantRestock は集落の種類ごとの入荷。Groups から1日に Draws 回 item group を引いて在庫へ足す
+type merchantRestock struct {
+	Groups []string
+	Draws  int
+}
+
+// merchantRestocks は集落の種類ごとの入荷。街は武具まで広く仕入れ、村は生活用品、一軒家は食料とがらくたに絞る
+var merchantRestocks = map[gc.SettlementKind]merchantRestock{
+	gc.SettlementTown:    {Groups: []string{"healing_item", "food", "ranged_weapon", "early_armor", "midgame_melee_weapon"}, Draws: 3},
+	gc.SettlementVillage: {Groups: []string{"healing_item", "food", "materials", "early_melee_weapon"}, Draws: 2},
+	gc.SettlementHamlet:  {Groups: []string{"food", "materials", "junk"}, Draws: 1},
+}
+
+// 入荷の調整値
+const (
+	// MerchantStockCap は入荷で埋める店頭の行数の上限。行はスタック単位で数える
+	MerchantStockCap = 16
+	// merchantRestockMaxDays は一度の入荷でまとめて数える日数の上限。長く空けても店頭が溢れないようにする
+	merchantRestockMaxDays = 3
+)
+
 // PopulateMerchantStock は商人の品揃えを決める。アイテムを商人所有の LocationInStorage で持たせる。
 // 商人生成時に一度だけ呼ぶ
 func PopulateMerchantStock(world w.World, merchant ecs.Entity, _ *rand.Rand) error {
@@ -35,3 +59,44 @@ func PopulateMerchantStock(world w.World, merchant ecs.Entity, _ *rand.Rand) err
 
 	return nil
 }
+
+// RestockMerchant は前回の入荷から日が変わっていれば商人に入荷させる。
+// 経過日数ぶん供給量を平常へ戻し、集落の種類に応じた item group から在庫を足す。
+// 在庫は店を開くときにまとめて補う。商人の状態を持たない相手には何もしない
+func RestockMerchant(world w.World, merchant ecs.Entity, rng *rand.Rand) error {
+	if !world.ECS.Alive(merchant) || !world.Components.Merchant.Has(merchant) {
+		return nil
+	}
+	m := world.Components.Merchant.Get(merchant)
+	today := query.GetGameTime(world).GetDayNumber()
+	days := today - m.RestockedDay
+	if days <= 0 {
+		return nil
+	}
+	m.RestockedDay = today
+	m.RecoverSupply(days)
+
+	restock, ok := merchantRestocks[m.Settlement]
+	if !ok {
+		return fmt.Errorf("no restock for settlement %s", m.Settlement)
+	}
+	draws := min(days, merchantRestockMaxDays) * restock.Draws
+	// 在庫の生成で商人のコンポーネント配置が変わり得るので、ここから先は m を触らない
+	for range draws {
+		if len(query.StorageStacks(world, merchant)) >= MerchantStockCap {
+			break
+		}
+		groupID := restock.Groups[rng.IntN(len(restock.Groups))]
+		drawn, err := raw.SelectFromItemGroup(world.Resources.RawMaster, groupID, rng)
+		if err != nil {
+			return fmt.Errorf("failed to draw restock from %s: %w", groupID, err)
+		}
+		for _, item := range drawn {
+			if _, err := SpawnStorageItem(world, item.Name, item.Count, merchant); err != nil {
+				return fmt.Errorf("failed to restock item %s: %w", item.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package lifecycle
 import (
 	"testing"
 
+	gc "github.com/kijimaD/ruins/internal/components"
 	"github.com/kijimaD/ruins/internal/testutil"
 	"github.com/kijimaD/ruins/internal/world/query"
 	"github.com/stretchr/testify/assert"
@@ -30,3 +31,45 @@ func TestPopulateMerchantStock_在庫にアイテムを積む(t *testing.T) {
 	stacks := query.StorageStacks(world, merchant)
 	assert.Len(t, stacks, len(merchantStockItems), "1品種1スタックに束ねられる")
 }
+
+// TestRestockMerchant は日が変わったときだけ入荷し、供給量が平常へ戻ることを固定する
+func TestRestockMerchant(t *testing.T) {
+	t.Parallel()
+
+	t.Run("同じ日のうちは入荷しない", func(t *testing.T) {
+		t.Parallel()
+		world := testutil.InitTestWorld(t)
+		merchant := world.ECS.NewEntity()
+		world.Components.Merchant.Add(merchant, gc.NewMerchant(gc.SettlementTown, query.GetGameTime(world).GetDayNumber()))
+
+		require.NoError(t, RestockMerchant(world, merchant, world.Resources.Config.RNG))
+		assert.Empty(t, query.GetStorageItems(world, merchant))
+	})
+
+	t.Run("日が変わると入荷し供給量が戻る", func(t *testing.T) {
+		t.Parallel()
+		world := testutil.InitTestWorld(t)
+		merchant := world.ECS.NewEntity()
+		m := gc.NewMerchant(gc.SettlementVillage, query.GetGameTime(world).GetDayNumber())
+		m.AddSupply("bread", 5)
+		world.Components.Merchant.Add(merchant, m)
+
+		query.GetGameTime(world).TotalTurns += 1500
+		require.NoError(t, RestockMerchant(world, merchant, world.Resources.Config.RNG))
+
+		assert.NotEmpty(t, query.GetStorageItems(world, merchant), "入荷で在庫が増える")
+		m = world.Components.Merchant.Get(merchant)
+		assert.Equal(t, query.GetGameTime(world).GetDayNumber(), m.RestockedDay)
+		assert.Equal(t, 5-gc.SupplyRecoveryPerDay, m.Supply["bread"], "1日ぶん平常へ戻る")
+	})
+
+	t.Run("商人の状態がなければ何もしない", func(t *testing.T) {
+		t.Parallel()
+		world := testutil.InitTestWorld(t)
+		merchant := world.ECS.NewEntity()
+
+		query.GetGameTime(world).TotalTurns += 1500
+		require.NoError(t, RestockMerchant(world, merchant, world.Resources.Config.RNG))
+		assert.Empty(t, query.GetStorageItems(world, merchant))
+	})
+}
@@ -28,8 +28,8 @@ var (
 )
 
 // initialPatrolDir はPatrol移動の初期方向をランダムに決定する。X軸方向で+1か-1を返す
-func initialPatrolDir() consts.Tile {
-	if rand.IntN(2) == 0 {
+func initialPatrolDir(rng *rand.Rand) consts.Tile {
+	if rng.IntN(2) == 0 {
 		return 1
 	}
 	return -1
@@ -94,8 +94,20 @@ func SpawnPlayer(world w.World, pos consts.Coord[consts.Tile], name string) (ecs
 	return playerEntity, nil
 }
 
+// SpawnNeutralNPCOption はSpawnNeutralNPCの振る舞いを変更する関数オプション
+type SpawnNeutralNPCOption func(ecs.Entity, w.World)
+
+// WithSettlement は商人が店を構える集落の種類を設定するオプション。品揃えと値付けが変わる。商人以外には何もしない
+func WithSettlement(kind gc.SettlementKind) SpawnNeutralNPCOption {
+	return func(entity ecs.Entity, world w.World) {
+		if world.Components.Merchant.Has(entity) {
+			world.Components.Merchant.Get(entity).Settlement = kind
+		}
+	}
+}
+
 // SpawnNeutralNPC はフィールド上に中立NPCを生成する（会話可能なNPC用）
-func SpawnNeutralNPC(world w.World, pos consts.Coord[consts.Tile], name string) (ecs.Entity, error) {
+func SpawnNeutralNPC(world w.World, pos consts.Coord[consts.Tile], name string, opts ...SpawnNeutralNPCOption) (ecs.Entity, error) {
 	entitySpec, err := raw.NewMemberSpec(world.Resources.RawMaster, name)
 	if err != nil {
 		return gc.InvalidEntity, fmt.Errorf("failed to generate neutral NPC: %w", err)
@@ -114,9 +126,9 @@ func SpawnNeutralNPC(world w.World, pos consts.Coord[consts.Tile], name string)
 		solo := entitySpec.SoloAI
 		solo.SubState = gc.AIStateWaiting
 		solo.StartSubStateTurn = 1
-		solo.DurationSubStateTurns = consts.Turn(2 + rand.IntN(3))
+		solo.DurationSubStateTurns = consts.Turn(2 + world.Resources.Config.RNG.IntN(3))
 		solo.Origin = pos
-		solo.PatrolDir.X = initialPatrolDir()
+		solo.PatrolDir.X = initialPatrolDir(world.Resources.Config.RNG)
 		solo.ViewDistance = consts.AIVisionDistance
 	}
 
@@ -126,8 +138,15 @@ func SpawnNeutralNPC(world w.World, pos consts.Coord[consts.Tile], name string)
 	}
 
 	// 商人は品揃えを在庫として持つ。生成経路に依らずここで積むことで、集落でも街マップの
-	// マッププランナ経由でも同じ在庫を持たせる。売買と雇用はこの在庫を出し入れする
+	// マッププランナ経由でも同じ在庫を持たせる。売買と雇用はこの在庫を出し入れする。
+	// 集落の種類はオプションで上書きし、指定がなければ街の商人とする
 	if name == "merchant" {
+		world.Components.Merchant.Add(npcEntity, gc.NewMerchant(gc.SettlementTown, query.GetGameTime(world).GetDayNumber()))
+	}
+	for _, opt := range opts {
+		opt(npcEntity, world)
+	}
+	if world.Components.Merchant.Has(npcEntity) {
 		if err := PopulateMerchantStock(world, npcEntity, world.Resources.Config.RNG); err != nil {
 			return gc.InvalidEntity, fmt.Errorf("failed to stock merchant: %w", err)
 		}
@@ -147,6 +166,13 @@ func WithBoss() SpawnEnemyOption {
 	}
 }
 
+// WithSquad は分隊所属を付与するオプション。群れのうち1体だけ leader を真にする
+func WithSquad(id uint64, leader bool) SpawnEnemyOption {
+	return func(entity ecs.Entity, world w.World) {
+		world.Components.Squad.Add(entity, &gc.Squad{ID: id, Leader: leader})
+	}
+}
+
 // SpawnEnemy はフィールド上に敵キャラクターを生成する
 func SpawnEnemy(world w.World, pos consts.Coord[consts.Tile], name string, opts ...SpawnEnemyOption) (ecs.Entity, error) {
 	entitySpec, err := raw.NewEnemySpec(world.Resources.RawMaster, name)
@@ -161,9 +187,9 @@ func SpawnEnemy(world w.World, pos consts.Coord[consts.Tile], name string, opts
 	solo := entitySpec.SoloAI
 	solo.SubState = gc.AIStateWaiting
 	solo.StartSubStateTurn = 1
-	solo.DurationSubStateTurns = consts.Turn(2 + rand.IntN(3))
+	solo.DurationSubStateTurns = consts.Turn(2 + world.Resources.Config.RNG.IntN(3))
 	solo.Origin = pos
-	solo.PatrolDir.X = initialPatrolDir()
+	solo.PatrolDir.X = initialPatrolDir(world.Resources.Config.RNG)
 	solo.ViewDistance = consts.AIVisionDistance
 	entitySpec.Interactable = &gc.Interactable{
 		Interactions: []gc.InteractionKind{gc.InteractionMelee},
@@ -2,6 +2,7 @@ package lifecycle
 
 import (
 	"fmt"
+	"slices"
 
 	gc "github.com/kijimaD/ruins/internal/components"
 	"github.com/kijimaD/ruins/internal/consts"
@@ -31,6 +32,35 @@ func CloseDoor(world w.World, doorEntity ecs.Entity) error {
 	return updateDoorState(world, doorEntity, doorComp.Orientation, false)
 }
 
+// LockEntity は扉や収納に錠を掛け、解錠と破錠の相互作用を足す。既に錠があれば難度と鍵を差し替える
+func LockEntity(world w.World, entity ecs.Entity, lock gc.Lock) error {
+	if !world.Components.Interactable.Has(entity) {
+		return fmt.Errorf("entity is not interactable")
+	}
+	if world.Components.Lock.Has(entity) {
+		*world.Components.Lock.Get(entity) = lock
+		return nil
+	}
+	world.Components.Lock.Add(entity, &lock)
+	interactable := world.Components.Interactable.Get(entity)
+	interactable.Interactions = append(interactable.Interactions, gc.InteractionPickLock, gc.InteractionForceLock)
+	return nil
+}
+
+// Unlock は錠を外し、解錠と破錠の相互作用を除く。錠が無ければ何もしない
+func Unlock(world w.World, entity ecs.Entity) {
+	if !world.Components.Lock.Has(entity) {
+		return
+	}
+	world.Components.Lock.Remove(entity)
+	if world.Components.Interactable.Has(entity) {
+		interactable := world.Components.Interactable.Get(entity)
+		interactable.Interactions = slices.DeleteFunc(interactable.Interactions, func(k gc.InteractionKind) bool {
+			return k == gc.InteractionPickLock || k == gc.InteractionForceLock
+		})
+	}
+}
+
 // updateDoorState は扉の向きと開閉状態に応じて、状態を更新する
 func updateDoorState(world w.World, doorEntity ecs.Entity, orientation gc.DoorOrientation, isOpen bool) error {
 	doorComp := world.Components.Door.Get(doorEntity)
@@ -76,6 +106,8 @@ func updateDoorState(world w.World, doorEntity ecs.Entity, orientation gc.DoorOr
 			world.Components.BlockView.Add(doorEntity, &gc.BlockView{})
 		}
 	}
+	// 開閉は同じターン内の視界・移動判定にすぐ効かせる
+	query.UpdateBlockerInIndex(world, doorEntity, nil)
 
 	return nil
 }
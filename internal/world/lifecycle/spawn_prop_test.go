@@ -119,3 +119,26 @@ func TestCloseDoor_扉でないエンティティはエラーになる(t *testin
 	require.Error(t, err)
 	assert.EqualError(t, err, "entity is not a door")
 }
+
+func TestLockEntity_錠を掛けると解錠と破錠の相互作用が付き外すと除かれる(t *testing.T) {
+	t.Parallel()
+	world := testutil.InitTestWorld(t)
+
+	door, err := lifecycle.SpawnDoor(world, consts.Coord[consts.Tile]{X: 3, Y: 4}, gc.DoorOrientationHorizontal)
+	require.NoError(t, err)
+
+	require.NoError(t, lifecycle.LockEntity(world, door, gc.Lock{Difficulty: 20, KeyID: "storeroom"}))
+	require.True(t, world.Components.Lock.Has(door))
+	assert.Equal(t, gc.Lock{Difficulty: 20, KeyID: "storeroom"}, *world.Components.Lock.Get(door))
+	assert.Equal(t, []gc.InteractionKind{gc.InteractionDoor, gc.InteractionPickLock, gc.InteractionForceLock},
+		world.Components.Interactable.Get(door).Interactions)
+
+	// 掛け直しは錠を差し替えるだけで、相互作用を重ねない
+	require.NoError(t, lifecycle.LockEntity(world, door, gc.Lock{Difficulty: 40}))
+	assert.Equal(t, 40, world.Components.Lock.Get(door).Difficulty)
+	assert.Len(t, world.Components.Interactable.Get(door).Interactions, 3)
+
+	lifecycle.Unlock(world, door)
+	assert.False(t, world.Components.Lock.Has(door))
+	assert.Equal(t, []gc.InteractionKind{gc.InteractionDoor}, world.Components.Interactable.Get(door).Interactions)
+}
@@ -7,18 +7,25 @@ import (
 	"github.com/mlange-42/ark/ecs"
 )
 
-// perishRate は entity の現在の劣化速度を返す。1.0 が基準。
-// 将来、置き場所の温度でこの値を変える。冷所は遅く、暖所は速い。今は一律 1.0。
-func perishRate(_ w.World, _ ecs.Entity) float64 {
-	return 1.0
-}
-
 // EffectiveRot は now 時点の累積劣化量を返す。Perishable の RotAccrued に、RotUpdatedTurn からの
 // 経過ぶんを現在の速度で加える。読み取り専用で副作用はない。段階算出と合流判定が通る。
+// 速度は置き場所の気温から PerishableSystem が毎ターン算出し、変わったときだけ畳み込む
 func EffectiveRot(world w.World, entity ecs.Entity, now consts.Turn) consts.Turn {
 	p := world.Components.Perishable.Get(entity)
 	elapsed := now - p.RotUpdatedTurn
-	return p.RotAccrued + consts.Turn(float64(elapsed)*perishRate(world, entity))
+	return p.RotAccrued + consts.Turn(p.Rate().ApplyInt(int(elapsed)))
+}
+
+// SetPerishRate は entity の劣化速度を rate に切り替える。now までの劣化を旧速度で RotAccrued へ
+// 畳み込んでから速度を差し替えるので、速度の変更は以後の経過にだけ効く
+func SetPerishRate(world w.World, entity ecs.Entity, rate consts.Percent, now consts.Turn) {
+	p := world.Components.Perishable.Get(entity)
+	if p.Rate() == rate {
+		return
+	}
+	p.RotAccrued = EffectiveRot(world, entity, now)
+	p.RotUpdatedTurn = now
+	p.RotRate = rate
 }
 
 // FreshnessStageOf は entity の鮮度段階を返す。Perishable を持たなければ ok=false。
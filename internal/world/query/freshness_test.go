@@ -73,3 +73,33 @@ func TestFreshnessMarker(t *testing.T) {
 		})
 	}
 }
+
+func TestSetPerishRate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("切り替え前の経過は旧速度で畳み込まれる", func(t *testing.T) {
+		t.Parallel()
+		world := testutil.InitTestWorld(t)
+		bread, err := lifecycle.SpawnFieldItem(world, "bread", 5, 5, 1)
+		require.NoError(t, err)
+
+		// 100ターンを等倍で過ごしてから半速へ切り替え、さらに100ターン置く
+		query.SetPerishRate(world, bread, 50, 100)
+		p := world.Components.Perishable.Get(bread)
+		assert.Equal(t, consts.Turn(100), p.RotAccrued, "切り替えまでの100ターンは等倍")
+		assert.Equal(t, consts.Turn(100), p.RotUpdatedTurn)
+		assert.Equal(t, consts.Turn(150), query.EffectiveRot(world, bread, 200), "切り替え後の100ターンは半速で50")
+	})
+
+	t.Run("同じ速度なら畳み込まない", func(t *testing.T) {
+		t.Parallel()
+		world := testutil.InitTestWorld(t)
+		bread, err := lifecycle.SpawnFieldItem(world, "bread", 5, 5, 1)
+		require.NoError(t, err)
+
+		query.SetPerishRate(world, bread, consts.PercentBase, 100)
+		p := world.Components.Perishable.Get(bread)
+		assert.Equal(t, consts.Turn(0), p.RotAccrued)
+		assert.Equal(t, consts.Turn(0), p.RotUpdatedTurn)
+	})
+}
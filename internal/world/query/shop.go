@@ -1,6 +1,7 @@
 package query
 
 import (
+	gc "github.com/kijimaD/ruins/internal/components"
 	"github.com/kijimaD/ruins/internal/consts"
 	w "github.com/kijimaD/ruins/internal/world"
 	"github.com/mlange-42/ark/ecs"
@@ -12,39 +13,81 @@ const (
 	SellPriceMultiplier = 0.5 // 売却価格は価値の半分
 )
 
-// CalculateBuyPrice は購入価格を計算する（価値の2倍）
+// CalculateBuyPrice は基準の購入価格を計算する（価値の2倍）
 func CalculateBuyPrice(baseValue int) consts.Currency {
 	return consts.Currency(float64(baseValue) * BuyPriceMultiplier)
 }
 
-// CalculateSellPrice は売却価格を計算する（価値の半分）
+// CalculateSellPrice は基準の売却価格を計算する（価値の半分）
 func CalculateSellPrice(baseValue int) consts.Currency {
 	return consts.Currency(float64(baseValue) * SellPriceMultiplier)
 }
 
-// BuyPrice はプレイヤーが entity を買うときの、交渉スキルの買値倍率込みの購入価格を返す。
-// base は価値と個数の積。店頭の表示価格と取引価格をこの1関数で揃える
-func BuyPrice(world w.World, player ecs.Entity, entity ecs.Entity) consts.Currency {
+// BuyPrice はプレイヤーが merchant から entity を買うときの購入価格を返す。
+// 価値と個数の積に、集落と品の供給量による倍率、交渉スキルの買値倍率を掛ける。店頭の表示価格と取引価格をこの1関数で揃える
+func BuyPrice(world w.World, player ecs.Entity, merchant ecs.Entity, entity ecs.Entity) consts.Currency {
 	base := GetItemValue(world, entity) * GetEntityCount(world, entity)
 	price := CalculateBuyPrice(base)
+	if m := getMerchant(world, merchant); m != nil {
+		price = consts.Currency(m.Settlement.BuyPricePercent().ApplyInt(int(price)))
+		price = consts.Currency(m.SupplyPricePercent(GetEntityID(entity, world)).ApplyInt(int(price)))
+	}
 	if world.Components.CharModifiers.Has(player) {
 		price = consts.Currency(world.Components.CharModifiers.Get(player).BuyPrice.ApplyInt(int(price)))
 	}
 	return price
 }
 
-// SellPrice はプレイヤーが entity を売るときの、交渉スキルの売値倍率込みの売却価格を返す。
-// base は価値と個数の積。店頭の表示価格と取引価格をこの1関数で揃える。
+// SellPrice はプレイヤーが merchant へ entity を売るときの売却価格を返す。
+// 価値と個数の積に、集落と品の供給量による倍率、交渉スキルの売値倍率を掛ける。店頭の表示価格と取引価格をこの1関数で揃える。
 // 無価値な品は素直に 0 を返す。売却自体は他の品と同様に可能で、対価が 0 になるだけ
-func SellPrice(world w.World, player ecs.Entity, entity ecs.Entity) consts.Currency {
+func SellPrice(world w.World, player ecs.Entity, merchant ecs.Entity, entity ecs.Entity) consts.Currency {
 	base := GetItemValue(world, entity) * GetEntityCount(world, entity)
 	price := CalculateSellPrice(base)
+	if m := getMerchant(world, merchant); m != nil {
+		price = consts.Currency(m.Settlement.SellPricePercent().ApplyInt(int(price)))
+		price = consts.Currency(m.SupplyPricePercent(GetEntityID(entity, world)).ApplyInt(int(price)))
+	}
 	if world.Components.CharModifiers.Has(player) {
 		price = consts.Currency(world.Components.CharModifiers.Get(player).SellPrice.ApplyInt(int(price)))
 	}
 	return price
 }
 
+// PriceTrend は品の値動きの向き
+type PriceTrend int
+
+// 値動きの向き
+const (
+	PriceTrendSteady PriceTrend = iota // 平常
+	PriceTrendUp                       // 品薄で値上がりしている
+	PriceTrendDown                     // 品余りで値下がりしている
+)
+
+// GetPriceTrend は merchant の店での entity の値動きの向きを返す。商人の状態を持たない相手は平常とみなす
+func GetPriceTrend(world w.World, merchant ecs.Entity, entity ecs.Entity) PriceTrend {
+	m := getMerchant(world, merchant)
+	if m == nil {
+		return PriceTrendSteady
+	}
+	switch p := m.SupplyPricePercent(GetEntityID(entity, world)); {
+	case p > consts.PercentBase:
+		return PriceTrendUp
+	case p < consts.PercentBase:
+		return PriceTrendDown
+	default:
+		return PriceTrendSteady
+	}
+}
+
+// getMerchant は商人の状態を返す。生きていないか商人でなければ nil を返す
+func getMerchant(world w.World, merchant ecs.Entity) *gc.Merchant {
+	if !world.ECS.Alive(merchant) || !world.Components.Merchant.Has(merchant) {
+		return nil
+	}
+	return world.Components.Merchant.Get(merchant)
+}
+
 // GetItemValue はアイテムの基本価値を取得する
 func GetItemValue(world w.World, entity ecs.Entity) int {
 	if !world.Components.Value.Has(entity) {
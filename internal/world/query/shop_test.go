@@ -63,7 +63,7 @@ func TestSellPrice(t *testing.T) {
 		item := world.ECS.NewEntity()
 		world.Components.Value.Add(item, &gc.Value{Value: 0})
 
-		assert.Equal(t, consts.Currency(0), SellPrice(world, player, item), "無価値な品の対価は0")
+		assert.Equal(t, consts.Currency(0), SellPrice(world, player, gc.InvalidEntity, item), "無価値な品の対価は0")
 	})
 
 	t.Run("倍率なしは価値の半分", func(t *testing.T) {
@@ -73,7 +73,7 @@ func TestSellPrice(t *testing.T) {
 		item := world.ECS.NewEntity()
 		world.Components.Value.Add(item, &gc.Value{Value: 100})
 
-		assert.Equal(t, consts.Currency(50), SellPrice(world, player, item), "CalculateSellPrice(100)=50")
+		assert.Equal(t, consts.Currency(50), SellPrice(world, player, gc.InvalidEntity, item), "CalculateSellPrice(100)=50")
 	})
 
 	t.Run("交渉スキルの売値倍率が乗る", func(t *testing.T) {
@@ -84,7 +84,7 @@ func TestSellPrice(t *testing.T) {
 		item := world.ECS.NewEntity()
 		world.Components.Value.Add(item, &gc.Value{Value: 100})
 
-		assert.Equal(t, consts.Currency(100), SellPrice(world, player, item), "売値倍率200%で50が倍額100")
+		assert.Equal(t, consts.Currency(100), SellPrice(world, player, gc.InvalidEntity, item), "売値倍率200%で50が倍額100")
 	})
 
 	t.Run("個数分だけ価値が乗る", func(t *testing.T) {
@@ -101,7 +101,7 @@ func TestSellPrice(t *testing.T) {
 			item = e
 		}
 
-		assert.Equal(t, consts.Currency(150), SellPrice(world, player, item), "価値100×3個の半分")
+		assert.Equal(t, consts.Currency(150), SellPrice(world, player, gc.InvalidEntity, item), "価値100×3個の半分")
 	})
 }
 
@@ -116,7 +116,7 @@ func TestBuyPrice(t *testing.T) {
 		item := world.ECS.NewEntity()
 		world.Components.Value.Add(item, &gc.Value{Value: 100})
 
-		assert.Equal(t, consts.Currency(200), BuyPrice(world, player, item), "CalculateBuyPrice(100)=200")
+		assert.Equal(t, consts.Currency(200), BuyPrice(world, player, gc.InvalidEntity, item), "CalculateBuyPrice(100)=200")
 	})
 
 	t.Run("交渉スキルの買値倍率が乗る", func(t *testing.T) {
@@ -127,8 +127,47 @@ func TestBuyPrice(t *testing.T) {
 		item := world.ECS.NewEntity()
 		world.Components.Value.Add(item, &gc.Value{Value: 100})
 
-		assert.Equal(t, consts.Currency(100), BuyPrice(world, player, item), "買値倍率50%で200が半額100")
+		assert.Equal(t, consts.Currency(100), BuyPrice(world, player, gc.InvalidEntity, item), "買値倍率50%で200が半額100")
 	})
+
+	t.Run("集落と供給量の倍率が乗る", func(t *testing.T) {
+		t.Parallel()
+		world := testutil.InitTestWorld(t)
+		player := world.ECS.NewEntity()
+		merchant := world.ECS.NewEntity()
+		m := gc.NewMerchant(gc.SettlementHamlet, 1)
+		m.AddSupply("gem", -4)
+		world.Components.Merchant.Add(merchant, m)
+		item := world.ECS.NewEntity()
+		world.Components.Value.Add(item, &gc.Value{Value: 100})
+		world.Components.RawID.Add(item, &gc.RawID{ID: "gem"})
+
+		// 200 に一軒家の125%で250、品薄4の120%で300
+		assert.Equal(t, consts.Currency(300), BuyPrice(world, player, merchant, item))
+		// 50 に一軒家の80%で40、品薄4の120%で48
+		assert.Equal(t, consts.Currency(48), SellPrice(world, player, merchant, item))
+	})
+}
+
+func TestGetPriceTrend(t *testing.T) {
+	t.Parallel()
+
+	world := testutil.InitTestWorld(t)
+	merchant := world.ECS.NewEntity()
+	m := gc.NewMerchant(gc.SettlementTown, 1)
+	m.AddSupply("scarce", -1)
+	m.AddSupply("surplus", 1)
+	world.Components.Merchant.Add(merchant, m)
+	newItem := func(id string) ecs.Entity {
+		e := world.ECS.NewEntity()
+		world.Components.RawID.Add(e, &gc.RawID{ID: id})
+		return e
+	}
+
+	assert.Equal(t, PriceTrendUp, GetPriceTrend(world, merchant, newItem("scarce")), "品薄は値上がり")
+	assert.Equal(t, PriceTrendDown, GetPriceTrend(world, merchant, newItem("surplus")), "品余りは値下がり")
+	assert.Equal(t, PriceTrendSteady, GetPriceTrend(world, merchant, newItem("other")), "取引のない品は平常")
+	assert.Equal(t, PriceTrendSteady, GetPriceTrend(world, world.ECS.NewEntity(), newItem("scarce")), "商人の状態がなければ平常")
 }
 
 func TestGetItemValue(t *testing.T) {
@@ -57,6 +57,11 @@ func GetRunStats(world w.World) *gc.RunStats {
 	return GetSingleton[gc.RunStats](world, world.Components.RunStats)
 }
 
+// GetQuestLog はシングルトンからクエストの記録を取得する
+func GetQuestLog(world w.World) *gc.QuestLog {
+	return GetSingleton[gc.QuestLog](world, world.Components.QuestLog)
+}
+
 // T は現在の設定言語での msgid の訳を返す。現在言語は UserSettings、マスタは Resources.I18N から引く。
 // args を渡すと訳を書式として整形する。"%s攻撃力" のようにデータ値を差し込む訳に使う。
 func T(world w.World, msgid string, args ...any) string {
@@ -210,6 +215,22 @@ func UpdateCharacterPositionInIndex(world w.World, entity ecs.Entity, from, to c
 	si.MoveCharacter(from, to, entity)
 }
 
+// UpdateBlockerInIndex はエンティティの通行・視線の遮蔽を空間インデックスへ増分反映する。
+// ドアの開閉や物の移動の後に呼び、全再構築のチャーンと古い遮蔽の残留を避ける。
+// from は移動前の座標で、移動でない場合は nil を渡す。移動前のタイルは遮蔽なしに戻す。
+// インデックスが未構築なら何もしない。
+func UpdateBlockerInIndex(world w.World, entity ecs.Entity, from *consts.Coord[consts.Tile]) {
+	si := GetSingleton[gc.SpatialIndex](world, world.Components.SpatialIndex)
+	if si == nil || !world.Components.GridElement.Has(entity) {
+		return
+	}
+	if from != nil {
+		si.SetBlocker(*from, false, false)
+	}
+	si.SetBlocker(world.Components.GridElement.Get(entity).Coord,
+		world.Components.BlockPass.Has(entity), world.Components.BlockView.Has(entity))
+}
+
 // buildSpatialIndex は壁・キャラクター・プレイヤーの位置をスキャンしてインデックスを構築する
 func buildSpatialIndex(world w.World, si *gc.SpatialIndex) {
 	dungeon := GetDungeon(world)
@@ -220,6 +241,7 @@ func buildSpatialIndex(world w.World, si *gc.SpatialIndex) {
 	si.MapWidth = field.Level.TileWidth
 	si.MapHeight = field.Level.TileHeight
 	si.BlockPass = make(map[gc.GridElement]bool)
+	si.BlockView = gc.NewBitGrid(si.MapWidth, si.MapHeight)
 	si.Characters = make(map[gc.GridElement]ecs.Entity)
 	si.PlayerEntity = nil
 
@@ -234,6 +256,17 @@ func buildSpatialIndex(world w.World, si *gc.SpatialIndex) {
 		si.BlockPass[*grid] = true
 	}
 
+	// 視線遮蔽のビット集合構築。BlockPass と同じく退避中ステージの物は混ぜない
+	blockViewQuery := ActiveFilter2[gc.GridElement, gc.BlockView](world).Query()
+	for blockViewQuery.Next() {
+		entity := blockViewQuery.Entity()
+		if world.Components.Dead.Has(entity) {
+			continue
+		}
+		grid := world.Components.GridElement.Get(entity)
+		si.BlockView.Set(grid.Coord, true)
+	}
+
 	// キャラクター位置のインデックス構築。退避中ステージのキャラクターは現ステージに混ぜない
 	characterQuery := ActiveFilter1[gc.GridElement](world).Query()
 	for characterQuery.Next() {
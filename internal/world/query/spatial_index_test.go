@@ -186,5 +186,77 @@ func TestInvalidateSpatialIndex_全マップがクリアされる(t *testing.T)
 	assert.False(t, si2.Built)
 	assert.Nil(t, si2.Characters)
 	assert.Nil(t, si2.BlockPass)
+	assert.Zero(t, si2.BlockView)
 	assert.Nil(t, si2.PlayerEntity)
 }
+
+func TestBuildSpatialIndex_BlockViewはビット集合に載る(t *testing.T) {
+	t.Parallel()
+	world := testutil.InitTestWorld(t)
+
+	wallPos := consts.Coord[consts.Tile]{X: 3, Y: 4}
+	wall := world.ECS.NewEntity()
+	world.Components.GridElement.Add(wall, &gc.GridElement{Coord: wallPos})
+	world.Components.BlockView.Add(wall, &gc.BlockView{})
+
+	floorPos := consts.Coord[consts.Tile]{X: 5, Y: 6}
+	floor := world.ECS.NewEntity()
+	world.Components.GridElement.Add(floor, &gc.GridElement{Coord: floorPos})
+
+	query.InvalidateSpatialIndex(world)
+	si := query.GetSpatialIndex(world)
+	require.NotNil(t, si)
+
+	assert.True(t, si.IsBlockView(wallPos), "BlockViewを持つタイルは視線を遮る")
+	assert.False(t, si.IsBlockView(floorPos), "床は視線を遮らない")
+	assert.False(t, si.IsBlockView(consts.Coord[consts.Tile]{X: -1, Y: 4}), "マップ外は遮らない")
+}
+
+func TestUpdateBlockerInIndex_ドアの開閉を再構築せずに反映する(t *testing.T) {
+	t.Parallel()
+	world := testutil.InitTestWorld(t)
+
+	doorPos := consts.Coord[consts.Tile]{X: 7, Y: 7}
+	door, err := lifecycle.SpawnDoor(world, doorPos, gc.DoorOrientationVertical)
+	require.NoError(t, err)
+
+	query.InvalidateSpatialIndex(world)
+	si := query.GetSpatialIndex(world)
+	require.NotNil(t, si)
+	builds := si.BuildCount
+	require.True(t, si.IsBlockView(doorPos), "閉じたドアは視線を遮る")
+	require.True(t, si.IsBlockPass(doorPos), "閉じたドアは通れない")
+
+	require.NoError(t, lifecycle.OpenDoor(world, door))
+	assert.False(t, si.IsBlockView(doorPos), "開いたドアは視線を通す")
+	assert.False(t, si.IsBlockPass(doorPos), "開いたドアは通れる")
+
+	require.NoError(t, lifecycle.CloseDoor(world, door))
+	assert.True(t, si.IsBlockView(doorPos), "閉じ直すと再び遮る")
+	assert.True(t, si.IsBlockPass(doorPos))
+
+	assert.Equal(t, builds, si.BuildCount, "開閉は増分更新で、再構築を起こさない")
+}
+
+func TestUpdateBlockerInIndex_移動元を空けて移動先を塞ぐ(t *testing.T) {
+	t.Parallel()
+	world := testutil.InitTestWorld(t)
+
+	from := consts.Coord[consts.Tile]{X: 4, Y: 4}
+	to := consts.Coord[consts.Tile]{X: 5, Y: 4}
+	crate := world.ECS.NewEntity()
+	world.Components.GridElement.Add(crate, &gc.GridElement{Coord: from})
+	world.Components.BlockPass.Add(crate, &gc.BlockPass{})
+
+	query.InvalidateSpatialIndex(world)
+	si := query.GetSpatialIndex(world)
+	require.NotNil(t, si)
+	require.True(t, si.IsBlockPass(from))
+
+	world.Components.GridElement.Get(crate).Coord = to
+	query.UpdateBlockerInIndex(world, crate, &from)
+
+	assert.False(t, si.IsBlockPass(from), "移動元は空く")
+	assert.True(t, si.IsBlockPass(to), "移動先が塞がる")
+	assert.False(t, si.IsBlockView(to), "BlockViewを持たない物は視線を遮らない")
+}
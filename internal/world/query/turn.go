@@ -17,6 +17,10 @@ const (
 	speedMinimum           = 25  // Speedの最小値（基本値の1/4）
 )
 
+// stunRecoverySteps は感電で痺れたときの AP 回復の段階数。重症度1段ごとに回復が 1/stunRecoverySteps ずつ減り、
+// 重度では回復しない。AP が負のまま残るので、その手番は行動できずに過ぎる
+const stunRecoverySteps = 3
+
 // CanPlayerAct はプレイヤーが行動可能かを判定する
 // プレイヤーターンかつAP >= 0 の場合にtrueを返す
 func CanPlayerAct(world w.World) bool {
@@ -80,8 +84,8 @@ func RestoreAllActionPoints(world w.World) error {
 		tb.Speed = speed
 		tb.AP.Max = maxAP
 
-		// 現在AP + Speed で上限まで回復
-		newAP := min(tb.AP.Current+speed, maxAP)
+		// 現在AP + Speed で上限まで回復する。感電で痺れていれば回復が減る
+		newAP := min(tb.AP.Current+stunnedRecovery(world, entity, speed), maxAP)
 		tb.AP.Current = newAP
 
 		log.Debug("action points restored",
@@ -94,6 +98,18 @@ func RestoreAllActionPoints(world w.World) error {
 	return err
 }
 
+// stunnedRecovery は entity が1ターンに回復する AP を、感電の重症度で減らして返す
+func stunnedRecovery(world w.World, entity ecs.Entity, speed int) int {
+	if !world.Components.HealthStatus.Has(entity) {
+		return speed
+	}
+	cond := world.Components.HealthStatus.Get(entity).Parts[gc.BodyPartWholeBody].GetCondition(gc.ConditionShocked)
+	if cond == nil {
+		return speed
+	}
+	return speed * max(stunRecoverySteps-int(cond.Severity), 0) / stunRecoverySteps
+}
+
 // CalculateMaxActionPoints はエンティティの最大アクションポイントを計算する
 // 敏捷性を重視したAP計算式
 func CalculateMaxActionPoints(world w.World, entity ecs.Entity) (int, error) {
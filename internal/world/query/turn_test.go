@@ -130,3 +130,54 @@ func TestRestoreAllActionPoints(t *testing.T) {
 	assert.Positive(t, tb.AP.Current, "APが回復している")
 	assert.LessOrEqual(t, tb.AP.Current, initialMax, "APは最大値を超えない")
 }
+
+func TestRestoreAllActionPoints_感電で回復が減る(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		severity gc.Severity
+		steps    int // 回復する 1/3 の数
+	}{
+		{"感電なし", gc.SeverityNone, 3},
+		{"軽度", gc.SeverityMinor, 2},
+		{"中度", gc.SeverityMedium, 1},
+		{"重度は回復せず手番を失う", gc.SeveritySevere, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			world := testutil.InitTestWorld(t)
+			player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 5, Y: 5}, "ash")
+			require.NoError(t, err)
+			if tt.severity != gc.SeverityNone {
+				world.Components.HealthStatus.Get(player).Parts[gc.BodyPartWholeBody].SetCondition(gc.HealthCondition{
+					Type:     gc.ConditionShocked,
+					Severity: tt.severity,
+				})
+			}
+			tb := world.Components.TurnBased.Get(player)
+			tb.AP.Current = -tb.AP.Max
+
+			require.NoError(t, query.RestoreAllActionPoints(world))
+
+			assert.Equal(t, -tb.AP.Max+tb.Speed*tt.steps/3, tb.AP.Current)
+		})
+	}
+
+	t.Run("重度ならプレイヤーは行動できない", func(t *testing.T) {
+		t.Parallel()
+		world := testutil.InitTestWorld(t)
+		player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 5, Y: 5}, "ash")
+		require.NoError(t, err)
+		world.Components.HealthStatus.Get(player).Parts[gc.BodyPartWholeBody].SetCondition(gc.HealthCondition{
+			Type:     gc.ConditionShocked,
+			Severity: gc.SeveritySevere,
+		})
+		world.Components.TurnBased.Get(player).AP.Current = -consts.StandardActionCost
+
+		require.NoError(t, query.RestoreAllActionPoints(world))
+
+		assert.False(t, query.CanPlayerAct(world), "痺れている間は手番が回ってこない")
+	})
+}
@@ -0,0 +1,23 @@
+package query
+
+import (
+	gc "github.com/kijimaD/ruins/internal/components"
+	w "github.com/kijimaD/ruins/internal/world"
+)
+
+// CurrentWeather は現在地の天候を返す。天候は地上だけのもので、遺跡の中では常に晴れ扱いになる。
+// 天候は帯の RunSeed と経過ターンの純関数なので、保存せずに毎回導く
+func CurrentWeather(world w.World) gc.Weather {
+	if !IsOnOverworld(world) {
+		return gc.WeatherClear
+	}
+	return gc.WeatherAt(GetSeamlessBand(world).RunSeed, GetGameTime(world).TotalTurns)
+}
+
+// WeatherForecast は現在の天候から count 期間ぶんの予報を返す。地上にいなければ nil を返す
+func WeatherForecast(world w.World, count int) []gc.WeatherPeriod {
+	if !IsOnOverworld(world) {
+		return nil
+	}
+	return gc.WeatherForecast(GetSeamlessBand(world).RunSeed, GetGameTime(world).TotalTurns, count)
+}
@@ -0,0 +1,46 @@
+package query
+
+import (
+	"testing"
+
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrentWeather(t *testing.T) {
+	t.Parallel()
+
+	t.Run("地上では RunSeed と経過ターンから天候が決まる", func(t *testing.T) {
+		t.Parallel()
+		world := testutil.InitTestWorld(t)
+		GetDungeon(world).CurrentStage = gc.NewOverworldStage()
+		sb := EnsureSeamlessBand(world)
+		sb.RunSeed = 12345
+		gt := GetGameTime(world)
+		gt.TotalTurns = 3*gc.WeatherPeriodTurns + 1
+
+		assert.Equal(t, gc.WeatherAt(12345, gt.TotalTurns), CurrentWeather(world))
+	})
+
+	t.Run("遺跡の中は晴れ扱い", func(t *testing.T) {
+		t.Parallel()
+		world := testutil.InitTestWorld(t)
+		GetDungeon(world).CurrentStage = gc.NewDungeonStage("テスト遺跡", 1)
+
+		assert.Equal(t, gc.WeatherClear, CurrentWeather(world))
+		assert.Nil(t, WeatherForecast(world, 3), "遺跡の中では予報を出さない")
+	})
+}
+
+func TestWeatherForecast(t *testing.T) {
+	t.Parallel()
+	world := testutil.InitTestWorld(t)
+	GetDungeon(world).CurrentStage = gc.NewOverworldStage()
+	EnsureSeamlessBand(world).RunSeed = 777
+
+	forecast := WeatherForecast(world, 4)
+	require.Len(t, forecast, 4)
+	assert.Equal(t, CurrentWeather(world), forecast[0].Weather, "先頭は現在の天候")
+}
@@ -3,6 +3,7 @@ package world
 
 import (
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/kijimaD/ruins/internal/audio"
 	gc "github.com/kijimaD/ruins/internal/components"
 	"github.com/kijimaD/ruins/internal/config"
 	"github.com/kijimaD/ruins/internal/gamelog"
@@ -53,6 +54,7 @@ func InitWorld(c *gc.Components, cfg *config.Config) (World, error) {
 		Renderers:  make(map[string]Renderer),
 	}
 	world.Resources.Config = cfg
+	world.Resources.Audio.SetVolume(audio.UserVolume(cfg.User))
 
 	world.InitSingleton()
 
@@ -76,6 +78,7 @@ func (world World) InitSingleton() {
 	world.Components.UserSettings.Add(singleton, gc.NewUserSettings(world.Resources.Config.User.Language))
 	world.Components.AuctionHistory.Add(singleton, gc.NewAuctionHistory())
 	world.Components.RunStats.Add(singleton, &gc.RunStats{})
+	world.Components.QuestLog.Add(singleton, &gc.QuestLog{})
 	world.Resources.SingletonEntity = singleton
 }
 
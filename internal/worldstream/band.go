@@ -77,7 +77,8 @@ func (b *Band) ShouldShiftWest(playerLocalX consts.Tile) bool {
 // ShiftEast は帯を東へ1チャンク進める。
 // 西端列の破棄 → リベース → 座標キー Map 追従 → eastIndex 前進 → 東端列の生成。
 func (b *Band) ShiftEast(world w.World, gen ChunkGen) error {
-	// 1. 西端の列を全行破棄する。前線が呑む。プレイヤーは残す
+	// 1. 西端の列を全行破棄する。前線が呑む。プレイヤーは残す。戻ってきたときのため差分を記録しておく
+	b.recordEvictedColumn(world, b.eastIndex, 0)
 	RemoveEntitiesInXRange(world, 0, b.chunkW, KeepPlayer(world))
 	// 2. リベース。全エンティティを西へ chunkW ずらしてプレイヤーを中央へ戻す
 	TranslateAllEntities(world, -b.chunkW, 0)
@@ -103,7 +104,8 @@ func (b *Band) ShiftWest(world w.World, gen ChunkGen) error {
 	if b.eastIndex <= 0 {
 		return fmt.Errorf("ShiftWest can only be called when eastIndex > 0: eastIndex=%d", b.eastIndex)
 	}
-	// 東端の列を全行破棄する
+	// 東端の列を全行破棄する。差分を記録してから消す
+	b.recordEvictedColumn(world, b.eastIndex+b.cols-1, (b.cols - 1).Tiles(b.chunkW))
 	RemoveEntitiesInXRange(world, (b.cols - 1).Tiles(b.chunkW), b.Width(), KeepPlayer(world))
 	// リベース：全エンティティを東へ chunkW
 	TranslateAllEntities(world, b.chunkW, 0)
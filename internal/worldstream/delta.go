@@ -0,0 +1,296 @@
+package worldstream
+
+import (
+	"cmp"
+	"slices"
+
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/consts"
+	w "github.com/kijimaD/ruins/internal/world"
+	"github.com/kijimaD/ruins/internal/world/lifecycle"
+	"github.com/kijimaD/ruins/internal/world/query"
+
+	"github.com/mlange-42/ark/ecs"
+)
+
+// チャンク差分は、帯から外れたチャンクを再び生成したときに荒らした跡を戻すための記録。
+//
+// チャンクは seed から決定的に生成されるので、生成物は「チャンク内の相対位置 + raw id」で
+// 再生成をまたいで同定できる。生成時に生成物へ ChunkOrigin を付けて一覧を控え、帯から外れるときに
+// 生き残りと突き合わせて失われたもの・開いた扉・外した錠・持ち込まれたアイテムを SeamlessBand.Chunks に畳む。
+// 再生成のときはその差分を当て直す。
+//
+// 追跡するのは raw id を持つ実体と扉だけ。敵の移動先やアイテムの劣化は持ち越さず、
+// 生き残った敵は生成位置に、持ち込んだアイテムは新品で戻る。
+//
+// 敵はチャンクの境を越えて歩くので、生き残りは位置でなく ChunkOrigin で数える。
+// 生まれたチャンクが外れても帯内を歩いている敵は生き残りとし、そのまま帯内に残す。
+// 歩いた先のチャンクと一緒に破棄された敵は、生まれたチャンクの生成記録から外して倒したことにしない。
+// どちらの場合も、生まれたチャンクを再生成すると生成位置に戻る。ただしまだ帯内を歩いていれば生成し直さない。
+
+// chunkRegion は帯ローカル座標でのチャンクの範囲 [x0, x0+w) × [y0, y0+h)
+type chunkRegion struct {
+	x0, y0 consts.Tile
+	w, h   consts.Tile
+}
+
+func (r chunkRegion) contains(c consts.Coord[consts.Tile]) bool {
+	return c.X >= r.x0 && c.X < r.x0+r.w && c.Y >= r.y0 && c.Y < r.y0+r.h
+}
+
+func (r chunkRegion) relative(c consts.Coord[consts.Tile]) consts.Coord[consts.Tile] {
+	return consts.Coord[consts.Tile]{X: c.X - r.x0, Y: c.Y - r.y0}
+}
+
+// TrackChunkDeltas は gen を包み、生成したチャンクに記録済みの差分を当て直す。
+// 生成物には ChunkOrigin を付け、帯から外れるときの差分計算に備える。
+// 帯の永続状態が無いときは差分を扱わず gen をそのまま呼ぶ。
+func TrackChunkDeltas(world w.World, gen ChunkGen, chunkW, chunkH consts.Tile) ChunkGen {
+	return func(c consts.Coord[consts.Chunk], offsetX, offsetY consts.Tile) error {
+		region := chunkRegion{x0: offsetX, y0: offsetY, w: chunkW, h: chunkH}
+		before := map[ecs.Entity]bool{}
+		for _, entity := range trackedEntitiesIn(world, region) {
+			before[entity] = true
+		}
+
+		if err := gen(c, offsetX, offsetY); err != nil {
+			return err
+		}
+		if query.GetSeamlessBand(world) == nil {
+			return nil
+		}
+
+		var generated []ecs.Entity
+		for _, entity := range trackedEntitiesIn(world, region) {
+			if !before[entity] {
+				generated = append(generated, entity)
+			}
+		}
+		keys := make([]gc.ChunkEntityKey, len(generated))
+		for i, entity := range generated {
+			keys[i] = chunkEntityKey(world, entity, region)
+			world.Components.ChunkOrigin.Add(entity, &gc.ChunkOrigin{Chunk: c, Key: keys[i], Locked: world.Components.Lock.Has(entity)})
+		}
+		slices.SortFunc(keys, compareChunkEntityKey)
+
+		delta := findChunkDelta(world, c)
+		delta.Generated = keys
+		removed := append(slices.Clone(delta.Removed), wanderingKeys(world, c, generated)...)
+		openDoors, unlocked, dropped := delta.OpenDoors, delta.Unlocked, delta.Dropped
+		pruneChunkDeltas(world)
+
+		return applyChunkDelta(world, generated, region, removed, openDoors, unlocked, dropped)
+	}
+}
+
+// RecordChunkDelta はチャンク c が帯から外れる直前に、生成時からの差分を記録する。
+// 領域内の実体はこの後に破棄される前提で、ここでは読むだけで消さない。
+// c で生まれて領域の外を歩いている敵も生き残りに数え、
+// 他のチャンクで生まれて領域内にいる敵は生まれたチャンクの生成記録から外す。
+func RecordChunkDelta(world w.World, c consts.Coord[consts.Chunk], offsetX, offsetY, chunkW, chunkH consts.Tile) {
+	if query.GetSeamlessBand(world) == nil {
+		return
+	}
+	region := chunkRegion{x0: offsetX, y0: offsetY, w: chunkW, h: chunkH}
+
+	var survivors, openDoors, unlocked, dropped []gc.ChunkEntityKey
+	for _, entity := range trackedEntities(world) {
+		inRegion := region.contains(world.Components.GridElement.Get(entity).Coord)
+		if world.Components.ChunkOrigin.Has(entity) {
+			origin := world.Components.ChunkOrigin.Get(entity)
+			// 持ち出されたアイテムは持ち出した先の差分で扱う。位置を問わないのは歩く実体だけ
+			if origin.Chunk == c && (inRegion || !isDroppedItem(world, entity)) {
+				survivors = append(survivors, origin.Key)
+				if world.Components.Door.Has(entity) && world.Components.Door.Get(entity).IsOpen {
+					openDoors = append(openDoors, origin.Key)
+				}
+				if origin.Locked && !world.Components.Lock.Has(entity) {
+					unlocked = append(unlocked, origin.Key)
+				}
+				continue
+			}
+			if inRegion && !isDroppedItem(world, entity) {
+				forgetGenerated(world, origin.Chunk, origin.Key)
+				continue
+			}
+		}
+		if inRegion && isDroppedItem(world, entity) {
+			dropped = append(dropped, chunkEntityKey(world, entity, region))
+		}
+	}
+
+	delta := findChunkDelta(world, c)
+	delta.Removed = subtractKeys(delta.Generated, survivors)
+	delta.Generated = nil
+	slices.SortFunc(openDoors, compareChunkEntityKey)
+	delta.OpenDoors = openDoors
+	slices.SortFunc(unlocked, compareChunkEntityKey)
+	delta.Unlocked = unlocked
+	slices.SortFunc(dropped, compareChunkEntityKey)
+	delta.Dropped = dropped
+	pruneChunkDeltas(world)
+}
+
+// recordEvictedColumn は帯から外れるチャンク列 chunkX の全行について差分を記録する
+func (b *Band) recordEvictedColumn(world w.World, chunkX consts.Chunk, offsetX consts.Tile) {
+	for cy := range b.rows {
+		RecordChunkDelta(world, consts.Coord[consts.Chunk]{X: chunkX, Y: cy}, offsetX, cy.Tiles(b.chunkH), b.chunkW, b.chunkH)
+	}
+}
+
+// applyChunkDelta は生成直後の実体に記録済みの差分を当てる
+func applyChunkDelta(world w.World, generated []ecs.Entity, region chunkRegion, removed, openDoors, unlocked, dropped []gc.ChunkEntityKey) error {
+	toRemove := countKeys(removed)
+	toOpen := countKeys(openDoors)
+	toUnlock := countKeys(unlocked)
+	var removeEntities []ecs.Entity
+	for _, entity := range generated {
+		key := world.Components.ChunkOrigin.Get(entity).Key
+		if toRemove[key] > 0 {
+			toRemove[key]--
+			removeEntities = append(removeEntities, entity)
+			continue
+		}
+		if toUnlock[key] > 0 && world.Components.Lock.Has(entity) {
+			toUnlock[key]--
+			lifecycle.Unlock(world, entity)
+		}
+		if toOpen[key] > 0 && world.Components.Door.Has(entity) {
+			toOpen[key]--
+			if err := lifecycle.OpenDoor(world, entity); err != nil {
+				return err
+			}
+		}
+	}
+	removeOwnedStorage(world, removeEntities)
+	for _, entity := range removeEntities {
+		world.ECS.RemoveEntity(entity)
+	}
+
+	for _, key := range dropped {
+		if _, err := lifecycle.SpawnFieldItem(world, key.ID, region.x0+key.Pos.X, region.y0+key.Pos.Y, 1); err != nil {
+			return err
+		}
+	}
+	if len(removeEntities) > 0 || len(dropped) > 0 {
+		query.InvalidateSpatialIndex(world)
+	}
+	return nil
+}
+
+// trackedEntities は帯内の追跡対象を返す。地形タイルとプレイヤーは除く
+func trackedEntities(world w.World) []ecs.Entity {
+	var entities []ecs.Entity
+	q := query.ActiveFilter1[gc.GridElement](world).Query()
+	for q.Next() {
+		entity := q.Entity()
+		if world.Components.Tile.Has(entity) || world.Components.Player.Has(entity) {
+			continue
+		}
+		if !world.Components.RawID.Has(entity) && !world.Components.Door.Has(entity) {
+			continue
+		}
+		entities = append(entities, entity)
+	}
+	return entities
+}
+
+// trackedEntitiesIn は領域内の追跡対象を返す
+func trackedEntitiesIn(world w.World, region chunkRegion) []ecs.Entity {
+	return slices.DeleteFunc(trackedEntities(world), func(entity ecs.Entity) bool {
+		return !region.contains(world.Components.GridElement.Get(entity).Coord)
+	})
+}
+
+// wanderingKeys は c で生まれていまも帯内を歩いている敵のキーを返す。generated は今回生成した実体で、数えない。
+// 再生成で同じ敵を2体にしないよう、生成し直した側を取り除くのに使う
+func wanderingKeys(world w.World, c consts.Coord[consts.Chunk], generated []ecs.Entity) []gc.ChunkEntityKey {
+	var keys []gc.ChunkEntityKey
+	for _, entity := range trackedEntities(world) {
+		if slices.Contains(generated, entity) || !world.Components.ChunkOrigin.Has(entity) || isDroppedItem(world, entity) {
+			continue
+		}
+		if origin := world.Components.ChunkOrigin.Get(entity); origin.Chunk == c {
+			keys = append(keys, origin.Key)
+		}
+	}
+	return keys
+}
+
+// forgetGenerated はチャンク c の生成記録から key を1つ外す。生成記録の無い帯外のチャンクでは何もしない。
+// 外したものは c が帯から外れるときに失われたものとして数えず、再生成で戻る
+func forgetGenerated(world w.World, c consts.Coord[consts.Chunk], key gc.ChunkEntityKey) {
+	sb := query.GetSeamlessBand(world)
+	idx := slices.IndexFunc(sb.Chunks, func(d gc.ChunkDelta) bool { return d.Chunk == c })
+	if idx < 0 {
+		return
+	}
+	delta := &sb.Chunks[idx]
+	if i := slices.Index(delta.Generated, key); i >= 0 {
+		delta.Generated = slices.Delete(delta.Generated, i, i+1)
+	}
+}
+
+// isDroppedItem は地面に置かれた持ち込みのアイテムかを返す
+func isDroppedItem(world w.World, entity ecs.Entity) bool {
+	return query.IsPickable(entity, world) && world.Components.RawID.Has(entity)
+}
+
+func chunkEntityKey(world w.World, entity ecs.Entity, region chunkRegion) gc.ChunkEntityKey {
+	key := gc.ChunkEntityKey{Pos: region.relative(world.Components.GridElement.Get(entity).Coord)}
+	if world.Components.RawID.Has(entity) {
+		key.ID = world.Components.RawID.Get(entity).ID
+	} else {
+		key.ID = gc.ChunkDoorID
+	}
+	return key
+}
+
+// findChunkDelta はチャンク c の差分を返す。無ければ追加する
+func findChunkDelta(world w.World, c consts.Coord[consts.Chunk]) *gc.ChunkDelta {
+	sb := query.GetSeamlessBand(world)
+	idx := slices.IndexFunc(sb.Chunks, func(d gc.ChunkDelta) bool { return d.Chunk == c })
+	if idx < 0 {
+		sb.Chunks = append(sb.Chunks, gc.ChunkDelta{Chunk: c})
+		idx = len(sb.Chunks) - 1
+	}
+	return &sb.Chunks[idx]
+}
+
+// pruneChunkDeltas は帯外にあって当て直すものが無い差分を捨て、記録が際限なく増えないようにする
+func pruneChunkDeltas(world w.World) {
+	sb := query.GetSeamlessBand(world)
+	sb.Chunks = slices.DeleteFunc(sb.Chunks, func(d gc.ChunkDelta) bool {
+		return len(d.Generated) == 0 && len(d.Removed) == 0 && len(d.OpenDoors) == 0 && len(d.Unlocked) == 0 && len(d.Dropped) == 0
+	})
+}
+
+func countKeys(keys []gc.ChunkEntityKey) map[gc.ChunkEntityKey]int {
+	counts := make(map[gc.ChunkEntityKey]int, len(keys))
+	for _, k := range keys {
+		counts[k]++
+	}
+	return counts
+}
+
+// subtractKeys は多重集合として all から keep を引いた残りを返す
+func subtractKeys(all, keep []gc.ChunkEntityKey) []gc.ChunkEntityKey {
+	remaining := countKeys(keep)
+	var out []gc.ChunkEntityKey
+	for _, k := range all {
+		if remaining[k] > 0 {
+			remaining[k]--
+			continue
+		}
+		out = append(out, k)
+	}
+	return out
+}
+
+func compareChunkEntityKey(a, b gc.ChunkEntityKey) int {
+	return cmp.Or(
+		cmp.Compare(a.Pos.Y, b.Pos.Y),
+		cmp.Compare(a.Pos.X, b.Pos.X),
+		cmp.Compare(a.ID, b.ID),
+	)
+}
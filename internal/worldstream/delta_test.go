@@ -0,0 +1,258 @@
+package worldstream_test
+
+import (
+	"testing"
+
+	gc "github.com/kijimaD/ruins/internal/components"
+	"github.com/kijimaD/ruins/internal/consts"
+	"github.com/kijimaD/ruins/internal/testutil"
+	w "github.com/kijimaD/ruins/internal/world"
+	"github.com/kijimaD/ruins/internal/world/lifecycle"
+	"github.com/kijimaD/ruins/internal/world/query"
+	"github.com/kijimaD/ruins/internal/worldstream"
+	"github.com/mlange-42/ark/ecs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// entityAt は帯ローカル座標 pos にいる、条件に合う実体を返す
+func entityAt(world w.World, pos consts.Coord[consts.Tile], match func(ecs.Entity) bool) (ecs.Entity, bool) {
+	q := query.ActiveFilter1[gc.GridElement](world).Query()
+	for q.Next() {
+		entity := q.Entity()
+		if world.Components.GridElement.Get(entity).Coord == pos && match(entity) {
+			q.Close()
+			return entity, true
+		}
+	}
+	return ecs.Entity{}, false
+}
+
+func rawIDIs(world w.World, id string) func(ecs.Entity) bool {
+	return func(entity ecs.Entity) bool {
+		return world.Components.RawID.Has(entity) && world.Components.RawID.Get(entity).ID == id
+	}
+}
+
+// TestTrackChunkDeltas_帯を往復しても変更が残る は、帯から外れたチャンクへ戻ったとき
+// 拾ったアイテムは消えたまま、開けた扉は開いたまま、置いたアイテムは置いたまま再生成されることを検証する。
+func TestTrackChunkDeltas_帯を往復しても変更が残る(t *testing.T) {
+	t.Parallel()
+
+	world := testutil.InitTestWorld(t, testutil.WithStageLevel(gc.Level{TileWidth: 300, TileHeight: 60}))
+	query.EnsureSeamlessBand(world).Active = true
+
+	// 各チャンクの (5,10) に剣、(7,10) に扉を決定的に置く
+	gen := worldstream.TrackChunkDeltas(world, func(_ consts.Coord[consts.Chunk], offsetX, offsetY consts.Tile) error {
+		if _, err := lifecycle.SpawnFieldItem(world, "wooden_sword", offsetX+5, offsetY+10, 1); err != nil {
+			return err
+		}
+		_, err := lifecycle.SpawnDoor(world, consts.Coord[consts.Tile]{X: offsetX + 7, Y: offsetY + 10}, gc.DoorOrientationVertical)
+		return err
+	}, 100, 60)
+
+	b := worldstream.NewBand(100, 60, 3, 1)
+	for i := range b.Cols() {
+		require.NoError(t, gen(consts.Coord[consts.Chunk]{X: i, Y: 0}, i.Tiles(100), 0))
+	}
+	player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 210, Y: 30}, "ash")
+	require.NoError(t, err)
+
+	// 西端チャンクを荒らす: 剣を拾い、扉を開け、パンを置く
+	sword, ok := entityAt(world, consts.Coord[consts.Tile]{X: 5, Y: 10}, rawIDIs(world, "wooden_sword"))
+	require.True(t, ok)
+	world.ECS.RemoveEntity(sword)
+	door, ok := entityAt(world, consts.Coord[consts.Tile]{X: 7, Y: 10}, world.Components.Door.Has)
+	require.True(t, ok)
+	require.NoError(t, lifecycle.OpenDoor(world, door))
+	_, err = lifecycle.SpawnFieldItem(world, "bread", 20, 20, 1)
+	require.NoError(t, err)
+
+	// 東へ進んで西端チャンクを捨て、西へ戻って再生成させる
+	require.NoError(t, b.ShiftEast(world, gen))
+	sb := query.GetSeamlessBand(world)
+	require.Len(t, sb.Chunks, 4, "外れたチャンクの差分と帯内3チャンクの生成記録を持つ")
+	world.Components.GridElement.Get(player).X = 90
+	require.NoError(t, b.ShiftWest(world, gen))
+
+	_, ok = entityAt(world, consts.Coord[consts.Tile]{X: 5, Y: 10}, rawIDIs(world, "wooden_sword"))
+	assert.False(t, ok, "拾ったアイテムは再生成されない")
+	door, ok = entityAt(world, consts.Coord[consts.Tile]{X: 7, Y: 10}, world.Components.Door.Has)
+	require.True(t, ok, "扉は再生成される")
+	assert.True(t, world.Components.Door.Get(door).IsOpen, "開けた扉は開いたまま")
+	_, ok = entityAt(world, consts.Coord[consts.Tile]{X: 20, Y: 20}, rawIDIs(world, "bread"))
+	assert.True(t, ok, "置いたアイテムは置いた位置に戻る")
+
+	// 触っていないチャンクは生成どおり
+	_, ok = entityAt(world, consts.Coord[consts.Tile]{X: 105, Y: 10}, rawIDIs(world, "wooden_sword"))
+	assert.True(t, ok, "触っていないチャンクの剣は残る")
+}
+
+// TestTrackChunkDeltas_外した錠は再生成で戻らない は、生成時に錠の掛かっていた扉の錠を外して
+// 帯を往復しても、再生成された扉に錠が掛かり直さないことを検証する。
+func TestTrackChunkDeltas_外した錠は再生成で戻らない(t *testing.T) {
+	t.Parallel()
+
+	world := testutil.InitTestWorld(t, testutil.WithStageLevel(gc.Level{TileWidth: 300, TileHeight: 60}))
+	query.EnsureSeamlessBand(world).Active = true
+
+	// 各チャンクの (7,10) に錠の掛かった扉を決定的に置く
+	gen := worldstream.TrackChunkDeltas(world, func(_ consts.Coord[consts.Chunk], offsetX, offsetY consts.Tile) error {
+		door, err := lifecycle.SpawnDoor(world, consts.Coord[consts.Tile]{X: offsetX + 7, Y: offsetY + 10}, gc.DoorOrientationVertical)
+		if err != nil {
+			return err
+		}
+		return lifecycle.LockEntity(world, door, gc.Lock{Difficulty: 20, KeyID: "storeroom"})
+	}, 100, 60)
+
+	b := worldstream.NewBand(100, 60, 3, 1)
+	for i := range b.Cols() {
+		require.NoError(t, gen(consts.Coord[consts.Chunk]{X: i, Y: 0}, i.Tiles(100), 0))
+	}
+	player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 210, Y: 30}, "ash")
+	require.NoError(t, err)
+
+	door, ok := entityAt(world, consts.Coord[consts.Tile]{X: 7, Y: 10}, world.Components.Door.Has)
+	require.True(t, ok)
+	lifecycle.Unlock(world, door)
+
+	require.NoError(t, b.ShiftEast(world, gen))
+	world.Components.GridElement.Get(player).X = 90
+	require.NoError(t, b.ShiftWest(world, gen))
+
+	door, ok = entityAt(world, consts.Coord[consts.Tile]{X: 7, Y: 10}, world.Components.Door.Has)
+	require.True(t, ok, "扉は再生成される")
+	assert.False(t, world.Components.Lock.Has(door), "外した錠は戻らない")
+	other, ok := entityAt(world, consts.Coord[consts.Tile]{X: 107, Y: 10}, world.Components.Door.Has)
+	require.True(t, ok)
+	assert.True(t, world.Components.Lock.Has(other), "触っていないチャンクの扉は錠が掛かったまま")
+}
+
+// TestRecordChunkDelta_変更の無いチャンクは記録を残さない は、
+// 差分の記録が訪れたチャンクの数だけ増え続けないことを検証する。
+func TestRecordChunkDelta_変更の無いチャンクは記録を残さない(t *testing.T) {
+	t.Parallel()
+
+	world := testutil.InitTestWorld(t, testutil.WithStageLevel(gc.Level{TileWidth: 300, TileHeight: 60}))
+	query.EnsureSeamlessBand(world).Active = true
+
+	gen := worldstream.TrackChunkDeltas(world, func(_ consts.Coord[consts.Chunk], offsetX, offsetY consts.Tile) error {
+		_, err := lifecycle.SpawnFieldItem(world, "wooden_sword", offsetX+5, offsetY+10, 1)
+		return err
+	}, 100, 60)
+
+	b := worldstream.NewBand(100, 60, 3, 1)
+	for i := range b.Cols() {
+		require.NoError(t, gen(consts.Coord[consts.Chunk]{X: i, Y: 0}, i.Tiles(100), 0))
+	}
+	_, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 210, Y: 30}, "ash")
+	require.NoError(t, err)
+
+	require.NoError(t, b.ShiftEast(world, gen))
+
+	sb := query.GetSeamlessBand(world)
+	for _, d := range sb.Chunks {
+		assert.NotEqual(t, consts.Chunk(0), d.Chunk.X, "外れた無変更のチャンクは記録から消える")
+		assert.NotEmpty(t, d.Generated, "帯内のチャンクは生成記録を持つ")
+	}
+	assert.Len(t, sb.Chunks, 3)
+}
+
+// countRawID は帯内で raw id が id の実体を数える
+func countRawID(world w.World, id string) int {
+	n := 0
+	q := query.ActiveFilter1[gc.GridElement](world).Query()
+	for q.Next() {
+		if rawIDIs(world, id)(q.Entity()) {
+			n++
+		}
+	}
+	return n
+}
+
+// TestTrackChunkDeltas_境を越えた敵は倒したことにならない は、生まれたチャンクから隣へ歩いた敵が
+// 両方のチャンクの破棄と再生成をまたいでも失われず、生成位置に戻ることを検証する。
+func TestTrackChunkDeltas_境を越えた敵は倒したことにならない(t *testing.T) {
+	t.Parallel()
+
+	world := testutil.InitTestWorld(t, testutil.WithStageLevel(gc.Level{TileWidth: 300, TileHeight: 60}))
+	query.EnsureSeamlessBand(world).Active = true
+
+	// 西端チャンクの (5,10) にだけ敵を置く
+	gen := worldstream.TrackChunkDeltas(world, func(c consts.Coord[consts.Chunk], offsetX, offsetY consts.Tile) error {
+		if c.X != 0 {
+			return nil
+		}
+		_, err := lifecycle.SpawnEnemy(world, consts.Coord[consts.Tile]{X: offsetX + 5, Y: offsetY + 10}, "bat")
+		return err
+	}, 100, 60)
+
+	b := worldstream.NewBand(100, 60, 3, 1)
+	for i := range b.Cols() {
+		require.NoError(t, gen(consts.Coord[consts.Chunk]{X: i, Y: 0}, i.Tiles(100), 0))
+	}
+	player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 150, Y: 30}, "ash")
+	require.NoError(t, err)
+
+	// 敵が隣のチャンクへ歩く
+	bat, ok := entityAt(world, consts.Coord[consts.Tile]{X: 5, Y: 10}, rawIDIs(world, "bat"))
+	require.True(t, ok)
+	world.Components.GridElement.Get(bat).X = 120
+
+	// 生まれたチャンク、歩いた先のチャンクの順に捨てる
+	require.NoError(t, b.ShiftEast(world, gen))
+	assert.Equal(t, 1, countRawID(world, "bat"), "生まれたチャンクが外れても歩いた先の敵は残る")
+	require.NoError(t, b.ShiftEast(world, gen))
+	assert.Equal(t, 0, countRawID(world, "bat"))
+
+	// 戻って両方を再生成する
+	world.Components.GridElement.Get(player).X = 90
+	require.NoError(t, b.ShiftWest(world, gen))
+	world.Components.GridElement.Get(player).X = 90
+	require.NoError(t, b.ShiftWest(world, gen))
+
+	_, ok = entityAt(world, consts.Coord[consts.Tile]{X: 5, Y: 10}, rawIDIs(world, "bat"))
+	assert.True(t, ok, "歩いただけの敵は生成位置に戻る")
+	assert.Equal(t, 1, countRawID(world, "bat"))
+}
+
+// TestTrackChunkDeltas_帯内を歩く敵は再生成で増えない は、生まれたチャンクが外れて戻ったとき、
+// まだ隣のチャンクを歩いている敵を生成し直さないことを検証する。
+func TestTrackChunkDeltas_帯内を歩く敵は再生成で増えない(t *testing.T) {
+	t.Parallel()
+
+	world := testutil.InitTestWorld(t, testutil.WithStageLevel(gc.Level{TileWidth: 300, TileHeight: 60}))
+	query.EnsureSeamlessBand(world).Active = true
+
+	gen := worldstream.TrackChunkDeltas(world, func(c consts.Coord[consts.Chunk], offsetX, offsetY consts.Tile) error {
+		if c.X != 0 {
+			return nil
+		}
+		_, err := lifecycle.SpawnEnemy(world, consts.Coord[consts.Tile]{X: offsetX + 5, Y: offsetY + 10}, "bat")
+		return err
+	}, 100, 60)
+
+	b := worldstream.NewBand(100, 60, 3, 1)
+	for i := range b.Cols() {
+		require.NoError(t, gen(consts.Coord[consts.Chunk]{X: i, Y: 0}, i.Tiles(100), 0))
+	}
+	player, err := lifecycle.SpawnPlayer(world, consts.Coord[consts.Tile]{X: 150, Y: 30}, "ash")
+	require.NoError(t, err)
+
+	bat, ok := entityAt(world, consts.Coord[consts.Tile]{X: 5, Y: 10}, rawIDIs(world, "bat"))
+	require.True(t, ok)
+	world.Components.GridElement.Get(bat).X = 120
+
+	require.NoError(t, b.ShiftEast(world, gen))
+	world.Components.GridElement.Get(player).X = 90
+	require.NoError(t, b.ShiftWest(world, gen))
+
+	assert.Equal(t, 1, countRawID(world, "bat"), "歩いている敵がいれば生成し直さない")
+	assert.Equal(t, consts.Tile(120), world.Components.GridElement.Get(bat).X)
+
+	// 歩いていた敵は生まれたチャンクのものとして記録し続ける
+	require.NoError(t, b.ShiftEast(world, gen))
+	world.Components.GridElement.Get(player).X = 90
+	require.NoError(t, b.ShiftWest(world, gen))
+	assert.Equal(t, 1, countRawID(world, "bat"))
+}
@@ -9,6 +9,7 @@
 //   - TranslateAllEntities / RemoveEntitiesInXRange: 帯シフトの原子操作。純 ECS
 //   - ToAbs / ToLocal: 絶対軸 X（consts.AbsTileX）と帯ローカル座標の分離・変換
 //   - Band: 帯の状態と ShiftEast/ShiftWest によるシフト
+//   - TrackChunkDeltas / RecordChunkDelta: 帯から外れたチャンクの差分を SeamlessBand に残し、再生成時に当て直す
 //
 // mapplanner/mapspawner には依存しない。チャンク生成は ChunkGen 注入で分離する。実生成の
 // アダプタは internal/overworld が提供する。